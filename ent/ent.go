@@ -12,13 +12,38 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jorge-j1m/hackspark_server/ent/collection"
+	"github.com/jorge-j1m/hackspark_server/ent/collectionitem"
+	"github.com/jorge-j1m/hackspark_server/ent/comment"
+	"github.com/jorge-j1m/hackspark_server/ent/event"
+	"github.com/jorge-j1m/hackspark_server/ent/eventcriterion"
+	"github.com/jorge-j1m/hackspark_server/ent/eventmember"
+	"github.com/jorge-j1m/hackspark_server/ent/eventscore"
+	"github.com/jorge-j1m/hackspark_server/ent/eventsubmission"
+	"github.com/jorge-j1m/hackspark_server/ent/job"
+	"github.com/jorge-j1m/hackspark_server/ent/joinrequest"
 	"github.com/jorge-j1m/hackspark_server/ent/like"
+	"github.com/jorge-j1m/hackspark_server/ent/milestone"
+	"github.com/jorge-j1m/hackspark_server/ent/notification"
+	"github.com/jorge-j1m/hackspark_server/ent/notificationpreference"
 	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectinvitation"
+	"github.com/jorge-j1m/hackspark_server/ent/projectlink"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmedia"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrevision"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrole"
+	"github.com/jorge-j1m/hackspark_server/ent/projectstatuschange"
 	"github.com/jorge-j1m/hackspark_server/ent/projecttag"
+	"github.com/jorge-j1m/hackspark_server/ent/scheduledrun"
 	"github.com/jorge-j1m/hackspark_server/ent/session"
 	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/ent/task"
+	"github.com/jorge-j1m/hackspark_server/ent/trendingsnapshot"
 	"github.com/jorge-j1m/hackspark_server/ent/user"
 	"github.com/jorge-j1m/hackspark_server/ent/usertechnology"
+	"github.com/jorge-j1m/hackspark_server/ent/webhook"
+	"github.com/jorge-j1m/hackspark_server/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -79,13 +104,38 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			like.Table:           like.ValidColumn,
-			project.Table:        project.ValidColumn,
-			projecttag.Table:     projecttag.ValidColumn,
-			session.Table:        session.ValidColumn,
-			tag.Table:            tag.ValidColumn,
-			user.Table:           user.ValidColumn,
-			usertechnology.Table: usertechnology.ValidColumn,
+			collection.Table:             collection.ValidColumn,
+			collectionitem.Table:         collectionitem.ValidColumn,
+			comment.Table:                comment.ValidColumn,
+			event.Table:                  event.ValidColumn,
+			eventcriterion.Table:         eventcriterion.ValidColumn,
+			eventmember.Table:            eventmember.ValidColumn,
+			eventscore.Table:             eventscore.ValidColumn,
+			eventsubmission.Table:        eventsubmission.ValidColumn,
+			job.Table:                    job.ValidColumn,
+			joinrequest.Table:            joinrequest.ValidColumn,
+			like.Table:                   like.ValidColumn,
+			milestone.Table:              milestone.ValidColumn,
+			notification.Table:           notification.ValidColumn,
+			notificationpreference.Table: notificationpreference.ValidColumn,
+			project.Table:                project.ValidColumn,
+			projectinvitation.Table:      projectinvitation.ValidColumn,
+			projectlink.Table:            projectlink.ValidColumn,
+			projectmedia.Table:           projectmedia.ValidColumn,
+			projectmember.Table:          projectmember.ValidColumn,
+			projectrevision.Table:        projectrevision.ValidColumn,
+			projectrole.Table:            projectrole.ValidColumn,
+			projectstatuschange.Table:    projectstatuschange.ValidColumn,
+			projecttag.Table:             projecttag.ValidColumn,
+			scheduledrun.Table:           scheduledrun.ValidColumn,
+			session.Table:                session.ValidColumn,
+			tag.Table:                    tag.ValidColumn,
+			task.Table:                   task.ValidColumn,
+			trendingsnapshot.Table:       trendingsnapshot.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			usertechnology.Table:         usertechnology.ValidColumn,
+			webhook.Table:                webhook.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -8,6 +8,415 @@ import (
 )
 
 var (
+	// CollectionsColumns holds the columns for the "collections" table.
+	CollectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"public", "private"}, Default: "public"},
+		{Name: "owner_id", Type: field.TypeString},
+	}
+	// CollectionsTable holds the schema information for the "collections" table.
+	CollectionsTable = &schema.Table{
+		Name:       "collections",
+		Columns:    CollectionsColumns,
+		PrimaryKey: []*schema.Column{CollectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "collections_users_collections",
+				Columns:    []*schema.Column{CollectionsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "collection_owner_id_visibility",
+				Unique:  false,
+				Columns: []*schema.Column{CollectionsColumns[6], CollectionsColumns[5]},
+			},
+		},
+	}
+	// CollectionItemsColumns holds the columns for the "collection_items" table.
+	CollectionItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "collection_id", Type: field.TypeString},
+		{Name: "project_id", Type: field.TypeString},
+	}
+	// CollectionItemsTable holds the schema information for the "collection_items" table.
+	CollectionItemsTable = &schema.Table{
+		Name:       "collection_items",
+		Columns:    CollectionItemsColumns,
+		PrimaryKey: []*schema.Column{CollectionItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "collection_items_collections_items",
+				Columns:    []*schema.Column{CollectionItemsColumns[5]},
+				RefColumns: []*schema.Column{CollectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "collection_items_projects_collection_items",
+				Columns:    []*schema.Column{CollectionItemsColumns[6]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "collectionitem_collection_id_project_id",
+				Unique:  true,
+				Columns: []*schema.Column{CollectionItemsColumns[5], CollectionItemsColumns[6]},
+			},
+			{
+				Name:    "collectionitem_collection_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{CollectionItemsColumns[5], CollectionItemsColumns[4]},
+			},
+		},
+	}
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "edited", Type: field.TypeBool, Default: false},
+		{Name: "hidden", Type: field.TypeBool, Default: false},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true},
+		{Name: "project_id", Type: field.TypeString},
+		{Name: "author_id", Type: field.TypeString},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_comments_replies",
+				Columns:    []*schema.Column{CommentsColumns[7]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "comments_projects_comments",
+				Columns:    []*schema.Column{CommentsColumns[8]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comments_users_comments",
+				Columns:    []*schema.Column{CommentsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_project_id_create_time_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[8], CommentsColumns[1], CommentsColumns[0]},
+			},
+			{
+				Name:    "comment_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[7]},
+			},
+			{
+				Name:    "comment_author_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[9]},
+			},
+		},
+	}
+	// EventsColumns holds the columns for the "events" table.
+	EventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "rules", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "frozen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "results_published_at", Type: field.TypeTime, Nullable: true},
+	}
+	// EventsTable holds the schema information for the "events" table.
+	EventsTable = &schema.Table{
+		Name:       "events",
+		Columns:    EventsColumns,
+		PrimaryKey: []*schema.Column{EventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "event_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[7]},
+			},
+			{
+				Name:    "event_ends_at_frozen_at",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[8], EventsColumns[9]},
+			},
+		},
+	}
+	// EventCriterionsColumns holds the columns for the "event_criterions" table.
+	EventCriterionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "weight", Type: field.TypeInt, Default: 1},
+		{Name: "max_score", Type: field.TypeInt, Default: 10},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "event_id", Type: field.TypeString},
+	}
+	// EventCriterionsTable holds the schema information for the "event_criterions" table.
+	EventCriterionsTable = &schema.Table{
+		Name:       "event_criterions",
+		Columns:    EventCriterionsColumns,
+		PrimaryKey: []*schema.Column{EventCriterionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "event_criterions_events_criteria",
+				Columns:    []*schema.Column{EventCriterionsColumns[8]},
+				RefColumns: []*schema.Column{EventsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventcriterion_event_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{EventCriterionsColumns[8], EventCriterionsColumns[7]},
+			},
+		},
+	}
+	// EventMembersColumns holds the columns for the "event_members" table.
+	EventMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"organizer", "judge", "participant"}, Default: "participant"},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// EventMembersTable holds the schema information for the "event_members" table.
+	EventMembersTable = &schema.Table{
+		Name:       "event_members",
+		Columns:    EventMembersColumns,
+		PrimaryKey: []*schema.Column{EventMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "event_members_events_members",
+				Columns:    []*schema.Column{EventMembersColumns[4]},
+				RefColumns: []*schema.Column{EventsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "event_members_users_event_memberships",
+				Columns:    []*schema.Column{EventMembersColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventmember_event_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{EventMembersColumns[4], EventMembersColumns[5]},
+			},
+			{
+				Name:    "eventmember_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{EventMembersColumns[5]},
+			},
+		},
+	}
+	// EventScoresColumns holds the columns for the "event_scores" table.
+	EventScoresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "score", Type: field.TypeInt},
+		{Name: "comment", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "criterion_id", Type: field.TypeString},
+		{Name: "submission_id", Type: field.TypeString},
+		{Name: "judge_id", Type: field.TypeString},
+	}
+	// EventScoresTable holds the schema information for the "event_scores" table.
+	EventScoresTable = &schema.Table{
+		Name:       "event_scores",
+		Columns:    EventScoresColumns,
+		PrimaryKey: []*schema.Column{EventScoresColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "event_scores_event_criterions_scores",
+				Columns:    []*schema.Column{EventScoresColumns[5]},
+				RefColumns: []*schema.Column{EventCriterionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "event_scores_event_submissions_scores",
+				Columns:    []*schema.Column{EventScoresColumns[6]},
+				RefColumns: []*schema.Column{EventSubmissionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "event_scores_users_event_scores",
+				Columns:    []*schema.Column{EventScoresColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventscore_submission_id_criterion_id_judge_id",
+				Unique:  true,
+				Columns: []*schema.Column{EventScoresColumns[6], EventScoresColumns[5], EventScoresColumns[7]},
+			},
+		},
+	}
+	// EventSubmissionsColumns holds the columns for the "event_submissions" table.
+	EventSubmissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "revision_number", Type: field.TypeInt, Nullable: true},
+		{Name: "frozen_name", Type: field.TypeString, Nullable: true},
+		{Name: "frozen_description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "frozen_tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "frozen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "project_id", Type: field.TypeString},
+		{Name: "submitter_id", Type: field.TypeString},
+	}
+	// EventSubmissionsTable holds the schema information for the "event_submissions" table.
+	EventSubmissionsTable = &schema.Table{
+		Name:       "event_submissions",
+		Columns:    EventSubmissionsColumns,
+		PrimaryKey: []*schema.Column{EventSubmissionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "event_submissions_events_submissions",
+				Columns:    []*schema.Column{EventSubmissionsColumns[8]},
+				RefColumns: []*schema.Column{EventsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "event_submissions_projects_event_submissions",
+				Columns:    []*schema.Column{EventSubmissionsColumns[9]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "event_submissions_users_event_submissions",
+				Columns:    []*schema.Column{EventSubmissionsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventsubmission_event_id_project_id",
+				Unique:  true,
+				Columns: []*schema.Column{EventSubmissionsColumns[8], EventSubmissionsColumns[9]},
+			},
+			{
+				Name:    "eventsubmission_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{EventSubmissionsColumns[9]},
+			},
+		},
+	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "succeeded", "dead"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt, Default: 5},
+		{Name: "run_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_status_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[5], JobsColumns[8]},
+			},
+		},
+	}
+	// JoinRequestsColumns holds the columns for the "join_requests" table.
+	JoinRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined", "withdrawn"}, Default: "pending"},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "project_id", Type: field.TypeString},
+		{Name: "role_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// JoinRequestsTable holds the schema information for the "join_requests" table.
+	JoinRequestsTable = &schema.Table{
+		Name:       "join_requests",
+		Columns:    JoinRequestsColumns,
+		PrimaryKey: []*schema.Column{JoinRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "join_requests_projects_join_requests",
+				Columns:    []*schema.Column{JoinRequestsColumns[6]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "join_requests_project_roles_join_requests",
+				Columns:    []*schema.Column{JoinRequestsColumns[7]},
+				RefColumns: []*schema.Column{ProjectRolesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "join_requests_users_join_requests",
+				Columns:    []*schema.Column{JoinRequestsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "joinrequest_project_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{JoinRequestsColumns[6], JoinRequestsColumns[4]},
+			},
+			{
+				Name:    "joinrequest_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{JoinRequestsColumns[8], JoinRequestsColumns[4]},
+			},
+		},
+	}
 	// LikesColumns holds the columns for the "likes" table.
 	LikesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -32,7 +441,7 @@ var (
 				Symbol:     "likes_projects_project",
 				Columns:    []*schema.Column{LikesColumns[4]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
@@ -48,15 +457,138 @@ var (
 			},
 		},
 	}
+	// MilestonesColumns holds the columns for the "milestones" table.
+	MilestonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "due_date", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "project_id", Type: field.TypeString},
+	}
+	// MilestonesTable holds the schema information for the "milestones" table.
+	MilestonesTable = &schema.Table{
+		Name:       "milestones",
+		Columns:    MilestonesColumns,
+		PrimaryKey: []*schema.Column{MilestonesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "milestones_projects_milestones",
+				Columns:    []*schema.Column{MilestonesColumns[8]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "milestone_project_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{MilestonesColumns[8], MilestonesColumns[5]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"project_liked", "project_commented", "comment_replied", "member_invited", "join_requested", "member_joined", "ownership_transferred", "project_remixed"}},
+		{Name: "subject_id", Type: field.TypeString, Nullable: true},
+		{Name: "group_key", Type: field.TypeString},
+		{Name: "actor_ids", Type: field.TypeJSON},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "project_id", Type: field.TypeString, Nullable: true},
+		{Name: "recipient_id", Type: field.TypeString},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_projects_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[8]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "notifications_users_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_recipient_id_group_key",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[9], NotificationsColumns[5]},
+			},
+			{
+				Name:    "notification_recipient_id_update_time",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[9], NotificationsColumns[2]},
+			},
+			{
+				Name:    "notification_recipient_id_read_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[9], NotificationsColumns[7]},
+			},
+		},
+	}
+	// NotificationPreferencesColumns holds the columns for the "notification_preferences" table.
+	NotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"project_liked", "project_commented", "comment_replied", "member_invited", "join_requested", "member_joined", "ownership_transferred", "project_remixed"}},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// NotificationPreferencesTable holds the schema information for the "notification_preferences" table.
+	NotificationPreferencesTable = &schema.Table{
+		Name:       "notification_preferences",
+		Columns:    NotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{NotificationPreferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_preferences_users_notification_preferences",
+				Columns:    []*schema.Column{NotificationPreferencesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationpreference_user_id_type",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationPreferencesColumns[5], NotificationPreferencesColumns[3]},
+			},
+		},
+	}
 	// ProjectsColumns holds the columns for the "projects" table.
 	ProjectsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString},
 		{Name: "like_count", Type: field.TypeInt, Default: 0},
 		{Name: "star_count", Type: field.TypeInt, Default: 0},
+		{Name: "comment_count", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"idea", "planning", "in_progress", "shipped", "abandoned"}, Default: "idea"},
+		{Name: "target_start_date", Type: field.TypeTime, Nullable: true},
+		{Name: "target_ship_date", Type: field.TypeTime, Nullable: true},
+		{Name: "shipped_at", Type: field.TypeTime, Nullable: true},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"public", "unlisted", "private"}, Default: "public"},
+		{Name: "draft", Type: field.TypeBool, Default: false},
+		{Name: "remix_count", Type: field.TypeInt, Default: 0},
+		{Name: "remixed_from_id", Type: field.TypeString, Nullable: true},
 		{Name: "user_owned_projects", Type: field.TypeString},
 	}
 	// ProjectsTable holds the schema information for the "projects" table.
@@ -65,9 +597,15 @@ var (
 		Columns:    ProjectsColumns,
 		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "projects_projects_remixes",
+				Columns:    []*schema.Column{ProjectsColumns[16]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "projects_users_owned_projects",
-				Columns:    []*schema.Column{ProjectsColumns[7]},
+				Columns:    []*schema.Column{ProjectsColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -76,12 +614,298 @@ var (
 			{
 				Name:    "project_like_count",
 				Unique:  false,
-				Columns: []*schema.Column{ProjectsColumns[5]},
+				Columns: []*schema.Column{ProjectsColumns[6]},
+			},
+			{
+				Name:    "project_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[9]},
+			},
+			{
+				Name:    "project_visibility_draft",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectsColumns[13], ProjectsColumns[14]},
 			},
 			{
 				Name:    "project_user_owned_projects",
 				Unique:  false,
-				Columns: []*schema.Column{ProjectsColumns[7]},
+				Columns: []*schema.Column{ProjectsColumns[17]},
+			},
+		},
+	}
+	// ProjectInvitationsColumns holds the columns for the "project_invitations" table.
+	ProjectInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"maintainer", "contributor"}, Default: "contributor"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined", "revoked"}, Default: "pending"},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "project_id", Type: field.TypeString},
+		{Name: "invitee_id", Type: field.TypeString},
+		{Name: "inviter_id", Type: field.TypeString},
+	}
+	// ProjectInvitationsTable holds the schema information for the "project_invitations" table.
+	ProjectInvitationsTable = &schema.Table{
+		Name:       "project_invitations",
+		Columns:    ProjectInvitationsColumns,
+		PrimaryKey: []*schema.Column{ProjectInvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "project_invitations_projects_invitations",
+				Columns:    []*schema.Column{ProjectInvitationsColumns[6]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "project_invitations_users_received_invitations",
+				Columns:    []*schema.Column{ProjectInvitationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "project_invitations_users_sent_invitations",
+				Columns:    []*schema.Column{ProjectInvitationsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "projectinvitation_project_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectInvitationsColumns[6], ProjectInvitationsColumns[4]},
+			},
+			{
+				Name:    "projectinvitation_invitee_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectInvitationsColumns[7], ProjectInvitationsColumns[4]},
+			},
+		},
+	}
+	// ProjectLinksColumns holds the columns for the "project_links" table.
+	ProjectLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"repo", "demo", "docs", "video"}},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "title", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "project_id", Type: field.TypeString},
+	}
+	// ProjectLinksTable holds the schema information for the "project_links" table.
+	ProjectLinksTable = &schema.Table{
+		Name:       "project_links",
+		Columns:    ProjectLinksColumns,
+		PrimaryKey: []*schema.Column{ProjectLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "project_links_projects_links",
+				Columns:    []*schema.Column{ProjectLinksColumns[6]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "projectlink_project_id_type",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectLinksColumns[6], ProjectLinksColumns[3]},
+			},
+		},
+	}
+	// ProjectMediaColumns holds the columns for the "project_media" table.
+	ProjectMediaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "thumbnail_key", Type: field.TypeString},
+		{Name: "thumbnail_url", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "width", Type: field.TypeInt},
+		{Name: "height", Type: field.TypeInt},
+		{Name: "caption", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "cover", Type: field.TypeBool, Default: false},
+		{Name: "project_id", Type: field.TypeString},
+		{Name: "uploader_id", Type: field.TypeString},
+	}
+	// ProjectMediaTable holds the schema information for the "project_media" table.
+	ProjectMediaTable = &schema.Table{
+		Name:       "project_media",
+		Columns:    ProjectMediaColumns,
+		PrimaryKey: []*schema.Column{ProjectMediaColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "project_media_projects_media",
+				Columns:    []*schema.Column{ProjectMediaColumns[13]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "project_media_users_uploaded_media",
+				Columns:    []*schema.Column{ProjectMediaColumns[14]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "projectmedia_project_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectMediaColumns[13], ProjectMediaColumns[1]},
+			},
+		},
+	}
+	// ProjectMembersColumns holds the columns for the "project_members" table.
+	ProjectMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "maintainer", "contributor"}, Default: "contributor"},
+		{Name: "project_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// ProjectMembersTable holds the schema information for the "project_members" table.
+	ProjectMembersTable = &schema.Table{
+		Name:       "project_members",
+		Columns:    ProjectMembersColumns,
+		PrimaryKey: []*schema.Column{ProjectMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "project_members_projects_memberships",
+				Columns:    []*schema.Column{ProjectMembersColumns[4]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "project_members_users_memberships",
+				Columns:    []*schema.Column{ProjectMembersColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "projectmember_project_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{ProjectMembersColumns[4], ProjectMembersColumns[5]},
+			},
+			{
+				Name:    "projectmember_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectMembersColumns[5]},
+			},
+		},
+	}
+	// ProjectRevisionsColumns holds the columns for the "project_revisions" table.
+	ProjectRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Size: 2147483647},
+		{Name: "tags", Type: field.TypeJSON},
+		{Name: "project_id", Type: field.TypeString},
+		{Name: "author_id", Type: field.TypeString, Nullable: true},
+	}
+	// ProjectRevisionsTable holds the schema information for the "project_revisions" table.
+	ProjectRevisionsTable = &schema.Table{
+		Name:       "project_revisions",
+		Columns:    ProjectRevisionsColumns,
+		PrimaryKey: []*schema.Column{ProjectRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "project_revisions_projects_revisions",
+				Columns:    []*schema.Column{ProjectRevisionsColumns[7]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "project_revisions_users_project_revisions",
+				Columns:    []*schema.Column{ProjectRevisionsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "projectrevision_project_id_number",
+				Unique:  true,
+				Columns: []*schema.Column{ProjectRevisionsColumns[7], ProjectRevisionsColumns[3]},
+			},
+		},
+	}
+	// ProjectRolesColumns holds the columns for the "project_roles" table.
+	ProjectRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "title", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "min_skill_level", Type: field.TypeEnum, Enums: []string{"beginner", "intermediate", "expert"}, Default: "beginner"},
+		{Name: "open", Type: field.TypeBool, Default: true},
+		{Name: "project_id", Type: field.TypeString},
+	}
+	// ProjectRolesTable holds the schema information for the "project_roles" table.
+	ProjectRolesTable = &schema.Table{
+		Name:       "project_roles",
+		Columns:    ProjectRolesColumns,
+		PrimaryKey: []*schema.Column{ProjectRolesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "project_roles_projects_roles",
+				Columns:    []*schema.Column{ProjectRolesColumns[7]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "projectrole_project_id_open",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectRolesColumns[7], ProjectRolesColumns[6]},
+			},
+		},
+	}
+	// ProjectStatusChangesColumns holds the columns for the "project_status_changes" table.
+	ProjectStatusChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "from_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"idea", "planning", "in_progress", "shipped", "abandoned"}},
+		{Name: "to_status", Type: field.TypeEnum, Enums: []string{"idea", "planning", "in_progress", "shipped", "abandoned"}},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "project_id", Type: field.TypeString},
+		{Name: "changed_by_id", Type: field.TypeString},
+	}
+	// ProjectStatusChangesTable holds the schema information for the "project_status_changes" table.
+	ProjectStatusChangesTable = &schema.Table{
+		Name:       "project_status_changes",
+		Columns:    ProjectStatusChangesColumns,
+		PrimaryKey: []*schema.Column{ProjectStatusChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "project_status_changes_projects_status_changes",
+				Columns:    []*schema.Column{ProjectStatusChangesColumns[6]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "project_status_changes_users_status_changes",
+				Columns:    []*schema.Column{ProjectStatusChangesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "projectstatuschange_project_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{ProjectStatusChangesColumns[6], ProjectStatusChangesColumns[1]},
 			},
 		},
 	}
@@ -103,7 +927,7 @@ var (
 				Symbol:     "project_tags_projects_project",
 				Columns:    []*schema.Column{ProjectTagsColumns[3]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "project_tags_tags_tag",
@@ -125,6 +949,40 @@ var (
 			},
 		},
 	}
+	// ScheduledRunsColumns holds the columns for the "scheduled_runs" table.
+	ScheduledRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "task", Type: field.TypeString},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"schedule", "manual"}},
+		{Name: "scheduled_for", Type: field.TypeTime, Nullable: true},
+		{Name: "triggered_by_id", Type: field.TypeString, Nullable: true},
+		{Name: "instance", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "succeeded", "failed"}, Default: "running"},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// ScheduledRunsTable holds the schema information for the "scheduled_runs" table.
+	ScheduledRunsTable = &schema.Table{
+		Name:       "scheduled_runs",
+		Columns:    ScheduledRunsColumns,
+		PrimaryKey: []*schema.Column{ScheduledRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduledrun_task_scheduled_for",
+				Unique:  true,
+				Columns: []*schema.Column{ScheduledRunsColumns[3], ScheduledRunsColumns[5]},
+			},
+			{
+				Name:    "scheduledrun_task_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledRunsColumns[3], ScheduledRunsColumns[9]},
+			},
+		},
+	}
 	// SessionsColumns holds the columns for the "sessions" table.
 	SessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -167,6 +1025,8 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "category", Type: field.TypeEnum, Enums: []string{"language", "framework", "tool", "database", "other"}, Default: "other"},
 		{Name: "usage_count", Type: field.TypeInt, Default: 0},
+		{Name: "event_required_tags", Type: field.TypeString, Nullable: true},
+		{Name: "project_role_required_tags", Type: field.TypeString, Nullable: true},
 		{Name: "user_created_tags", Type: field.TypeString, Nullable: true},
 	}
 	// TagsTable holds the schema information for the "tags" table.
@@ -176,8 +1036,20 @@ var (
 		PrimaryKey: []*schema.Column{TagsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "tags_users_created_tags",
+				Symbol:     "tags_events_required_tags",
 				Columns:    []*schema.Column{TagsColumns[9]},
+				RefColumns: []*schema.Column{EventsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tags_project_roles_required_tags",
+				Columns:    []*schema.Column{TagsColumns[10]},
+				RefColumns: []*schema.Column{ProjectRolesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tags_users_created_tags",
+				Columns:    []*schema.Column{TagsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -205,6 +1077,79 @@ var (
 			},
 		},
 	}
+	// TasksColumns holds the columns for the "tasks" table.
+	TasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "title", Type: field.TypeString},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "milestone_id", Type: field.TypeString},
+		{Name: "project_id", Type: field.TypeString},
+	}
+	// TasksTable holds the schema information for the "tasks" table.
+	TasksTable = &schema.Table{
+		Name:       "tasks",
+		Columns:    TasksColumns,
+		PrimaryKey: []*schema.Column{TasksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tasks_milestones_tasks",
+				Columns:    []*schema.Column{TasksColumns[6]},
+				RefColumns: []*schema.Column{MilestonesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tasks_projects_tasks",
+				Columns:    []*schema.Column{TasksColumns[7]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "task_milestone_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[6], TasksColumns[4]},
+			},
+			{
+				Name:    "task_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[7]},
+			},
+		},
+	}
+	// TrendingSnapshotsColumns holds the columns for the "trending_snapshots" table.
+	TrendingSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "subject_type", Type: field.TypeEnum, Enums: []string{"tag", "project"}},
+		{Name: "subject_id", Type: field.TypeString},
+		{Name: "period", Type: field.TypeEnum, Enums: []string{"24h", "7d", "30d"}},
+		{Name: "score", Type: field.TypeFloat64, Default: 0},
+		{Name: "rank", Type: field.TypeInt},
+		{Name: "activity_count", Type: field.TypeInt, Default: 0},
+	}
+	// TrendingSnapshotsTable holds the schema information for the "trending_snapshots" table.
+	TrendingSnapshotsTable = &schema.Table{
+		Name:       "trending_snapshots",
+		Columns:    TrendingSnapshotsColumns,
+		PrimaryKey: []*schema.Column{TrendingSnapshotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "trendingsnapshot_subject_type_period_subject_id",
+				Unique:  true,
+				Columns: []*schema.Column{TrendingSnapshotsColumns[3], TrendingSnapshotsColumns[5], TrendingSnapshotsColumns[4]},
+			},
+			{
+				Name:    "trendingsnapshot_subject_type_period_rank",
+				Unique:  false,
+				Columns: []*schema.Column{TrendingSnapshotsColumns[3], TrendingSnapshotsColumns[5], TrendingSnapshotsColumns[7]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -218,8 +1163,15 @@ var (
 		{Name: "last_name", Type: field.TypeString},
 		{Name: "bio", Type: field.TypeString, Nullable: true},
 		{Name: "avatar_url", Type: field.TypeString, Nullable: true},
+		{Name: "discoverable", Type: field.TypeBool, Default: true},
+		{Name: "hide_likes", Type: field.TypeBool, Default: false},
+		{Name: "digest_frequency", Type: field.TypeEnum, Enums: []string{"off", "daily", "weekly"}, Default: "weekly"},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "last_digest_at", Type: field.TypeTime, Nullable: true},
+		{Name: "unsubscribe_token", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
 		{Name: "account_status", Type: field.TypeEnum, Enums: []string{"pending", "active", "suspended"}, Default: "pending"},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "admin"}, Default: "user"},
 		{Name: "verification_token", Type: field.TypeString, Nullable: true},
 		{Name: "verification_token_expiry_at", Type: field.TypeTime, Nullable: true},
 		{Name: "failed_login_attempts", Type: field.TypeInt, Default: 0},
@@ -279,26 +1231,184 @@ var (
 			},
 		},
 	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"json", "slack", "discord"}, Default: "json"},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "project_id", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_projects_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[9]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "webhooks_users_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[10]},
+			},
+			{
+				Name:    "webhook_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[9]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "response_body", Type: field.TypeString, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "webhook_id", Type: field.TypeString},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[14]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[5], WebhookDeliveriesColumns[7]},
+			},
+			{
+				Name:    "webhookdelivery_webhook_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[14], WebhookDeliveriesColumns[1]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		CollectionsTable,
+		CollectionItemsTable,
+		CommentsTable,
+		EventsTable,
+		EventCriterionsTable,
+		EventMembersTable,
+		EventScoresTable,
+		EventSubmissionsTable,
+		JobsTable,
+		JoinRequestsTable,
 		LikesTable,
+		MilestonesTable,
+		NotificationsTable,
+		NotificationPreferencesTable,
 		ProjectsTable,
+		ProjectInvitationsTable,
+		ProjectLinksTable,
+		ProjectMediaTable,
+		ProjectMembersTable,
+		ProjectRevisionsTable,
+		ProjectRolesTable,
+		ProjectStatusChangesTable,
 		ProjectTagsTable,
+		ScheduledRunsTable,
 		SessionsTable,
 		TagsTable,
+		TasksTable,
+		TrendingSnapshotsTable,
 		UsersTable,
 		UserTechnologiesTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 	}
 )
 
 func init() {
+	CollectionsTable.ForeignKeys[0].RefTable = UsersTable
+	CollectionItemsTable.ForeignKeys[0].RefTable = CollectionsTable
+	CollectionItemsTable.ForeignKeys[1].RefTable = ProjectsTable
+	CommentsTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentsTable.ForeignKeys[1].RefTable = ProjectsTable
+	CommentsTable.ForeignKeys[2].RefTable = UsersTable
+	EventCriterionsTable.ForeignKeys[0].RefTable = EventsTable
+	EventMembersTable.ForeignKeys[0].RefTable = EventsTable
+	EventMembersTable.ForeignKeys[1].RefTable = UsersTable
+	EventScoresTable.ForeignKeys[0].RefTable = EventCriterionsTable
+	EventScoresTable.ForeignKeys[1].RefTable = EventSubmissionsTable
+	EventScoresTable.ForeignKeys[2].RefTable = UsersTable
+	EventSubmissionsTable.ForeignKeys[0].RefTable = EventsTable
+	EventSubmissionsTable.ForeignKeys[1].RefTable = ProjectsTable
+	EventSubmissionsTable.ForeignKeys[2].RefTable = UsersTable
+	JoinRequestsTable.ForeignKeys[0].RefTable = ProjectsTable
+	JoinRequestsTable.ForeignKeys[1].RefTable = ProjectRolesTable
+	JoinRequestsTable.ForeignKeys[2].RefTable = UsersTable
 	LikesTable.ForeignKeys[0].RefTable = UsersTable
 	LikesTable.ForeignKeys[1].RefTable = ProjectsTable
-	ProjectsTable.ForeignKeys[0].RefTable = UsersTable
+	MilestonesTable.ForeignKeys[0].RefTable = ProjectsTable
+	NotificationsTable.ForeignKeys[0].RefTable = ProjectsTable
+	NotificationsTable.ForeignKeys[1].RefTable = UsersTable
+	NotificationPreferencesTable.ForeignKeys[0].RefTable = UsersTable
+	ProjectsTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectsTable.ForeignKeys[1].RefTable = UsersTable
+	ProjectInvitationsTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectInvitationsTable.ForeignKeys[1].RefTable = UsersTable
+	ProjectInvitationsTable.ForeignKeys[2].RefTable = UsersTable
+	ProjectLinksTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectMediaTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectMediaTable.ForeignKeys[1].RefTable = UsersTable
+	ProjectMembersTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectMembersTable.ForeignKeys[1].RefTable = UsersTable
+	ProjectRevisionsTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectRevisionsTable.ForeignKeys[1].RefTable = UsersTable
+	ProjectRolesTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectStatusChangesTable.ForeignKeys[0].RefTable = ProjectsTable
+	ProjectStatusChangesTable.ForeignKeys[1].RefTable = UsersTable
 	ProjectTagsTable.ForeignKeys[0].RefTable = ProjectsTable
 	ProjectTagsTable.ForeignKeys[1].RefTable = TagsTable
 	SessionsTable.ForeignKeys[0].RefTable = UsersTable
-	TagsTable.ForeignKeys[0].RefTable = UsersTable
+	TagsTable.ForeignKeys[0].RefTable = EventsTable
+	TagsTable.ForeignKeys[1].RefTable = ProjectRolesTable
+	TagsTable.ForeignKeys[2].RefTable = UsersTable
+	TasksTable.ForeignKeys[0].RefTable = MilestonesTable
+	TasksTable.ForeignKeys[1].RefTable = ProjectsTable
 	UserTechnologiesTable.ForeignKeys[0].RefTable = UsersTable
 	UserTechnologiesTable.ForeignKeys[1].RefTable = TagsTable
+	WebhooksTable.ForeignKeys[0].RefTable = ProjectsTable
+	WebhooksTable.ForeignKeys[1].RefTable = UsersTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 }
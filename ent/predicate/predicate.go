@@ -6,23 +6,98 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Collection is the predicate function for collection builders.
+type Collection func(*sql.Selector)
+
+// CollectionItem is the predicate function for collectionitem builders.
+type CollectionItem func(*sql.Selector)
+
+// Comment is the predicate function for comment builders.
+type Comment func(*sql.Selector)
+
+// Event is the predicate function for event builders.
+type Event func(*sql.Selector)
+
+// EventCriterion is the predicate function for eventcriterion builders.
+type EventCriterion func(*sql.Selector)
+
+// EventMember is the predicate function for eventmember builders.
+type EventMember func(*sql.Selector)
+
+// EventScore is the predicate function for eventscore builders.
+type EventScore func(*sql.Selector)
+
+// EventSubmission is the predicate function for eventsubmission builders.
+type EventSubmission func(*sql.Selector)
+
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
+// JoinRequest is the predicate function for joinrequest builders.
+type JoinRequest func(*sql.Selector)
+
 // Like is the predicate function for like builders.
 type Like func(*sql.Selector)
 
+// Milestone is the predicate function for milestone builders.
+type Milestone func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// NotificationPreference is the predicate function for notificationpreference builders.
+type NotificationPreference func(*sql.Selector)
+
 // Project is the predicate function for project builders.
 type Project func(*sql.Selector)
 
+// ProjectInvitation is the predicate function for projectinvitation builders.
+type ProjectInvitation func(*sql.Selector)
+
+// ProjectLink is the predicate function for projectlink builders.
+type ProjectLink func(*sql.Selector)
+
+// ProjectMedia is the predicate function for projectmedia builders.
+type ProjectMedia func(*sql.Selector)
+
+// ProjectMember is the predicate function for projectmember builders.
+type ProjectMember func(*sql.Selector)
+
+// ProjectRevision is the predicate function for projectrevision builders.
+type ProjectRevision func(*sql.Selector)
+
+// ProjectRole is the predicate function for projectrole builders.
+type ProjectRole func(*sql.Selector)
+
+// ProjectStatusChange is the predicate function for projectstatuschange builders.
+type ProjectStatusChange func(*sql.Selector)
+
 // ProjectTag is the predicate function for projecttag builders.
 type ProjectTag func(*sql.Selector)
 
+// ScheduledRun is the predicate function for scheduledrun builders.
+type ScheduledRun func(*sql.Selector)
+
 // Session is the predicate function for session builders.
 type Session func(*sql.Selector)
 
 // Tag is the predicate function for tag builders.
 type Tag func(*sql.Selector)
 
+// Task is the predicate function for task builders.
+type Task func(*sql.Selector)
+
+// TrendingSnapshot is the predicate function for trendingsnapshot builders.
+type TrendingSnapshot func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
 // UserTechnology is the predicate function for usertechnology builders.
 type UserTechnology func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
@@ -22,6 +22,8 @@ type Project struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
@@ -30,6 +32,24 @@ type Project struct {
 	LikeCount int `json:"like_count,omitempty"`
 	// StarCount holds the value of the "star_count" field.
 	StarCount int `json:"star_count,omitempty"`
+	// Number of visible comments, i.e. not deleted nor hidden by the owner.
+	CommentCount int `json:"comment_count,omitempty"`
+	// Status holds the value of the "status" field.
+	Status project.Status `json:"status,omitempty"`
+	// TargetStartDate holds the value of the "target_start_date" field.
+	TargetStartDate *time.Time `json:"target_start_date,omitempty"`
+	// TargetShipDate holds the value of the "target_ship_date" field.
+	TargetShipDate *time.Time `json:"target_ship_date,omitempty"`
+	// ShippedAt holds the value of the "shipped_at" field.
+	ShippedAt *time.Time `json:"shipped_at,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility project.Visibility `json:"visibility,omitempty"`
+	// Drafts are only visible to the owner and members, whatever their visibility.
+	Draft bool `json:"draft,omitempty"`
+	// The project this one is a remix of. Cleared when that project is purged.
+	RemixedFromID *string `json:"remixed_from_id,omitempty"`
+	// Number of remixes of the project, until they are purged.
+	RemixCount int `json:"remix_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProjectQuery when eager-loading is set.
 	Edges               ProjectEdges `json:"edges"`
@@ -45,13 +65,47 @@ type ProjectEdges struct {
 	LikedBy []*User `json:"liked_by,omitempty"`
 	// Tags holds the value of the tags edge.
 	Tags []*Tag `json:"tags,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// StatusChanges holds the value of the status_changes edge.
+	StatusChanges []*ProjectStatusChange `json:"status_changes,omitempty"`
+	// Milestones holds the value of the milestones edge.
+	Milestones []*Milestone `json:"milestones,omitempty"`
+	// Tasks holds the value of the tasks edge.
+	Tasks []*Task `json:"tasks,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*ProjectMember `json:"memberships,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*ProjectInvitation `json:"invitations,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*ProjectRole `json:"roles,omitempty"`
+	// JoinRequests holds the value of the join_requests edge.
+	JoinRequests []*JoinRequest `json:"join_requests,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*ProjectRevision `json:"revisions,omitempty"`
+	// Links holds the value of the links edge.
+	Links []*ProjectLink `json:"links,omitempty"`
+	// Media holds the value of the media edge.
+	Media []*ProjectMedia `json:"media,omitempty"`
+	// CollectionItems holds the value of the collection_items edge.
+	CollectionItems []*CollectionItem `json:"collection_items,omitempty"`
+	// EventSubmissions holds the value of the event_submissions edge.
+	EventSubmissions []*EventSubmission `json:"event_submissions,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
+	// RemixedFrom holds the value of the remixed_from edge.
+	RemixedFrom *Project `json:"remixed_from,omitempty"`
+	// Remixes holds the value of the remixes edge.
+	Remixes []*Project `json:"remixes,omitempty"`
 	// Likes holds the value of the likes edge.
 	Likes []*Like `json:"likes,omitempty"`
 	// ProjectTags holds the value of the project_tags edge.
 	ProjectTags []*ProjectTag `json:"project_tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [22]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -83,10 +137,165 @@ func (e ProjectEdges) TagsOrErr() ([]*Tag, error) {
 	return nil, &NotLoadedError{edge: "tags"}
 }
 
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[3] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// StatusChangesOrErr returns the StatusChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) StatusChangesOrErr() ([]*ProjectStatusChange, error) {
+	if e.loadedTypes[4] {
+		return e.StatusChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "status_changes"}
+}
+
+// MilestonesOrErr returns the Milestones value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) MilestonesOrErr() ([]*Milestone, error) {
+	if e.loadedTypes[5] {
+		return e.Milestones, nil
+	}
+	return nil, &NotLoadedError{edge: "milestones"}
+}
+
+// TasksOrErr returns the Tasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) TasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[6] {
+		return e.Tasks, nil
+	}
+	return nil, &NotLoadedError{edge: "tasks"}
+}
+
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) MembershipsOrErr() ([]*ProjectMember, error) {
+	if e.loadedTypes[7] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) InvitationsOrErr() ([]*ProjectInvitation, error) {
+	if e.loadedTypes[8] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) RolesOrErr() ([]*ProjectRole, error) {
+	if e.loadedTypes[9] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
+// JoinRequestsOrErr returns the JoinRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) JoinRequestsOrErr() ([]*JoinRequest, error) {
+	if e.loadedTypes[10] {
+		return e.JoinRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "join_requests"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) RevisionsOrErr() ([]*ProjectRevision, error) {
+	if e.loadedTypes[11] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// LinksOrErr returns the Links value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) LinksOrErr() ([]*ProjectLink, error) {
+	if e.loadedTypes[12] {
+		return e.Links, nil
+	}
+	return nil, &NotLoadedError{edge: "links"}
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) MediaOrErr() ([]*ProjectMedia, error) {
+	if e.loadedTypes[13] {
+		return e.Media, nil
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// CollectionItemsOrErr returns the CollectionItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) CollectionItemsOrErr() ([]*CollectionItem, error) {
+	if e.loadedTypes[14] {
+		return e.CollectionItems, nil
+	}
+	return nil, &NotLoadedError{edge: "collection_items"}
+}
+
+// EventSubmissionsOrErr returns the EventSubmissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) EventSubmissionsOrErr() ([]*EventSubmission, error) {
+	if e.loadedTypes[15] {
+		return e.EventSubmissions, nil
+	}
+	return nil, &NotLoadedError{edge: "event_submissions"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[16] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[17] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
+// RemixedFromOrErr returns the RemixedFrom value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProjectEdges) RemixedFromOrErr() (*Project, error) {
+	if e.RemixedFrom != nil {
+		return e.RemixedFrom, nil
+	} else if e.loadedTypes[18] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "remixed_from"}
+}
+
+// RemixesOrErr returns the Remixes value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) RemixesOrErr() ([]*Project, error) {
+	if e.loadedTypes[19] {
+		return e.Remixes, nil
+	}
+	return nil, &NotLoadedError{edge: "remixes"}
+}
+
 // LikesOrErr returns the Likes value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) LikesOrErr() ([]*Like, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[20] {
 		return e.Likes, nil
 	}
 	return nil, &NotLoadedError{edge: "likes"}
@@ -95,7 +304,7 @@ func (e ProjectEdges) LikesOrErr() ([]*Like, error) {
 // ProjectTagsOrErr returns the ProjectTags value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) ProjectTagsOrErr() ([]*ProjectTag, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[21] {
 		return e.ProjectTags, nil
 	}
 	return nil, &NotLoadedError{edge: "project_tags"}
@@ -106,11 +315,13 @@ func (*Project) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case project.FieldLikeCount, project.FieldStarCount:
+		case project.FieldDraft:
+			values[i] = new(sql.NullBool)
+		case project.FieldLikeCount, project.FieldStarCount, project.FieldCommentCount, project.FieldRemixCount:
 			values[i] = new(sql.NullInt64)
-		case project.FieldID, project.FieldName, project.FieldDescription:
+		case project.FieldID, project.FieldName, project.FieldDescription, project.FieldStatus, project.FieldVisibility, project.FieldRemixedFromID:
 			values[i] = new(sql.NullString)
-		case project.FieldCreateTime, project.FieldUpdateTime:
+		case project.FieldCreateTime, project.FieldUpdateTime, project.FieldDeletedAt, project.FieldTargetStartDate, project.FieldTargetShipDate, project.FieldShippedAt:
 			values[i] = new(sql.NullTime)
 		case project.ForeignKeys[0]: // user_owned_projects
 			values[i] = new(sql.NullString)
@@ -147,6 +358,13 @@ func (_m *Project) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdateTime = value.Time
 			}
+		case project.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case project.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -171,6 +389,64 @@ func (_m *Project) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.StarCount = int(value.Int64)
 			}
+		case project.FieldCommentCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_count", values[i])
+			} else if value.Valid {
+				_m.CommentCount = int(value.Int64)
+			}
+		case project.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = project.Status(value.String)
+			}
+		case project.FieldTargetStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field target_start_date", values[i])
+			} else if value.Valid {
+				_m.TargetStartDate = new(time.Time)
+				*_m.TargetStartDate = value.Time
+			}
+		case project.FieldTargetShipDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field target_ship_date", values[i])
+			} else if value.Valid {
+				_m.TargetShipDate = new(time.Time)
+				*_m.TargetShipDate = value.Time
+			}
+		case project.FieldShippedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field shipped_at", values[i])
+			} else if value.Valid {
+				_m.ShippedAt = new(time.Time)
+				*_m.ShippedAt = value.Time
+			}
+		case project.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = project.Visibility(value.String)
+			}
+		case project.FieldDraft:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field draft", values[i])
+			} else if value.Valid {
+				_m.Draft = value.Bool
+			}
+		case project.FieldRemixedFromID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field remixed_from_id", values[i])
+			} else if value.Valid {
+				_m.RemixedFromID = new(string)
+				*_m.RemixedFromID = value.String
+			}
+		case project.FieldRemixCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field remix_count", values[i])
+			} else if value.Valid {
+				_m.RemixCount = int(value.Int64)
+			}
 		case project.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field user_owned_projects", values[i])
@@ -206,6 +482,91 @@ func (_m *Project) QueryTags() *TagQuery {
 	return NewProjectClient(_m.config).QueryTags(_m)
 }
 
+// QueryComments queries the "comments" edge of the Project entity.
+func (_m *Project) QueryComments() *CommentQuery {
+	return NewProjectClient(_m.config).QueryComments(_m)
+}
+
+// QueryStatusChanges queries the "status_changes" edge of the Project entity.
+func (_m *Project) QueryStatusChanges() *ProjectStatusChangeQuery {
+	return NewProjectClient(_m.config).QueryStatusChanges(_m)
+}
+
+// QueryMilestones queries the "milestones" edge of the Project entity.
+func (_m *Project) QueryMilestones() *MilestoneQuery {
+	return NewProjectClient(_m.config).QueryMilestones(_m)
+}
+
+// QueryTasks queries the "tasks" edge of the Project entity.
+func (_m *Project) QueryTasks() *TaskQuery {
+	return NewProjectClient(_m.config).QueryTasks(_m)
+}
+
+// QueryMemberships queries the "memberships" edge of the Project entity.
+func (_m *Project) QueryMemberships() *ProjectMemberQuery {
+	return NewProjectClient(_m.config).QueryMemberships(_m)
+}
+
+// QueryInvitations queries the "invitations" edge of the Project entity.
+func (_m *Project) QueryInvitations() *ProjectInvitationQuery {
+	return NewProjectClient(_m.config).QueryInvitations(_m)
+}
+
+// QueryRoles queries the "roles" edge of the Project entity.
+func (_m *Project) QueryRoles() *ProjectRoleQuery {
+	return NewProjectClient(_m.config).QueryRoles(_m)
+}
+
+// QueryJoinRequests queries the "join_requests" edge of the Project entity.
+func (_m *Project) QueryJoinRequests() *JoinRequestQuery {
+	return NewProjectClient(_m.config).QueryJoinRequests(_m)
+}
+
+// QueryRevisions queries the "revisions" edge of the Project entity.
+func (_m *Project) QueryRevisions() *ProjectRevisionQuery {
+	return NewProjectClient(_m.config).QueryRevisions(_m)
+}
+
+// QueryLinks queries the "links" edge of the Project entity.
+func (_m *Project) QueryLinks() *ProjectLinkQuery {
+	return NewProjectClient(_m.config).QueryLinks(_m)
+}
+
+// QueryMedia queries the "media" edge of the Project entity.
+func (_m *Project) QueryMedia() *ProjectMediaQuery {
+	return NewProjectClient(_m.config).QueryMedia(_m)
+}
+
+// QueryCollectionItems queries the "collection_items" edge of the Project entity.
+func (_m *Project) QueryCollectionItems() *CollectionItemQuery {
+	return NewProjectClient(_m.config).QueryCollectionItems(_m)
+}
+
+// QueryEventSubmissions queries the "event_submissions" edge of the Project entity.
+func (_m *Project) QueryEventSubmissions() *EventSubmissionQuery {
+	return NewProjectClient(_m.config).QueryEventSubmissions(_m)
+}
+
+// QueryNotifications queries the "notifications" edge of the Project entity.
+func (_m *Project) QueryNotifications() *NotificationQuery {
+	return NewProjectClient(_m.config).QueryNotifications(_m)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the Project entity.
+func (_m *Project) QueryWebhooks() *WebhookQuery {
+	return NewProjectClient(_m.config).QueryWebhooks(_m)
+}
+
+// QueryRemixedFrom queries the "remixed_from" edge of the Project entity.
+func (_m *Project) QueryRemixedFrom() *ProjectQuery {
+	return NewProjectClient(_m.config).QueryRemixedFrom(_m)
+}
+
+// QueryRemixes queries the "remixes" edge of the Project entity.
+func (_m *Project) QueryRemixes() *ProjectQuery {
+	return NewProjectClient(_m.config).QueryRemixes(_m)
+}
+
 // QueryLikes queries the "likes" edge of the Project entity.
 func (_m *Project) QueryLikes() *LikeQuery {
 	return NewProjectClient(_m.config).QueryLikes(_m)
@@ -245,6 +606,11 @@ func (_m *Project) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
@@ -256,6 +622,41 @@ func (_m *Project) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("star_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.StarCount))
+	builder.WriteString(", ")
+	builder.WriteString("comment_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CommentCount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.TargetStartDate; v != nil {
+		builder.WriteString("target_start_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TargetShipDate; v != nil {
+		builder.WriteString("target_ship_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ShippedAt; v != nil {
+		builder.WriteString("shipped_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("draft=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Draft))
+	builder.WriteString(", ")
+	if v := _m.RemixedFromID; v != nil {
+		builder.WriteString("remixed_from_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("remix_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RemixCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
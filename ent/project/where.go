@@ -75,6 +75,11 @@ func UpdateTime(v time.Time) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldName, v))
@@ -95,6 +100,41 @@ func StarCount(v int) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldStarCount, v))
 }
 
+// CommentCount applies equality check predicate on the "comment_count" field. It's identical to CommentCountEQ.
+func CommentCount(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldCommentCount, v))
+}
+
+// TargetStartDate applies equality check predicate on the "target_start_date" field. It's identical to TargetStartDateEQ.
+func TargetStartDate(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldTargetStartDate, v))
+}
+
+// TargetShipDate applies equality check predicate on the "target_ship_date" field. It's identical to TargetShipDateEQ.
+func TargetShipDate(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldTargetShipDate, v))
+}
+
+// ShippedAt applies equality check predicate on the "shipped_at" field. It's identical to ShippedAtEQ.
+func ShippedAt(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldShippedAt, v))
+}
+
+// Draft applies equality check predicate on the "draft" field. It's identical to DraftEQ.
+func Draft(v bool) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldDraft, v))
+}
+
+// RemixedFromID applies equality check predicate on the "remixed_from_id" field. It's identical to RemixedFromIDEQ.
+func RemixedFromID(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldRemixedFromID, v))
+}
+
+// RemixCount applies equality check predicate on the "remix_count" field. It's identical to RemixCountEQ.
+func RemixCount(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldRemixCount, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldCreateTime, v))
@@ -175,6 +215,56 @@ func UpdateTimeLTE(v time.Time) predicate.Project {
 	return predicate.Project(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldName, v))
@@ -385,6 +475,361 @@ func StarCountLTE(v int) predicate.Project {
 	return predicate.Project(sql.FieldLTE(FieldStarCount, v))
 }
 
+// CommentCountEQ applies the EQ predicate on the "comment_count" field.
+func CommentCountEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldCommentCount, v))
+}
+
+// CommentCountNEQ applies the NEQ predicate on the "comment_count" field.
+func CommentCountNEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldCommentCount, v))
+}
+
+// CommentCountIn applies the In predicate on the "comment_count" field.
+func CommentCountIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldCommentCount, vs...))
+}
+
+// CommentCountNotIn applies the NotIn predicate on the "comment_count" field.
+func CommentCountNotIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldCommentCount, vs...))
+}
+
+// CommentCountGT applies the GT predicate on the "comment_count" field.
+func CommentCountGT(v int) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldCommentCount, v))
+}
+
+// CommentCountGTE applies the GTE predicate on the "comment_count" field.
+func CommentCountGTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldCommentCount, v))
+}
+
+// CommentCountLT applies the LT predicate on the "comment_count" field.
+func CommentCountLT(v int) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldCommentCount, v))
+}
+
+// CommentCountLTE applies the LTE predicate on the "comment_count" field.
+func CommentCountLTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldCommentCount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TargetStartDateEQ applies the EQ predicate on the "target_start_date" field.
+func TargetStartDateEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldTargetStartDate, v))
+}
+
+// TargetStartDateNEQ applies the NEQ predicate on the "target_start_date" field.
+func TargetStartDateNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldTargetStartDate, v))
+}
+
+// TargetStartDateIn applies the In predicate on the "target_start_date" field.
+func TargetStartDateIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldTargetStartDate, vs...))
+}
+
+// TargetStartDateNotIn applies the NotIn predicate on the "target_start_date" field.
+func TargetStartDateNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldTargetStartDate, vs...))
+}
+
+// TargetStartDateGT applies the GT predicate on the "target_start_date" field.
+func TargetStartDateGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldTargetStartDate, v))
+}
+
+// TargetStartDateGTE applies the GTE predicate on the "target_start_date" field.
+func TargetStartDateGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldTargetStartDate, v))
+}
+
+// TargetStartDateLT applies the LT predicate on the "target_start_date" field.
+func TargetStartDateLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldTargetStartDate, v))
+}
+
+// TargetStartDateLTE applies the LTE predicate on the "target_start_date" field.
+func TargetStartDateLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldTargetStartDate, v))
+}
+
+// TargetStartDateIsNil applies the IsNil predicate on the "target_start_date" field.
+func TargetStartDateIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldTargetStartDate))
+}
+
+// TargetStartDateNotNil applies the NotNil predicate on the "target_start_date" field.
+func TargetStartDateNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldTargetStartDate))
+}
+
+// TargetShipDateEQ applies the EQ predicate on the "target_ship_date" field.
+func TargetShipDateEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldTargetShipDate, v))
+}
+
+// TargetShipDateNEQ applies the NEQ predicate on the "target_ship_date" field.
+func TargetShipDateNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldTargetShipDate, v))
+}
+
+// TargetShipDateIn applies the In predicate on the "target_ship_date" field.
+func TargetShipDateIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldTargetShipDate, vs...))
+}
+
+// TargetShipDateNotIn applies the NotIn predicate on the "target_ship_date" field.
+func TargetShipDateNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldTargetShipDate, vs...))
+}
+
+// TargetShipDateGT applies the GT predicate on the "target_ship_date" field.
+func TargetShipDateGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldTargetShipDate, v))
+}
+
+// TargetShipDateGTE applies the GTE predicate on the "target_ship_date" field.
+func TargetShipDateGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldTargetShipDate, v))
+}
+
+// TargetShipDateLT applies the LT predicate on the "target_ship_date" field.
+func TargetShipDateLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldTargetShipDate, v))
+}
+
+// TargetShipDateLTE applies the LTE predicate on the "target_ship_date" field.
+func TargetShipDateLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldTargetShipDate, v))
+}
+
+// TargetShipDateIsNil applies the IsNil predicate on the "target_ship_date" field.
+func TargetShipDateIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldTargetShipDate))
+}
+
+// TargetShipDateNotNil applies the NotNil predicate on the "target_ship_date" field.
+func TargetShipDateNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldTargetShipDate))
+}
+
+// ShippedAtEQ applies the EQ predicate on the "shipped_at" field.
+func ShippedAtEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldShippedAt, v))
+}
+
+// ShippedAtNEQ applies the NEQ predicate on the "shipped_at" field.
+func ShippedAtNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldShippedAt, v))
+}
+
+// ShippedAtIn applies the In predicate on the "shipped_at" field.
+func ShippedAtIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldShippedAt, vs...))
+}
+
+// ShippedAtNotIn applies the NotIn predicate on the "shipped_at" field.
+func ShippedAtNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldShippedAt, vs...))
+}
+
+// ShippedAtGT applies the GT predicate on the "shipped_at" field.
+func ShippedAtGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldShippedAt, v))
+}
+
+// ShippedAtGTE applies the GTE predicate on the "shipped_at" field.
+func ShippedAtGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldShippedAt, v))
+}
+
+// ShippedAtLT applies the LT predicate on the "shipped_at" field.
+func ShippedAtLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldShippedAt, v))
+}
+
+// ShippedAtLTE applies the LTE predicate on the "shipped_at" field.
+func ShippedAtLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldShippedAt, v))
+}
+
+// ShippedAtIsNil applies the IsNil predicate on the "shipped_at" field.
+func ShippedAtIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldShippedAt))
+}
+
+// ShippedAtNotNil applies the NotNil predicate on the "shipped_at" field.
+func ShippedAtNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldShippedAt))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// DraftEQ applies the EQ predicate on the "draft" field.
+func DraftEQ(v bool) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldDraft, v))
+}
+
+// DraftNEQ applies the NEQ predicate on the "draft" field.
+func DraftNEQ(v bool) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldDraft, v))
+}
+
+// RemixedFromIDEQ applies the EQ predicate on the "remixed_from_id" field.
+func RemixedFromIDEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldRemixedFromID, v))
+}
+
+// RemixedFromIDNEQ applies the NEQ predicate on the "remixed_from_id" field.
+func RemixedFromIDNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldRemixedFromID, v))
+}
+
+// RemixedFromIDIn applies the In predicate on the "remixed_from_id" field.
+func RemixedFromIDIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldRemixedFromID, vs...))
+}
+
+// RemixedFromIDNotIn applies the NotIn predicate on the "remixed_from_id" field.
+func RemixedFromIDNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldRemixedFromID, vs...))
+}
+
+// RemixedFromIDGT applies the GT predicate on the "remixed_from_id" field.
+func RemixedFromIDGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldRemixedFromID, v))
+}
+
+// RemixedFromIDGTE applies the GTE predicate on the "remixed_from_id" field.
+func RemixedFromIDGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldRemixedFromID, v))
+}
+
+// RemixedFromIDLT applies the LT predicate on the "remixed_from_id" field.
+func RemixedFromIDLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldRemixedFromID, v))
+}
+
+// RemixedFromIDLTE applies the LTE predicate on the "remixed_from_id" field.
+func RemixedFromIDLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldRemixedFromID, v))
+}
+
+// RemixedFromIDContains applies the Contains predicate on the "remixed_from_id" field.
+func RemixedFromIDContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldRemixedFromID, v))
+}
+
+// RemixedFromIDHasPrefix applies the HasPrefix predicate on the "remixed_from_id" field.
+func RemixedFromIDHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldRemixedFromID, v))
+}
+
+// RemixedFromIDHasSuffix applies the HasSuffix predicate on the "remixed_from_id" field.
+func RemixedFromIDHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldRemixedFromID, v))
+}
+
+// RemixedFromIDIsNil applies the IsNil predicate on the "remixed_from_id" field.
+func RemixedFromIDIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldRemixedFromID))
+}
+
+// RemixedFromIDNotNil applies the NotNil predicate on the "remixed_from_id" field.
+func RemixedFromIDNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldRemixedFromID))
+}
+
+// RemixedFromIDEqualFold applies the EqualFold predicate on the "remixed_from_id" field.
+func RemixedFromIDEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldRemixedFromID, v))
+}
+
+// RemixedFromIDContainsFold applies the ContainsFold predicate on the "remixed_from_id" field.
+func RemixedFromIDContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldRemixedFromID, v))
+}
+
+// RemixCountEQ applies the EQ predicate on the "remix_count" field.
+func RemixCountEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldRemixCount, v))
+}
+
+// RemixCountNEQ applies the NEQ predicate on the "remix_count" field.
+func RemixCountNEQ(v int) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldRemixCount, v))
+}
+
+// RemixCountIn applies the In predicate on the "remix_count" field.
+func RemixCountIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldRemixCount, vs...))
+}
+
+// RemixCountNotIn applies the NotIn predicate on the "remix_count" field.
+func RemixCountNotIn(vs ...int) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldRemixCount, vs...))
+}
+
+// RemixCountGT applies the GT predicate on the "remix_count" field.
+func RemixCountGT(v int) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldRemixCount, v))
+}
+
+// RemixCountGTE applies the GTE predicate on the "remix_count" field.
+func RemixCountGTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldRemixCount, v))
+}
+
+// RemixCountLT applies the LT predicate on the "remix_count" field.
+func RemixCountLT(v int) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldRemixCount, v))
+}
+
+// RemixCountLTE applies the LTE predicate on the "remix_count" field.
+func RemixCountLTE(v int) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldRemixCount, v))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Project {
 	return predicate.Project(func(s *sql.Selector) {
@@ -454,6 +899,397 @@ func HasTagsWith(preds ...predicate.Tag) predicate.Project {
 	})
 }
 
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatusChanges applies the HasEdge predicate on the "status_changes" edge.
+func HasStatusChanges() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusChangesTable, StatusChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusChangesWith applies the HasEdge predicate on the "status_changes" edge with a given conditions (other predicates).
+func HasStatusChangesWith(preds ...predicate.ProjectStatusChange) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newStatusChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMilestones applies the HasEdge predicate on the "milestones" edge.
+func HasMilestones() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MilestonesTable, MilestonesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMilestonesWith applies the HasEdge predicate on the "milestones" edge with a given conditions (other predicates).
+func HasMilestonesWith(preds ...predicate.Milestone) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newMilestonesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTasks applies the HasEdge predicate on the "tasks" edge.
+func HasTasks() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TasksTable, TasksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTasksWith applies the HasEdge predicate on the "tasks" edge with a given conditions (other predicates).
+func HasTasksWith(preds ...predicate.Task) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newTasksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMemberships applies the HasEdge predicate on the "memberships" edge.
+func HasMemberships() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembershipsTable, MembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembershipsWith applies the HasEdge predicate on the "memberships" edge with a given conditions (other predicates).
+func HasMembershipsWith(preds ...predicate.ProjectMember) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvitations applies the HasEdge predicate on the "invitations" edge.
+func HasInvitations() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitationsWith applies the HasEdge predicate on the "invitations" edge with a given conditions (other predicates).
+func HasInvitationsWith(preds ...predicate.ProjectInvitation) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRoles applies the HasEdge predicate on the "roles" edge.
+func HasRoles() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RolesTable, RolesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRolesWith applies the HasEdge predicate on the "roles" edge with a given conditions (other predicates).
+func HasRolesWith(preds ...predicate.ProjectRole) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasJoinRequests applies the HasEdge predicate on the "join_requests" edge.
+func HasJoinRequests() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JoinRequestsTable, JoinRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJoinRequestsWith applies the HasEdge predicate on the "join_requests" edge with a given conditions (other predicates).
+func HasJoinRequestsWith(preds ...predicate.JoinRequest) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newJoinRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.ProjectRevision) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLinks applies the HasEdge predicate on the "links" edge.
+func HasLinks() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LinksTable, LinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinksWith applies the HasEdge predicate on the "links" edge with a given conditions (other predicates).
+func HasLinksWith(preds ...predicate.ProjectLink) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMedia applies the HasEdge predicate on the "media" edge.
+func HasMedia() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MediaTable, MediaColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMediaWith applies the HasEdge predicate on the "media" edge with a given conditions (other predicates).
+func HasMediaWith(preds ...predicate.ProjectMedia) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newMediaStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCollectionItems applies the HasEdge predicate on the "collection_items" edge.
+func HasCollectionItems() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CollectionItemsTable, CollectionItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollectionItemsWith applies the HasEdge predicate on the "collection_items" edge with a given conditions (other predicates).
+func HasCollectionItemsWith(preds ...predicate.CollectionItem) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newCollectionItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEventSubmissions applies the HasEdge predicate on the "event_submissions" edge.
+func HasEventSubmissions() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventSubmissionsTable, EventSubmissionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventSubmissionsWith applies the HasEdge predicate on the "event_submissions" edge with a given conditions (other predicates).
+func HasEventSubmissionsWith(preds ...predicate.EventSubmission) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newEventSubmissionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotifications applies the HasEdge predicate on the "notifications" edge.
+func HasNotifications() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationsWith applies the HasEdge predicate on the "notifications" edge with a given conditions (other predicates).
+func HasNotificationsWith(preds ...predicate.Notification) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newNotificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newWebhooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRemixedFrom applies the HasEdge predicate on the "remixed_from" edge.
+func HasRemixedFrom() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RemixedFromTable, RemixedFromColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRemixedFromWith applies the HasEdge predicate on the "remixed_from" edge with a given conditions (other predicates).
+func HasRemixedFromWith(preds ...predicate.Project) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newRemixedFromStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRemixes applies the HasEdge predicate on the "remixes" edge.
+func HasRemixes() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RemixesTable, RemixesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRemixesWith applies the HasEdge predicate on the "remixes" edge with a given conditions (other predicates).
+func HasRemixesWith(preds ...predicate.Project) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newRemixesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasLikes applies the HasEdge predicate on the "likes" edge.
 func HasLikes() predicate.Project {
 	return predicate.Project(func(s *sql.Selector) {
@@ -10,11 +10,26 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jorge-j1m/hackspark_server/ent/collectionitem"
+	"github.com/jorge-j1m/hackspark_server/ent/comment"
+	"github.com/jorge-j1m/hackspark_server/ent/eventsubmission"
+	"github.com/jorge-j1m/hackspark_server/ent/joinrequest"
 	"github.com/jorge-j1m/hackspark_server/ent/like"
+	"github.com/jorge-j1m/hackspark_server/ent/milestone"
+	"github.com/jorge-j1m/hackspark_server/ent/notification"
 	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectinvitation"
+	"github.com/jorge-j1m/hackspark_server/ent/projectlink"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmedia"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrevision"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrole"
+	"github.com/jorge-j1m/hackspark_server/ent/projectstatuschange"
 	"github.com/jorge-j1m/hackspark_server/ent/projecttag"
 	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/ent/task"
 	"github.com/jorge-j1m/hackspark_server/ent/user"
+	"github.com/jorge-j1m/hackspark_server/ent/webhook"
 )
 
 // ProjectCreate is the builder for creating a Project entity.
@@ -52,6 +67,20 @@ func (_c *ProjectCreate) SetNillableUpdateTime(v *time.Time) *ProjectCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ProjectCreate) SetDeletedAt(v time.Time) *ProjectCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableDeletedAt(v *time.Time) *ProjectCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetName sets the "name" field.
 func (_c *ProjectCreate) SetName(v string) *ProjectCreate {
 	_c.mutation.SetName(v)
@@ -92,6 +121,132 @@ func (_c *ProjectCreate) SetNillableStarCount(v *int) *ProjectCreate {
 	return _c
 }
 
+// SetCommentCount sets the "comment_count" field.
+func (_c *ProjectCreate) SetCommentCount(v int) *ProjectCreate {
+	_c.mutation.SetCommentCount(v)
+	return _c
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableCommentCount(v *int) *ProjectCreate {
+	if v != nil {
+		_c.SetCommentCount(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ProjectCreate) SetStatus(v project.Status) *ProjectCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableStatus(v *project.Status) *ProjectCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTargetStartDate sets the "target_start_date" field.
+func (_c *ProjectCreate) SetTargetStartDate(v time.Time) *ProjectCreate {
+	_c.mutation.SetTargetStartDate(v)
+	return _c
+}
+
+// SetNillableTargetStartDate sets the "target_start_date" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableTargetStartDate(v *time.Time) *ProjectCreate {
+	if v != nil {
+		_c.SetTargetStartDate(*v)
+	}
+	return _c
+}
+
+// SetTargetShipDate sets the "target_ship_date" field.
+func (_c *ProjectCreate) SetTargetShipDate(v time.Time) *ProjectCreate {
+	_c.mutation.SetTargetShipDate(v)
+	return _c
+}
+
+// SetNillableTargetShipDate sets the "target_ship_date" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableTargetShipDate(v *time.Time) *ProjectCreate {
+	if v != nil {
+		_c.SetTargetShipDate(*v)
+	}
+	return _c
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (_c *ProjectCreate) SetShippedAt(v time.Time) *ProjectCreate {
+	_c.mutation.SetShippedAt(v)
+	return _c
+}
+
+// SetNillableShippedAt sets the "shipped_at" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableShippedAt(v *time.Time) *ProjectCreate {
+	if v != nil {
+		_c.SetShippedAt(*v)
+	}
+	return _c
+}
+
+// SetVisibility sets the "visibility" field.
+func (_c *ProjectCreate) SetVisibility(v project.Visibility) *ProjectCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableVisibility(v *project.Visibility) *ProjectCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
+// SetDraft sets the "draft" field.
+func (_c *ProjectCreate) SetDraft(v bool) *ProjectCreate {
+	_c.mutation.SetDraft(v)
+	return _c
+}
+
+// SetNillableDraft sets the "draft" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableDraft(v *bool) *ProjectCreate {
+	if v != nil {
+		_c.SetDraft(*v)
+	}
+	return _c
+}
+
+// SetRemixedFromID sets the "remixed_from_id" field.
+func (_c *ProjectCreate) SetRemixedFromID(v string) *ProjectCreate {
+	_c.mutation.SetRemixedFromID(v)
+	return _c
+}
+
+// SetNillableRemixedFromID sets the "remixed_from_id" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableRemixedFromID(v *string) *ProjectCreate {
+	if v != nil {
+		_c.SetRemixedFromID(*v)
+	}
+	return _c
+}
+
+// SetRemixCount sets the "remix_count" field.
+func (_c *ProjectCreate) SetRemixCount(v int) *ProjectCreate {
+	_c.mutation.SetRemixCount(v)
+	return _c
+}
+
+// SetNillableRemixCount sets the "remix_count" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableRemixCount(v *int) *ProjectCreate {
+	if v != nil {
+		_c.SetRemixCount(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ProjectCreate) SetID(v string) *ProjectCreate {
 	_c.mutation.SetID(v)
@@ -147,6 +302,251 @@ func (_c *ProjectCreate) AddTags(v ...*Tag) *ProjectCreate {
 	return _c.AddTagIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_c *ProjectCreate) AddCommentIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddCommentIDs(ids...)
+	return _c
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_c *ProjectCreate) AddComments(v ...*Comment) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCommentIDs(ids...)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the ProjectStatusChange entity by IDs.
+func (_c *ProjectCreate) AddStatusChangeIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddStatusChangeIDs(ids...)
+	return _c
+}
+
+// AddStatusChanges adds the "status_changes" edges to the ProjectStatusChange entity.
+func (_c *ProjectCreate) AddStatusChanges(v ...*ProjectStatusChange) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStatusChangeIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by IDs.
+func (_c *ProjectCreate) AddMilestoneIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddMilestoneIDs(ids...)
+	return _c
+}
+
+// AddMilestones adds the "milestones" edges to the Milestone entity.
+func (_c *ProjectCreate) AddMilestones(v ...*Milestone) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMilestoneIDs(ids...)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (_c *ProjectCreate) AddTaskIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddTaskIDs(ids...)
+	return _c
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (_c *ProjectCreate) AddTasks(v ...*Task) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTaskIDs(ids...)
+}
+
+// AddMembershipIDs adds the "memberships" edge to the ProjectMember entity by IDs.
+func (_c *ProjectCreate) AddMembershipIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddMembershipIDs(ids...)
+	return _c
+}
+
+// AddMemberships adds the "memberships" edges to the ProjectMember entity.
+func (_c *ProjectCreate) AddMemberships(v ...*ProjectMember) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMembershipIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the ProjectInvitation entity by IDs.
+func (_c *ProjectCreate) AddInvitationIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddInvitationIDs(ids...)
+	return _c
+}
+
+// AddInvitations adds the "invitations" edges to the ProjectInvitation entity.
+func (_c *ProjectCreate) AddInvitations(v ...*ProjectInvitation) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInvitationIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the ProjectRole entity by IDs.
+func (_c *ProjectCreate) AddRoleIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddRoleIDs(ids...)
+	return _c
+}
+
+// AddRoles adds the "roles" edges to the ProjectRole entity.
+func (_c *ProjectCreate) AddRoles(v ...*ProjectRole) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRoleIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by IDs.
+func (_c *ProjectCreate) AddJoinRequestIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddJoinRequestIDs(ids...)
+	return _c
+}
+
+// AddJoinRequests adds the "join_requests" edges to the JoinRequest entity.
+func (_c *ProjectCreate) AddJoinRequests(v ...*JoinRequest) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddJoinRequestIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ProjectRevision entity by IDs.
+func (_c *ProjectCreate) AddRevisionIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the ProjectRevision entity.
+func (_c *ProjectCreate) AddRevisions(v ...*ProjectRevision) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// AddLinkIDs adds the "links" edge to the ProjectLink entity by IDs.
+func (_c *ProjectCreate) AddLinkIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddLinkIDs(ids...)
+	return _c
+}
+
+// AddLinks adds the "links" edges to the ProjectLink entity.
+func (_c *ProjectCreate) AddLinks(v ...*ProjectLink) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLinkIDs(ids...)
+}
+
+// AddMediumIDs adds the "media" edge to the ProjectMedia entity by IDs.
+func (_c *ProjectCreate) AddMediumIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddMediumIDs(ids...)
+	return _c
+}
+
+// AddMedia adds the "media" edges to the ProjectMedia entity.
+func (_c *ProjectCreate) AddMedia(v ...*ProjectMedia) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMediumIDs(ids...)
+}
+
+// AddCollectionItemIDs adds the "collection_items" edge to the CollectionItem entity by IDs.
+func (_c *ProjectCreate) AddCollectionItemIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddCollectionItemIDs(ids...)
+	return _c
+}
+
+// AddCollectionItems adds the "collection_items" edges to the CollectionItem entity.
+func (_c *ProjectCreate) AddCollectionItems(v ...*CollectionItem) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCollectionItemIDs(ids...)
+}
+
+// AddEventSubmissionIDs adds the "event_submissions" edge to the EventSubmission entity by IDs.
+func (_c *ProjectCreate) AddEventSubmissionIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddEventSubmissionIDs(ids...)
+	return _c
+}
+
+// AddEventSubmissions adds the "event_submissions" edges to the EventSubmission entity.
+func (_c *ProjectCreate) AddEventSubmissions(v ...*EventSubmission) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEventSubmissionIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_c *ProjectCreate) AddNotificationIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddNotificationIDs(ids...)
+	return _c
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_c *ProjectCreate) AddNotifications(v ...*Notification) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_c *ProjectCreate) AddWebhookIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddWebhookIDs(ids...)
+	return _c
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_c *ProjectCreate) AddWebhooks(v ...*Webhook) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookIDs(ids...)
+}
+
+// SetRemixedFrom sets the "remixed_from" edge to the Project entity.
+func (_c *ProjectCreate) SetRemixedFrom(v *Project) *ProjectCreate {
+	return _c.SetRemixedFromID(v.ID)
+}
+
+// AddRemixIDs adds the "remixes" edge to the Project entity by IDs.
+func (_c *ProjectCreate) AddRemixIDs(ids ...string) *ProjectCreate {
+	_c.mutation.AddRemixIDs(ids...)
+	return _c
+}
+
+// AddRemixes adds the "remixes" edges to the Project entity.
+func (_c *ProjectCreate) AddRemixes(v ...*Project) *ProjectCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRemixIDs(ids...)
+}
+
 // AddLikeIDs adds the "likes" edge to the Like entity by IDs.
 func (_c *ProjectCreate) AddLikeIDs(ids ...string) *ProjectCreate {
 	_c.mutation.AddLikeIDs(ids...)
@@ -184,7 +584,9 @@ func (_c *ProjectCreate) Mutation() *ProjectMutation {
 
 // Save creates the Project in the database.
 func (_c *ProjectCreate) Save(ctx context.Context) (*Project, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -211,12 +613,18 @@ func (_c *ProjectCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *ProjectCreate) defaults() {
+func (_c *ProjectCreate) defaults() error {
 	if _, ok := _c.mutation.CreateTime(); !ok {
+		if project.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized project.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := project.DefaultCreateTime()
 		_c.mutation.SetCreateTime(v)
 	}
 	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if project.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized project.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := project.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
@@ -228,10 +636,34 @@ func (_c *ProjectCreate) defaults() {
 		v := project.DefaultStarCount
 		_c.mutation.SetStarCount(v)
 	}
+	if _, ok := _c.mutation.CommentCount(); !ok {
+		v := project.DefaultCommentCount
+		_c.mutation.SetCommentCount(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := project.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := project.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
+	if _, ok := _c.mutation.Draft(); !ok {
+		v := project.DefaultDraft
+		_c.mutation.SetDraft(v)
+	}
+	if _, ok := _c.mutation.RemixCount(); !ok {
+		v := project.DefaultRemixCount
+		_c.mutation.SetRemixCount(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if project.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized project.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := project.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -264,6 +696,31 @@ func (_c *ProjectCreate) check() error {
 	if _, ok := _c.mutation.StarCount(); !ok {
 		return &ValidationError{Name: "star_count", err: errors.New(`ent: missing required field "Project.star_count"`)}
 	}
+	if _, ok := _c.mutation.CommentCount(); !ok {
+		return &ValidationError{Name: "comment_count", err: errors.New(`ent: missing required field "Project.comment_count"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Project.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := project.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Project.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Project.visibility"`)}
+	}
+	if v, ok := _c.mutation.Visibility(); ok {
+		if err := project.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Project.visibility": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Draft(); !ok {
+		return &ValidationError{Name: "draft", err: errors.New(`ent: missing required field "Project.draft"`)}
+	}
+	if _, ok := _c.mutation.RemixCount(); !ok {
+		return &ValidationError{Name: "remix_count", err: errors.New(`ent: missing required field "Project.remix_count"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := project.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Project.id": %w`, err)}
@@ -315,6 +772,10 @@ func (_c *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		_spec.SetField(project.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(project.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.Name(); ok {
 		_spec.SetField(project.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -331,6 +792,38 @@ func (_c *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		_spec.SetField(project.FieldStarCount, field.TypeInt, value)
 		_node.StarCount = value
 	}
+	if value, ok := _c.mutation.CommentCount(); ok {
+		_spec.SetField(project.FieldCommentCount, field.TypeInt, value)
+		_node.CommentCount = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(project.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TargetStartDate(); ok {
+		_spec.SetField(project.FieldTargetStartDate, field.TypeTime, value)
+		_node.TargetStartDate = &value
+	}
+	if value, ok := _c.mutation.TargetShipDate(); ok {
+		_spec.SetField(project.FieldTargetShipDate, field.TypeTime, value)
+		_node.TargetShipDate = &value
+	}
+	if value, ok := _c.mutation.ShippedAt(); ok {
+		_spec.SetField(project.FieldShippedAt, field.TypeTime, value)
+		_node.ShippedAt = &value
+	}
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(project.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := _c.mutation.Draft(); ok {
+		_spec.SetField(project.FieldDraft, field.TypeBool, value)
+		_node.Draft = value
+	}
+	if value, ok := _c.mutation.RemixCount(); ok {
+		_spec.SetField(project.FieldRemixCount, field.TypeInt, value)
+		_node.RemixCount = value
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -394,6 +887,279 @@ func (_c *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CommentsTable,
+			Columns: []string{project.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.StatusChangesTable,
+			Columns: []string{project.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MembershipsTable,
+			Columns: []string{project.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvitationsTable,
+			Columns: []string{project.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.JoinRequestsTable,
+			Columns: []string{project.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RevisionsTable,
+			Columns: []string{project.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LinksTable,
+			Columns: []string{project.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectlink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MediaTable,
+			Columns: []string{project.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CollectionItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CollectionItemsTable,
+			Columns: []string{project.CollectionItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EventSubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EventSubmissionsTable,
+			Columns: []string{project.EventSubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.NotificationsTable,
+			Columns: []string{project.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RemixedFromIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.RemixedFromTable,
+			Columns: []string{project.RemixedFromColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RemixedFromID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RemixesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RemixesTable,
+			Columns: []string{project.RemixesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.LikesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -11,12 +11,27 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jorge-j1m/hackspark_server/ent/collectionitem"
+	"github.com/jorge-j1m/hackspark_server/ent/comment"
+	"github.com/jorge-j1m/hackspark_server/ent/eventsubmission"
+	"github.com/jorge-j1m/hackspark_server/ent/joinrequest"
 	"github.com/jorge-j1m/hackspark_server/ent/like"
+	"github.com/jorge-j1m/hackspark_server/ent/milestone"
+	"github.com/jorge-j1m/hackspark_server/ent/notification"
 	"github.com/jorge-j1m/hackspark_server/ent/predicate"
 	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectinvitation"
+	"github.com/jorge-j1m/hackspark_server/ent/projectlink"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmedia"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrevision"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrole"
+	"github.com/jorge-j1m/hackspark_server/ent/projectstatuschange"
 	"github.com/jorge-j1m/hackspark_server/ent/projecttag"
 	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/ent/task"
 	"github.com/jorge-j1m/hackspark_server/ent/user"
+	"github.com/jorge-j1m/hackspark_server/ent/webhook"
 )
 
 // ProjectUpdate is the builder for updating Project entities.
@@ -38,6 +53,26 @@ func (_u *ProjectUpdate) SetUpdateTime(v time.Time) *ProjectUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ProjectUpdate) SetDeletedAt(v time.Time) *ProjectUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableDeletedAt(v *time.Time) *ProjectUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ProjectUpdate) ClearDeletedAt() *ProjectUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetName sets the "name" field.
 func (_u *ProjectUpdate) SetName(v string) *ProjectUpdate {
 	_u.mutation.SetName(v)
@@ -108,6 +143,150 @@ func (_u *ProjectUpdate) AddStarCount(v int) *ProjectUpdate {
 	return _u
 }
 
+// SetCommentCount sets the "comment_count" field.
+func (_u *ProjectUpdate) SetCommentCount(v int) *ProjectUpdate {
+	_u.mutation.ResetCommentCount()
+	_u.mutation.SetCommentCount(v)
+	return _u
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableCommentCount(v *int) *ProjectUpdate {
+	if v != nil {
+		_u.SetCommentCount(*v)
+	}
+	return _u
+}
+
+// AddCommentCount adds value to the "comment_count" field.
+func (_u *ProjectUpdate) AddCommentCount(v int) *ProjectUpdate {
+	_u.mutation.AddCommentCount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProjectUpdate) SetStatus(v project.Status) *ProjectUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableStatus(v *project.Status) *ProjectUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTargetStartDate sets the "target_start_date" field.
+func (_u *ProjectUpdate) SetTargetStartDate(v time.Time) *ProjectUpdate {
+	_u.mutation.SetTargetStartDate(v)
+	return _u
+}
+
+// SetNillableTargetStartDate sets the "target_start_date" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableTargetStartDate(v *time.Time) *ProjectUpdate {
+	if v != nil {
+		_u.SetTargetStartDate(*v)
+	}
+	return _u
+}
+
+// ClearTargetStartDate clears the value of the "target_start_date" field.
+func (_u *ProjectUpdate) ClearTargetStartDate() *ProjectUpdate {
+	_u.mutation.ClearTargetStartDate()
+	return _u
+}
+
+// SetTargetShipDate sets the "target_ship_date" field.
+func (_u *ProjectUpdate) SetTargetShipDate(v time.Time) *ProjectUpdate {
+	_u.mutation.SetTargetShipDate(v)
+	return _u
+}
+
+// SetNillableTargetShipDate sets the "target_ship_date" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableTargetShipDate(v *time.Time) *ProjectUpdate {
+	if v != nil {
+		_u.SetTargetShipDate(*v)
+	}
+	return _u
+}
+
+// ClearTargetShipDate clears the value of the "target_ship_date" field.
+func (_u *ProjectUpdate) ClearTargetShipDate() *ProjectUpdate {
+	_u.mutation.ClearTargetShipDate()
+	return _u
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (_u *ProjectUpdate) SetShippedAt(v time.Time) *ProjectUpdate {
+	_u.mutation.SetShippedAt(v)
+	return _u
+}
+
+// SetNillableShippedAt sets the "shipped_at" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableShippedAt(v *time.Time) *ProjectUpdate {
+	if v != nil {
+		_u.SetShippedAt(*v)
+	}
+	return _u
+}
+
+// ClearShippedAt clears the value of the "shipped_at" field.
+func (_u *ProjectUpdate) ClearShippedAt() *ProjectUpdate {
+	_u.mutation.ClearShippedAt()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *ProjectUpdate) SetVisibility(v project.Visibility) *ProjectUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableVisibility(v *project.Visibility) *ProjectUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetDraft sets the "draft" field.
+func (_u *ProjectUpdate) SetDraft(v bool) *ProjectUpdate {
+	_u.mutation.SetDraft(v)
+	return _u
+}
+
+// SetNillableDraft sets the "draft" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableDraft(v *bool) *ProjectUpdate {
+	if v != nil {
+		_u.SetDraft(*v)
+	}
+	return _u
+}
+
+// SetRemixCount sets the "remix_count" field.
+func (_u *ProjectUpdate) SetRemixCount(v int) *ProjectUpdate {
+	_u.mutation.ResetRemixCount()
+	_u.mutation.SetRemixCount(v)
+	return _u
+}
+
+// SetNillableRemixCount sets the "remix_count" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableRemixCount(v *int) *ProjectUpdate {
+	if v != nil {
+		_u.SetRemixCount(*v)
+	}
+	return _u
+}
+
+// AddRemixCount adds value to the "remix_count" field.
+func (_u *ProjectUpdate) AddRemixCount(v int) *ProjectUpdate {
+	_u.mutation.AddRemixCount(v)
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *ProjectUpdate) SetOwnerID(id string) *ProjectUpdate {
 	_u.mutation.SetOwnerID(id)
@@ -149,6 +328,246 @@ func (_u *ProjectUpdate) AddTags(v ...*Tag) *ProjectUpdate {
 	return _u.AddTagIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *ProjectUpdate) AddCommentIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *ProjectUpdate) AddComments(v ...*Comment) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the ProjectStatusChange entity by IDs.
+func (_u *ProjectUpdate) AddStatusChangeIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddStatusChangeIDs(ids...)
+	return _u
+}
+
+// AddStatusChanges adds the "status_changes" edges to the ProjectStatusChange entity.
+func (_u *ProjectUpdate) AddStatusChanges(v ...*ProjectStatusChange) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusChangeIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by IDs.
+func (_u *ProjectUpdate) AddMilestoneIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddMilestoneIDs(ids...)
+	return _u
+}
+
+// AddMilestones adds the "milestones" edges to the Milestone entity.
+func (_u *ProjectUpdate) AddMilestones(v ...*Milestone) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMilestoneIDs(ids...)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (_u *ProjectUpdate) AddTaskIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddTaskIDs(ids...)
+	return _u
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (_u *ProjectUpdate) AddTasks(v ...*Task) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTaskIDs(ids...)
+}
+
+// AddMembershipIDs adds the "memberships" edge to the ProjectMember entity by IDs.
+func (_u *ProjectUpdate) AddMembershipIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddMembershipIDs(ids...)
+	return _u
+}
+
+// AddMemberships adds the "memberships" edges to the ProjectMember entity.
+func (_u *ProjectUpdate) AddMemberships(v ...*ProjectMember) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMembershipIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the ProjectInvitation entity by IDs.
+func (_u *ProjectUpdate) AddInvitationIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddInvitationIDs(ids...)
+	return _u
+}
+
+// AddInvitations adds the "invitations" edges to the ProjectInvitation entity.
+func (_u *ProjectUpdate) AddInvitations(v ...*ProjectInvitation) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvitationIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the ProjectRole entity by IDs.
+func (_u *ProjectUpdate) AddRoleIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddRoleIDs(ids...)
+	return _u
+}
+
+// AddRoles adds the "roles" edges to the ProjectRole entity.
+func (_u *ProjectUpdate) AddRoles(v ...*ProjectRole) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by IDs.
+func (_u *ProjectUpdate) AddJoinRequestIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddJoinRequestIDs(ids...)
+	return _u
+}
+
+// AddJoinRequests adds the "join_requests" edges to the JoinRequest entity.
+func (_u *ProjectUpdate) AddJoinRequests(v ...*JoinRequest) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJoinRequestIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ProjectRevision entity by IDs.
+func (_u *ProjectUpdate) AddRevisionIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the ProjectRevision entity.
+func (_u *ProjectUpdate) AddRevisions(v ...*ProjectRevision) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddLinkIDs adds the "links" edge to the ProjectLink entity by IDs.
+func (_u *ProjectUpdate) AddLinkIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddLinkIDs(ids...)
+	return _u
+}
+
+// AddLinks adds the "links" edges to the ProjectLink entity.
+func (_u *ProjectUpdate) AddLinks(v ...*ProjectLink) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLinkIDs(ids...)
+}
+
+// AddMediumIDs adds the "media" edge to the ProjectMedia entity by IDs.
+func (_u *ProjectUpdate) AddMediumIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddMediumIDs(ids...)
+	return _u
+}
+
+// AddMedia adds the "media" edges to the ProjectMedia entity.
+func (_u *ProjectUpdate) AddMedia(v ...*ProjectMedia) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMediumIDs(ids...)
+}
+
+// AddCollectionItemIDs adds the "collection_items" edge to the CollectionItem entity by IDs.
+func (_u *ProjectUpdate) AddCollectionItemIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddCollectionItemIDs(ids...)
+	return _u
+}
+
+// AddCollectionItems adds the "collection_items" edges to the CollectionItem entity.
+func (_u *ProjectUpdate) AddCollectionItems(v ...*CollectionItem) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCollectionItemIDs(ids...)
+}
+
+// AddEventSubmissionIDs adds the "event_submissions" edge to the EventSubmission entity by IDs.
+func (_u *ProjectUpdate) AddEventSubmissionIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddEventSubmissionIDs(ids...)
+	return _u
+}
+
+// AddEventSubmissions adds the "event_submissions" edges to the EventSubmission entity.
+func (_u *ProjectUpdate) AddEventSubmissions(v ...*EventSubmission) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventSubmissionIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *ProjectUpdate) AddNotificationIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *ProjectUpdate) AddNotifications(v ...*Notification) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *ProjectUpdate) AddWebhookIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *ProjectUpdate) AddWebhooks(v ...*Webhook) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
+// AddRemixIDs adds the "remixes" edge to the Project entity by IDs.
+func (_u *ProjectUpdate) AddRemixIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.AddRemixIDs(ids...)
+	return _u
+}
+
+// AddRemixes adds the "remixes" edges to the Project entity.
+func (_u *ProjectUpdate) AddRemixes(v ...*Project) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRemixIDs(ids...)
+}
+
 // AddLikeIDs adds the "likes" edge to the Like entity by IDs.
 func (_u *ProjectUpdate) AddLikeIDs(ids ...string) *ProjectUpdate {
 	_u.mutation.AddLikeIDs(ids...)
@@ -232,52 +651,390 @@ func (_u *ProjectUpdate) RemoveTags(v ...*Tag) *ProjectUpdate {
 	return _u.RemoveTagIDs(ids...)
 }
 
-// ClearLikes clears all "likes" edges to the Like entity.
-func (_u *ProjectUpdate) ClearLikes() *ProjectUpdate {
-	_u.mutation.ClearLikes()
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *ProjectUpdate) ClearComments() *ProjectUpdate {
+	_u.mutation.ClearComments()
 	return _u
 }
 
-// RemoveLikeIDs removes the "likes" edge to Like entities by IDs.
-func (_u *ProjectUpdate) RemoveLikeIDs(ids ...string) *ProjectUpdate {
-	_u.mutation.RemoveLikeIDs(ids...)
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *ProjectUpdate) RemoveCommentIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveCommentIDs(ids...)
 	return _u
 }
 
-// RemoveLikes removes "likes" edges to Like entities.
-func (_u *ProjectUpdate) RemoveLikes(v ...*Like) *ProjectUpdate {
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *ProjectUpdate) RemoveComments(v ...*Comment) *ProjectUpdate {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLikeIDs(ids...)
+	return _u.RemoveCommentIDs(ids...)
 }
 
-// ClearProjectTags clears all "project_tags" edges to the ProjectTag entity.
-func (_u *ProjectUpdate) ClearProjectTags() *ProjectUpdate {
-	_u.mutation.ClearProjectTags()
+// ClearStatusChanges clears all "status_changes" edges to the ProjectStatusChange entity.
+func (_u *ProjectUpdate) ClearStatusChanges() *ProjectUpdate {
+	_u.mutation.ClearStatusChanges()
 	return _u
 }
 
-// RemoveProjectTagIDs removes the "project_tags" edge to ProjectTag entities by IDs.
-func (_u *ProjectUpdate) RemoveProjectTagIDs(ids ...string) *ProjectUpdate {
-	_u.mutation.RemoveProjectTagIDs(ids...)
+// RemoveStatusChangeIDs removes the "status_changes" edge to ProjectStatusChange entities by IDs.
+func (_u *ProjectUpdate) RemoveStatusChangeIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveStatusChangeIDs(ids...)
 	return _u
 }
 
-// RemoveProjectTags removes "project_tags" edges to ProjectTag entities.
-func (_u *ProjectUpdate) RemoveProjectTags(v ...*ProjectTag) *ProjectUpdate {
+// RemoveStatusChanges removes "status_changes" edges to ProjectStatusChange entities.
+func (_u *ProjectUpdate) RemoveStatusChanges(v ...*ProjectStatusChange) *ProjectUpdate {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveProjectTagIDs(ids...)
+	return _u.RemoveStatusChangeIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *ProjectUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearMilestones clears all "milestones" edges to the Milestone entity.
+func (_u *ProjectUpdate) ClearMilestones() *ProjectUpdate {
+	_u.mutation.ClearMilestones()
+	return _u
+}
+
+// RemoveMilestoneIDs removes the "milestones" edge to Milestone entities by IDs.
+func (_u *ProjectUpdate) RemoveMilestoneIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveMilestoneIDs(ids...)
+	return _u
+}
+
+// RemoveMilestones removes "milestones" edges to Milestone entities.
+func (_u *ProjectUpdate) RemoveMilestones(v ...*Milestone) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMilestoneIDs(ids...)
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (_u *ProjectUpdate) ClearTasks() *ProjectUpdate {
+	_u.mutation.ClearTasks()
+	return _u
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (_u *ProjectUpdate) RemoveTaskIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveTaskIDs(ids...)
+	return _u
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (_u *ProjectUpdate) RemoveTasks(v ...*Task) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTaskIDs(ids...)
+}
+
+// ClearMemberships clears all "memberships" edges to the ProjectMember entity.
+func (_u *ProjectUpdate) ClearMemberships() *ProjectUpdate {
+	_u.mutation.ClearMemberships()
+	return _u
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to ProjectMember entities by IDs.
+func (_u *ProjectUpdate) RemoveMembershipIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveMemberships removes "memberships" edges to ProjectMember entities.
+func (_u *ProjectUpdate) RemoveMemberships(v ...*ProjectMember) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMembershipIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the ProjectInvitation entity.
+func (_u *ProjectUpdate) ClearInvitations() *ProjectUpdate {
+	_u.mutation.ClearInvitations()
+	return _u
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to ProjectInvitation entities by IDs.
+func (_u *ProjectUpdate) RemoveInvitationIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveInvitations removes "invitations" edges to ProjectInvitation entities.
+func (_u *ProjectUpdate) RemoveInvitations(v ...*ProjectInvitation) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvitationIDs(ids...)
+}
+
+// ClearRoles clears all "roles" edges to the ProjectRole entity.
+func (_u *ProjectUpdate) ClearRoles() *ProjectUpdate {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to ProjectRole entities by IDs.
+func (_u *ProjectUpdate) RemoveRoleIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to ProjectRole entities.
+func (_u *ProjectUpdate) RemoveRoles(v ...*ProjectRole) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
+// ClearJoinRequests clears all "join_requests" edges to the JoinRequest entity.
+func (_u *ProjectUpdate) ClearJoinRequests() *ProjectUpdate {
+	_u.mutation.ClearJoinRequests()
+	return _u
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to JoinRequest entities by IDs.
+func (_u *ProjectUpdate) RemoveJoinRequestIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveJoinRequestIDs(ids...)
+	return _u
+}
+
+// RemoveJoinRequests removes "join_requests" edges to JoinRequest entities.
+func (_u *ProjectUpdate) RemoveJoinRequests(v ...*JoinRequest) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJoinRequestIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the ProjectRevision entity.
+func (_u *ProjectUpdate) ClearRevisions() *ProjectUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to ProjectRevision entities by IDs.
+func (_u *ProjectUpdate) RemoveRevisionIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to ProjectRevision entities.
+func (_u *ProjectUpdate) RemoveRevisions(v ...*ProjectRevision) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearLinks clears all "links" edges to the ProjectLink entity.
+func (_u *ProjectUpdate) ClearLinks() *ProjectUpdate {
+	_u.mutation.ClearLinks()
+	return _u
+}
+
+// RemoveLinkIDs removes the "links" edge to ProjectLink entities by IDs.
+func (_u *ProjectUpdate) RemoveLinkIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveLinkIDs(ids...)
+	return _u
+}
+
+// RemoveLinks removes "links" edges to ProjectLink entities.
+func (_u *ProjectUpdate) RemoveLinks(v ...*ProjectLink) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLinkIDs(ids...)
+}
+
+// ClearMedia clears all "media" edges to the ProjectMedia entity.
+func (_u *ProjectUpdate) ClearMedia() *ProjectUpdate {
+	_u.mutation.ClearMedia()
+	return _u
+}
+
+// RemoveMediumIDs removes the "media" edge to ProjectMedia entities by IDs.
+func (_u *ProjectUpdate) RemoveMediumIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveMediumIDs(ids...)
+	return _u
+}
+
+// RemoveMedia removes "media" edges to ProjectMedia entities.
+func (_u *ProjectUpdate) RemoveMedia(v ...*ProjectMedia) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMediumIDs(ids...)
+}
+
+// ClearCollectionItems clears all "collection_items" edges to the CollectionItem entity.
+func (_u *ProjectUpdate) ClearCollectionItems() *ProjectUpdate {
+	_u.mutation.ClearCollectionItems()
+	return _u
+}
+
+// RemoveCollectionItemIDs removes the "collection_items" edge to CollectionItem entities by IDs.
+func (_u *ProjectUpdate) RemoveCollectionItemIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveCollectionItemIDs(ids...)
+	return _u
+}
+
+// RemoveCollectionItems removes "collection_items" edges to CollectionItem entities.
+func (_u *ProjectUpdate) RemoveCollectionItems(v ...*CollectionItem) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCollectionItemIDs(ids...)
+}
+
+// ClearEventSubmissions clears all "event_submissions" edges to the EventSubmission entity.
+func (_u *ProjectUpdate) ClearEventSubmissions() *ProjectUpdate {
+	_u.mutation.ClearEventSubmissions()
+	return _u
+}
+
+// RemoveEventSubmissionIDs removes the "event_submissions" edge to EventSubmission entities by IDs.
+func (_u *ProjectUpdate) RemoveEventSubmissionIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveEventSubmissionIDs(ids...)
+	return _u
+}
+
+// RemoveEventSubmissions removes "event_submissions" edges to EventSubmission entities.
+func (_u *ProjectUpdate) RemoveEventSubmissions(v ...*EventSubmission) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventSubmissionIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *ProjectUpdate) ClearNotifications() *ProjectUpdate {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *ProjectUpdate) RemoveNotificationIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *ProjectUpdate) RemoveNotifications(v ...*Notification) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *ProjectUpdate) ClearWebhooks() *ProjectUpdate {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *ProjectUpdate) RemoveWebhookIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *ProjectUpdate) RemoveWebhooks(v ...*Webhook) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
+// ClearRemixes clears all "remixes" edges to the Project entity.
+func (_u *ProjectUpdate) ClearRemixes() *ProjectUpdate {
+	_u.mutation.ClearRemixes()
+	return _u
+}
+
+// RemoveRemixIDs removes the "remixes" edge to Project entities by IDs.
+func (_u *ProjectUpdate) RemoveRemixIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveRemixIDs(ids...)
+	return _u
+}
+
+// RemoveRemixes removes "remixes" edges to Project entities.
+func (_u *ProjectUpdate) RemoveRemixes(v ...*Project) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRemixIDs(ids...)
+}
+
+// ClearLikes clears all "likes" edges to the Like entity.
+func (_u *ProjectUpdate) ClearLikes() *ProjectUpdate {
+	_u.mutation.ClearLikes()
+	return _u
+}
+
+// RemoveLikeIDs removes the "likes" edge to Like entities by IDs.
+func (_u *ProjectUpdate) RemoveLikeIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveLikeIDs(ids...)
+	return _u
+}
+
+// RemoveLikes removes "likes" edges to Like entities.
+func (_u *ProjectUpdate) RemoveLikes(v ...*Like) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLikeIDs(ids...)
+}
+
+// ClearProjectTags clears all "project_tags" edges to the ProjectTag entity.
+func (_u *ProjectUpdate) ClearProjectTags() *ProjectUpdate {
+	_u.mutation.ClearProjectTags()
+	return _u
+}
+
+// RemoveProjectTagIDs removes the "project_tags" edge to ProjectTag entities by IDs.
+func (_u *ProjectUpdate) RemoveProjectTagIDs(ids ...string) *ProjectUpdate {
+	_u.mutation.RemoveProjectTagIDs(ids...)
+	return _u
+}
+
+// RemoveProjectTags removes "project_tags" edges to ProjectTag entities.
+func (_u *ProjectUpdate) RemoveProjectTags(v ...*ProjectTag) *ProjectUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveProjectTagIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProjectUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
@@ -303,11 +1060,15 @@ func (_u *ProjectUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ProjectUpdate) defaults() {
+func (_u *ProjectUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if project.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized project.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := project.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -322,6 +1083,16 @@ func (_u *ProjectUpdate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Project.description": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := project.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Project.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := project.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Project.visibility": %w`, err)}
+		}
+	}
 	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Project.owner"`)
 	}
@@ -343,6 +1114,12 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(project.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(project.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(project.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(project.FieldName, field.TypeString, value)
 	}
@@ -361,6 +1138,45 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedStarCount(); ok {
 		_spec.AddField(project.FieldStarCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.CommentCount(); ok {
+		_spec.SetField(project.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCommentCount(); ok {
+		_spec.AddField(project.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(project.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TargetStartDate(); ok {
+		_spec.SetField(project.FieldTargetStartDate, field.TypeTime, value)
+	}
+	if _u.mutation.TargetStartDateCleared() {
+		_spec.ClearField(project.FieldTargetStartDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TargetShipDate(); ok {
+		_spec.SetField(project.FieldTargetShipDate, field.TypeTime, value)
+	}
+	if _u.mutation.TargetShipDateCleared() {
+		_spec.ClearField(project.FieldTargetShipDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ShippedAt(); ok {
+		_spec.SetField(project.FieldShippedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ShippedAtCleared() {
+		_spec.ClearField(project.FieldShippedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(project.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Draft(); ok {
+		_spec.SetField(project.FieldDraft, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RemixCount(); ok {
+		_spec.SetField(project.FieldRemixCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRemixCount(); ok {
+		_spec.AddField(project.FieldRemixCount, field.TypeInt, value)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -522,28 +1338,28 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LikesCleared() {
+	if _u.mutation.CommentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   project.LikesTable,
-			Columns: []string{project.LikesColumn},
+			Inverse: false,
+			Table:   project.CommentsTable,
+			Columns: []string{project.CommentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLikesIDs(); len(nodes) > 0 && !_u.mutation.LikesCleared() {
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   project.LikesTable,
-			Columns: []string{project.LikesColumn},
+			Inverse: false,
+			Table:   project.CommentsTable,
+			Columns: []string{project.CommentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -551,15 +1367,15 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LikesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   project.LikesTable,
-			Columns: []string{project.LikesColumn},
+			Inverse: false,
+			Table:   project.CommentsTable,
+			Columns: []string{project.CommentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -567,28 +1383,28 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ProjectTagsCleared() {
+	if _u.mutation.StatusChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   project.ProjectTagsTable,
-			Columns: []string{project.ProjectTagsColumn},
+			Inverse: false,
+			Table:   project.StatusChangesTable,
+			Columns: []string{project.StatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(projecttag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedProjectTagsIDs(); len(nodes) > 0 && !_u.mutation.ProjectTagsCleared() {
+	if nodes := _u.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !_u.mutation.StatusChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   project.ProjectTagsTable,
-			Columns: []string{project.ProjectTagsColumn},
+			Inverse: false,
+			Table:   project.StatusChangesTable,
+			Columns: []string{project.StatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(projecttag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -596,15 +1412,15 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ProjectTagsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.StatusChangesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   project.ProjectTagsTable,
-			Columns: []string{project.ProjectTagsColumn},
+			Inverse: false,
+			Table:   project.StatusChangesTable,
+			Columns: []string{project.StatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(projecttag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -612,407 +1428,2600 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{project.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeString),
+			},
 		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// ProjectUpdateOne is the builder for updating a single Project entity.
-type ProjectUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ProjectMutation
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (_u *ProjectUpdateOne) SetUpdateTime(v time.Time) *ProjectUpdateOne {
-	_u.mutation.SetUpdateTime(v)
-	return _u
-}
-
-// SetName sets the "name" field.
-func (_u *ProjectUpdateOne) SetName(v string) *ProjectUpdateOne {
-	_u.mutation.SetName(v)
-	return _u
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *ProjectUpdateOne) SetNillableName(v *string) *ProjectUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetDescription sets the "description" field.
-func (_u *ProjectUpdateOne) SetDescription(v string) *ProjectUpdateOne {
-	_u.mutation.SetDescription(v)
-	return _u
-}
-
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (_u *ProjectUpdateOne) SetNillableDescription(v *string) *ProjectUpdateOne {
-	if v != nil {
-		_u.SetDescription(*v)
+	if nodes := _u.mutation.RemovedMilestonesIDs(); len(nodes) > 0 && !_u.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetLikeCount sets the "like_count" field.
-func (_u *ProjectUpdateOne) SetLikeCount(v int) *ProjectUpdateOne {
-	_u.mutation.ResetLikeCount()
-	_u.mutation.SetLikeCount(v)
-	return _u
-}
-
-// SetNillableLikeCount sets the "like_count" field if the given value is not nil.
-func (_u *ProjectUpdateOne) SetNillableLikeCount(v *int) *ProjectUpdateOne {
-	if v != nil {
-		_u.SetLikeCount(*v)
+	if nodes := _u.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// AddLikeCount adds value to the "like_count" field.
-func (_u *ProjectUpdateOne) AddLikeCount(v int) *ProjectUpdateOne {
-	_u.mutation.AddLikeCount(v)
-	return _u
-}
-
-// SetStarCount sets the "star_count" field.
-func (_u *ProjectUpdateOne) SetStarCount(v int) *ProjectUpdateOne {
-	_u.mutation.ResetStarCount()
-	_u.mutation.SetStarCount(v)
-	return _u
-}
-
-// SetNillableStarCount sets the "star_count" field if the given value is not nil.
-func (_u *ProjectUpdateOne) SetNillableStarCount(v *int) *ProjectUpdateOne {
-	if v != nil {
-		_u.SetStarCount(*v)
+	if _u.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// AddStarCount adds value to the "star_count" field.
-func (_u *ProjectUpdateOne) AddStarCount(v int) *ProjectUpdateOne {
-	_u.mutation.AddStarCount(v)
-	return _u
-}
-
-// SetOwnerID sets the "owner" edge to the User entity by ID.
-func (_u *ProjectUpdateOne) SetOwnerID(id string) *ProjectUpdateOne {
-	_u.mutation.SetOwnerID(id)
-	return _u
-}
-
-// SetOwner sets the "owner" edge to the User entity.
-func (_u *ProjectUpdateOne) SetOwner(v *User) *ProjectUpdateOne {
-	return _u.SetOwnerID(v.ID)
-}
-
-// AddLikedByIDs adds the "liked_by" edge to the User entity by IDs.
-func (_u *ProjectUpdateOne) AddLikedByIDs(ids ...string) *ProjectUpdateOne {
-	_u.mutation.AddLikedByIDs(ids...)
-	return _u
-}
-
-// AddLikedBy adds the "liked_by" edges to the User entity.
-func (_u *ProjectUpdateOne) AddLikedBy(v ...*User) *ProjectUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedTasksIDs(); len(nodes) > 0 && !_u.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddLikedByIDs(ids...)
-}
-
-// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
-func (_u *ProjectUpdateOne) AddTagIDs(ids ...string) *ProjectUpdateOne {
-	_u.mutation.AddTagIDs(ids...)
-	return _u
-}
-
-// AddTags adds the "tags" edges to the Tag entity.
-func (_u *ProjectUpdateOne) AddTags(v ...*Tag) *ProjectUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddTagIDs(ids...)
-}
-
-// AddLikeIDs adds the "likes" edge to the Like entity by IDs.
-func (_u *ProjectUpdateOne) AddLikeIDs(ids ...string) *ProjectUpdateOne {
-	_u.mutation.AddLikeIDs(ids...)
-	return _u
-}
-
-// AddLikes adds the "likes" edges to the Like entity.
-func (_u *ProjectUpdateOne) AddLikes(v ...*Like) *ProjectUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MembershipsTable,
+			Columns: []string{project.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddLikeIDs(ids...)
-}
-
-// AddProjectTagIDs adds the "project_tags" edge to the ProjectTag entity by IDs.
-func (_u *ProjectUpdateOne) AddProjectTagIDs(ids ...string) *ProjectUpdateOne {
-	_u.mutation.AddProjectTagIDs(ids...)
-	return _u
-}
-
-// AddProjectTags adds the "project_tags" edges to the ProjectTag entity.
-func (_u *ProjectUpdateOne) AddProjectTags(v ...*ProjectTag) *ProjectUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !_u.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MembershipsTable,
+			Columns: []string{project.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddProjectTagIDs(ids...)
-}
-
-// Mutation returns the ProjectMutation object of the builder.
-func (_u *ProjectUpdateOne) Mutation() *ProjectMutation {
-	return _u.mutation
-}
-
+	if nodes := _u.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MembershipsTable,
+			Columns: []string{project.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvitationsTable,
+			Columns: []string{project.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !_u.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvitationsTable,
+			Columns: []string{project.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvitationsTable,
+			Columns: []string{project.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.JoinRequestsTable,
+			Columns: []string{project.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedJoinRequestsIDs(); len(nodes) > 0 && !_u.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.JoinRequestsTable,
+			Columns: []string{project.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.JoinRequestsTable,
+			Columns: []string{project.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RevisionsTable,
+			Columns: []string{project.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RevisionsTable,
+			Columns: []string{project.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RevisionsTable,
+			Columns: []string{project.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LinksTable,
+			Columns: []string{project.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectlink.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinksIDs(); len(nodes) > 0 && !_u.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LinksTable,
+			Columns: []string{project.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectlink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LinksTable,
+			Columns: []string{project.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectlink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MediaTable,
+			Columns: []string{project.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMediaIDs(); len(nodes) > 0 && !_u.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MediaTable,
+			Columns: []string{project.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MediaTable,
+			Columns: []string{project.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CollectionItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CollectionItemsTable,
+			Columns: []string{project.CollectionItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCollectionItemsIDs(); len(nodes) > 0 && !_u.mutation.CollectionItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CollectionItemsTable,
+			Columns: []string{project.CollectionItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CollectionItemsTable,
+			Columns: []string{project.CollectionItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventSubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EventSubmissionsTable,
+			Columns: []string{project.EventSubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventSubmissionsIDs(); len(nodes) > 0 && !_u.mutation.EventSubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EventSubmissionsTable,
+			Columns: []string{project.EventSubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventSubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EventSubmissionsTable,
+			Columns: []string{project.EventSubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.NotificationsTable,
+			Columns: []string{project.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.NotificationsTable,
+			Columns: []string{project.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.NotificationsTable,
+			Columns: []string{project.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RemixesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RemixesTable,
+			Columns: []string{project.RemixesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRemixesIDs(); len(nodes) > 0 && !_u.mutation.RemixesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RemixesTable,
+			Columns: []string{project.RemixesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemixesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RemixesTable,
+			Columns: []string{project.RemixesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   project.LikesTable,
+			Columns: []string{project.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLikesIDs(); len(nodes) > 0 && !_u.mutation.LikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   project.LikesTable,
+			Columns: []string{project.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LikesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   project.LikesTable,
+			Columns: []string{project.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProjectTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   project.ProjectTagsTable,
+			Columns: []string{project.ProjectTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedProjectTagsIDs(); len(nodes) > 0 && !_u.mutation.ProjectTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   project.ProjectTagsTable,
+			Columns: []string{project.ProjectTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   project.ProjectTagsTable,
+			Columns: []string{project.ProjectTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projecttag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{project.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProjectUpdateOne is the builder for updating a single Project entity.
+type ProjectUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProjectMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ProjectUpdateOne) SetUpdateTime(v time.Time) *ProjectUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ProjectUpdateOne) SetDeletedAt(v time.Time) *ProjectUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableDeletedAt(v *time.Time) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ProjectUpdateOne) ClearDeletedAt() *ProjectUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ProjectUpdateOne) SetName(v string) *ProjectUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableName(v *string) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ProjectUpdateOne) SetDescription(v string) *ProjectUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableDescription(v *string) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetLikeCount sets the "like_count" field.
+func (_u *ProjectUpdateOne) SetLikeCount(v int) *ProjectUpdateOne {
+	_u.mutation.ResetLikeCount()
+	_u.mutation.SetLikeCount(v)
+	return _u
+}
+
+// SetNillableLikeCount sets the "like_count" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableLikeCount(v *int) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetLikeCount(*v)
+	}
+	return _u
+}
+
+// AddLikeCount adds value to the "like_count" field.
+func (_u *ProjectUpdateOne) AddLikeCount(v int) *ProjectUpdateOne {
+	_u.mutation.AddLikeCount(v)
+	return _u
+}
+
+// SetStarCount sets the "star_count" field.
+func (_u *ProjectUpdateOne) SetStarCount(v int) *ProjectUpdateOne {
+	_u.mutation.ResetStarCount()
+	_u.mutation.SetStarCount(v)
+	return _u
+}
+
+// SetNillableStarCount sets the "star_count" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableStarCount(v *int) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetStarCount(*v)
+	}
+	return _u
+}
+
+// AddStarCount adds value to the "star_count" field.
+func (_u *ProjectUpdateOne) AddStarCount(v int) *ProjectUpdateOne {
+	_u.mutation.AddStarCount(v)
+	return _u
+}
+
+// SetCommentCount sets the "comment_count" field.
+func (_u *ProjectUpdateOne) SetCommentCount(v int) *ProjectUpdateOne {
+	_u.mutation.ResetCommentCount()
+	_u.mutation.SetCommentCount(v)
+	return _u
+}
+
+// SetNillableCommentCount sets the "comment_count" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableCommentCount(v *int) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetCommentCount(*v)
+	}
+	return _u
+}
+
+// AddCommentCount adds value to the "comment_count" field.
+func (_u *ProjectUpdateOne) AddCommentCount(v int) *ProjectUpdateOne {
+	_u.mutation.AddCommentCount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProjectUpdateOne) SetStatus(v project.Status) *ProjectUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableStatus(v *project.Status) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTargetStartDate sets the "target_start_date" field.
+func (_u *ProjectUpdateOne) SetTargetStartDate(v time.Time) *ProjectUpdateOne {
+	_u.mutation.SetTargetStartDate(v)
+	return _u
+}
+
+// SetNillableTargetStartDate sets the "target_start_date" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableTargetStartDate(v *time.Time) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetTargetStartDate(*v)
+	}
+	return _u
+}
+
+// ClearTargetStartDate clears the value of the "target_start_date" field.
+func (_u *ProjectUpdateOne) ClearTargetStartDate() *ProjectUpdateOne {
+	_u.mutation.ClearTargetStartDate()
+	return _u
+}
+
+// SetTargetShipDate sets the "target_ship_date" field.
+func (_u *ProjectUpdateOne) SetTargetShipDate(v time.Time) *ProjectUpdateOne {
+	_u.mutation.SetTargetShipDate(v)
+	return _u
+}
+
+// SetNillableTargetShipDate sets the "target_ship_date" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableTargetShipDate(v *time.Time) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetTargetShipDate(*v)
+	}
+	return _u
+}
+
+// ClearTargetShipDate clears the value of the "target_ship_date" field.
+func (_u *ProjectUpdateOne) ClearTargetShipDate() *ProjectUpdateOne {
+	_u.mutation.ClearTargetShipDate()
+	return _u
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (_u *ProjectUpdateOne) SetShippedAt(v time.Time) *ProjectUpdateOne {
+	_u.mutation.SetShippedAt(v)
+	return _u
+}
+
+// SetNillableShippedAt sets the "shipped_at" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableShippedAt(v *time.Time) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetShippedAt(*v)
+	}
+	return _u
+}
+
+// ClearShippedAt clears the value of the "shipped_at" field.
+func (_u *ProjectUpdateOne) ClearShippedAt() *ProjectUpdateOne {
+	_u.mutation.ClearShippedAt()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *ProjectUpdateOne) SetVisibility(v project.Visibility) *ProjectUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableVisibility(v *project.Visibility) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetDraft sets the "draft" field.
+func (_u *ProjectUpdateOne) SetDraft(v bool) *ProjectUpdateOne {
+	_u.mutation.SetDraft(v)
+	return _u
+}
+
+// SetNillableDraft sets the "draft" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableDraft(v *bool) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetDraft(*v)
+	}
+	return _u
+}
+
+// SetRemixCount sets the "remix_count" field.
+func (_u *ProjectUpdateOne) SetRemixCount(v int) *ProjectUpdateOne {
+	_u.mutation.ResetRemixCount()
+	_u.mutation.SetRemixCount(v)
+	return _u
+}
+
+// SetNillableRemixCount sets the "remix_count" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableRemixCount(v *int) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetRemixCount(*v)
+	}
+	return _u
+}
+
+// AddRemixCount adds value to the "remix_count" field.
+func (_u *ProjectUpdateOne) AddRemixCount(v int) *ProjectUpdateOne {
+	_u.mutation.AddRemixCount(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *ProjectUpdateOne) SetOwnerID(id string) *ProjectUpdateOne {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *ProjectUpdateOne) SetOwner(v *User) *ProjectUpdateOne {
+	return _u.SetOwnerID(v.ID)
+}
+
+// AddLikedByIDs adds the "liked_by" edge to the User entity by IDs.
+func (_u *ProjectUpdateOne) AddLikedByIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddLikedByIDs(ids...)
+	return _u
+}
+
+// AddLikedBy adds the "liked_by" edges to the User entity.
+func (_u *ProjectUpdateOne) AddLikedBy(v ...*User) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLikedByIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *ProjectUpdateOne) AddTagIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *ProjectUpdateOne) AddTags(v ...*Tag) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *ProjectUpdateOne) AddCommentIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *ProjectUpdateOne) AddComments(v ...*Comment) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the ProjectStatusChange entity by IDs.
+func (_u *ProjectUpdateOne) AddStatusChangeIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddStatusChangeIDs(ids...)
+	return _u
+}
+
+// AddStatusChanges adds the "status_changes" edges to the ProjectStatusChange entity.
+func (_u *ProjectUpdateOne) AddStatusChanges(v ...*ProjectStatusChange) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusChangeIDs(ids...)
+}
+
+// AddMilestoneIDs adds the "milestones" edge to the Milestone entity by IDs.
+func (_u *ProjectUpdateOne) AddMilestoneIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddMilestoneIDs(ids...)
+	return _u
+}
+
+// AddMilestones adds the "milestones" edges to the Milestone entity.
+func (_u *ProjectUpdateOne) AddMilestones(v ...*Milestone) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMilestoneIDs(ids...)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (_u *ProjectUpdateOne) AddTaskIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddTaskIDs(ids...)
+	return _u
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (_u *ProjectUpdateOne) AddTasks(v ...*Task) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTaskIDs(ids...)
+}
+
+// AddMembershipIDs adds the "memberships" edge to the ProjectMember entity by IDs.
+func (_u *ProjectUpdateOne) AddMembershipIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddMembershipIDs(ids...)
+	return _u
+}
+
+// AddMemberships adds the "memberships" edges to the ProjectMember entity.
+func (_u *ProjectUpdateOne) AddMemberships(v ...*ProjectMember) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMembershipIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the ProjectInvitation entity by IDs.
+func (_u *ProjectUpdateOne) AddInvitationIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddInvitationIDs(ids...)
+	return _u
+}
+
+// AddInvitations adds the "invitations" edges to the ProjectInvitation entity.
+func (_u *ProjectUpdateOne) AddInvitations(v ...*ProjectInvitation) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvitationIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the ProjectRole entity by IDs.
+func (_u *ProjectUpdateOne) AddRoleIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddRoleIDs(ids...)
+	return _u
+}
+
+// AddRoles adds the "roles" edges to the ProjectRole entity.
+func (_u *ProjectUpdateOne) AddRoles(v ...*ProjectRole) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by IDs.
+func (_u *ProjectUpdateOne) AddJoinRequestIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddJoinRequestIDs(ids...)
+	return _u
+}
+
+// AddJoinRequests adds the "join_requests" edges to the JoinRequest entity.
+func (_u *ProjectUpdateOne) AddJoinRequests(v ...*JoinRequest) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJoinRequestIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ProjectRevision entity by IDs.
+func (_u *ProjectUpdateOne) AddRevisionIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the ProjectRevision entity.
+func (_u *ProjectUpdateOne) AddRevisions(v ...*ProjectRevision) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddLinkIDs adds the "links" edge to the ProjectLink entity by IDs.
+func (_u *ProjectUpdateOne) AddLinkIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddLinkIDs(ids...)
+	return _u
+}
+
+// AddLinks adds the "links" edges to the ProjectLink entity.
+func (_u *ProjectUpdateOne) AddLinks(v ...*ProjectLink) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLinkIDs(ids...)
+}
+
+// AddMediumIDs adds the "media" edge to the ProjectMedia entity by IDs.
+func (_u *ProjectUpdateOne) AddMediumIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddMediumIDs(ids...)
+	return _u
+}
+
+// AddMedia adds the "media" edges to the ProjectMedia entity.
+func (_u *ProjectUpdateOne) AddMedia(v ...*ProjectMedia) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMediumIDs(ids...)
+}
+
+// AddCollectionItemIDs adds the "collection_items" edge to the CollectionItem entity by IDs.
+func (_u *ProjectUpdateOne) AddCollectionItemIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddCollectionItemIDs(ids...)
+	return _u
+}
+
+// AddCollectionItems adds the "collection_items" edges to the CollectionItem entity.
+func (_u *ProjectUpdateOne) AddCollectionItems(v ...*CollectionItem) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCollectionItemIDs(ids...)
+}
+
+// AddEventSubmissionIDs adds the "event_submissions" edge to the EventSubmission entity by IDs.
+func (_u *ProjectUpdateOne) AddEventSubmissionIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddEventSubmissionIDs(ids...)
+	return _u
+}
+
+// AddEventSubmissions adds the "event_submissions" edges to the EventSubmission entity.
+func (_u *ProjectUpdateOne) AddEventSubmissions(v ...*EventSubmission) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventSubmissionIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *ProjectUpdateOne) AddNotificationIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *ProjectUpdateOne) AddNotifications(v ...*Notification) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *ProjectUpdateOne) AddWebhookIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *ProjectUpdateOne) AddWebhooks(v ...*Webhook) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
+// AddRemixIDs adds the "remixes" edge to the Project entity by IDs.
+func (_u *ProjectUpdateOne) AddRemixIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddRemixIDs(ids...)
+	return _u
+}
+
+// AddRemixes adds the "remixes" edges to the Project entity.
+func (_u *ProjectUpdateOne) AddRemixes(v ...*Project) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRemixIDs(ids...)
+}
+
+// AddLikeIDs adds the "likes" edge to the Like entity by IDs.
+func (_u *ProjectUpdateOne) AddLikeIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddLikeIDs(ids...)
+	return _u
+}
+
+// AddLikes adds the "likes" edges to the Like entity.
+func (_u *ProjectUpdateOne) AddLikes(v ...*Like) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLikeIDs(ids...)
+}
+
+// AddProjectTagIDs adds the "project_tags" edge to the ProjectTag entity by IDs.
+func (_u *ProjectUpdateOne) AddProjectTagIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.AddProjectTagIDs(ids...)
+	return _u
+}
+
+// AddProjectTags adds the "project_tags" edges to the ProjectTag entity.
+func (_u *ProjectUpdateOne) AddProjectTags(v ...*ProjectTag) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddProjectTagIDs(ids...)
+}
+
+// Mutation returns the ProjectMutation object of the builder.
+func (_u *ProjectUpdateOne) Mutation() *ProjectMutation {
+	return _u.mutation
+}
+
 // ClearOwner clears the "owner" edge to the User entity.
 func (_u *ProjectUpdateOne) ClearOwner() *ProjectUpdateOne {
 	_u.mutation.ClearOwner()
 	return _u
 }
 
-// ClearLikedBy clears all "liked_by" edges to the User entity.
-func (_u *ProjectUpdateOne) ClearLikedBy() *ProjectUpdateOne {
-	_u.mutation.ClearLikedBy()
+// ClearLikedBy clears all "liked_by" edges to the User entity.
+func (_u *ProjectUpdateOne) ClearLikedBy() *ProjectUpdateOne {
+	_u.mutation.ClearLikedBy()
+	return _u
+}
+
+// RemoveLikedByIDs removes the "liked_by" edge to User entities by IDs.
+func (_u *ProjectUpdateOne) RemoveLikedByIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveLikedByIDs(ids...)
+	return _u
+}
+
+// RemoveLikedBy removes "liked_by" edges to User entities.
+func (_u *ProjectUpdateOne) RemoveLikedBy(v ...*User) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLikedByIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *ProjectUpdateOne) ClearTags() *ProjectUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *ProjectUpdateOne) RemoveTagIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *ProjectUpdateOne) RemoveTags(v ...*Tag) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *ProjectUpdateOne) ClearComments() *ProjectUpdateOne {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *ProjectUpdateOne) RemoveCommentIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveCommentIDs(ids...)
+	return _u
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *ProjectUpdateOne) RemoveComments(v ...*Comment) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearStatusChanges clears all "status_changes" edges to the ProjectStatusChange entity.
+func (_u *ProjectUpdateOne) ClearStatusChanges() *ProjectUpdateOne {
+	_u.mutation.ClearStatusChanges()
+	return _u
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to ProjectStatusChange entities by IDs.
+func (_u *ProjectUpdateOne) RemoveStatusChangeIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveStatusChangeIDs(ids...)
+	return _u
+}
+
+// RemoveStatusChanges removes "status_changes" edges to ProjectStatusChange entities.
+func (_u *ProjectUpdateOne) RemoveStatusChanges(v ...*ProjectStatusChange) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatusChangeIDs(ids...)
+}
+
+// ClearMilestones clears all "milestones" edges to the Milestone entity.
+func (_u *ProjectUpdateOne) ClearMilestones() *ProjectUpdateOne {
+	_u.mutation.ClearMilestones()
+	return _u
+}
+
+// RemoveMilestoneIDs removes the "milestones" edge to Milestone entities by IDs.
+func (_u *ProjectUpdateOne) RemoveMilestoneIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveMilestoneIDs(ids...)
+	return _u
+}
+
+// RemoveMilestones removes "milestones" edges to Milestone entities.
+func (_u *ProjectUpdateOne) RemoveMilestones(v ...*Milestone) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMilestoneIDs(ids...)
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (_u *ProjectUpdateOne) ClearTasks() *ProjectUpdateOne {
+	_u.mutation.ClearTasks()
+	return _u
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (_u *ProjectUpdateOne) RemoveTaskIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveTaskIDs(ids...)
+	return _u
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (_u *ProjectUpdateOne) RemoveTasks(v ...*Task) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTaskIDs(ids...)
+}
+
+// ClearMemberships clears all "memberships" edges to the ProjectMember entity.
+func (_u *ProjectUpdateOne) ClearMemberships() *ProjectUpdateOne {
+	_u.mutation.ClearMemberships()
+	return _u
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to ProjectMember entities by IDs.
+func (_u *ProjectUpdateOne) RemoveMembershipIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveMemberships removes "memberships" edges to ProjectMember entities.
+func (_u *ProjectUpdateOne) RemoveMemberships(v ...*ProjectMember) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMembershipIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the ProjectInvitation entity.
+func (_u *ProjectUpdateOne) ClearInvitations() *ProjectUpdateOne {
+	_u.mutation.ClearInvitations()
+	return _u
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to ProjectInvitation entities by IDs.
+func (_u *ProjectUpdateOne) RemoveInvitationIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveInvitations removes "invitations" edges to ProjectInvitation entities.
+func (_u *ProjectUpdateOne) RemoveInvitations(v ...*ProjectInvitation) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvitationIDs(ids...)
+}
+
+// ClearRoles clears all "roles" edges to the ProjectRole entity.
+func (_u *ProjectUpdateOne) ClearRoles() *ProjectUpdateOne {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to ProjectRole entities by IDs.
+func (_u *ProjectUpdateOne) RemoveRoleIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to ProjectRole entities.
+func (_u *ProjectUpdateOne) RemoveRoles(v ...*ProjectRole) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
+// ClearJoinRequests clears all "join_requests" edges to the JoinRequest entity.
+func (_u *ProjectUpdateOne) ClearJoinRequests() *ProjectUpdateOne {
+	_u.mutation.ClearJoinRequests()
+	return _u
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to JoinRequest entities by IDs.
+func (_u *ProjectUpdateOne) RemoveJoinRequestIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveJoinRequestIDs(ids...)
+	return _u
+}
+
+// RemoveJoinRequests removes "join_requests" edges to JoinRequest entities.
+func (_u *ProjectUpdateOne) RemoveJoinRequests(v ...*JoinRequest) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJoinRequestIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the ProjectRevision entity.
+func (_u *ProjectUpdateOne) ClearRevisions() *ProjectUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to ProjectRevision entities by IDs.
+func (_u *ProjectUpdateOne) RemoveRevisionIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to ProjectRevision entities.
+func (_u *ProjectUpdateOne) RemoveRevisions(v ...*ProjectRevision) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearLinks clears all "links" edges to the ProjectLink entity.
+func (_u *ProjectUpdateOne) ClearLinks() *ProjectUpdateOne {
+	_u.mutation.ClearLinks()
+	return _u
+}
+
+// RemoveLinkIDs removes the "links" edge to ProjectLink entities by IDs.
+func (_u *ProjectUpdateOne) RemoveLinkIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveLinkIDs(ids...)
+	return _u
+}
+
+// RemoveLinks removes "links" edges to ProjectLink entities.
+func (_u *ProjectUpdateOne) RemoveLinks(v ...*ProjectLink) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLinkIDs(ids...)
+}
+
+// ClearMedia clears all "media" edges to the ProjectMedia entity.
+func (_u *ProjectUpdateOne) ClearMedia() *ProjectUpdateOne {
+	_u.mutation.ClearMedia()
+	return _u
+}
+
+// RemoveMediumIDs removes the "media" edge to ProjectMedia entities by IDs.
+func (_u *ProjectUpdateOne) RemoveMediumIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveMediumIDs(ids...)
+	return _u
+}
+
+// RemoveMedia removes "media" edges to ProjectMedia entities.
+func (_u *ProjectUpdateOne) RemoveMedia(v ...*ProjectMedia) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMediumIDs(ids...)
+}
+
+// ClearCollectionItems clears all "collection_items" edges to the CollectionItem entity.
+func (_u *ProjectUpdateOne) ClearCollectionItems() *ProjectUpdateOne {
+	_u.mutation.ClearCollectionItems()
+	return _u
+}
+
+// RemoveCollectionItemIDs removes the "collection_items" edge to CollectionItem entities by IDs.
+func (_u *ProjectUpdateOne) RemoveCollectionItemIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveCollectionItemIDs(ids...)
+	return _u
+}
+
+// RemoveCollectionItems removes "collection_items" edges to CollectionItem entities.
+func (_u *ProjectUpdateOne) RemoveCollectionItems(v ...*CollectionItem) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCollectionItemIDs(ids...)
+}
+
+// ClearEventSubmissions clears all "event_submissions" edges to the EventSubmission entity.
+func (_u *ProjectUpdateOne) ClearEventSubmissions() *ProjectUpdateOne {
+	_u.mutation.ClearEventSubmissions()
+	return _u
+}
+
+// RemoveEventSubmissionIDs removes the "event_submissions" edge to EventSubmission entities by IDs.
+func (_u *ProjectUpdateOne) RemoveEventSubmissionIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveEventSubmissionIDs(ids...)
+	return _u
+}
+
+// RemoveEventSubmissions removes "event_submissions" edges to EventSubmission entities.
+func (_u *ProjectUpdateOne) RemoveEventSubmissions(v ...*EventSubmission) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventSubmissionIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *ProjectUpdateOne) ClearNotifications() *ProjectUpdateOne {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *ProjectUpdateOne) RemoveNotificationIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *ProjectUpdateOne) RemoveNotifications(v ...*Notification) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *ProjectUpdateOne) ClearWebhooks() *ProjectUpdateOne {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *ProjectUpdateOne) RemoveWebhookIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveWebhookIDs(ids...)
 	return _u
 }
 
-// RemoveLikedByIDs removes the "liked_by" edge to User entities by IDs.
-func (_u *ProjectUpdateOne) RemoveLikedByIDs(ids ...string) *ProjectUpdateOne {
-	_u.mutation.RemoveLikedByIDs(ids...)
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *ProjectUpdateOne) RemoveWebhooks(v ...*Webhook) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
+// ClearRemixes clears all "remixes" edges to the Project entity.
+func (_u *ProjectUpdateOne) ClearRemixes() *ProjectUpdateOne {
+	_u.mutation.ClearRemixes()
 	return _u
 }
 
-// RemoveLikedBy removes "liked_by" edges to User entities.
-func (_u *ProjectUpdateOne) RemoveLikedBy(v ...*User) *ProjectUpdateOne {
+// RemoveRemixIDs removes the "remixes" edge to Project entities by IDs.
+func (_u *ProjectUpdateOne) RemoveRemixIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveRemixIDs(ids...)
+	return _u
+}
+
+// RemoveRemixes removes "remixes" edges to Project entities.
+func (_u *ProjectUpdateOne) RemoveRemixes(v ...*Project) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRemixIDs(ids...)
+}
+
+// ClearLikes clears all "likes" edges to the Like entity.
+func (_u *ProjectUpdateOne) ClearLikes() *ProjectUpdateOne {
+	_u.mutation.ClearLikes()
+	return _u
+}
+
+// RemoveLikeIDs removes the "likes" edge to Like entities by IDs.
+func (_u *ProjectUpdateOne) RemoveLikeIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveLikeIDs(ids...)
+	return _u
+}
+
+// RemoveLikes removes "likes" edges to Like entities.
+func (_u *ProjectUpdateOne) RemoveLikes(v ...*Like) *ProjectUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLikeIDs(ids...)
+}
+
+// ClearProjectTags clears all "project_tags" edges to the ProjectTag entity.
+func (_u *ProjectUpdateOne) ClearProjectTags() *ProjectUpdateOne {
+	_u.mutation.ClearProjectTags()
+	return _u
+}
+
+// RemoveProjectTagIDs removes the "project_tags" edge to ProjectTag entities by IDs.
+func (_u *ProjectUpdateOne) RemoveProjectTagIDs(ids ...string) *ProjectUpdateOne {
+	_u.mutation.RemoveProjectTagIDs(ids...)
+	return _u
+}
+
+// RemoveProjectTags removes "project_tags" edges to ProjectTag entities.
+func (_u *ProjectUpdateOne) RemoveProjectTags(v ...*ProjectTag) *ProjectUpdateOne {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLikedByIDs(ids...)
-}
-
-// ClearTags clears all "tags" edges to the Tag entity.
-func (_u *ProjectUpdateOne) ClearTags() *ProjectUpdateOne {
-	_u.mutation.ClearTags()
-	return _u
-}
-
-// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
-func (_u *ProjectUpdateOne) RemoveTagIDs(ids ...string) *ProjectUpdateOne {
-	_u.mutation.RemoveTagIDs(ids...)
-	return _u
-}
-
-// RemoveTags removes "tags" edges to Tag entities.
-func (_u *ProjectUpdateOne) RemoveTags(v ...*Tag) *ProjectUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	return _u.RemoveProjectTagIDs(ids...)
+}
+
+// Where appends a list predicates to the ProjectUpdate builder.
+func (_u *ProjectUpdateOne) Where(ps ...predicate.Project) *ProjectUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProjectUpdateOne) Select(field string, fields ...string) *ProjectUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Project entity.
+func (_u *ProjectUpdateOne) Save(ctx context.Context) (*Project, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProjectUpdateOne) SaveX(ctx context.Context) *Project {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProjectUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProjectUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProjectUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if project.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized project.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := project.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProjectUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := project.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Project.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := project.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Project.description": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := project.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Project.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := project.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Project.visibility": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Project.owner"`)
+	}
+	return nil
+}
+
+func (_u *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(project.Table, project.Columns, sqlgraph.NewFieldSpec(project.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Project.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, project.FieldID)
+		for _, f := range fields {
+			if !project.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != project.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(project.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(project.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(project.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(project.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(project.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LikeCount(); ok {
+		_spec.SetField(project.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLikeCount(); ok {
+		_spec.AddField(project.FieldLikeCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StarCount(); ok {
+		_spec.SetField(project.FieldStarCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStarCount(); ok {
+		_spec.AddField(project.FieldStarCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CommentCount(); ok {
+		_spec.SetField(project.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCommentCount(); ok {
+		_spec.AddField(project.FieldCommentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(project.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TargetStartDate(); ok {
+		_spec.SetField(project.FieldTargetStartDate, field.TypeTime, value)
+	}
+	if _u.mutation.TargetStartDateCleared() {
+		_spec.ClearField(project.FieldTargetStartDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TargetShipDate(); ok {
+		_spec.SetField(project.FieldTargetShipDate, field.TypeTime, value)
+	}
+	if _u.mutation.TargetShipDateCleared() {
+		_spec.ClearField(project.FieldTargetShipDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ShippedAt(); ok {
+		_spec.SetField(project.FieldShippedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ShippedAtCleared() {
+		_spec.ClearField(project.FieldShippedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(project.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Draft(); ok {
+		_spec.SetField(project.FieldDraft, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RemixCount(); ok {
+		_spec.SetField(project.FieldRemixCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRemixCount(); ok {
+		_spec.AddField(project.FieldRemixCount, field.TypeInt, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.OwnerTable,
+			Columns: []string{project.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   project.OwnerTable,
+			Columns: []string{project.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LikedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.LikedByTable,
+			Columns: project.LikedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		createE := &LikeCreate{config: _u.config, mutation: newLikeMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLikedByIDs(); len(nodes) > 0 && !_u.mutation.LikedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.LikedByTable,
+			Columns: project.LikedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &LikeCreate{config: _u.config, mutation: newLikeMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LikedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   project.LikedByTable,
+			Columns: project.LikedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &LikeCreate{config: _u.config, mutation: newLikeMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.TagsTable,
+			Columns: project.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		createE := &ProjectTagCreate{config: _u.config, mutation: newProjectTagMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.TagsTable,
+			Columns: project.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &ProjectTagCreate{config: _u.config, mutation: newProjectTagMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   project.TagsTable,
+			Columns: project.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &ProjectTagCreate{config: _u.config, mutation: newProjectTagMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CommentsTable,
+			Columns: []string{project.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CommentsTable,
+			Columns: []string{project.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CommentsTable,
+			Columns: []string{project.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.StatusChangesTable,
+			Columns: []string{project.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !_u.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.StatusChangesTable,
+			Columns: []string{project.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.StatusChangesTable,
+			Columns: []string{project.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMilestonesIDs(); len(nodes) > 0 && !_u.mutation.MilestonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MilestonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MilestonesTable,
+			Columns: []string{project.MilestonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(milestone.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTasksIDs(); len(nodes) > 0 && !_u.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MembershipsTable,
+			Columns: []string{project.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !_u.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MembershipsTable,
+			Columns: []string{project.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MembershipsTable,
+			Columns: []string{project.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveTagIDs(ids...)
-}
-
-// ClearLikes clears all "likes" edges to the Like entity.
-func (_u *ProjectUpdateOne) ClearLikes() *ProjectUpdateOne {
-	_u.mutation.ClearLikes()
-	return _u
-}
-
-// RemoveLikeIDs removes the "likes" edge to Like entities by IDs.
-func (_u *ProjectUpdateOne) RemoveLikeIDs(ids ...string) *ProjectUpdateOne {
-	_u.mutation.RemoveLikeIDs(ids...)
-	return _u
-}
-
-// RemoveLikes removes "likes" edges to Like entities.
-func (_u *ProjectUpdateOne) RemoveLikes(v ...*Like) *ProjectUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvitationsTable,
+			Columns: []string{project.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveLikeIDs(ids...)
-}
-
-// ClearProjectTags clears all "project_tags" edges to the ProjectTag entity.
-func (_u *ProjectUpdateOne) ClearProjectTags() *ProjectUpdateOne {
-	_u.mutation.ClearProjectTags()
-	return _u
-}
-
-// RemoveProjectTagIDs removes the "project_tags" edge to ProjectTag entities by IDs.
-func (_u *ProjectUpdateOne) RemoveProjectTagIDs(ids ...string) *ProjectUpdateOne {
-	_u.mutation.RemoveProjectTagIDs(ids...)
-	return _u
-}
-
-// RemoveProjectTags removes "project_tags" edges to ProjectTag entities.
-func (_u *ProjectUpdateOne) RemoveProjectTags(v ...*ProjectTag) *ProjectUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !_u.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvitationsTable,
+			Columns: []string{project.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveProjectTagIDs(ids...)
-}
-
-// Where appends a list predicates to the ProjectUpdate builder.
-func (_u *ProjectUpdateOne) Where(ps ...predicate.Project) *ProjectUpdateOne {
-	_u.mutation.Where(ps...)
-	return _u
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *ProjectUpdateOne) Select(field string, fields ...string) *ProjectUpdateOne {
-	_u.fields = append([]string{field}, fields...)
-	return _u
-}
-
-// Save executes the query and returns the updated Project entity.
-func (_u *ProjectUpdateOne) Save(ctx context.Context) (*Project, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (_u *ProjectUpdateOne) SaveX(ctx context.Context) *Project {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := _u.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvitationsTable,
+			Columns: []string{project.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (_u *ProjectUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *ProjectUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (_u *ProjectUpdateOne) defaults() {
-	if _, ok := _u.mutation.UpdateTime(); !ok {
-		v := project.UpdateDefaultUpdateTime()
-		_u.mutation.SetUpdateTime(v)
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (_u *ProjectUpdateOne) check() error {
-	if v, ok := _u.mutation.Name(); ok {
-		if err := project.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Project.name": %w`, err)}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RolesTable,
+			Columns: []string{project.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrole.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := _u.mutation.Description(); ok {
-		if err := project.DescriptionValidator(v); err != nil {
-			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Project.description": %w`, err)}
+	if _u.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.JoinRequestsTable,
+			Columns: []string{project.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Project.owner"`)
+	if nodes := _u.mutation.RemovedJoinRequestsIDs(); len(nodes) > 0 && !_u.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.JoinRequestsTable,
+			Columns: []string{project.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (_u *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+	if nodes := _u.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.JoinRequestsTable,
+			Columns: []string{project.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(project.Table, project.Columns, sqlgraph.NewFieldSpec(project.FieldID, field.TypeString))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Project.id" for update`)}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RevisionsTable,
+			Columns: []string{project.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, project.FieldID)
-		for _, f := range fields {
-			if !project.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != project.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RevisionsTable,
+			Columns: []string{project.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RevisionsTable,
+			Columns: []string{project.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.UpdateTime(); ok {
-		_spec.SetField(project.FieldUpdateTime, field.TypeTime, value)
+	if _u.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LinksTable,
+			Columns: []string{project.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectlink.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(project.FieldName, field.TypeString, value)
+	if nodes := _u.mutation.RemovedLinksIDs(); len(nodes) > 0 && !_u.mutation.LinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LinksTable,
+			Columns: []string{project.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectlink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Description(); ok {
-		_spec.SetField(project.FieldDescription, field.TypeString, value)
+	if nodes := _u.mutation.LinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LinksTable,
+			Columns: []string{project.LinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectlink.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.LikeCount(); ok {
-		_spec.SetField(project.FieldLikeCount, field.TypeInt, value)
+	if _u.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MediaTable,
+			Columns: []string{project.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.AddedLikeCount(); ok {
-		_spec.AddField(project.FieldLikeCount, field.TypeInt, value)
+	if nodes := _u.mutation.RemovedMediaIDs(); len(nodes) > 0 && !_u.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MediaTable,
+			Columns: []string{project.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.StarCount(); ok {
-		_spec.SetField(project.FieldStarCount, field.TypeInt, value)
+	if nodes := _u.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.MediaTable,
+			Columns: []string{project.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.AddedStarCount(); ok {
-		_spec.AddField(project.FieldStarCount, field.TypeInt, value)
+	if _u.mutation.CollectionItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CollectionItemsTable,
+			Columns: []string{project.CollectionItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collectionitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.OwnerCleared() {
+	if nodes := _u.mutation.RemovedCollectionItemsIDs(); len(nodes) > 0 && !_u.mutation.CollectionItemsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   project.OwnerTable,
-			Columns: []string{project.OwnerColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CollectionItemsTable,
+			Columns: []string{project.CollectionItemsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(collectionitem.FieldID, field.TypeString),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CollectionItemsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   project.OwnerTable,
-			Columns: []string{project.OwnerColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CollectionItemsTable,
+			Columns: []string{project.CollectionItemsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(collectionitem.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1020,135 +4029,183 @@ func (_u *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LikedByCleared() {
+	if _u.mutation.EventSubmissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   project.LikedByTable,
-			Columns: project.LikedByPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EventSubmissionsTable,
+			Columns: []string{project.EventSubmissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
 			},
 		}
-		createE := &LikeCreate{config: _u.config, mutation: newLikeMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventSubmissionsIDs(); len(nodes) > 0 && !_u.mutation.EventSubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EventSubmissionsTable,
+			Columns: []string{project.EventSubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLikedByIDs(); len(nodes) > 0 && !_u.mutation.LikedByCleared() {
+	if nodes := _u.mutation.EventSubmissionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   project.LikedByTable,
-			Columns: project.LikedByPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.EventSubmissionsTable,
+			Columns: []string{project.EventSubmissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &LikeCreate{config: _u.config, mutation: newLikeMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.NotificationsTable,
+			Columns: []string{project.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LikedByIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   project.LikedByTable,
-			Columns: project.LikedByPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.NotificationsTable,
+			Columns: []string{project.NotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &LikeCreate{config: _u.config, mutation: newLikeMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.NotificationsTable,
+			Columns: []string{project.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TagsCleared() {
+	if _u.mutation.WebhooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.TagsTable,
-			Columns: project.TagsPrimaryKey,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
 			},
 		}
-		createE := &ProjectTagCreate{config: _u.config, mutation: newProjectTagMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.TagsTable,
-			Columns: project.TagsPrimaryKey,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &ProjectTagCreate{config: _u.config, mutation: newProjectTagMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RemixesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RemixesTable,
+			Columns: []string{project.RemixesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedRemixesIDs(); len(nodes) > 0 && !_u.mutation.RemixesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.TagsTable,
-			Columns: project.TagsPrimaryKey,
+			Table:   project.RemixesTable,
+			Columns: []string{project.RemixesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &ProjectTagCreate{config: _u.config, mutation: newProjectTagMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemixesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.RemixesTable,
+			Columns: []string{project.RemixesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
@@ -5,20 +5,447 @@ package runtime
 import (
 	"time"
 
+	"github.com/jorge-j1m/hackspark_server/ent/collection"
+	"github.com/jorge-j1m/hackspark_server/ent/collectionitem"
+	"github.com/jorge-j1m/hackspark_server/ent/comment"
+	"github.com/jorge-j1m/hackspark_server/ent/event"
+	"github.com/jorge-j1m/hackspark_server/ent/eventcriterion"
+	"github.com/jorge-j1m/hackspark_server/ent/eventmember"
+	"github.com/jorge-j1m/hackspark_server/ent/eventscore"
+	"github.com/jorge-j1m/hackspark_server/ent/eventsubmission"
+	"github.com/jorge-j1m/hackspark_server/ent/job"
+	"github.com/jorge-j1m/hackspark_server/ent/joinrequest"
 	"github.com/jorge-j1m/hackspark_server/ent/like"
+	"github.com/jorge-j1m/hackspark_server/ent/milestone"
+	"github.com/jorge-j1m/hackspark_server/ent/notification"
+	"github.com/jorge-j1m/hackspark_server/ent/notificationpreference"
 	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectinvitation"
+	"github.com/jorge-j1m/hackspark_server/ent/projectlink"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmedia"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrevision"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrole"
+	"github.com/jorge-j1m/hackspark_server/ent/projectstatuschange"
 	"github.com/jorge-j1m/hackspark_server/ent/projecttag"
+	"github.com/jorge-j1m/hackspark_server/ent/scheduledrun"
 	"github.com/jorge-j1m/hackspark_server/ent/schema"
 	"github.com/jorge-j1m/hackspark_server/ent/session"
 	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/ent/task"
+	"github.com/jorge-j1m/hackspark_server/ent/trendingsnapshot"
 	"github.com/jorge-j1m/hackspark_server/ent/user"
 	"github.com/jorge-j1m/hackspark_server/ent/usertechnology"
+	"github.com/jorge-j1m/hackspark_server/ent/webhook"
+	"github.com/jorge-j1m/hackspark_server/ent/webhookdelivery"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	collectionMixin := schema.Collection{}.Mixin()
+	collectionMixinFields0 := collectionMixin[0].Fields()
+	_ = collectionMixinFields0
+	collectionFields := schema.Collection{}.Fields()
+	_ = collectionFields
+	// collectionDescCreateTime is the schema descriptor for create_time field.
+	collectionDescCreateTime := collectionMixinFields0[0].Descriptor()
+	// collection.DefaultCreateTime holds the default value on creation for the create_time field.
+	collection.DefaultCreateTime = collectionDescCreateTime.Default.(func() time.Time)
+	// collectionDescUpdateTime is the schema descriptor for update_time field.
+	collectionDescUpdateTime := collectionMixinFields0[1].Descriptor()
+	// collection.DefaultUpdateTime holds the default value on creation for the update_time field.
+	collection.DefaultUpdateTime = collectionDescUpdateTime.Default.(func() time.Time)
+	// collection.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	collection.UpdateDefaultUpdateTime = collectionDescUpdateTime.UpdateDefault.(func() time.Time)
+	// collectionDescOwnerID is the schema descriptor for owner_id field.
+	collectionDescOwnerID := collectionFields[1].Descriptor()
+	// collection.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	collection.OwnerIDValidator = collectionDescOwnerID.Validators[0].(func(string) error)
+	// collectionDescName is the schema descriptor for name field.
+	collectionDescName := collectionFields[2].Descriptor()
+	// collection.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	collection.NameValidator = func() func(string) error {
+		validators := collectionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// collectionDescDescription is the schema descriptor for description field.
+	collectionDescDescription := collectionFields[3].Descriptor()
+	// collection.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	collection.DescriptionValidator = collectionDescDescription.Validators[0].(func(string) error)
+	// collectionDescID is the schema descriptor for id field.
+	collectionDescID := collectionFields[0].Descriptor()
+	// collection.DefaultID holds the default value on creation for the id field.
+	collection.DefaultID = collectionDescID.Default.(func() string)
+	// collection.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	collection.IDValidator = collectionDescID.Validators[0].(func(string) error)
+	collectionitemMixin := schema.CollectionItem{}.Mixin()
+	collectionitemMixinFields0 := collectionitemMixin[0].Fields()
+	_ = collectionitemMixinFields0
+	collectionitemFields := schema.CollectionItem{}.Fields()
+	_ = collectionitemFields
+	// collectionitemDescCreateTime is the schema descriptor for create_time field.
+	collectionitemDescCreateTime := collectionitemMixinFields0[0].Descriptor()
+	// collectionitem.DefaultCreateTime holds the default value on creation for the create_time field.
+	collectionitem.DefaultCreateTime = collectionitemDescCreateTime.Default.(func() time.Time)
+	// collectionitemDescUpdateTime is the schema descriptor for update_time field.
+	collectionitemDescUpdateTime := collectionitemMixinFields0[1].Descriptor()
+	// collectionitem.DefaultUpdateTime holds the default value on creation for the update_time field.
+	collectionitem.DefaultUpdateTime = collectionitemDescUpdateTime.Default.(func() time.Time)
+	// collectionitem.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	collectionitem.UpdateDefaultUpdateTime = collectionitemDescUpdateTime.UpdateDefault.(func() time.Time)
+	// collectionitemDescCollectionID is the schema descriptor for collection_id field.
+	collectionitemDescCollectionID := collectionitemFields[1].Descriptor()
+	// collectionitem.CollectionIDValidator is a validator for the "collection_id" field. It is called by the builders before save.
+	collectionitem.CollectionIDValidator = collectionitemDescCollectionID.Validators[0].(func(string) error)
+	// collectionitemDescProjectID is the schema descriptor for project_id field.
+	collectionitemDescProjectID := collectionitemFields[2].Descriptor()
+	// collectionitem.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	collectionitem.ProjectIDValidator = collectionitemDescProjectID.Validators[0].(func(string) error)
+	// collectionitemDescNote is the schema descriptor for note field.
+	collectionitemDescNote := collectionitemFields[3].Descriptor()
+	// collectionitem.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	collectionitem.NoteValidator = collectionitemDescNote.Validators[0].(func(string) error)
+	// collectionitemDescPosition is the schema descriptor for position field.
+	collectionitemDescPosition := collectionitemFields[4].Descriptor()
+	// collectionitem.DefaultPosition holds the default value on creation for the position field.
+	collectionitem.DefaultPosition = collectionitemDescPosition.Default.(int)
+	// collectionitemDescID is the schema descriptor for id field.
+	collectionitemDescID := collectionitemFields[0].Descriptor()
+	// collectionitem.DefaultID holds the default value on creation for the id field.
+	collectionitem.DefaultID = collectionitemDescID.Default.(func() string)
+	// collectionitem.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	collectionitem.IDValidator = collectionitemDescID.Validators[0].(func(string) error)
+	commentMixin := schema.Comment{}.Mixin()
+	commentMixinFields0 := commentMixin[0].Fields()
+	_ = commentMixinFields0
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescCreateTime is the schema descriptor for create_time field.
+	commentDescCreateTime := commentMixinFields0[0].Descriptor()
+	// comment.DefaultCreateTime holds the default value on creation for the create_time field.
+	comment.DefaultCreateTime = commentDescCreateTime.Default.(func() time.Time)
+	// commentDescUpdateTime is the schema descriptor for update_time field.
+	commentDescUpdateTime := commentMixinFields0[1].Descriptor()
+	// comment.DefaultUpdateTime holds the default value on creation for the update_time field.
+	comment.DefaultUpdateTime = commentDescUpdateTime.Default.(func() time.Time)
+	// comment.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	comment.UpdateDefaultUpdateTime = commentDescUpdateTime.UpdateDefault.(func() time.Time)
+	// commentDescProjectID is the schema descriptor for project_id field.
+	commentDescProjectID := commentFields[1].Descriptor()
+	// comment.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	comment.ProjectIDValidator = commentDescProjectID.Validators[0].(func(string) error)
+	// commentDescAuthorID is the schema descriptor for author_id field.
+	commentDescAuthorID := commentFields[2].Descriptor()
+	// comment.AuthorIDValidator is a validator for the "author_id" field. It is called by the builders before save.
+	comment.AuthorIDValidator = commentDescAuthorID.Validators[0].(func(string) error)
+	// commentDescBody is the schema descriptor for body field.
+	commentDescBody := commentFields[4].Descriptor()
+	// comment.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	comment.BodyValidator = commentDescBody.Validators[0].(func(string) error)
+	// commentDescEdited is the schema descriptor for edited field.
+	commentDescEdited := commentFields[5].Descriptor()
+	// comment.DefaultEdited holds the default value on creation for the edited field.
+	comment.DefaultEdited = commentDescEdited.Default.(bool)
+	// commentDescHidden is the schema descriptor for hidden field.
+	commentDescHidden := commentFields[6].Descriptor()
+	// comment.DefaultHidden holds the default value on creation for the hidden field.
+	comment.DefaultHidden = commentDescHidden.Default.(bool)
+	// commentDescID is the schema descriptor for id field.
+	commentDescID := commentFields[0].Descriptor()
+	// comment.DefaultID holds the default value on creation for the id field.
+	comment.DefaultID = commentDescID.Default.(func() string)
+	// comment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	comment.IDValidator = commentDescID.Validators[0].(func(string) error)
+	eventMixin := schema.Event{}.Mixin()
+	eventMixinFields0 := eventMixin[0].Fields()
+	_ = eventMixinFields0
+	eventFields := schema.Event{}.Fields()
+	_ = eventFields
+	// eventDescCreateTime is the schema descriptor for create_time field.
+	eventDescCreateTime := eventMixinFields0[0].Descriptor()
+	// event.DefaultCreateTime holds the default value on creation for the create_time field.
+	event.DefaultCreateTime = eventDescCreateTime.Default.(func() time.Time)
+	// eventDescUpdateTime is the schema descriptor for update_time field.
+	eventDescUpdateTime := eventMixinFields0[1].Descriptor()
+	// event.DefaultUpdateTime holds the default value on creation for the update_time field.
+	event.DefaultUpdateTime = eventDescUpdateTime.Default.(func() time.Time)
+	// event.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	event.UpdateDefaultUpdateTime = eventDescUpdateTime.UpdateDefault.(func() time.Time)
+	// eventDescName is the schema descriptor for name field.
+	eventDescName := eventFields[1].Descriptor()
+	// event.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	event.NameValidator = func() func(string) error {
+		validators := eventDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// eventDescSlug is the schema descriptor for slug field.
+	eventDescSlug := eventFields[2].Descriptor()
+	// event.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	event.SlugValidator = eventDescSlug.Validators[0].(func(string) error)
+	// eventDescID is the schema descriptor for id field.
+	eventDescID := eventFields[0].Descriptor()
+	// event.DefaultID holds the default value on creation for the id field.
+	event.DefaultID = eventDescID.Default.(func() string)
+	// event.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	event.IDValidator = eventDescID.Validators[0].(func(string) error)
+	eventcriterionMixin := schema.EventCriterion{}.Mixin()
+	eventcriterionMixinFields0 := eventcriterionMixin[0].Fields()
+	_ = eventcriterionMixinFields0
+	eventcriterionFields := schema.EventCriterion{}.Fields()
+	_ = eventcriterionFields
+	// eventcriterionDescCreateTime is the schema descriptor for create_time field.
+	eventcriterionDescCreateTime := eventcriterionMixinFields0[0].Descriptor()
+	// eventcriterion.DefaultCreateTime holds the default value on creation for the create_time field.
+	eventcriterion.DefaultCreateTime = eventcriterionDescCreateTime.Default.(func() time.Time)
+	// eventcriterionDescUpdateTime is the schema descriptor for update_time field.
+	eventcriterionDescUpdateTime := eventcriterionMixinFields0[1].Descriptor()
+	// eventcriterion.DefaultUpdateTime holds the default value on creation for the update_time field.
+	eventcriterion.DefaultUpdateTime = eventcriterionDescUpdateTime.Default.(func() time.Time)
+	// eventcriterion.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	eventcriterion.UpdateDefaultUpdateTime = eventcriterionDescUpdateTime.UpdateDefault.(func() time.Time)
+	// eventcriterionDescEventID is the schema descriptor for event_id field.
+	eventcriterionDescEventID := eventcriterionFields[1].Descriptor()
+	// eventcriterion.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	eventcriterion.EventIDValidator = eventcriterionDescEventID.Validators[0].(func(string) error)
+	// eventcriterionDescName is the schema descriptor for name field.
+	eventcriterionDescName := eventcriterionFields[2].Descriptor()
+	// eventcriterion.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	eventcriterion.NameValidator = func() func(string) error {
+		validators := eventcriterionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// eventcriterionDescDescription is the schema descriptor for description field.
+	eventcriterionDescDescription := eventcriterionFields[3].Descriptor()
+	// eventcriterion.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	eventcriterion.DescriptionValidator = eventcriterionDescDescription.Validators[0].(func(string) error)
+	// eventcriterionDescWeight is the schema descriptor for weight field.
+	eventcriterionDescWeight := eventcriterionFields[4].Descriptor()
+	// eventcriterion.DefaultWeight holds the default value on creation for the weight field.
+	eventcriterion.DefaultWeight = eventcriterionDescWeight.Default.(int)
+	// eventcriterion.WeightValidator is a validator for the "weight" field. It is called by the builders before save.
+	eventcriterion.WeightValidator = eventcriterionDescWeight.Validators[0].(func(int) error)
+	// eventcriterionDescMaxScore is the schema descriptor for max_score field.
+	eventcriterionDescMaxScore := eventcriterionFields[5].Descriptor()
+	// eventcriterion.DefaultMaxScore holds the default value on creation for the max_score field.
+	eventcriterion.DefaultMaxScore = eventcriterionDescMaxScore.Default.(int)
+	// eventcriterion.MaxScoreValidator is a validator for the "max_score" field. It is called by the builders before save.
+	eventcriterion.MaxScoreValidator = eventcriterionDescMaxScore.Validators[0].(func(int) error)
+	// eventcriterionDescPosition is the schema descriptor for position field.
+	eventcriterionDescPosition := eventcriterionFields[6].Descriptor()
+	// eventcriterion.DefaultPosition holds the default value on creation for the position field.
+	eventcriterion.DefaultPosition = eventcriterionDescPosition.Default.(int)
+	// eventcriterionDescID is the schema descriptor for id field.
+	eventcriterionDescID := eventcriterionFields[0].Descriptor()
+	// eventcriterion.DefaultID holds the default value on creation for the id field.
+	eventcriterion.DefaultID = eventcriterionDescID.Default.(func() string)
+	// eventcriterion.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	eventcriterion.IDValidator = eventcriterionDescID.Validators[0].(func(string) error)
+	eventmemberMixin := schema.EventMember{}.Mixin()
+	eventmemberMixinFields0 := eventmemberMixin[0].Fields()
+	_ = eventmemberMixinFields0
+	eventmemberFields := schema.EventMember{}.Fields()
+	_ = eventmemberFields
+	// eventmemberDescCreateTime is the schema descriptor for create_time field.
+	eventmemberDescCreateTime := eventmemberMixinFields0[0].Descriptor()
+	// eventmember.DefaultCreateTime holds the default value on creation for the create_time field.
+	eventmember.DefaultCreateTime = eventmemberDescCreateTime.Default.(func() time.Time)
+	// eventmemberDescUpdateTime is the schema descriptor for update_time field.
+	eventmemberDescUpdateTime := eventmemberMixinFields0[1].Descriptor()
+	// eventmember.DefaultUpdateTime holds the default value on creation for the update_time field.
+	eventmember.DefaultUpdateTime = eventmemberDescUpdateTime.Default.(func() time.Time)
+	// eventmember.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	eventmember.UpdateDefaultUpdateTime = eventmemberDescUpdateTime.UpdateDefault.(func() time.Time)
+	// eventmemberDescEventID is the schema descriptor for event_id field.
+	eventmemberDescEventID := eventmemberFields[1].Descriptor()
+	// eventmember.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	eventmember.EventIDValidator = eventmemberDescEventID.Validators[0].(func(string) error)
+	// eventmemberDescUserID is the schema descriptor for user_id field.
+	eventmemberDescUserID := eventmemberFields[2].Descriptor()
+	// eventmember.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	eventmember.UserIDValidator = eventmemberDescUserID.Validators[0].(func(string) error)
+	// eventmemberDescID is the schema descriptor for id field.
+	eventmemberDescID := eventmemberFields[0].Descriptor()
+	// eventmember.DefaultID holds the default value on creation for the id field.
+	eventmember.DefaultID = eventmemberDescID.Default.(func() string)
+	// eventmember.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	eventmember.IDValidator = eventmemberDescID.Validators[0].(func(string) error)
+	eventscoreMixin := schema.EventScore{}.Mixin()
+	eventscoreMixinFields0 := eventscoreMixin[0].Fields()
+	_ = eventscoreMixinFields0
+	eventscoreFields := schema.EventScore{}.Fields()
+	_ = eventscoreFields
+	// eventscoreDescCreateTime is the schema descriptor for create_time field.
+	eventscoreDescCreateTime := eventscoreMixinFields0[0].Descriptor()
+	// eventscore.DefaultCreateTime holds the default value on creation for the create_time field.
+	eventscore.DefaultCreateTime = eventscoreDescCreateTime.Default.(func() time.Time)
+	// eventscoreDescUpdateTime is the schema descriptor for update_time field.
+	eventscoreDescUpdateTime := eventscoreMixinFields0[1].Descriptor()
+	// eventscore.DefaultUpdateTime holds the default value on creation for the update_time field.
+	eventscore.DefaultUpdateTime = eventscoreDescUpdateTime.Default.(func() time.Time)
+	// eventscore.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	eventscore.UpdateDefaultUpdateTime = eventscoreDescUpdateTime.UpdateDefault.(func() time.Time)
+	// eventscoreDescSubmissionID is the schema descriptor for submission_id field.
+	eventscoreDescSubmissionID := eventscoreFields[1].Descriptor()
+	// eventscore.SubmissionIDValidator is a validator for the "submission_id" field. It is called by the builders before save.
+	eventscore.SubmissionIDValidator = eventscoreDescSubmissionID.Validators[0].(func(string) error)
+	// eventscoreDescCriterionID is the schema descriptor for criterion_id field.
+	eventscoreDescCriterionID := eventscoreFields[2].Descriptor()
+	// eventscore.CriterionIDValidator is a validator for the "criterion_id" field. It is called by the builders before save.
+	eventscore.CriterionIDValidator = eventscoreDescCriterionID.Validators[0].(func(string) error)
+	// eventscoreDescJudgeID is the schema descriptor for judge_id field.
+	eventscoreDescJudgeID := eventscoreFields[3].Descriptor()
+	// eventscore.JudgeIDValidator is a validator for the "judge_id" field. It is called by the builders before save.
+	eventscore.JudgeIDValidator = eventscoreDescJudgeID.Validators[0].(func(string) error)
+	// eventscoreDescScore is the schema descriptor for score field.
+	eventscoreDescScore := eventscoreFields[4].Descriptor()
+	// eventscore.ScoreValidator is a validator for the "score" field. It is called by the builders before save.
+	eventscore.ScoreValidator = eventscoreDescScore.Validators[0].(func(int) error)
+	// eventscoreDescComment is the schema descriptor for comment field.
+	eventscoreDescComment := eventscoreFields[5].Descriptor()
+	// eventscore.CommentValidator is a validator for the "comment" field. It is called by the builders before save.
+	eventscore.CommentValidator = eventscoreDescComment.Validators[0].(func(string) error)
+	// eventscoreDescID is the schema descriptor for id field.
+	eventscoreDescID := eventscoreFields[0].Descriptor()
+	// eventscore.DefaultID holds the default value on creation for the id field.
+	eventscore.DefaultID = eventscoreDescID.Default.(func() string)
+	// eventscore.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	eventscore.IDValidator = eventscoreDescID.Validators[0].(func(string) error)
+	eventsubmissionMixin := schema.EventSubmission{}.Mixin()
+	eventsubmissionMixinFields0 := eventsubmissionMixin[0].Fields()
+	_ = eventsubmissionMixinFields0
+	eventsubmissionFields := schema.EventSubmission{}.Fields()
+	_ = eventsubmissionFields
+	// eventsubmissionDescCreateTime is the schema descriptor for create_time field.
+	eventsubmissionDescCreateTime := eventsubmissionMixinFields0[0].Descriptor()
+	// eventsubmission.DefaultCreateTime holds the default value on creation for the create_time field.
+	eventsubmission.DefaultCreateTime = eventsubmissionDescCreateTime.Default.(func() time.Time)
+	// eventsubmissionDescUpdateTime is the schema descriptor for update_time field.
+	eventsubmissionDescUpdateTime := eventsubmissionMixinFields0[1].Descriptor()
+	// eventsubmission.DefaultUpdateTime holds the default value on creation for the update_time field.
+	eventsubmission.DefaultUpdateTime = eventsubmissionDescUpdateTime.Default.(func() time.Time)
+	// eventsubmission.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	eventsubmission.UpdateDefaultUpdateTime = eventsubmissionDescUpdateTime.UpdateDefault.(func() time.Time)
+	// eventsubmissionDescEventID is the schema descriptor for event_id field.
+	eventsubmissionDescEventID := eventsubmissionFields[1].Descriptor()
+	// eventsubmission.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	eventsubmission.EventIDValidator = eventsubmissionDescEventID.Validators[0].(func(string) error)
+	// eventsubmissionDescProjectID is the schema descriptor for project_id field.
+	eventsubmissionDescProjectID := eventsubmissionFields[2].Descriptor()
+	// eventsubmission.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	eventsubmission.ProjectIDValidator = eventsubmissionDescProjectID.Validators[0].(func(string) error)
+	// eventsubmissionDescSubmitterID is the schema descriptor for submitter_id field.
+	eventsubmissionDescSubmitterID := eventsubmissionFields[3].Descriptor()
+	// eventsubmission.SubmitterIDValidator is a validator for the "submitter_id" field. It is called by the builders before save.
+	eventsubmission.SubmitterIDValidator = eventsubmissionDescSubmitterID.Validators[0].(func(string) error)
+	// eventsubmissionDescID is the schema descriptor for id field.
+	eventsubmissionDescID := eventsubmissionFields[0].Descriptor()
+	// eventsubmission.DefaultID holds the default value on creation for the id field.
+	eventsubmission.DefaultID = eventsubmissionDescID.Default.(func() string)
+	// eventsubmission.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	eventsubmission.IDValidator = eventsubmissionDescID.Validators[0].(func(string) error)
+	jobMixin := schema.Job{}.Mixin()
+	jobMixinFields0 := jobMixin[0].Fields()
+	_ = jobMixinFields0
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescCreateTime is the schema descriptor for create_time field.
+	jobDescCreateTime := jobMixinFields0[0].Descriptor()
+	// job.DefaultCreateTime holds the default value on creation for the create_time field.
+	job.DefaultCreateTime = jobDescCreateTime.Default.(func() time.Time)
+	// jobDescUpdateTime is the schema descriptor for update_time field.
+	jobDescUpdateTime := jobMixinFields0[1].Descriptor()
+	// job.DefaultUpdateTime holds the default value on creation for the update_time field.
+	job.DefaultUpdateTime = jobDescUpdateTime.Default.(func() time.Time)
+	// job.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	job.UpdateDefaultUpdateTime = jobDescUpdateTime.UpdateDefault.(func() time.Time)
+	// jobDescKind is the schema descriptor for kind field.
+	jobDescKind := jobFields[1].Descriptor()
+	// job.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	job.KindValidator = jobDescKind.Validators[0].(func(string) error)
+	// jobDescAttempts is the schema descriptor for attempts field.
+	jobDescAttempts := jobFields[4].Descriptor()
+	// job.DefaultAttempts holds the default value on creation for the attempts field.
+	job.DefaultAttempts = jobDescAttempts.Default.(int)
+	// jobDescMaxAttempts is the schema descriptor for max_attempts field.
+	jobDescMaxAttempts := jobFields[5].Descriptor()
+	// job.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	job.DefaultMaxAttempts = jobDescMaxAttempts.Default.(int)
+	// job.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	job.MaxAttemptsValidator = jobDescMaxAttempts.Validators[0].(func(int) error)
+	// jobDescID is the schema descriptor for id field.
+	jobDescID := jobFields[0].Descriptor()
+	// job.DefaultID holds the default value on creation for the id field.
+	job.DefaultID = jobDescID.Default.(func() string)
+	// job.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	job.IDValidator = jobDescID.Validators[0].(func(string) error)
+	joinrequestMixin := schema.JoinRequest{}.Mixin()
+	joinrequestMixinFields0 := joinrequestMixin[0].Fields()
+	_ = joinrequestMixinFields0
+	joinrequestFields := schema.JoinRequest{}.Fields()
+	_ = joinrequestFields
+	// joinrequestDescCreateTime is the schema descriptor for create_time field.
+	joinrequestDescCreateTime := joinrequestMixinFields0[0].Descriptor()
+	// joinrequest.DefaultCreateTime holds the default value on creation for the create_time field.
+	joinrequest.DefaultCreateTime = joinrequestDescCreateTime.Default.(func() time.Time)
+	// joinrequestDescUpdateTime is the schema descriptor for update_time field.
+	joinrequestDescUpdateTime := joinrequestMixinFields0[1].Descriptor()
+	// joinrequest.DefaultUpdateTime holds the default value on creation for the update_time field.
+	joinrequest.DefaultUpdateTime = joinrequestDescUpdateTime.Default.(func() time.Time)
+	// joinrequest.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	joinrequest.UpdateDefaultUpdateTime = joinrequestDescUpdateTime.UpdateDefault.(func() time.Time)
+	// joinrequestDescProjectID is the schema descriptor for project_id field.
+	joinrequestDescProjectID := joinrequestFields[1].Descriptor()
+	// joinrequest.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	joinrequest.ProjectIDValidator = joinrequestDescProjectID.Validators[0].(func(string) error)
+	// joinrequestDescUserID is the schema descriptor for user_id field.
+	joinrequestDescUserID := joinrequestFields[2].Descriptor()
+	// joinrequest.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	joinrequest.UserIDValidator = joinrequestDescUserID.Validators[0].(func(string) error)
+	// joinrequestDescMessage is the schema descriptor for message field.
+	joinrequestDescMessage := joinrequestFields[4].Descriptor()
+	// joinrequest.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	joinrequest.MessageValidator = joinrequestDescMessage.Validators[0].(func(string) error)
+	// joinrequestDescID is the schema descriptor for id field.
+	joinrequestDescID := joinrequestFields[0].Descriptor()
+	// joinrequest.DefaultID holds the default value on creation for the id field.
+	joinrequest.DefaultID = joinrequestDescID.Default.(func() string)
+	// joinrequest.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	joinrequest.IDValidator = joinrequestDescID.Validators[0].(func(string) error)
 	likeMixin := schema.Like{}.Mixin()
 	likeMixinFields0 := likeMixin[0].Fields()
 	_ = likeMixinFields0
@@ -48,7 +475,106 @@ func init() {
 	like.DefaultID = likeDescID.Default.(func() string)
 	// like.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	like.IDValidator = likeDescID.Validators[0].(func(string) error)
+	milestoneMixin := schema.Milestone{}.Mixin()
+	milestoneMixinFields0 := milestoneMixin[0].Fields()
+	_ = milestoneMixinFields0
+	milestoneFields := schema.Milestone{}.Fields()
+	_ = milestoneFields
+	// milestoneDescCreateTime is the schema descriptor for create_time field.
+	milestoneDescCreateTime := milestoneMixinFields0[0].Descriptor()
+	// milestone.DefaultCreateTime holds the default value on creation for the create_time field.
+	milestone.DefaultCreateTime = milestoneDescCreateTime.Default.(func() time.Time)
+	// milestoneDescUpdateTime is the schema descriptor for update_time field.
+	milestoneDescUpdateTime := milestoneMixinFields0[1].Descriptor()
+	// milestone.DefaultUpdateTime holds the default value on creation for the update_time field.
+	milestone.DefaultUpdateTime = milestoneDescUpdateTime.Default.(func() time.Time)
+	// milestone.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	milestone.UpdateDefaultUpdateTime = milestoneDescUpdateTime.UpdateDefault.(func() time.Time)
+	// milestoneDescProjectID is the schema descriptor for project_id field.
+	milestoneDescProjectID := milestoneFields[1].Descriptor()
+	// milestone.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	milestone.ProjectIDValidator = milestoneDescProjectID.Validators[0].(func(string) error)
+	// milestoneDescTitle is the schema descriptor for title field.
+	milestoneDescTitle := milestoneFields[2].Descriptor()
+	// milestone.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	milestone.TitleValidator = milestoneDescTitle.Validators[0].(func(string) error)
+	// milestoneDescPosition is the schema descriptor for position field.
+	milestoneDescPosition := milestoneFields[4].Descriptor()
+	// milestone.DefaultPosition holds the default value on creation for the position field.
+	milestone.DefaultPosition = milestoneDescPosition.Default.(int)
+	// milestoneDescID is the schema descriptor for id field.
+	milestoneDescID := milestoneFields[0].Descriptor()
+	// milestone.DefaultID holds the default value on creation for the id field.
+	milestone.DefaultID = milestoneDescID.Default.(func() string)
+	// milestone.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	milestone.IDValidator = milestoneDescID.Validators[0].(func(string) error)
+	notificationMixin := schema.Notification{}.Mixin()
+	notificationMixinFields0 := notificationMixin[0].Fields()
+	_ = notificationMixinFields0
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreateTime is the schema descriptor for create_time field.
+	notificationDescCreateTime := notificationMixinFields0[0].Descriptor()
+	// notification.DefaultCreateTime holds the default value on creation for the create_time field.
+	notification.DefaultCreateTime = notificationDescCreateTime.Default.(func() time.Time)
+	// notificationDescUpdateTime is the schema descriptor for update_time field.
+	notificationDescUpdateTime := notificationMixinFields0[1].Descriptor()
+	// notification.DefaultUpdateTime holds the default value on creation for the update_time field.
+	notification.DefaultUpdateTime = notificationDescUpdateTime.Default.(func() time.Time)
+	// notification.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	notification.UpdateDefaultUpdateTime = notificationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// notificationDescRecipientID is the schema descriptor for recipient_id field.
+	notificationDescRecipientID := notificationFields[1].Descriptor()
+	// notification.RecipientIDValidator is a validator for the "recipient_id" field. It is called by the builders before save.
+	notification.RecipientIDValidator = notificationDescRecipientID.Validators[0].(func(string) error)
+	// notificationDescGroupKey is the schema descriptor for group_key field.
+	notificationDescGroupKey := notificationFields[5].Descriptor()
+	// notification.GroupKeyValidator is a validator for the "group_key" field. It is called by the builders before save.
+	notification.GroupKeyValidator = notificationDescGroupKey.Validators[0].(func(string) error)
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() string)
+	// notification.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notification.IDValidator = notificationDescID.Validators[0].(func(string) error)
+	notificationpreferenceMixin := schema.NotificationPreference{}.Mixin()
+	notificationpreferenceMixinFields0 := notificationpreferenceMixin[0].Fields()
+	_ = notificationpreferenceMixinFields0
+	notificationpreferenceFields := schema.NotificationPreference{}.Fields()
+	_ = notificationpreferenceFields
+	// notificationpreferenceDescCreateTime is the schema descriptor for create_time field.
+	notificationpreferenceDescCreateTime := notificationpreferenceMixinFields0[0].Descriptor()
+	// notificationpreference.DefaultCreateTime holds the default value on creation for the create_time field.
+	notificationpreference.DefaultCreateTime = notificationpreferenceDescCreateTime.Default.(func() time.Time)
+	// notificationpreferenceDescUpdateTime is the schema descriptor for update_time field.
+	notificationpreferenceDescUpdateTime := notificationpreferenceMixinFields0[1].Descriptor()
+	// notificationpreference.DefaultUpdateTime holds the default value on creation for the update_time field.
+	notificationpreference.DefaultUpdateTime = notificationpreferenceDescUpdateTime.Default.(func() time.Time)
+	// notificationpreference.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	notificationpreference.UpdateDefaultUpdateTime = notificationpreferenceDescUpdateTime.UpdateDefault.(func() time.Time)
+	// notificationpreferenceDescUserID is the schema descriptor for user_id field.
+	notificationpreferenceDescUserID := notificationpreferenceFields[1].Descriptor()
+	// notificationpreference.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	notificationpreference.UserIDValidator = notificationpreferenceDescUserID.Validators[0].(func(string) error)
+	// notificationpreferenceDescEnabled is the schema descriptor for enabled field.
+	notificationpreferenceDescEnabled := notificationpreferenceFields[3].Descriptor()
+	// notificationpreference.DefaultEnabled holds the default value on creation for the enabled field.
+	notificationpreference.DefaultEnabled = notificationpreferenceDescEnabled.Default.(bool)
+	// notificationpreferenceDescID is the schema descriptor for id field.
+	notificationpreferenceDescID := notificationpreferenceFields[0].Descriptor()
+	// notificationpreference.DefaultID holds the default value on creation for the id field.
+	notificationpreference.DefaultID = notificationpreferenceDescID.Default.(func() string)
+	// notificationpreference.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	notificationpreference.IDValidator = notificationpreferenceDescID.Validators[0].(func(string) error)
 	projectMixin := schema.Project{}.Mixin()
+	projectMixinHooks1 := projectMixin[1].Hooks()
+	projectHooks := schema.Project{}.Hooks()
+	project.Hooks[0] = projectMixinHooks1[0]
+	project.Hooks[1] = projectHooks[0]
+	projectMixinInters1 := projectMixin[1].Interceptors()
+	projectInters := schema.Project{}.Interceptors()
+	project.Interceptors[0] = projectMixinInters1[0]
+	project.Interceptors[1] = projectInters[0]
 	projectMixinFields0 := projectMixin[0].Fields()
 	_ = projectMixinFields0
 	projectFields := schema.Project{}.Fields()
@@ -79,12 +605,311 @@ func init() {
 	projectDescStarCount := projectFields[4].Descriptor()
 	// project.DefaultStarCount holds the default value on creation for the star_count field.
 	project.DefaultStarCount = projectDescStarCount.Default.(int)
+	// projectDescCommentCount is the schema descriptor for comment_count field.
+	projectDescCommentCount := projectFields[5].Descriptor()
+	// project.DefaultCommentCount holds the default value on creation for the comment_count field.
+	project.DefaultCommentCount = projectDescCommentCount.Default.(int)
+	// projectDescDraft is the schema descriptor for draft field.
+	projectDescDraft := projectFields[11].Descriptor()
+	// project.DefaultDraft holds the default value on creation for the draft field.
+	project.DefaultDraft = projectDescDraft.Default.(bool)
+	// projectDescRemixCount is the schema descriptor for remix_count field.
+	projectDescRemixCount := projectFields[13].Descriptor()
+	// project.DefaultRemixCount holds the default value on creation for the remix_count field.
+	project.DefaultRemixCount = projectDescRemixCount.Default.(int)
 	// projectDescID is the schema descriptor for id field.
 	projectDescID := projectFields[0].Descriptor()
 	// project.DefaultID holds the default value on creation for the id field.
 	project.DefaultID = projectDescID.Default.(func() string)
 	// project.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	project.IDValidator = projectDescID.Validators[0].(func(string) error)
+	projectinvitationMixin := schema.ProjectInvitation{}.Mixin()
+	projectinvitationMixinFields0 := projectinvitationMixin[0].Fields()
+	_ = projectinvitationMixinFields0
+	projectinvitationFields := schema.ProjectInvitation{}.Fields()
+	_ = projectinvitationFields
+	// projectinvitationDescCreateTime is the schema descriptor for create_time field.
+	projectinvitationDescCreateTime := projectinvitationMixinFields0[0].Descriptor()
+	// projectinvitation.DefaultCreateTime holds the default value on creation for the create_time field.
+	projectinvitation.DefaultCreateTime = projectinvitationDescCreateTime.Default.(func() time.Time)
+	// projectinvitationDescUpdateTime is the schema descriptor for update_time field.
+	projectinvitationDescUpdateTime := projectinvitationMixinFields0[1].Descriptor()
+	// projectinvitation.DefaultUpdateTime holds the default value on creation for the update_time field.
+	projectinvitation.DefaultUpdateTime = projectinvitationDescUpdateTime.Default.(func() time.Time)
+	// projectinvitation.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	projectinvitation.UpdateDefaultUpdateTime = projectinvitationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// projectinvitationDescProjectID is the schema descriptor for project_id field.
+	projectinvitationDescProjectID := projectinvitationFields[1].Descriptor()
+	// projectinvitation.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	projectinvitation.ProjectIDValidator = projectinvitationDescProjectID.Validators[0].(func(string) error)
+	// projectinvitationDescInviteeID is the schema descriptor for invitee_id field.
+	projectinvitationDescInviteeID := projectinvitationFields[2].Descriptor()
+	// projectinvitation.InviteeIDValidator is a validator for the "invitee_id" field. It is called by the builders before save.
+	projectinvitation.InviteeIDValidator = projectinvitationDescInviteeID.Validators[0].(func(string) error)
+	// projectinvitationDescInviterID is the schema descriptor for inviter_id field.
+	projectinvitationDescInviterID := projectinvitationFields[3].Descriptor()
+	// projectinvitation.InviterIDValidator is a validator for the "inviter_id" field. It is called by the builders before save.
+	projectinvitation.InviterIDValidator = projectinvitationDescInviterID.Validators[0].(func(string) error)
+	// projectinvitationDescID is the schema descriptor for id field.
+	projectinvitationDescID := projectinvitationFields[0].Descriptor()
+	// projectinvitation.DefaultID holds the default value on creation for the id field.
+	projectinvitation.DefaultID = projectinvitationDescID.Default.(func() string)
+	// projectinvitation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	projectinvitation.IDValidator = projectinvitationDescID.Validators[0].(func(string) error)
+	projectlinkMixin := schema.ProjectLink{}.Mixin()
+	projectlinkMixinFields0 := projectlinkMixin[0].Fields()
+	_ = projectlinkMixinFields0
+	projectlinkFields := schema.ProjectLink{}.Fields()
+	_ = projectlinkFields
+	// projectlinkDescCreateTime is the schema descriptor for create_time field.
+	projectlinkDescCreateTime := projectlinkMixinFields0[0].Descriptor()
+	// projectlink.DefaultCreateTime holds the default value on creation for the create_time field.
+	projectlink.DefaultCreateTime = projectlinkDescCreateTime.Default.(func() time.Time)
+	// projectlinkDescUpdateTime is the schema descriptor for update_time field.
+	projectlinkDescUpdateTime := projectlinkMixinFields0[1].Descriptor()
+	// projectlink.DefaultUpdateTime holds the default value on creation for the update_time field.
+	projectlink.DefaultUpdateTime = projectlinkDescUpdateTime.Default.(func() time.Time)
+	// projectlink.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	projectlink.UpdateDefaultUpdateTime = projectlinkDescUpdateTime.UpdateDefault.(func() time.Time)
+	// projectlinkDescProjectID is the schema descriptor for project_id field.
+	projectlinkDescProjectID := projectlinkFields[1].Descriptor()
+	// projectlink.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	projectlink.ProjectIDValidator = projectlinkDescProjectID.Validators[0].(func(string) error)
+	// projectlinkDescURL is the schema descriptor for url field.
+	projectlinkDescURL := projectlinkFields[3].Descriptor()
+	// projectlink.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	projectlink.URLValidator = func() func(string) error {
+		validators := projectlinkDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// projectlinkDescTitle is the schema descriptor for title field.
+	projectlinkDescTitle := projectlinkFields[4].Descriptor()
+	// projectlink.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	projectlink.TitleValidator = projectlinkDescTitle.Validators[0].(func(string) error)
+	// projectlinkDescID is the schema descriptor for id field.
+	projectlinkDescID := projectlinkFields[0].Descriptor()
+	// projectlink.DefaultID holds the default value on creation for the id field.
+	projectlink.DefaultID = projectlinkDescID.Default.(func() string)
+	// projectlink.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	projectlink.IDValidator = projectlinkDescID.Validators[0].(func(string) error)
+	projectmediaMixin := schema.ProjectMedia{}.Mixin()
+	projectmediaMixinFields0 := projectmediaMixin[0].Fields()
+	_ = projectmediaMixinFields0
+	projectmediaFields := schema.ProjectMedia{}.Fields()
+	_ = projectmediaFields
+	// projectmediaDescCreateTime is the schema descriptor for create_time field.
+	projectmediaDescCreateTime := projectmediaMixinFields0[0].Descriptor()
+	// projectmedia.DefaultCreateTime holds the default value on creation for the create_time field.
+	projectmedia.DefaultCreateTime = projectmediaDescCreateTime.Default.(func() time.Time)
+	// projectmediaDescUpdateTime is the schema descriptor for update_time field.
+	projectmediaDescUpdateTime := projectmediaMixinFields0[1].Descriptor()
+	// projectmedia.DefaultUpdateTime holds the default value on creation for the update_time field.
+	projectmedia.DefaultUpdateTime = projectmediaDescUpdateTime.Default.(func() time.Time)
+	// projectmedia.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	projectmedia.UpdateDefaultUpdateTime = projectmediaDescUpdateTime.UpdateDefault.(func() time.Time)
+	// projectmediaDescProjectID is the schema descriptor for project_id field.
+	projectmediaDescProjectID := projectmediaFields[1].Descriptor()
+	// projectmedia.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	projectmedia.ProjectIDValidator = projectmediaDescProjectID.Validators[0].(func(string) error)
+	// projectmediaDescUploaderID is the schema descriptor for uploader_id field.
+	projectmediaDescUploaderID := projectmediaFields[2].Descriptor()
+	// projectmedia.UploaderIDValidator is a validator for the "uploader_id" field. It is called by the builders before save.
+	projectmedia.UploaderIDValidator = projectmediaDescUploaderID.Validators[0].(func(string) error)
+	// projectmediaDescKey is the schema descriptor for key field.
+	projectmediaDescKey := projectmediaFields[3].Descriptor()
+	// projectmedia.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	projectmedia.KeyValidator = projectmediaDescKey.Validators[0].(func(string) error)
+	// projectmediaDescURL is the schema descriptor for url field.
+	projectmediaDescURL := projectmediaFields[4].Descriptor()
+	// projectmedia.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	projectmedia.URLValidator = projectmediaDescURL.Validators[0].(func(string) error)
+	// projectmediaDescThumbnailKey is the schema descriptor for thumbnail_key field.
+	projectmediaDescThumbnailKey := projectmediaFields[5].Descriptor()
+	// projectmedia.ThumbnailKeyValidator is a validator for the "thumbnail_key" field. It is called by the builders before save.
+	projectmedia.ThumbnailKeyValidator = projectmediaDescThumbnailKey.Validators[0].(func(string) error)
+	// projectmediaDescThumbnailURL is the schema descriptor for thumbnail_url field.
+	projectmediaDescThumbnailURL := projectmediaFields[6].Descriptor()
+	// projectmedia.ThumbnailURLValidator is a validator for the "thumbnail_url" field. It is called by the builders before save.
+	projectmedia.ThumbnailURLValidator = projectmediaDescThumbnailURL.Validators[0].(func(string) error)
+	// projectmediaDescContentType is the schema descriptor for content_type field.
+	projectmediaDescContentType := projectmediaFields[7].Descriptor()
+	// projectmedia.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	projectmedia.ContentTypeValidator = projectmediaDescContentType.Validators[0].(func(string) error)
+	// projectmediaDescSize is the schema descriptor for size field.
+	projectmediaDescSize := projectmediaFields[8].Descriptor()
+	// projectmedia.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	projectmedia.SizeValidator = projectmediaDescSize.Validators[0].(func(int64) error)
+	// projectmediaDescWidth is the schema descriptor for width field.
+	projectmediaDescWidth := projectmediaFields[9].Descriptor()
+	// projectmedia.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	projectmedia.WidthValidator = projectmediaDescWidth.Validators[0].(func(int) error)
+	// projectmediaDescHeight is the schema descriptor for height field.
+	projectmediaDescHeight := projectmediaFields[10].Descriptor()
+	// projectmedia.HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	projectmedia.HeightValidator = projectmediaDescHeight.Validators[0].(func(int) error)
+	// projectmediaDescCaption is the schema descriptor for caption field.
+	projectmediaDescCaption := projectmediaFields[11].Descriptor()
+	// projectmedia.CaptionValidator is a validator for the "caption" field. It is called by the builders before save.
+	projectmedia.CaptionValidator = projectmediaDescCaption.Validators[0].(func(string) error)
+	// projectmediaDescCover is the schema descriptor for cover field.
+	projectmediaDescCover := projectmediaFields[12].Descriptor()
+	// projectmedia.DefaultCover holds the default value on creation for the cover field.
+	projectmedia.DefaultCover = projectmediaDescCover.Default.(bool)
+	// projectmediaDescID is the schema descriptor for id field.
+	projectmediaDescID := projectmediaFields[0].Descriptor()
+	// projectmedia.DefaultID holds the default value on creation for the id field.
+	projectmedia.DefaultID = projectmediaDescID.Default.(func() string)
+	// projectmedia.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	projectmedia.IDValidator = projectmediaDescID.Validators[0].(func(string) error)
+	projectmemberMixin := schema.ProjectMember{}.Mixin()
+	projectmemberMixinFields0 := projectmemberMixin[0].Fields()
+	_ = projectmemberMixinFields0
+	projectmemberFields := schema.ProjectMember{}.Fields()
+	_ = projectmemberFields
+	// projectmemberDescCreateTime is the schema descriptor for create_time field.
+	projectmemberDescCreateTime := projectmemberMixinFields0[0].Descriptor()
+	// projectmember.DefaultCreateTime holds the default value on creation for the create_time field.
+	projectmember.DefaultCreateTime = projectmemberDescCreateTime.Default.(func() time.Time)
+	// projectmemberDescUpdateTime is the schema descriptor for update_time field.
+	projectmemberDescUpdateTime := projectmemberMixinFields0[1].Descriptor()
+	// projectmember.DefaultUpdateTime holds the default value on creation for the update_time field.
+	projectmember.DefaultUpdateTime = projectmemberDescUpdateTime.Default.(func() time.Time)
+	// projectmember.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	projectmember.UpdateDefaultUpdateTime = projectmemberDescUpdateTime.UpdateDefault.(func() time.Time)
+	// projectmemberDescProjectID is the schema descriptor for project_id field.
+	projectmemberDescProjectID := projectmemberFields[1].Descriptor()
+	// projectmember.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	projectmember.ProjectIDValidator = projectmemberDescProjectID.Validators[0].(func(string) error)
+	// projectmemberDescUserID is the schema descriptor for user_id field.
+	projectmemberDescUserID := projectmemberFields[2].Descriptor()
+	// projectmember.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	projectmember.UserIDValidator = projectmemberDescUserID.Validators[0].(func(string) error)
+	// projectmemberDescID is the schema descriptor for id field.
+	projectmemberDescID := projectmemberFields[0].Descriptor()
+	// projectmember.DefaultID holds the default value on creation for the id field.
+	projectmember.DefaultID = projectmemberDescID.Default.(func() string)
+	// projectmember.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	projectmember.IDValidator = projectmemberDescID.Validators[0].(func(string) error)
+	projectrevisionMixin := schema.ProjectRevision{}.Mixin()
+	projectrevisionMixinFields0 := projectrevisionMixin[0].Fields()
+	_ = projectrevisionMixinFields0
+	projectrevisionFields := schema.ProjectRevision{}.Fields()
+	_ = projectrevisionFields
+	// projectrevisionDescCreateTime is the schema descriptor for create_time field.
+	projectrevisionDescCreateTime := projectrevisionMixinFields0[0].Descriptor()
+	// projectrevision.DefaultCreateTime holds the default value on creation for the create_time field.
+	projectrevision.DefaultCreateTime = projectrevisionDescCreateTime.Default.(func() time.Time)
+	// projectrevisionDescUpdateTime is the schema descriptor for update_time field.
+	projectrevisionDescUpdateTime := projectrevisionMixinFields0[1].Descriptor()
+	// projectrevision.DefaultUpdateTime holds the default value on creation for the update_time field.
+	projectrevision.DefaultUpdateTime = projectrevisionDescUpdateTime.Default.(func() time.Time)
+	// projectrevision.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	projectrevision.UpdateDefaultUpdateTime = projectrevisionDescUpdateTime.UpdateDefault.(func() time.Time)
+	// projectrevisionDescProjectID is the schema descriptor for project_id field.
+	projectrevisionDescProjectID := projectrevisionFields[1].Descriptor()
+	// projectrevision.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	projectrevision.ProjectIDValidator = projectrevisionDescProjectID.Validators[0].(func(string) error)
+	// projectrevisionDescNumber is the schema descriptor for number field.
+	projectrevisionDescNumber := projectrevisionFields[3].Descriptor()
+	// projectrevision.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	projectrevision.NumberValidator = projectrevisionDescNumber.Validators[0].(func(int) error)
+	// projectrevisionDescID is the schema descriptor for id field.
+	projectrevisionDescID := projectrevisionFields[0].Descriptor()
+	// projectrevision.DefaultID holds the default value on creation for the id field.
+	projectrevision.DefaultID = projectrevisionDescID.Default.(func() string)
+	// projectrevision.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	projectrevision.IDValidator = projectrevisionDescID.Validators[0].(func(string) error)
+	projectroleMixin := schema.ProjectRole{}.Mixin()
+	projectroleMixinFields0 := projectroleMixin[0].Fields()
+	_ = projectroleMixinFields0
+	projectroleFields := schema.ProjectRole{}.Fields()
+	_ = projectroleFields
+	// projectroleDescCreateTime is the schema descriptor for create_time field.
+	projectroleDescCreateTime := projectroleMixinFields0[0].Descriptor()
+	// projectrole.DefaultCreateTime holds the default value on creation for the create_time field.
+	projectrole.DefaultCreateTime = projectroleDescCreateTime.Default.(func() time.Time)
+	// projectroleDescUpdateTime is the schema descriptor for update_time field.
+	projectroleDescUpdateTime := projectroleMixinFields0[1].Descriptor()
+	// projectrole.DefaultUpdateTime holds the default value on creation for the update_time field.
+	projectrole.DefaultUpdateTime = projectroleDescUpdateTime.Default.(func() time.Time)
+	// projectrole.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	projectrole.UpdateDefaultUpdateTime = projectroleDescUpdateTime.UpdateDefault.(func() time.Time)
+	// projectroleDescProjectID is the schema descriptor for project_id field.
+	projectroleDescProjectID := projectroleFields[1].Descriptor()
+	// projectrole.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	projectrole.ProjectIDValidator = projectroleDescProjectID.Validators[0].(func(string) error)
+	// projectroleDescTitle is the schema descriptor for title field.
+	projectroleDescTitle := projectroleFields[2].Descriptor()
+	// projectrole.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	projectrole.TitleValidator = func() func(string) error {
+		validators := projectroleDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// projectroleDescDescription is the schema descriptor for description field.
+	projectroleDescDescription := projectroleFields[3].Descriptor()
+	// projectrole.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	projectrole.DescriptionValidator = projectroleDescDescription.Validators[0].(func(string) error)
+	// projectroleDescOpen is the schema descriptor for open field.
+	projectroleDescOpen := projectroleFields[5].Descriptor()
+	// projectrole.DefaultOpen holds the default value on creation for the open field.
+	projectrole.DefaultOpen = projectroleDescOpen.Default.(bool)
+	// projectroleDescID is the schema descriptor for id field.
+	projectroleDescID := projectroleFields[0].Descriptor()
+	// projectrole.DefaultID holds the default value on creation for the id field.
+	projectrole.DefaultID = projectroleDescID.Default.(func() string)
+	// projectrole.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	projectrole.IDValidator = projectroleDescID.Validators[0].(func(string) error)
+	projectstatuschangeMixin := schema.ProjectStatusChange{}.Mixin()
+	projectstatuschangeMixinFields0 := projectstatuschangeMixin[0].Fields()
+	_ = projectstatuschangeMixinFields0
+	projectstatuschangeFields := schema.ProjectStatusChange{}.Fields()
+	_ = projectstatuschangeFields
+	// projectstatuschangeDescCreateTime is the schema descriptor for create_time field.
+	projectstatuschangeDescCreateTime := projectstatuschangeMixinFields0[0].Descriptor()
+	// projectstatuschange.DefaultCreateTime holds the default value on creation for the create_time field.
+	projectstatuschange.DefaultCreateTime = projectstatuschangeDescCreateTime.Default.(func() time.Time)
+	// projectstatuschangeDescUpdateTime is the schema descriptor for update_time field.
+	projectstatuschangeDescUpdateTime := projectstatuschangeMixinFields0[1].Descriptor()
+	// projectstatuschange.DefaultUpdateTime holds the default value on creation for the update_time field.
+	projectstatuschange.DefaultUpdateTime = projectstatuschangeDescUpdateTime.Default.(func() time.Time)
+	// projectstatuschange.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	projectstatuschange.UpdateDefaultUpdateTime = projectstatuschangeDescUpdateTime.UpdateDefault.(func() time.Time)
+	// projectstatuschangeDescProjectID is the schema descriptor for project_id field.
+	projectstatuschangeDescProjectID := projectstatuschangeFields[1].Descriptor()
+	// projectstatuschange.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	projectstatuschange.ProjectIDValidator = projectstatuschangeDescProjectID.Validators[0].(func(string) error)
+	// projectstatuschangeDescChangedByID is the schema descriptor for changed_by_id field.
+	projectstatuschangeDescChangedByID := projectstatuschangeFields[2].Descriptor()
+	// projectstatuschange.ChangedByIDValidator is a validator for the "changed_by_id" field. It is called by the builders before save.
+	projectstatuschange.ChangedByIDValidator = projectstatuschangeDescChangedByID.Validators[0].(func(string) error)
+	// projectstatuschangeDescID is the schema descriptor for id field.
+	projectstatuschangeDescID := projectstatuschangeFields[0].Descriptor()
+	// projectstatuschange.DefaultID holds the default value on creation for the id field.
+	projectstatuschange.DefaultID = projectstatuschangeDescID.Default.(func() string)
+	// projectstatuschange.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	projectstatuschange.IDValidator = projectstatuschangeDescID.Validators[0].(func(string) error)
 	projecttagMixin := schema.ProjectTag{}.Mixin()
 	projecttagMixinFields0 := projecttagMixin[0].Fields()
 	_ = projecttagMixinFields0
@@ -114,6 +939,31 @@ func init() {
 	projecttag.DefaultID = projecttagDescID.Default.(func() string)
 	// projecttag.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	projecttag.IDValidator = projecttagDescID.Validators[0].(func(string) error)
+	scheduledrunMixin := schema.ScheduledRun{}.Mixin()
+	scheduledrunMixinFields0 := scheduledrunMixin[0].Fields()
+	_ = scheduledrunMixinFields0
+	scheduledrunFields := schema.ScheduledRun{}.Fields()
+	_ = scheduledrunFields
+	// scheduledrunDescCreateTime is the schema descriptor for create_time field.
+	scheduledrunDescCreateTime := scheduledrunMixinFields0[0].Descriptor()
+	// scheduledrun.DefaultCreateTime holds the default value on creation for the create_time field.
+	scheduledrun.DefaultCreateTime = scheduledrunDescCreateTime.Default.(func() time.Time)
+	// scheduledrunDescUpdateTime is the schema descriptor for update_time field.
+	scheduledrunDescUpdateTime := scheduledrunMixinFields0[1].Descriptor()
+	// scheduledrun.DefaultUpdateTime holds the default value on creation for the update_time field.
+	scheduledrun.DefaultUpdateTime = scheduledrunDescUpdateTime.Default.(func() time.Time)
+	// scheduledrun.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	scheduledrun.UpdateDefaultUpdateTime = scheduledrunDescUpdateTime.UpdateDefault.(func() time.Time)
+	// scheduledrunDescTask is the schema descriptor for task field.
+	scheduledrunDescTask := scheduledrunFields[1].Descriptor()
+	// scheduledrun.TaskValidator is a validator for the "task" field. It is called by the builders before save.
+	scheduledrun.TaskValidator = scheduledrunDescTask.Validators[0].(func(string) error)
+	// scheduledrunDescID is the schema descriptor for id field.
+	scheduledrunDescID := scheduledrunFields[0].Descriptor()
+	// scheduledrun.DefaultID holds the default value on creation for the id field.
+	scheduledrun.DefaultID = scheduledrunDescID.Default.(func() string)
+	// scheduledrun.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	scheduledrun.IDValidator = scheduledrunDescID.Validators[0].(func(string) error)
 	sessionMixin := schema.Session{}.Mixin()
 	sessionMixinFields0 := sessionMixin[0].Fields()
 	_ = sessionMixinFields0
@@ -172,6 +1022,76 @@ func init() {
 	tag.DefaultID = tagDescID.Default.(func() string)
 	// tag.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	tag.IDValidator = tagDescID.Validators[0].(func(string) error)
+	taskMixin := schema.Task{}.Mixin()
+	taskMixinFields0 := taskMixin[0].Fields()
+	_ = taskMixinFields0
+	taskFields := schema.Task{}.Fields()
+	_ = taskFields
+	// taskDescCreateTime is the schema descriptor for create_time field.
+	taskDescCreateTime := taskMixinFields0[0].Descriptor()
+	// task.DefaultCreateTime holds the default value on creation for the create_time field.
+	task.DefaultCreateTime = taskDescCreateTime.Default.(func() time.Time)
+	// taskDescUpdateTime is the schema descriptor for update_time field.
+	taskDescUpdateTime := taskMixinFields0[1].Descriptor()
+	// task.DefaultUpdateTime holds the default value on creation for the update_time field.
+	task.DefaultUpdateTime = taskDescUpdateTime.Default.(func() time.Time)
+	// task.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	task.UpdateDefaultUpdateTime = taskDescUpdateTime.UpdateDefault.(func() time.Time)
+	// taskDescProjectID is the schema descriptor for project_id field.
+	taskDescProjectID := taskFields[1].Descriptor()
+	// task.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	task.ProjectIDValidator = taskDescProjectID.Validators[0].(func(string) error)
+	// taskDescMilestoneID is the schema descriptor for milestone_id field.
+	taskDescMilestoneID := taskFields[2].Descriptor()
+	// task.MilestoneIDValidator is a validator for the "milestone_id" field. It is called by the builders before save.
+	task.MilestoneIDValidator = taskDescMilestoneID.Validators[0].(func(string) error)
+	// taskDescTitle is the schema descriptor for title field.
+	taskDescTitle := taskFields[3].Descriptor()
+	// task.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	task.TitleValidator = taskDescTitle.Validators[0].(func(string) error)
+	// taskDescPosition is the schema descriptor for position field.
+	taskDescPosition := taskFields[4].Descriptor()
+	// task.DefaultPosition holds the default value on creation for the position field.
+	task.DefaultPosition = taskDescPosition.Default.(int)
+	// taskDescID is the schema descriptor for id field.
+	taskDescID := taskFields[0].Descriptor()
+	// task.DefaultID holds the default value on creation for the id field.
+	task.DefaultID = taskDescID.Default.(func() string)
+	// task.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	task.IDValidator = taskDescID.Validators[0].(func(string) error)
+	trendingsnapshotMixin := schema.TrendingSnapshot{}.Mixin()
+	trendingsnapshotMixinFields0 := trendingsnapshotMixin[0].Fields()
+	_ = trendingsnapshotMixinFields0
+	trendingsnapshotFields := schema.TrendingSnapshot{}.Fields()
+	_ = trendingsnapshotFields
+	// trendingsnapshotDescCreateTime is the schema descriptor for create_time field.
+	trendingsnapshotDescCreateTime := trendingsnapshotMixinFields0[0].Descriptor()
+	// trendingsnapshot.DefaultCreateTime holds the default value on creation for the create_time field.
+	trendingsnapshot.DefaultCreateTime = trendingsnapshotDescCreateTime.Default.(func() time.Time)
+	// trendingsnapshotDescUpdateTime is the schema descriptor for update_time field.
+	trendingsnapshotDescUpdateTime := trendingsnapshotMixinFields0[1].Descriptor()
+	// trendingsnapshot.DefaultUpdateTime holds the default value on creation for the update_time field.
+	trendingsnapshot.DefaultUpdateTime = trendingsnapshotDescUpdateTime.Default.(func() time.Time)
+	// trendingsnapshot.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	trendingsnapshot.UpdateDefaultUpdateTime = trendingsnapshotDescUpdateTime.UpdateDefault.(func() time.Time)
+	// trendingsnapshotDescSubjectID is the schema descriptor for subject_id field.
+	trendingsnapshotDescSubjectID := trendingsnapshotFields[2].Descriptor()
+	// trendingsnapshot.SubjectIDValidator is a validator for the "subject_id" field. It is called by the builders before save.
+	trendingsnapshot.SubjectIDValidator = trendingsnapshotDescSubjectID.Validators[0].(func(string) error)
+	// trendingsnapshotDescScore is the schema descriptor for score field.
+	trendingsnapshotDescScore := trendingsnapshotFields[4].Descriptor()
+	// trendingsnapshot.DefaultScore holds the default value on creation for the score field.
+	trendingsnapshot.DefaultScore = trendingsnapshotDescScore.Default.(float64)
+	// trendingsnapshotDescActivityCount is the schema descriptor for activity_count field.
+	trendingsnapshotDescActivityCount := trendingsnapshotFields[6].Descriptor()
+	// trendingsnapshot.DefaultActivityCount holds the default value on creation for the activity_count field.
+	trendingsnapshot.DefaultActivityCount = trendingsnapshotDescActivityCount.Default.(int)
+	// trendingsnapshotDescID is the schema descriptor for id field.
+	trendingsnapshotDescID := trendingsnapshotFields[0].Descriptor()
+	// trendingsnapshot.DefaultID holds the default value on creation for the id field.
+	trendingsnapshot.DefaultID = trendingsnapshotDescID.Default.(func() string)
+	// trendingsnapshot.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	trendingsnapshot.IDValidator = trendingsnapshotDescID.Validators[0].(func(string) error)
 	userMixin := schema.User{}.Mixin()
 	userHooks := schema.User{}.Hooks()
 	user.Hooks[0] = userHooks[0]
@@ -213,8 +1133,20 @@ func init() {
 	userDescLastName := userFields[6].Descriptor()
 	// user.LastNameValidator is a validator for the "last_name" field. It is called by the builders before save.
 	user.LastNameValidator = userDescLastName.Validators[0].(func(string) error)
+	// userDescDiscoverable is the schema descriptor for discoverable field.
+	userDescDiscoverable := userFields[9].Descriptor()
+	// user.DefaultDiscoverable holds the default value on creation for the discoverable field.
+	user.DefaultDiscoverable = userDescDiscoverable.Default.(bool)
+	// userDescHideLikes is the schema descriptor for hide_likes field.
+	userDescHideLikes := userFields[10].Descriptor()
+	// user.DefaultHideLikes holds the default value on creation for the hide_likes field.
+	user.DefaultHideLikes = userDescHideLikes.Default.(bool)
+	// userDescTimezone is the schema descriptor for timezone field.
+	userDescTimezone := userFields[12].Descriptor()
+	// user.DefaultTimezone holds the default value on creation for the timezone field.
+	user.DefaultTimezone = userDescTimezone.Default.(string)
 	// userDescFailedLoginAttempts is the schema descriptor for failed_login_attempts field.
-	userDescFailedLoginAttempts := userFields[13].Descriptor()
+	userDescFailedLoginAttempts := userFields[20].Descriptor()
 	// user.DefaultFailedLoginAttempts holds the default value on creation for the failed_login_attempts field.
 	user.DefaultFailedLoginAttempts = userDescFailedLoginAttempts.Default.(int)
 	// userDescID is the schema descriptor for id field.
@@ -252,6 +1184,94 @@ func init() {
 	usertechnology.DefaultID = usertechnologyDescID.Default.(func() string)
 	// usertechnology.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	usertechnology.IDValidator = usertechnologyDescID.Validators[0].(func(string) error)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreateTime is the schema descriptor for create_time field.
+	webhookDescCreateTime := webhookMixinFields0[0].Descriptor()
+	// webhook.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhook.DefaultCreateTime = webhookDescCreateTime.Default.(func() time.Time)
+	// webhookDescUpdateTime is the schema descriptor for update_time field.
+	webhookDescUpdateTime := webhookMixinFields0[1].Descriptor()
+	// webhook.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhook.DefaultUpdateTime = webhookDescUpdateTime.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhook.UpdateDefaultUpdateTime = webhookDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhookDescOwnerID is the schema descriptor for owner_id field.
+	webhookDescOwnerID := webhookFields[1].Descriptor()
+	// webhook.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	webhook.OwnerIDValidator = webhookDescOwnerID.Validators[0].(func(string) error)
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[3].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = func() func(string) error {
+		validators := webhookDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescDescription is the schema descriptor for description field.
+	webhookDescDescription := webhookFields[4].Descriptor()
+	// webhook.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	webhook.DescriptionValidator = webhookDescDescription.Validators[0].(func(string) error)
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[6].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[8].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() string)
+	// webhook.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhook.IDValidator = webhookDescID.Validators[0].(func(string) error)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreateTime is the schema descriptor for create_time field.
+	webhookdeliveryDescCreateTime := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhookdelivery.DefaultCreateTime = webhookdeliveryDescCreateTime.Default.(func() time.Time)
+	// webhookdeliveryDescUpdateTime is the schema descriptor for update_time field.
+	webhookdeliveryDescUpdateTime := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhookdelivery.DefaultUpdateTime = webhookdeliveryDescUpdateTime.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhookdelivery.UpdateDefaultUpdateTime = webhookdeliveryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescWebhookID is the schema descriptor for webhook_id field.
+	webhookdeliveryDescWebhookID := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.WebhookIDValidator is a validator for the "webhook_id" field. It is called by the builders before save.
+	webhookdelivery.WebhookIDValidator = webhookdeliveryDescWebhookID.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = webhookdeliveryDescEventType.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() string)
+	// webhookdelivery.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhookdelivery.IDValidator = webhookdeliveryDescID.Validators[0].(func(string) error)
 }
 
 const (
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// Collection holds the schema definition for the Collection entity.
+type Collection struct {
+	ent.Schema
+}
+
+// Mixin of the Collection.
+func (Collection) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the Collection.
+func (Collection) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("coll").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("owner_id").
+			NotEmpty().
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255),
+		field.String("description").
+			Optional().
+			Nillable().
+			MaxLen(1000),
+		field.Enum("visibility").
+			Values("public", "private").
+			Default("public").
+			Comment("Private collections are only visible to their owner."),
+	}
+}
+
+// Edges of the Collection.
+func (Collection) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("collections").
+			Unique().
+			Required().
+			Immutable().
+			Field("owner_id"),
+		edge.To("items", CollectionItem.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Collection.
+func (Collection) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id", "visibility"),
+	}
+}
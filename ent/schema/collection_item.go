@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// CollectionItem holds the schema definition for the CollectionItem entity.
+type CollectionItem struct {
+	ent.Schema
+}
+
+// Mixin of the CollectionItem.
+func (CollectionItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the CollectionItem.
+func (CollectionItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("citem").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("collection_id").
+			NotEmpty().
+			Immutable(),
+		field.String("project_id").
+			NotEmpty().
+			Immutable(),
+		field.String("note").
+			Optional().
+			Nillable().
+			MaxLen(500),
+		field.Int("position").
+			Default(0),
+	}
+}
+
+// Edges of the CollectionItem.
+func (CollectionItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("collection", Collection.Type).
+			Ref("items").
+			Unique().
+			Required().
+			Immutable().
+			Field("collection_id"),
+		edge.From("project", Project.Type).
+			Ref("collection_items").
+			Unique().
+			Required().
+			Immutable().
+			Field("project_id"),
+	}
+}
+
+// Indexes of the CollectionItem.
+func (CollectionItem) Indexes() []ent.Index {
+	return []ent.Index{
+		// A project appears at most once in a collection
+		index.Fields("collection_id", "project_id").
+			Unique(),
+		index.Fields("collection_id", "position"),
+	}
+}
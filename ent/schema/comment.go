@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// Comment holds the schema definition for the Comment entity.
+type Comment struct {
+	ent.Schema
+}
+
+// Mixin of the Comment.
+func (Comment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("cmt").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("project_id").
+			NotEmpty().
+			Immutable(),
+		field.String("author_id").
+			NotEmpty().
+			Immutable(),
+		field.String("parent_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Set for replies. Only one level of threading is allowed, so the parent is always a top-level comment."),
+		field.Text("body").
+			NotEmpty().
+			Comment("Markdown source, rendered by the clients."),
+		field.Bool("edited").
+			Default(false),
+		field.Bool("hidden").
+			Default(false).
+			Comment("Set by the project owner to moderate the comment."),
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("comments").
+			Unique().
+			Required().
+			Immutable().
+			Field("project_id"),
+		edge.From("author", User.Type).
+			Ref("comments").
+			Unique().
+			Required().
+			Immutable().
+			Field("author_id"),
+		edge.To("replies", Comment.Type).
+			From("parent").
+			Unique().
+			Immutable().
+			Field("parent_id"),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		// Keyset pagination over the top-level comments of a project
+		index.Fields("project_id", "create_time", "id"),
+		index.Fields("parent_id"),
+		index.Fields("author_id"),
+	}
+}
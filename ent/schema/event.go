@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// Event holds the schema definition for the Event entity, a hackathon projects are submitted to.
+type Event struct {
+	ent.Schema
+}
+
+// Mixin of the Event.
+func (Event) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the Event.
+func (Event) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("evt").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255),
+		field.String("slug").
+			NotEmpty().
+			Unique(),
+		field.Text("description").
+			Optional().
+			Nillable(),
+		field.Text("rules").
+			Optional().
+			Nillable(),
+		field.Time("starts_at"),
+		field.Time("ends_at").
+			Comment("Submissions close and are frozen at the end of the event."),
+		field.Time("frozen_at").
+			Optional().
+			Nillable().
+			Comment("When the submissions were frozen, judging starts then."),
+		field.Time("results_published_at").
+			Optional().
+			Nillable().
+			Comment("Results are only visible to organizers and judges until they are published."),
+	}
+}
+
+// Edges of the Event.
+func (Event) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Submitted projects must have every required tag
+		edge.To("required_tags", Tag.Type),
+		edge.To("members", EventMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("submissions", EventSubmission.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("criteria", EventCriterion.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Event.
+func (Event) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("starts_at"),
+		index.Fields("ends_at", "frozen_at"),
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// EventCriterion holds the schema definition for the EventCriterion entity, something submissions are judged on.
+type EventCriterion struct {
+	ent.Schema
+}
+
+// Mixin of the EventCriterion.
+func (EventCriterion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the EventCriterion.
+func (EventCriterion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("ecrit").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("event_id").
+			NotEmpty().
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255),
+		field.String("description").
+			Optional().
+			Nillable().
+			MaxLen(1000),
+		field.Int("weight").
+			Range(1, 10).
+			Default(1).
+			Comment("Relative weight of the criterion in the total score."),
+		field.Int("max_score").
+			Range(1, 100).
+			Default(10).
+			Comment("Judges score from 0 to max_score."),
+		field.Int("position").
+			Default(0),
+	}
+}
+
+// Edges of the EventCriterion.
+func (EventCriterion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("event", Event.Type).
+			Ref("criteria").
+			Unique().
+			Required().
+			Immutable().
+			Field("event_id"),
+		edge.To("scores", EventScore.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the EventCriterion.
+func (EventCriterion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("event_id", "position"),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// EventMember holds the schema definition for the EventMember entity.
+// Participants register themselves, organizers and judges are appointed by organizers.
+type EventMember struct {
+	ent.Schema
+}
+
+// Mixin of the EventMember.
+func (EventMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the EventMember.
+func (EventMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("evmem").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("event_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.Enum("role").
+			Values("organizer", "judge", "participant").
+			Default("participant"),
+	}
+}
+
+// Edges of the EventMember.
+func (EventMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("event", Event.Type).
+			Ref("members").
+			Unique().
+			Required().
+			Immutable().
+			Field("event_id"),
+		edge.From("user", User.Type).
+			Ref("event_memberships").
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the EventMember.
+func (EventMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("event_id", "user_id").
+			Unique(),
+		index.Fields("user_id"),
+	}
+}
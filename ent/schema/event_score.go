@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// EventScore holds the schema definition for the EventScore entity, the score a judge gives
+// a submission on a criterion.
+type EventScore struct {
+	ent.Schema
+}
+
+// Mixin of the EventScore.
+func (EventScore) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the EventScore.
+func (EventScore) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("escore").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("submission_id").
+			NotEmpty().
+			Immutable(),
+		field.String("criterion_id").
+			NotEmpty().
+			Immutable(),
+		field.String("judge_id").
+			NotEmpty().
+			Immutable(),
+		field.Int("score").
+			NonNegative(),
+		field.String("comment").
+			Optional().
+			Nillable().
+			MaxLen(1000),
+	}
+}
+
+// Edges of the EventScore.
+func (EventScore) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("submission", EventSubmission.Type).
+			Ref("scores").
+			Unique().
+			Required().
+			Immutable().
+			Field("submission_id"),
+		edge.From("criterion", EventCriterion.Type).
+			Ref("scores").
+			Unique().
+			Required().
+			Immutable().
+			Field("criterion_id"),
+		edge.From("judge", User.Type).
+			Ref("event_scores").
+			Unique().
+			Required().
+			Immutable().
+			Field("judge_id"),
+	}
+}
+
+// Indexes of the EventScore.
+func (EventScore) Indexes() []ent.Index {
+	return []ent.Index{
+		// A judge scores a submission once per criterion
+		index.Fields("submission_id", "criterion_id", "judge_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// EventSubmission holds the schema definition for the EventSubmission entity.
+// Once the event ends the submission is frozen: it points to the revision the project had at the
+// deadline, or holds a copy of its content when the project had no revision then.
+type EventSubmission struct {
+	ent.Schema
+}
+
+// Mixin of the EventSubmission.
+func (EventSubmission) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the EventSubmission.
+func (EventSubmission) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("esub").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("event_id").
+			NotEmpty().
+			Immutable(),
+		field.String("project_id").
+			NotEmpty().
+			Immutable(),
+		field.String("submitter_id").
+			NotEmpty().
+			Immutable(),
+		field.Int("revision_number").
+			Optional().
+			Nillable().
+			Comment("Number of the project revision the submission was frozen at."),
+		field.String("frozen_name").
+			Optional().
+			Nillable(),
+		field.Text("frozen_description").
+			Optional().
+			Nillable(),
+		field.Strings("frozen_tags").
+			Optional(),
+		field.Time("frozen_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the EventSubmission.
+func (EventSubmission) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("event", Event.Type).
+			Ref("submissions").
+			Unique().
+			Required().
+			Immutable().
+			Field("event_id"),
+		edge.From("project", Project.Type).
+			Ref("event_submissions").
+			Unique().
+			Required().
+			Immutable().
+			Field("project_id"),
+		edge.From("submitter", User.Type).
+			Ref("event_submissions").
+			Unique().
+			Required().
+			Immutable().
+			Field("submitter_id"),
+		edge.To("scores", EventScore.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the EventSubmission.
+func (EventSubmission) Indexes() []ent.Index {
+	return []ent.Index{
+		// A project is submitted at most once to an event
+		index.Fields("event_id", "project_id").
+			Unique(),
+		index.Fields("project_id"),
+	}
+}
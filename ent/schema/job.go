@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// Job holds the schema definition for the Job entity.
+// Jobs are the side effects of domain changes, written in the same transaction as the change
+// itself and run afterwards by the workers of the job runner.
+type Job struct {
+	ent.Schema
+}
+
+// Mixin of the Job.
+func (Job) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("job").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("kind").
+			NotEmpty().
+			Immutable(),
+		field.JSON("payload", json.RawMessage{}).
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "running", "succeeded", "dead").
+			Default("pending").
+			Comment("Jobs that failed on every attempt are dead, and kept for inspection."),
+		field.Int("attempts").
+			Default(0),
+		field.Int("max_attempts").
+			Positive().
+			Default(5),
+		field.Time("run_at").
+			Comment("When the job may run next."),
+		field.Time("locked_until").
+			Optional().
+			Nillable().
+			Comment("Set while a worker runs the job. Jobs still running past it were abandoned and are taken over."),
+		field.String("last_error").
+			Optional().
+			Nillable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Job.
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "run_at"),
+	}
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// JoinRequest holds the schema definition for the JoinRequest entity.
+// It is the counterpart of a ProjectInvitation, started by the user who wants to join.
+type JoinRequest struct {
+	ent.Schema
+}
+
+// Mixin of the JoinRequest.
+func (JoinRequest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the JoinRequest.
+func (JoinRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("jreq").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("project_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.String("role_id").
+			Optional().
+			Nillable().
+			Comment("Open role the user applies for, if any."),
+		field.String("message").
+			Optional().
+			Nillable().
+			MaxLen(1000),
+		field.Enum("status").
+			Values("pending", "accepted", "declined", "withdrawn").
+			Default("pending"),
+		field.Time("responded_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the JoinRequest.
+func (JoinRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("join_requests").
+			Unique().
+			Required().
+			Immutable().
+			Field("project_id"),
+		edge.From("user", User.Type).
+			Ref("join_requests").
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id"),
+		edge.From("role", ProjectRole.Type).
+			Ref("join_requests").
+			Unique().
+			Field("role_id"),
+	}
+}
+
+// Indexes of the JoinRequest.
+func (JoinRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "status"),
+		index.Fields("user_id", "status"),
+	}
+}
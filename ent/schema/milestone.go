@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// Milestone holds the schema definition for the Milestone entity.
+type Milestone struct {
+	ent.Schema
+}
+
+// Mixin of the Milestone.
+func (Milestone) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the Milestone.
+func (Milestone) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("mile").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("project_id").
+			NotEmpty().
+			Immutable(),
+		field.String("title").
+			NotEmpty(),
+		field.String("description").
+			Optional().
+			Nillable(),
+		field.Int("position").
+			Default(0),
+		field.Time("due_date").
+			Optional().
+			Nillable(),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Milestone.
+func (Milestone) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("milestones").
+			Unique().
+			Required().
+			Immutable().
+			Field("project_id"),
+		edge.To("tasks", Task.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Milestone.
+func (Milestone) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "position"),
+	}
+}
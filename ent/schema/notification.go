@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// notificationTypes are the kinds of notifications, shared with the notification preferences
+var notificationTypes = []string{
+	"project_liked",
+	"project_commented",
+	"comment_replied",
+	"member_invited",
+	"join_requested",
+	"member_joined",
+	"ownership_transferred",
+	"project_remixed",
+}
+
+// Notification holds the schema definition for the Notification entity.
+type Notification struct {
+	ent.Schema
+}
+
+// Mixin of the Notification.
+func (Notification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("ntf").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("recipient_id").
+			NotEmpty().
+			Immutable(),
+		field.Enum("type").
+			Values(notificationTypes...).
+			Immutable(),
+		field.String("project_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("subject_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The comment, invitation or other entity the notification is about, besides the project."),
+		field.String("group_key").
+			NotEmpty().
+			Immutable().
+			Comment("Unread notifications with the same key are aggregated into one."),
+		field.Strings("actor_ids").
+			Comment("The distinct users who caused the notification, the most recent first."),
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("recipient", User.Type).
+			Ref("notifications").
+			Unique().
+			Required().
+			Immutable().
+			Field("recipient_id"),
+		edge.From("project", Project.Type).
+			Ref("notifications").
+			Unique().
+			Immutable().
+			Field("project_id"),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("recipient_id", "group_key"),
+		index.Fields("recipient_id", "update_time"),
+		index.Fields("recipient_id", "read_at"),
+	}
+}
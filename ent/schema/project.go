@@ -1,14 +1,28 @@
 package schema
 
 import (
+	"context"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"go.jetify.com/typeid/v2"
+
+	gen "github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/intercept"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/revision"
 )
 
+// ProjectStatuses are the lifecycle stages a project goes through
+var ProjectStatuses = []string{"idea", "planning", "in_progress", "shipped", "abandoned"}
+
+// ProjectVisibilities are who can find a project: everyone, only those with its ID, or only its members
+var ProjectVisibilities = []string{"public", "unlisted", "private"}
+
 // Project holds the schema definition for the Project entity.
 type Project struct {
 	ent.Schema
@@ -17,7 +31,8 @@ type Project struct {
 // Mixin of the Project.
 func (Project) Mixin() []ent.Mixin {
 	return []ent.Mixin{
-		mixin.Time{}, // Provides created_at and updated_at fields
+		mixin.Time{},      // Provides created_at and updated_at fields
+		SoftDeleteMixin{}, // Deleted projects can be restored until they are purged
 	}
 }
 
@@ -39,6 +54,41 @@ func (Project) Fields() []ent.Field {
 			Default(0),
 		field.Int("star_count").
 			Default(0),
+		field.Int("comment_count").
+			Default(0).
+			Comment("Number of visible comments, i.e. not deleted nor hidden by the owner."),
+
+		// Progress tracking
+		field.Enum("status").
+			Values(ProjectStatuses...).
+			Default("idea"),
+		field.Time("target_start_date").
+			Optional().
+			Nillable(),
+		field.Time("target_ship_date").
+			Optional().
+			Nillable(),
+		field.Time("shipped_at").
+			Optional().
+			Nillable(),
+
+		// Visibility
+		field.Enum("visibility").
+			Values(ProjectVisibilities...).
+			Default("public"),
+		field.Bool("draft").
+			Default(false).
+			Comment("Drafts are only visible to the owner and members, whatever their visibility."),
+
+		// Remixes
+		field.String("remixed_from_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The project this one is a remix of. Cleared when that project is purged."),
+		field.Int("remix_count").
+			Default(0).
+			Comment("Number of remixes of the project, until they are purged."),
 	}
 }
 
@@ -54,6 +104,66 @@ func (Project) Edges() []ent.Edge {
 			Through("likes", Like.Type),
 		edge.To("tags", Tag.Type).
 			Through("project_tags", ProjectTag.Type),
+		// Rows that only make sense as part of the project go away with it
+		edge.To("comments", Comment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("status_changes", ProjectStatusChange.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("milestones", Milestone.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tasks", Task.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("memberships", ProjectMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invitations", ProjectInvitation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("roles", ProjectRole.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("join_requests", JoinRequest.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("revisions", ProjectRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("links", ProjectLink.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("media", ProjectMedia.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("collection_items", CollectionItem.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("event_submissions", EventSubmission.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("notifications", Notification.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("webhooks", Webhook.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Remixes outlive the project they were remixed from
+		edge.To("remixes", Project.Type).
+			From("remixed_from").
+			Unique().
+			Immutable().
+			Field("remixed_from_id").
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+// Interceptors of the Project.
+func (Project) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		// Every project query, including traversals and eager loading, only returns
+		// the projects the viewer of the context is allowed to see
+		intercept.TraverseProject(func(ctx context.Context, q *gen.ProjectQuery) error {
+			if p, ok := visibility.Filter(ctx); ok {
+				q.Where(p)
+			}
+			return nil
+		}),
+	}
+}
+
+// Hooks of the Project.
+func (Project) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// Every change of the content of a project is kept as a revision
+		revision.Hook(),
 	}
 }
 
@@ -61,6 +171,8 @@ func (Project) Edges() []ent.Edge {
 func (Project) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("like_count"),
+		index.Fields("status"),
+		index.Fields("visibility", "draft"),
 		index.Edges("owner"),
 	}
 }
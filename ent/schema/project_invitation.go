@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// ProjectInvitation holds the schema definition for the ProjectInvitation entity.
+type ProjectInvitation struct {
+	ent.Schema
+}
+
+// Mixin of the ProjectInvitation.
+func (ProjectInvitation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the ProjectInvitation.
+func (ProjectInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("pinv").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("project_id").
+			NotEmpty().
+			Immutable(),
+		field.String("invitee_id").
+			NotEmpty().
+			Immutable(),
+		field.String("inviter_id").
+			NotEmpty().
+			Immutable(),
+		field.Enum("role").
+			Values("maintainer", "contributor").
+			Default("contributor").
+			Comment("Role granted on acceptance. Ownership is only ever transferred, never invited into."),
+		field.Enum("status").
+			Values("pending", "accepted", "declined", "revoked").
+			Default("pending"),
+		field.Time("responded_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the ProjectInvitation.
+func (ProjectInvitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("invitations").
+			Unique().
+			Required().
+			Immutable().
+			Field("project_id"),
+		edge.From("invitee", User.Type).
+			Ref("received_invitations").
+			Unique().
+			Required().
+			Immutable().
+			Field("invitee_id"),
+		edge.From("inviter", User.Type).
+			Ref("sent_invitations").
+			Unique().
+			Required().
+			Immutable().
+			Field("inviter_id"),
+	}
+}
+
+// Indexes of the ProjectInvitation.
+func (ProjectInvitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "status"),
+		index.Fields("invitee_id", "status"),
+	}
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// ProjectLinkTypes are the kinds of resources a project can link to
+var ProjectLinkTypes = []string{"repo", "demo", "docs", "video"}
+
+// ProjectLink holds the schema definition for the ProjectLink entity.
+type ProjectLink struct {
+	ent.Schema
+}
+
+// Mixin of the ProjectLink.
+func (ProjectLink) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the ProjectLink.
+func (ProjectLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("plink").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("project_id").
+			NotEmpty().
+			Immutable(),
+		field.Enum("type").
+			Values(ProjectLinkTypes...),
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048),
+		field.String("title").
+			Optional().
+			Nillable().
+			MaxLen(255),
+	}
+}
+
+// Edges of the ProjectLink.
+func (ProjectLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("links").
+			Unique().
+			Required().
+			Immutable().
+			Field("project_id"),
+	}
+}
+
+// Indexes of the ProjectLink.
+func (ProjectLink) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "type"),
+	}
+}
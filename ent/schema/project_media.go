@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// ProjectMedia holds the schema definition for the ProjectMedia entity.
+// It is an image of a project, such as a screenshot, stored along with its thumbnail.
+type ProjectMedia struct {
+	ent.Schema
+}
+
+// Mixin of the ProjectMedia.
+func (ProjectMedia) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the ProjectMedia.
+func (ProjectMedia) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("pmed").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("project_id").
+			NotEmpty().
+			Immutable(),
+		field.String("uploader_id").
+			NotEmpty().
+			Immutable(),
+
+		// Files, stored under their key and served from their URL
+		field.String("key").
+			NotEmpty().
+			Immutable(),
+		field.String("url").
+			NotEmpty().
+			Immutable(),
+		field.String("thumbnail_key").
+			NotEmpty().
+			Immutable(),
+		field.String("thumbnail_url").
+			NotEmpty().
+			Immutable(),
+		field.String("content_type").
+			NotEmpty().
+			Immutable(),
+		field.Int64("size").
+			Positive().
+			Immutable().
+			Comment("Size of the file in bytes."),
+		field.Int("width").
+			Positive().
+			Immutable(),
+		field.Int("height").
+			Positive().
+			Immutable(),
+
+		field.String("caption").
+			Optional().
+			Nillable().
+			MaxLen(500),
+		field.Bool("cover").
+			Default(false).
+			Comment("The cover image represents the project in lists. A project has at most one."),
+	}
+}
+
+// Edges of the ProjectMedia.
+func (ProjectMedia) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("media").
+			Unique().
+			Required().
+			Immutable().
+			Field("project_id"),
+		edge.From("uploader", User.Type).
+			Ref("uploaded_media").
+			Unique().
+			Required().
+			Immutable().
+			Field("uploader_id"),
+	}
+}
+
+// Indexes of the ProjectMedia.
+func (ProjectMedia) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "create_time"),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// ProjectMember holds the schema definition for the ProjectMember entity.
+// The owner of a project is a member too, mirroring the owner edge of the Project.
+type ProjectMember struct {
+	ent.Schema
+}
+
+// Mixin of the ProjectMember.
+func (ProjectMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the ProjectMember.
+func (ProjectMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("pmem").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("project_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.Enum("role").
+			Values("owner", "maintainer", "contributor").
+			Default("contributor"),
+	}
+}
+
+// Edges of the ProjectMember.
+func (ProjectMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("memberships").
+			Unique().
+			Required().
+			Immutable().
+			Field("project_id"),
+		edge.From("user", User.Type).
+			Ref("memberships").
+			Unique().
+			Required().
+			Immutable().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the ProjectMember.
+func (ProjectMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "user_id").
+			Unique(),
+		index.Fields("user_id"),
+	}
+}
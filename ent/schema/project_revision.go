@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// ProjectRevision holds the schema definition for the ProjectRevision entity.
+// It is a snapshot of the content of a project, recorded every time the content changes.
+type ProjectRevision struct {
+	ent.Schema
+}
+
+// Mixin of the ProjectRevision.
+func (ProjectRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the ProjectRevision.
+func (ProjectRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("prev").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("project_id").
+			NotEmpty().
+			Immutable(),
+		field.String("author_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Empty when the revision was not made by a user, e.g. the content the project had before revisions were recorded."),
+		field.Int("number").
+			Positive().
+			Immutable().
+			Comment("Sequential number of the revision within the project, starting at 1."),
+		field.String("name").
+			Immutable(),
+		field.Text("description").
+			Immutable(),
+		field.Strings("tags").
+			Immutable().
+			Comment("Sorted slugs of the tags of the project."),
+	}
+}
+
+// Edges of the ProjectRevision.
+func (ProjectRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("revisions").
+			Unique().
+			Required().
+			Immutable().
+			Field("project_id"),
+		edge.From("author", User.Type).
+			Ref("project_revisions").
+			Unique().
+			Immutable().
+			Field("author_id"),
+	}
+}
+
+// Indexes of the ProjectRevision.
+func (ProjectRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "number").
+			Unique(),
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// ProjectRole holds the schema definition for the ProjectRole entity.
+// An open role advertises that a project is looking for collaborators with some technologies.
+type ProjectRole struct {
+	ent.Schema
+}
+
+// Mixin of the ProjectRole.
+func (ProjectRole) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the ProjectRole.
+func (ProjectRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("prole").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("project_id").
+			NotEmpty().
+			Immutable(),
+		field.String("title").
+			NotEmpty().
+			MaxLen(255),
+		field.String("description").
+			Optional().
+			Nillable().
+			MaxLen(1000),
+		field.Enum("min_skill_level").
+			Values("beginner", "intermediate", "expert").
+			Default("beginner").
+			Comment("Minimum skill level candidates need on every required tag."),
+		field.Bool("open").
+			Default(true),
+	}
+}
+
+// Edges of the ProjectRole.
+func (ProjectRole) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("roles").
+			Unique().
+			Required().
+			Immutable().
+			Field("project_id"),
+		edge.To("required_tags", Tag.Type),
+		// Requests outlive the role they applied for
+		edge.To("join_requests", JoinRequest.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+// Indexes of the ProjectRole.
+func (ProjectRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "open"),
+	}
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// ProjectStatusChange holds the schema definition for the ProjectStatusChange entity.
+// Every transition of a project's lifecycle status is recorded, including the initial one.
+type ProjectStatusChange struct {
+	ent.Schema
+}
+
+// Mixin of the ProjectStatusChange.
+func (ProjectStatusChange) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the ProjectStatusChange.
+func (ProjectStatusChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("pstat").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("project_id").
+			NotEmpty().
+			Immutable(),
+		field.String("changed_by_id").
+			NotEmpty().
+			Immutable(),
+		field.Enum("from_status").
+			Values(ProjectStatuses...).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Empty for the initial status of the project."),
+		field.Enum("to_status").
+			Values(ProjectStatuses...).
+			Immutable(),
+		field.String("note").
+			Optional().
+			Nillable().
+			Immutable(),
+	}
+}
+
+// Edges of the ProjectStatusChange.
+func (ProjectStatusChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("status_changes").
+			Unique().
+			Required().
+			Immutable().
+			Field("project_id"),
+		edge.From("changed_by", User.Type).
+			Ref("status_changes").
+			Unique().
+			Required().
+			Immutable().
+			Field("changed_by_id"),
+	}
+}
+
+// Indexes of the ProjectStatusChange.
+func (ProjectStatusChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "create_time"),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -44,7 +45,8 @@ func (ProjectTag) Edges() []ent.Edge {
 		edge.To("project", Project.Type).
 			Unique().
 			Required().
-			Field("project_id"),
+			Field("project_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("tag", Tag.Type).
 			Unique().
 			Required().
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// ScheduledRun holds the schema definition for the ScheduledRun entity.
+// Every run of a scheduled task is recorded, whichever replica ran it and whether it was due
+// or triggered by hand.
+type ScheduledRun struct {
+	ent.Schema
+}
+
+// Mixin of the ScheduledRun.
+func (ScheduledRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the ScheduledRun.
+func (ScheduledRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("srun").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("task").
+			NotEmpty().
+			Immutable(),
+		field.Enum("trigger").
+			Values("schedule", "manual").
+			Immutable(),
+		field.Time("scheduled_for").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The time the run was due at, unset for manual runs. A task runs once for each time it is due."),
+		field.String("triggered_by_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The user who triggered a manual run."),
+		field.String("instance").
+			Immutable().
+			Comment("The replica that ran the task."),
+		field.Enum("status").
+			Values("running", "succeeded", "failed").
+			Default("running"),
+		field.Time("started_at").
+			Immutable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+		field.Int("duration_ms").
+			Optional().
+			Nillable(),
+		field.Text("error").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the ScheduledRun.
+func (ScheduledRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task", "scheduled_for").
+			Unique(),
+		index.Fields("task", "started_at"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// TrendingSnapshot holds the schema definition for the TrendingSnapshot entity.
+// Rows are recomputed periodically and replaced as a whole per subject type and period,
+// so reads only need to sort by rank.
+type TrendingSnapshot struct {
+	ent.Schema
+}
+
+// Mixin of the TrendingSnapshot.
+func (TrendingSnapshot) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the TrendingSnapshot.
+func (TrendingSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("trend").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Enum("subject_type").
+			Values("tag", "project"),
+		field.String("subject_id").
+			NotEmpty(),
+		field.Enum("period").
+			NamedValues(
+				"Day", "24h",
+				"Week", "7d",
+				"Month", "30d",
+			),
+		field.Float("score").
+			Default(0),
+		field.Int("rank"),
+		field.Int("activity_count").
+			Default(0).
+			Comment("Number of raw events (likes, tag usages) that contributed to the score."),
+	}
+}
+
+// Indexes of the TrendingSnapshot.
+func (TrendingSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subject_type", "period", "subject_id").
+			Unique(),
+		index.Fields("subject_type", "period", "rank"),
+	}
+}
@@ -57,6 +57,28 @@ func (User) Fields() []ent.Field {
 		field.String("avatar_url").
 			Optional().
 			Nillable(),
+		field.Bool("discoverable").
+			Default(true).
+			Comment("Whether the user can be suggested as a candidate for open project roles."),
+		field.Bool("hide_likes").
+			Default(false).
+			Comment("Whether the projects liked by the user are hidden from everyone else."),
+		field.Enum("digest_frequency").
+			Values("off", "daily", "weekly").
+			Default("weekly").
+			Comment("How often the user gets an email digest of their unread notifications."),
+		field.String("timezone").
+			Default("UTC").
+			Comment("IANA name of the timezone of the user, digests are sent in the morning of their day."),
+		field.Time("last_digest_at").
+			Optional().
+			Nillable(),
+		field.String("unsubscribe_token").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive().
+			Comment("Lets the user turn digests off from the email itself, without logging in."),
 
 		// Account management fields
 		field.Time("last_login_at").
@@ -65,6 +87,10 @@ func (User) Fields() []ent.Field {
 		field.Enum("account_status").
 			Values("pending", "active", "suspended").
 			Default("pending"),
+		field.Enum("role").
+			Values("user", "admin").
+			Default("user").
+			Comment("Admins may run maintenance tasks. It is only ever set in the database."),
 		field.String("verification_token").
 			Optional().
 			Nillable().
@@ -99,6 +125,21 @@ func (User) Edges() []ent.Edge {
 		edge.To("liked_projects", Project.Type).Through("likes", Like.Type),                 // A user can like many projects.
 		edge.To("technologies", Tag.Type).Through("user_technologies", UserTechnology.Type), // A user can know many technologies.
 		edge.To("created_tags", Tag.Type),                                                   // A user can create many tags.
+		edge.To("comments", Comment.Type),                                                   // A user can write many comments.
+		edge.To("status_changes", ProjectStatusChange.Type),                                 // A user can change the status of projects.
+		edge.To("memberships", ProjectMember.Type),                                          // A user can be a member of many projects.
+		edge.To("received_invitations", ProjectInvitation.Type),                             // A user can be invited to many projects.
+		edge.To("sent_invitations", ProjectInvitation.Type),                                 // A user can invite others to projects.
+		edge.To("join_requests", JoinRequest.Type),                                          // A user can ask to join many projects.
+		edge.To("project_revisions", ProjectRevision.Type),                                  // A user can edit the content of projects.
+		edge.To("uploaded_media", ProjectMedia.Type),                                        // A user can upload images of projects.
+		edge.To("collections", Collection.Type),                                             // A user can curate many collections of projects.
+		edge.To("event_memberships", EventMember.Type),                                      // A user can take part in many events.
+		edge.To("event_submissions", EventSubmission.Type),                                  // A user can submit projects to events.
+		edge.To("event_scores", EventScore.Type),                                            // A user can judge event submissions.
+		edge.To("notifications", Notification.Type),                                         // A user can receive many notifications.
+		edge.To("notification_preferences", NotificationPreference.Type),                    // A user can opt out of types of notifications.
+		edge.To("webhooks", Webhook.Type),                                                   // A user can send events to other services.
 	}
 }
 
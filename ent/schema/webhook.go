@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+// A webhook without a project is scoped to its owner: it gets the events of the projects they
+// own and of what they do themselves.
+type Webhook struct {
+	ent.Schema
+}
+
+// Mixin of the Webhook.
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("whk").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("owner_id").
+			NotEmpty().
+			Immutable(),
+		field.String("project_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048),
+		field.String("description").
+			Optional().
+			Nillable().
+			MaxLen(255),
+		field.Enum("format").
+			Values("json", "slack", "discord").
+			Default("json").
+			Comment("Shape of the payloads: the signed event, or a message for Slack or Discord incoming webhooks."),
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Comment("Key of the HMAC-SHA256 signature of the payloads."),
+		field.Strings("event_types").
+			Optional().
+			Comment("Types of events delivered, all of them when empty."),
+		field.Bool("active").
+			Default(true),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("webhooks").
+			Unique().
+			Required().
+			Immutable().
+			Field("owner_id"),
+		edge.From("project", Project.Type).
+			Ref("webhooks").
+			Unique().
+			Immutable().
+			Field("project_id"),
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Webhook.
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner_id"),
+		index.Fields("project_id"),
+	}
+}
@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"go.jetify.com/typeid/v2"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// Deliveries are stored before being sent, so they survive restarts and are retried until they
+// succeed or run out of attempts.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookDelivery.
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{}, // Provides created_at and updated_at fields
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(func() string {
+				return typeid.MustGenerate("whdel").String()
+			}).
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.String("webhook_id").
+			NotEmpty().
+			Immutable(),
+		field.String("event_type").
+			NotEmpty().
+			Immutable(),
+		field.Text("payload").
+			Immutable().
+			Comment("The exact body sent, so that every attempt carries the same signed content."),
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at"),
+		field.Time("locked_until").
+			Optional().
+			Nillable().
+			Comment("Set while a worker sends the delivery, so that other instances leave it alone."),
+		field.Int("response_status").
+			Optional().
+			Nillable(),
+		field.String("response_body").
+			Optional().
+			Nillable().
+			Comment("The beginning of the response body of the last attempt."),
+		field.String("last_error").
+			Optional().
+			Nillable(),
+		field.Int("duration_ms").
+			Optional().
+			Nillable(),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Unique().
+			Required().
+			Immutable().
+			Field("webhook_id"),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("webhook_id", "create_time"),
+	}
+}
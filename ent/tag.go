@@ -36,9 +36,11 @@ type Tag struct {
 	UsageCount int `json:"usage_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TagQuery when eager-loading is set.
-	Edges             TagEdges `json:"edges"`
-	user_created_tags *string
-	selectValues      sql.SelectValues
+	Edges                      TagEdges `json:"edges"`
+	event_required_tags        *string
+	project_role_required_tags *string
+	user_created_tags          *string
+	selectValues               sql.SelectValues
 }
 
 // TagEdges holds the relations/edges for other nodes in the graph.
@@ -116,7 +118,11 @@ func (*Tag) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullString)
 		case tag.FieldCreateTime, tag.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
-		case tag.ForeignKeys[0]: // user_created_tags
+		case tag.ForeignKeys[0]: // event_required_tags
+			values[i] = new(sql.NullString)
+		case tag.ForeignKeys[1]: // project_role_required_tags
+			values[i] = new(sql.NullString)
+		case tag.ForeignKeys[2]: // user_created_tags
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -190,6 +196,20 @@ func (_m *Tag) assignValues(columns []string, values []any) error {
 				_m.UsageCount = int(value.Int64)
 			}
 		case tag.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_required_tags", values[i])
+			} else if value.Valid {
+				_m.event_required_tags = new(string)
+				*_m.event_required_tags = value.String
+			}
+		case tag.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project_role_required_tags", values[i])
+			} else if value.Valid {
+				_m.project_role_required_tags = new(string)
+				*_m.project_role_required_tags = value.String
+			}
+		case tag.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field user_created_tags", values[i])
 			} else if value.Valid {
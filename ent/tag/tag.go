@@ -92,6 +92,8 @@ var Columns = []string{
 // ForeignKeys holds the SQL foreign-keys that are owned by the "tags"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"event_required_tags",
+	"project_role_required_tags",
 	"user_created_tags",
 }
 
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,20 +14,70 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Collection is the client for interacting with the Collection builders.
+	Collection *CollectionClient
+	// CollectionItem is the client for interacting with the CollectionItem builders.
+	CollectionItem *CollectionItemClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
+	// Event is the client for interacting with the Event builders.
+	Event *EventClient
+	// EventCriterion is the client for interacting with the EventCriterion builders.
+	EventCriterion *EventCriterionClient
+	// EventMember is the client for interacting with the EventMember builders.
+	EventMember *EventMemberClient
+	// EventScore is the client for interacting with the EventScore builders.
+	EventScore *EventScoreClient
+	// EventSubmission is the client for interacting with the EventSubmission builders.
+	EventSubmission *EventSubmissionClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// JoinRequest is the client for interacting with the JoinRequest builders.
+	JoinRequest *JoinRequestClient
 	// Like is the client for interacting with the Like builders.
 	Like *LikeClient
+	// Milestone is the client for interacting with the Milestone builders.
+	Milestone *MilestoneClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// NotificationPreference is the client for interacting with the NotificationPreference builders.
+	NotificationPreference *NotificationPreferenceClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
+	// ProjectInvitation is the client for interacting with the ProjectInvitation builders.
+	ProjectInvitation *ProjectInvitationClient
+	// ProjectLink is the client for interacting with the ProjectLink builders.
+	ProjectLink *ProjectLinkClient
+	// ProjectMedia is the client for interacting with the ProjectMedia builders.
+	ProjectMedia *ProjectMediaClient
+	// ProjectMember is the client for interacting with the ProjectMember builders.
+	ProjectMember *ProjectMemberClient
+	// ProjectRevision is the client for interacting with the ProjectRevision builders.
+	ProjectRevision *ProjectRevisionClient
+	// ProjectRole is the client for interacting with the ProjectRole builders.
+	ProjectRole *ProjectRoleClient
+	// ProjectStatusChange is the client for interacting with the ProjectStatusChange builders.
+	ProjectStatusChange *ProjectStatusChangeClient
 	// ProjectTag is the client for interacting with the ProjectTag builders.
 	ProjectTag *ProjectTagClient
+	// ScheduledRun is the client for interacting with the ScheduledRun builders.
+	ScheduledRun *ScheduledRunClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
+	// Task is the client for interacting with the Task builders.
+	Task *TaskClient
+	// TrendingSnapshot is the client for interacting with the TrendingSnapshot builders.
+	TrendingSnapshot *TrendingSnapshotClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserTechnology is the client for interacting with the UserTechnology builders.
 	UserTechnology *UserTechnologyClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -157,13 +209,38 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Collection = NewCollectionClient(tx.config)
+	tx.CollectionItem = NewCollectionItemClient(tx.config)
+	tx.Comment = NewCommentClient(tx.config)
+	tx.Event = NewEventClient(tx.config)
+	tx.EventCriterion = NewEventCriterionClient(tx.config)
+	tx.EventMember = NewEventMemberClient(tx.config)
+	tx.EventScore = NewEventScoreClient(tx.config)
+	tx.EventSubmission = NewEventSubmissionClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
+	tx.JoinRequest = NewJoinRequestClient(tx.config)
 	tx.Like = NewLikeClient(tx.config)
+	tx.Milestone = NewMilestoneClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
 	tx.Project = NewProjectClient(tx.config)
+	tx.ProjectInvitation = NewProjectInvitationClient(tx.config)
+	tx.ProjectLink = NewProjectLinkClient(tx.config)
+	tx.ProjectMedia = NewProjectMediaClient(tx.config)
+	tx.ProjectMember = NewProjectMemberClient(tx.config)
+	tx.ProjectRevision = NewProjectRevisionClient(tx.config)
+	tx.ProjectRole = NewProjectRoleClient(tx.config)
+	tx.ProjectStatusChange = NewProjectStatusChangeClient(tx.config)
 	tx.ProjectTag = NewProjectTagClient(tx.config)
+	tx.ScheduledRun = NewScheduledRunClient(tx.config)
 	tx.Session = NewSessionClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
+	tx.Task = NewTaskClient(tx.config)
+	tx.TrendingSnapshot = NewTrendingSnapshotClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserTechnology = NewUserTechnologyClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -173,7 +250,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Like.QueryXXX(), the query will be executed
+// applies a query, for example: Collection.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -226,3 +303,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -37,10 +37,24 @@ type User struct {
 	Bio *string `json:"bio,omitempty"`
 	// AvatarURL holds the value of the "avatar_url" field.
 	AvatarURL *string `json:"avatar_url,omitempty"`
+	// Whether the user can be suggested as a candidate for open project roles.
+	Discoverable bool `json:"discoverable,omitempty"`
+	// Whether the projects liked by the user are hidden from everyone else.
+	HideLikes bool `json:"hide_likes,omitempty"`
+	// How often the user gets an email digest of their unread notifications.
+	DigestFrequency user.DigestFrequency `json:"digest_frequency,omitempty"`
+	// IANA name of the timezone of the user, digests are sent in the morning of their day.
+	Timezone string `json:"timezone,omitempty"`
+	// LastDigestAt holds the value of the "last_digest_at" field.
+	LastDigestAt *time.Time `json:"last_digest_at,omitempty"`
+	// Lets the user turn digests off from the email itself, without logging in.
+	UnsubscribeToken *string `json:"-"`
 	// LastLoginAt holds the value of the "last_login_at" field.
 	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
 	// AccountStatus holds the value of the "account_status" field.
 	AccountStatus user.AccountStatus `json:"account_status,omitempty"`
+	// Admins may run maintenance tasks. It is only ever set in the database.
+	Role user.Role `json:"role,omitempty"`
 	// VerificationToken holds the value of the "verification_token" field.
 	VerificationToken *string `json:"-"`
 	// VerificationTokenExpiryAt holds the value of the "verification_token_expiry_at" field.
@@ -69,13 +83,43 @@ type UserEdges struct {
 	Technologies []*Tag `json:"technologies,omitempty"`
 	// CreatedTags holds the value of the created_tags edge.
 	CreatedTags []*Tag `json:"created_tags,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*Comment `json:"comments,omitempty"`
+	// StatusChanges holds the value of the status_changes edge.
+	StatusChanges []*ProjectStatusChange `json:"status_changes,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*ProjectMember `json:"memberships,omitempty"`
+	// ReceivedInvitations holds the value of the received_invitations edge.
+	ReceivedInvitations []*ProjectInvitation `json:"received_invitations,omitempty"`
+	// SentInvitations holds the value of the sent_invitations edge.
+	SentInvitations []*ProjectInvitation `json:"sent_invitations,omitempty"`
+	// JoinRequests holds the value of the join_requests edge.
+	JoinRequests []*JoinRequest `json:"join_requests,omitempty"`
+	// ProjectRevisions holds the value of the project_revisions edge.
+	ProjectRevisions []*ProjectRevision `json:"project_revisions,omitempty"`
+	// UploadedMedia holds the value of the uploaded_media edge.
+	UploadedMedia []*ProjectMedia `json:"uploaded_media,omitempty"`
+	// Collections holds the value of the collections edge.
+	Collections []*Collection `json:"collections,omitempty"`
+	// EventMemberships holds the value of the event_memberships edge.
+	EventMemberships []*EventMember `json:"event_memberships,omitempty"`
+	// EventSubmissions holds the value of the event_submissions edge.
+	EventSubmissions []*EventSubmission `json:"event_submissions,omitempty"`
+	// EventScores holds the value of the event_scores edge.
+	EventScores []*EventScore `json:"event_scores,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// NotificationPreferences holds the value of the notification_preferences edge.
+	NotificationPreferences []*NotificationPreference `json:"notification_preferences,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
 	// Likes holds the value of the likes edge.
 	Likes []*Like `json:"likes,omitempty"`
 	// UserTechnologies holds the value of the user_technologies edge.
 	UserTechnologies []*UserTechnology `json:"user_technologies,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [22]bool
 }
 
 // SessionsOrErr returns the Sessions value or an error if the edge
@@ -123,10 +167,145 @@ func (e UserEdges) CreatedTagsOrErr() ([]*Tag, error) {
 	return nil, &NotLoadedError{edge: "created_tags"}
 }
 
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[5] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// StatusChangesOrErr returns the StatusChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) StatusChangesOrErr() ([]*ProjectStatusChange, error) {
+	if e.loadedTypes[6] {
+		return e.StatusChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "status_changes"}
+}
+
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MembershipsOrErr() ([]*ProjectMember, error) {
+	if e.loadedTypes[7] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
+// ReceivedInvitationsOrErr returns the ReceivedInvitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReceivedInvitationsOrErr() ([]*ProjectInvitation, error) {
+	if e.loadedTypes[8] {
+		return e.ReceivedInvitations, nil
+	}
+	return nil, &NotLoadedError{edge: "received_invitations"}
+}
+
+// SentInvitationsOrErr returns the SentInvitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SentInvitationsOrErr() ([]*ProjectInvitation, error) {
+	if e.loadedTypes[9] {
+		return e.SentInvitations, nil
+	}
+	return nil, &NotLoadedError{edge: "sent_invitations"}
+}
+
+// JoinRequestsOrErr returns the JoinRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) JoinRequestsOrErr() ([]*JoinRequest, error) {
+	if e.loadedTypes[10] {
+		return e.JoinRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "join_requests"}
+}
+
+// ProjectRevisionsOrErr returns the ProjectRevisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ProjectRevisionsOrErr() ([]*ProjectRevision, error) {
+	if e.loadedTypes[11] {
+		return e.ProjectRevisions, nil
+	}
+	return nil, &NotLoadedError{edge: "project_revisions"}
+}
+
+// UploadedMediaOrErr returns the UploadedMedia value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) UploadedMediaOrErr() ([]*ProjectMedia, error) {
+	if e.loadedTypes[12] {
+		return e.UploadedMedia, nil
+	}
+	return nil, &NotLoadedError{edge: "uploaded_media"}
+}
+
+// CollectionsOrErr returns the Collections value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CollectionsOrErr() ([]*Collection, error) {
+	if e.loadedTypes[13] {
+		return e.Collections, nil
+	}
+	return nil, &NotLoadedError{edge: "collections"}
+}
+
+// EventMembershipsOrErr returns the EventMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EventMembershipsOrErr() ([]*EventMember, error) {
+	if e.loadedTypes[14] {
+		return e.EventMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "event_memberships"}
+}
+
+// EventSubmissionsOrErr returns the EventSubmissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EventSubmissionsOrErr() ([]*EventSubmission, error) {
+	if e.loadedTypes[15] {
+		return e.EventSubmissions, nil
+	}
+	return nil, &NotLoadedError{edge: "event_submissions"}
+}
+
+// EventScoresOrErr returns the EventScores value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EventScoresOrErr() ([]*EventScore, error) {
+	if e.loadedTypes[16] {
+		return e.EventScores, nil
+	}
+	return nil, &NotLoadedError{edge: "event_scores"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[17] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// NotificationPreferencesOrErr returns the NotificationPreferences value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationPreferencesOrErr() ([]*NotificationPreference, error) {
+	if e.loadedTypes[18] {
+		return e.NotificationPreferences, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_preferences"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[19] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
 // LikesOrErr returns the Likes value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) LikesOrErr() ([]*Like, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[20] {
 		return e.Likes, nil
 	}
 	return nil, &NotLoadedError{edge: "likes"}
@@ -135,7 +314,7 @@ func (e UserEdges) LikesOrErr() ([]*Like, error) {
 // UserTechnologiesOrErr returns the UserTechnologies value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) UserTechnologiesOrErr() ([]*UserTechnology, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[21] {
 		return e.UserTechnologies, nil
 	}
 	return nil, &NotLoadedError{edge: "user_technologies"}
@@ -146,13 +325,13 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldEmailVerified:
+		case user.FieldEmailVerified, user.FieldDiscoverable, user.FieldHideLikes:
 			values[i] = new(sql.NullBool)
 		case user.FieldFailedLoginAttempts:
 			values[i] = new(sql.NullInt64)
-		case user.FieldID, user.FieldUsername, user.FieldEmail, user.FieldPassword, user.FieldFirstName, user.FieldLastName, user.FieldBio, user.FieldAvatarURL, user.FieldAccountStatus, user.FieldVerificationToken, user.FieldResetPasswordToken:
+		case user.FieldID, user.FieldUsername, user.FieldEmail, user.FieldPassword, user.FieldFirstName, user.FieldLastName, user.FieldBio, user.FieldAvatarURL, user.FieldDigestFrequency, user.FieldTimezone, user.FieldUnsubscribeToken, user.FieldAccountStatus, user.FieldRole, user.FieldVerificationToken, user.FieldResetPasswordToken:
 			values[i] = new(sql.NullString)
-		case user.FieldCreateTime, user.FieldUpdateTime, user.FieldLastLoginAt, user.FieldVerificationTokenExpiryAt, user.FieldResetPasswordTokenExpiryAt:
+		case user.FieldCreateTime, user.FieldUpdateTime, user.FieldLastDigestAt, user.FieldLastLoginAt, user.FieldVerificationTokenExpiryAt, user.FieldResetPasswordTokenExpiryAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -237,6 +416,44 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.AvatarURL = new(string)
 				*_m.AvatarURL = value.String
 			}
+		case user.FieldDiscoverable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field discoverable", values[i])
+			} else if value.Valid {
+				_m.Discoverable = value.Bool
+			}
+		case user.FieldHideLikes:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hide_likes", values[i])
+			} else if value.Valid {
+				_m.HideLikes = value.Bool
+			}
+		case user.FieldDigestFrequency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest_frequency", values[i])
+			} else if value.Valid {
+				_m.DigestFrequency = user.DigestFrequency(value.String)
+			}
+		case user.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
+		case user.FieldLastDigestAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_digest_at", values[i])
+			} else if value.Valid {
+				_m.LastDigestAt = new(time.Time)
+				*_m.LastDigestAt = value.Time
+			}
+		case user.FieldUnsubscribeToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unsubscribe_token", values[i])
+			} else if value.Valid {
+				_m.UnsubscribeToken = new(string)
+				*_m.UnsubscribeToken = value.String
+			}
 		case user.FieldLastLoginAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_login_at", values[i])
@@ -250,6 +467,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AccountStatus = user.AccountStatus(value.String)
 			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = user.Role(value.String)
+			}
 		case user.FieldVerificationToken:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field verification_token", values[i])
@@ -322,6 +545,81 @@ func (_m *User) QueryCreatedTags() *TagQuery {
 	return NewUserClient(_m.config).QueryCreatedTags(_m)
 }
 
+// QueryComments queries the "comments" edge of the User entity.
+func (_m *User) QueryComments() *CommentQuery {
+	return NewUserClient(_m.config).QueryComments(_m)
+}
+
+// QueryStatusChanges queries the "status_changes" edge of the User entity.
+func (_m *User) QueryStatusChanges() *ProjectStatusChangeQuery {
+	return NewUserClient(_m.config).QueryStatusChanges(_m)
+}
+
+// QueryMemberships queries the "memberships" edge of the User entity.
+func (_m *User) QueryMemberships() *ProjectMemberQuery {
+	return NewUserClient(_m.config).QueryMemberships(_m)
+}
+
+// QueryReceivedInvitations queries the "received_invitations" edge of the User entity.
+func (_m *User) QueryReceivedInvitations() *ProjectInvitationQuery {
+	return NewUserClient(_m.config).QueryReceivedInvitations(_m)
+}
+
+// QuerySentInvitations queries the "sent_invitations" edge of the User entity.
+func (_m *User) QuerySentInvitations() *ProjectInvitationQuery {
+	return NewUserClient(_m.config).QuerySentInvitations(_m)
+}
+
+// QueryJoinRequests queries the "join_requests" edge of the User entity.
+func (_m *User) QueryJoinRequests() *JoinRequestQuery {
+	return NewUserClient(_m.config).QueryJoinRequests(_m)
+}
+
+// QueryProjectRevisions queries the "project_revisions" edge of the User entity.
+func (_m *User) QueryProjectRevisions() *ProjectRevisionQuery {
+	return NewUserClient(_m.config).QueryProjectRevisions(_m)
+}
+
+// QueryUploadedMedia queries the "uploaded_media" edge of the User entity.
+func (_m *User) QueryUploadedMedia() *ProjectMediaQuery {
+	return NewUserClient(_m.config).QueryUploadedMedia(_m)
+}
+
+// QueryCollections queries the "collections" edge of the User entity.
+func (_m *User) QueryCollections() *CollectionQuery {
+	return NewUserClient(_m.config).QueryCollections(_m)
+}
+
+// QueryEventMemberships queries the "event_memberships" edge of the User entity.
+func (_m *User) QueryEventMemberships() *EventMemberQuery {
+	return NewUserClient(_m.config).QueryEventMemberships(_m)
+}
+
+// QueryEventSubmissions queries the "event_submissions" edge of the User entity.
+func (_m *User) QueryEventSubmissions() *EventSubmissionQuery {
+	return NewUserClient(_m.config).QueryEventSubmissions(_m)
+}
+
+// QueryEventScores queries the "event_scores" edge of the User entity.
+func (_m *User) QueryEventScores() *EventScoreQuery {
+	return NewUserClient(_m.config).QueryEventScores(_m)
+}
+
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (_m *User) QueryNotifications() *NotificationQuery {
+	return NewUserClient(_m.config).QueryNotifications(_m)
+}
+
+// QueryNotificationPreferences queries the "notification_preferences" edge of the User entity.
+func (_m *User) QueryNotificationPreferences() *NotificationPreferenceQuery {
+	return NewUserClient(_m.config).QueryNotificationPreferences(_m)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the User entity.
+func (_m *User) QueryWebhooks() *WebhookQuery {
+	return NewUserClient(_m.config).QueryWebhooks(_m)
+}
+
 // QueryLikes queries the "likes" edge of the User entity.
 func (_m *User) QueryLikes() *LikeQuery {
 	return NewUserClient(_m.config).QueryLikes(_m)
@@ -388,6 +686,25 @@ func (_m *User) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("discoverable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Discoverable))
+	builder.WriteString(", ")
+	builder.WriteString("hide_likes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HideLikes))
+	builder.WriteString(", ")
+	builder.WriteString("digest_frequency=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DigestFrequency))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
+	if v := _m.LastDigestAt; v != nil {
+		builder.WriteString("last_digest_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("unsubscribe_token=<sensitive>")
+	builder.WriteString(", ")
 	if v := _m.LastLoginAt; v != nil {
 		builder.WriteString("last_login_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -396,6 +713,9 @@ func (_m *User) String() string {
 	builder.WriteString("account_status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AccountStatus))
 	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
 	builder.WriteString("verification_token=<sensitive>")
 	builder.WriteString(", ")
 	if v := _m.VerificationTokenExpiryAt; v != nil {
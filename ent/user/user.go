@@ -36,10 +36,24 @@ const (
 	FieldBio = "bio"
 	// FieldAvatarURL holds the string denoting the avatar_url field in the database.
 	FieldAvatarURL = "avatar_url"
+	// FieldDiscoverable holds the string denoting the discoverable field in the database.
+	FieldDiscoverable = "discoverable"
+	// FieldHideLikes holds the string denoting the hide_likes field in the database.
+	FieldHideLikes = "hide_likes"
+	// FieldDigestFrequency holds the string denoting the digest_frequency field in the database.
+	FieldDigestFrequency = "digest_frequency"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldLastDigestAt holds the string denoting the last_digest_at field in the database.
+	FieldLastDigestAt = "last_digest_at"
+	// FieldUnsubscribeToken holds the string denoting the unsubscribe_token field in the database.
+	FieldUnsubscribeToken = "unsubscribe_token"
 	// FieldLastLoginAt holds the string denoting the last_login_at field in the database.
 	FieldLastLoginAt = "last_login_at"
 	// FieldAccountStatus holds the string denoting the account_status field in the database.
 	FieldAccountStatus = "account_status"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
 	// FieldVerificationToken holds the string denoting the verification_token field in the database.
 	FieldVerificationToken = "verification_token"
 	// FieldVerificationTokenExpiryAt holds the string denoting the verification_token_expiry_at field in the database.
@@ -60,6 +74,36 @@ const (
 	EdgeTechnologies = "technologies"
 	// EdgeCreatedTags holds the string denoting the created_tags edge name in mutations.
 	EdgeCreatedTags = "created_tags"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
+	// EdgeStatusChanges holds the string denoting the status_changes edge name in mutations.
+	EdgeStatusChanges = "status_changes"
+	// EdgeMemberships holds the string denoting the memberships edge name in mutations.
+	EdgeMemberships = "memberships"
+	// EdgeReceivedInvitations holds the string denoting the received_invitations edge name in mutations.
+	EdgeReceivedInvitations = "received_invitations"
+	// EdgeSentInvitations holds the string denoting the sent_invitations edge name in mutations.
+	EdgeSentInvitations = "sent_invitations"
+	// EdgeJoinRequests holds the string denoting the join_requests edge name in mutations.
+	EdgeJoinRequests = "join_requests"
+	// EdgeProjectRevisions holds the string denoting the project_revisions edge name in mutations.
+	EdgeProjectRevisions = "project_revisions"
+	// EdgeUploadedMedia holds the string denoting the uploaded_media edge name in mutations.
+	EdgeUploadedMedia = "uploaded_media"
+	// EdgeCollections holds the string denoting the collections edge name in mutations.
+	EdgeCollections = "collections"
+	// EdgeEventMemberships holds the string denoting the event_memberships edge name in mutations.
+	EdgeEventMemberships = "event_memberships"
+	// EdgeEventSubmissions holds the string denoting the event_submissions edge name in mutations.
+	EdgeEventSubmissions = "event_submissions"
+	// EdgeEventScores holds the string denoting the event_scores edge name in mutations.
+	EdgeEventScores = "event_scores"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
+	// EdgeNotificationPreferences holds the string denoting the notification_preferences edge name in mutations.
+	EdgeNotificationPreferences = "notification_preferences"
+	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
+	EdgeWebhooks = "webhooks"
 	// EdgeLikes holds the string denoting the likes edge name in mutations.
 	EdgeLikes = "likes"
 	// EdgeUserTechnologies holds the string denoting the user_technologies edge name in mutations.
@@ -97,6 +141,111 @@ const (
 	CreatedTagsInverseTable = "tags"
 	// CreatedTagsColumn is the table column denoting the created_tags relation/edge.
 	CreatedTagsColumn = "user_created_tags"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "comments"
+	// CommentsInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	CommentsInverseTable = "comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "author_id"
+	// StatusChangesTable is the table that holds the status_changes relation/edge.
+	StatusChangesTable = "project_status_changes"
+	// StatusChangesInverseTable is the table name for the ProjectStatusChange entity.
+	// It exists in this package in order to avoid circular dependency with the "projectstatuschange" package.
+	StatusChangesInverseTable = "project_status_changes"
+	// StatusChangesColumn is the table column denoting the status_changes relation/edge.
+	StatusChangesColumn = "changed_by_id"
+	// MembershipsTable is the table that holds the memberships relation/edge.
+	MembershipsTable = "project_members"
+	// MembershipsInverseTable is the table name for the ProjectMember entity.
+	// It exists in this package in order to avoid circular dependency with the "projectmember" package.
+	MembershipsInverseTable = "project_members"
+	// MembershipsColumn is the table column denoting the memberships relation/edge.
+	MembershipsColumn = "user_id"
+	// ReceivedInvitationsTable is the table that holds the received_invitations relation/edge.
+	ReceivedInvitationsTable = "project_invitations"
+	// ReceivedInvitationsInverseTable is the table name for the ProjectInvitation entity.
+	// It exists in this package in order to avoid circular dependency with the "projectinvitation" package.
+	ReceivedInvitationsInverseTable = "project_invitations"
+	// ReceivedInvitationsColumn is the table column denoting the received_invitations relation/edge.
+	ReceivedInvitationsColumn = "invitee_id"
+	// SentInvitationsTable is the table that holds the sent_invitations relation/edge.
+	SentInvitationsTable = "project_invitations"
+	// SentInvitationsInverseTable is the table name for the ProjectInvitation entity.
+	// It exists in this package in order to avoid circular dependency with the "projectinvitation" package.
+	SentInvitationsInverseTable = "project_invitations"
+	// SentInvitationsColumn is the table column denoting the sent_invitations relation/edge.
+	SentInvitationsColumn = "inviter_id"
+	// JoinRequestsTable is the table that holds the join_requests relation/edge.
+	JoinRequestsTable = "join_requests"
+	// JoinRequestsInverseTable is the table name for the JoinRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "joinrequest" package.
+	JoinRequestsInverseTable = "join_requests"
+	// JoinRequestsColumn is the table column denoting the join_requests relation/edge.
+	JoinRequestsColumn = "user_id"
+	// ProjectRevisionsTable is the table that holds the project_revisions relation/edge.
+	ProjectRevisionsTable = "project_revisions"
+	// ProjectRevisionsInverseTable is the table name for the ProjectRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "projectrevision" package.
+	ProjectRevisionsInverseTable = "project_revisions"
+	// ProjectRevisionsColumn is the table column denoting the project_revisions relation/edge.
+	ProjectRevisionsColumn = "author_id"
+	// UploadedMediaTable is the table that holds the uploaded_media relation/edge.
+	UploadedMediaTable = "project_media"
+	// UploadedMediaInverseTable is the table name for the ProjectMedia entity.
+	// It exists in this package in order to avoid circular dependency with the "projectmedia" package.
+	UploadedMediaInverseTable = "project_media"
+	// UploadedMediaColumn is the table column denoting the uploaded_media relation/edge.
+	UploadedMediaColumn = "uploader_id"
+	// CollectionsTable is the table that holds the collections relation/edge.
+	CollectionsTable = "collections"
+	// CollectionsInverseTable is the table name for the Collection entity.
+	// It exists in this package in order to avoid circular dependency with the "collection" package.
+	CollectionsInverseTable = "collections"
+	// CollectionsColumn is the table column denoting the collections relation/edge.
+	CollectionsColumn = "owner_id"
+	// EventMembershipsTable is the table that holds the event_memberships relation/edge.
+	EventMembershipsTable = "event_members"
+	// EventMembershipsInverseTable is the table name for the EventMember entity.
+	// It exists in this package in order to avoid circular dependency with the "eventmember" package.
+	EventMembershipsInverseTable = "event_members"
+	// EventMembershipsColumn is the table column denoting the event_memberships relation/edge.
+	EventMembershipsColumn = "user_id"
+	// EventSubmissionsTable is the table that holds the event_submissions relation/edge.
+	EventSubmissionsTable = "event_submissions"
+	// EventSubmissionsInverseTable is the table name for the EventSubmission entity.
+	// It exists in this package in order to avoid circular dependency with the "eventsubmission" package.
+	EventSubmissionsInverseTable = "event_submissions"
+	// EventSubmissionsColumn is the table column denoting the event_submissions relation/edge.
+	EventSubmissionsColumn = "submitter_id"
+	// EventScoresTable is the table that holds the event_scores relation/edge.
+	EventScoresTable = "event_scores"
+	// EventScoresInverseTable is the table name for the EventScore entity.
+	// It exists in this package in order to avoid circular dependency with the "eventscore" package.
+	EventScoresInverseTable = "event_scores"
+	// EventScoresColumn is the table column denoting the event_scores relation/edge.
+	EventScoresColumn = "judge_id"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "recipient_id"
+	// NotificationPreferencesTable is the table that holds the notification_preferences relation/edge.
+	NotificationPreferencesTable = "notification_preferences"
+	// NotificationPreferencesInverseTable is the table name for the NotificationPreference entity.
+	// It exists in this package in order to avoid circular dependency with the "notificationpreference" package.
+	NotificationPreferencesInverseTable = "notification_preferences"
+	// NotificationPreferencesColumn is the table column denoting the notification_preferences relation/edge.
+	NotificationPreferencesColumn = "user_id"
+	// WebhooksTable is the table that holds the webhooks relation/edge.
+	WebhooksTable = "webhooks"
+	// WebhooksInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhooksInverseTable = "webhooks"
+	// WebhooksColumn is the table column denoting the webhooks relation/edge.
+	WebhooksColumn = "owner_id"
 	// LikesTable is the table that holds the likes relation/edge.
 	LikesTable = "likes"
 	// LikesInverseTable is the table name for the Like entity.
@@ -126,8 +275,15 @@ var Columns = []string{
 	FieldLastName,
 	FieldBio,
 	FieldAvatarURL,
+	FieldDiscoverable,
+	FieldHideLikes,
+	FieldDigestFrequency,
+	FieldTimezone,
+	FieldLastDigestAt,
+	FieldUnsubscribeToken,
 	FieldLastLoginAt,
 	FieldAccountStatus,
+	FieldRole,
 	FieldVerificationToken,
 	FieldVerificationTokenExpiryAt,
 	FieldFailedLoginAttempts,
@@ -179,6 +335,12 @@ var (
 	FirstNameValidator func(string) error
 	// LastNameValidator is a validator for the "last_name" field. It is called by the builders before save.
 	LastNameValidator func(string) error
+	// DefaultDiscoverable holds the default value on creation for the "discoverable" field.
+	DefaultDiscoverable bool
+	// DefaultHideLikes holds the default value on creation for the "hide_likes" field.
+	DefaultHideLikes bool
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
 	// DefaultFailedLoginAttempts holds the default value on creation for the "failed_login_attempts" field.
 	DefaultFailedLoginAttempts int
 	// DefaultID holds the default value on creation for the "id" field.
@@ -187,6 +349,33 @@ var (
 	IDValidator func(string) error
 )
 
+// DigestFrequency defines the type for the "digest_frequency" enum field.
+type DigestFrequency string
+
+// DigestFrequencyWeekly is the default value of the DigestFrequency enum.
+const DefaultDigestFrequency = DigestFrequencyWeekly
+
+// DigestFrequency values.
+const (
+	DigestFrequencyOff    DigestFrequency = "off"
+	DigestFrequencyDaily  DigestFrequency = "daily"
+	DigestFrequencyWeekly DigestFrequency = "weekly"
+)
+
+func (df DigestFrequency) String() string {
+	return string(df)
+}
+
+// DigestFrequencyValidator is a validator for the "digest_frequency" field enum values. It is called by the builders before save.
+func DigestFrequencyValidator(df DigestFrequency) error {
+	switch df {
+	case DigestFrequencyOff, DigestFrequencyDaily, DigestFrequencyWeekly:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for digest_frequency field: %q", df)
+	}
+}
+
 // AccountStatus defines the type for the "account_status" enum field.
 type AccountStatus string
 
@@ -214,6 +403,32 @@ func AccountStatusValidator(as AccountStatus) error {
 	}
 }
 
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleUser is the default value of the Role enum.
+const DefaultRole = RoleUser
+
+// Role values.
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleUser, RoleAdmin:
+		return nil
+	default:
+		return fmt.Errorf("user: invalid enum value for role field: %q", r)
+	}
+}
+
 // OrderOption defines the ordering options for the User queries.
 type OrderOption func(*sql.Selector)
 
@@ -272,6 +487,36 @@ func ByAvatarURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvatarURL, opts...).ToFunc()
 }
 
+// ByDiscoverable orders the results by the discoverable field.
+func ByDiscoverable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscoverable, opts...).ToFunc()
+}
+
+// ByHideLikes orders the results by the hide_likes field.
+func ByHideLikes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHideLikes, opts...).ToFunc()
+}
+
+// ByDigestFrequency orders the results by the digest_frequency field.
+func ByDigestFrequency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDigestFrequency, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByLastDigestAt orders the results by the last_digest_at field.
+func ByLastDigestAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastDigestAt, opts...).ToFunc()
+}
+
+// ByUnsubscribeToken orders the results by the unsubscribe_token field.
+func ByUnsubscribeToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnsubscribeToken, opts...).ToFunc()
+}
+
 // ByLastLoginAt orders the results by the last_login_at field.
 func ByLastLoginAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastLoginAt, opts...).ToFunc()
@@ -282,6 +527,11 @@ func ByAccountStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccountStatus, opts...).ToFunc()
 }
 
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
 // ByVerificationToken orders the results by the verification_token field.
 func ByVerificationToken(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVerificationToken, opts...).ToFunc()
@@ -377,6 +627,216 @@ func ByCreatedTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByCommentsCount orders the results by comments count.
+func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentsStep(), opts...)
+	}
+}
+
+// ByComments orders the results by comments terms.
+func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatusChangesCount orders the results by status_changes count.
+func ByStatusChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatusChangesStep(), opts...)
+	}
+}
+
+// ByStatusChanges orders the results by status_changes terms.
+func ByStatusChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatusChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMembershipsCount orders the results by memberships count.
+func ByMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembershipsStep(), opts...)
+	}
+}
+
+// ByMemberships orders the results by memberships terms.
+func ByMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReceivedInvitationsCount orders the results by received_invitations count.
+func ByReceivedInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReceivedInvitationsStep(), opts...)
+	}
+}
+
+// ByReceivedInvitations orders the results by received_invitations terms.
+func ByReceivedInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceivedInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySentInvitationsCount orders the results by sent_invitations count.
+func BySentInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSentInvitationsStep(), opts...)
+	}
+}
+
+// BySentInvitations orders the results by sent_invitations terms.
+func BySentInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSentInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByJoinRequestsCount orders the results by join_requests count.
+func ByJoinRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newJoinRequestsStep(), opts...)
+	}
+}
+
+// ByJoinRequests orders the results by join_requests terms.
+func ByJoinRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJoinRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByProjectRevisionsCount orders the results by project_revisions count.
+func ByProjectRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProjectRevisionsStep(), opts...)
+	}
+}
+
+// ByProjectRevisions orders the results by project_revisions terms.
+func ByProjectRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUploadedMediaCount orders the results by uploaded_media count.
+func ByUploadedMediaCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUploadedMediaStep(), opts...)
+	}
+}
+
+// ByUploadedMedia orders the results by uploaded_media terms.
+func ByUploadedMedia(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUploadedMediaStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCollectionsCount orders the results by collections count.
+func ByCollectionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCollectionsStep(), opts...)
+	}
+}
+
+// ByCollections orders the results by collections terms.
+func ByCollections(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCollectionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEventMembershipsCount orders the results by event_memberships count.
+func ByEventMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEventMembershipsStep(), opts...)
+	}
+}
+
+// ByEventMemberships orders the results by event_memberships terms.
+func ByEventMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEventSubmissionsCount orders the results by event_submissions count.
+func ByEventSubmissionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEventSubmissionsStep(), opts...)
+	}
+}
+
+// ByEventSubmissions orders the results by event_submissions terms.
+func ByEventSubmissions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventSubmissionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEventScoresCount orders the results by event_scores count.
+func ByEventScoresCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEventScoresStep(), opts...)
+	}
+}
+
+// ByEventScores orders the results by event_scores terms.
+func ByEventScores(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventScoresStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationsCount orders the results by notifications count.
+func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsStep(), opts...)
+	}
+}
+
+// ByNotifications orders the results by notifications terms.
+func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationPreferencesCount orders the results by notification_preferences count.
+func ByNotificationPreferencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationPreferencesStep(), opts...)
+	}
+}
+
+// ByNotificationPreferences orders the results by notification_preferences terms.
+func ByNotificationPreferences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationPreferencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhooksCount orders the results by webhooks count.
+func ByWebhooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhooksStep(), opts...)
+	}
+}
+
+// ByWebhooks orders the results by webhooks terms.
+func ByWebhooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByLikesCount orders the results by likes count.
 func ByLikesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -439,6 +899,111 @@ func newCreatedTagsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CreatedTagsTable, CreatedTagsColumn),
 	)
 }
+func newCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+	)
+}
+func newStatusChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatusChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatusChangesTable, StatusChangesColumn),
+	)
+}
+func newMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MembershipsTable, MembershipsColumn),
+	)
+}
+func newReceivedInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceivedInvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReceivedInvitationsTable, ReceivedInvitationsColumn),
+	)
+}
+func newSentInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SentInvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SentInvitationsTable, SentInvitationsColumn),
+	)
+}
+func newJoinRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JoinRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, JoinRequestsTable, JoinRequestsColumn),
+	)
+}
+func newProjectRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProjectRevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ProjectRevisionsTable, ProjectRevisionsColumn),
+	)
+}
+func newUploadedMediaStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UploadedMediaInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UploadedMediaTable, UploadedMediaColumn),
+	)
+}
+func newCollectionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CollectionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CollectionsTable, CollectionsColumn),
+	)
+}
+func newEventMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventMembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EventMembershipsTable, EventMembershipsColumn),
+	)
+}
+func newEventSubmissionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventSubmissionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EventSubmissionsTable, EventSubmissionsColumn),
+	)
+}
+func newEventScoresStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventScoresInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EventScoresTable, EventScoresColumn),
+	)
+}
+func newNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+	)
+}
+func newNotificationPreferencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationPreferencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationPreferencesTable, NotificationPreferencesColumn),
+	)
+}
+func newWebhooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+	)
+}
 func newLikesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -115,6 +115,31 @@ func AvatarURL(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldAvatarURL, v))
 }
 
+// Discoverable applies equality check predicate on the "discoverable" field. It's identical to DiscoverableEQ.
+func Discoverable(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDiscoverable, v))
+}
+
+// HideLikes applies equality check predicate on the "hide_likes" field. It's identical to HideLikesEQ.
+func HideLikes(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHideLikes, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTimezone, v))
+}
+
+// LastDigestAt applies equality check predicate on the "last_digest_at" field. It's identical to LastDigestAtEQ.
+func LastDigestAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLastDigestAt, v))
+}
+
+// UnsubscribeToken applies equality check predicate on the "unsubscribe_token" field. It's identical to UnsubscribeTokenEQ.
+func UnsubscribeToken(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUnsubscribeToken, v))
+}
+
 // LastLoginAt applies equality check predicate on the "last_login_at" field. It's identical to LastLoginAtEQ.
 func LastLoginAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldLastLoginAt, v))
@@ -710,6 +735,236 @@ func AvatarURLContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldAvatarURL, v))
 }
 
+// DiscoverableEQ applies the EQ predicate on the "discoverable" field.
+func DiscoverableEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDiscoverable, v))
+}
+
+// DiscoverableNEQ applies the NEQ predicate on the "discoverable" field.
+func DiscoverableNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDiscoverable, v))
+}
+
+// HideLikesEQ applies the EQ predicate on the "hide_likes" field.
+func HideLikesEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHideLikes, v))
+}
+
+// HideLikesNEQ applies the NEQ predicate on the "hide_likes" field.
+func HideLikesNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldHideLikes, v))
+}
+
+// DigestFrequencyEQ applies the EQ predicate on the "digest_frequency" field.
+func DigestFrequencyEQ(v DigestFrequency) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDigestFrequency, v))
+}
+
+// DigestFrequencyNEQ applies the NEQ predicate on the "digest_frequency" field.
+func DigestFrequencyNEQ(v DigestFrequency) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDigestFrequency, v))
+}
+
+// DigestFrequencyIn applies the In predicate on the "digest_frequency" field.
+func DigestFrequencyIn(vs ...DigestFrequency) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDigestFrequency, vs...))
+}
+
+// DigestFrequencyNotIn applies the NotIn predicate on the "digest_frequency" field.
+func DigestFrequencyNotIn(vs ...DigestFrequency) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDigestFrequency, vs...))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// LastDigestAtEQ applies the EQ predicate on the "last_digest_at" field.
+func LastDigestAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLastDigestAt, v))
+}
+
+// LastDigestAtNEQ applies the NEQ predicate on the "last_digest_at" field.
+func LastDigestAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLastDigestAt, v))
+}
+
+// LastDigestAtIn applies the In predicate on the "last_digest_at" field.
+func LastDigestAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLastDigestAt, vs...))
+}
+
+// LastDigestAtNotIn applies the NotIn predicate on the "last_digest_at" field.
+func LastDigestAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLastDigestAt, vs...))
+}
+
+// LastDigestAtGT applies the GT predicate on the "last_digest_at" field.
+func LastDigestAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldLastDigestAt, v))
+}
+
+// LastDigestAtGTE applies the GTE predicate on the "last_digest_at" field.
+func LastDigestAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldLastDigestAt, v))
+}
+
+// LastDigestAtLT applies the LT predicate on the "last_digest_at" field.
+func LastDigestAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldLastDigestAt, v))
+}
+
+// LastDigestAtLTE applies the LTE predicate on the "last_digest_at" field.
+func LastDigestAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldLastDigestAt, v))
+}
+
+// LastDigestAtIsNil applies the IsNil predicate on the "last_digest_at" field.
+func LastDigestAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLastDigestAt))
+}
+
+// LastDigestAtNotNil applies the NotNil predicate on the "last_digest_at" field.
+func LastDigestAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLastDigestAt))
+}
+
+// UnsubscribeTokenEQ applies the EQ predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldUnsubscribeToken, v))
+}
+
+// UnsubscribeTokenNEQ applies the NEQ predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldUnsubscribeToken, v))
+}
+
+// UnsubscribeTokenIn applies the In predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldUnsubscribeToken, vs...))
+}
+
+// UnsubscribeTokenNotIn applies the NotIn predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldUnsubscribeToken, vs...))
+}
+
+// UnsubscribeTokenGT applies the GT predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldUnsubscribeToken, v))
+}
+
+// UnsubscribeTokenGTE applies the GTE predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldUnsubscribeToken, v))
+}
+
+// UnsubscribeTokenLT applies the LT predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldUnsubscribeToken, v))
+}
+
+// UnsubscribeTokenLTE applies the LTE predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldUnsubscribeToken, v))
+}
+
+// UnsubscribeTokenContains applies the Contains predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldUnsubscribeToken, v))
+}
+
+// UnsubscribeTokenHasPrefix applies the HasPrefix predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldUnsubscribeToken, v))
+}
+
+// UnsubscribeTokenHasSuffix applies the HasSuffix predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldUnsubscribeToken, v))
+}
+
+// UnsubscribeTokenIsNil applies the IsNil predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldUnsubscribeToken))
+}
+
+// UnsubscribeTokenNotNil applies the NotNil predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldUnsubscribeToken))
+}
+
+// UnsubscribeTokenEqualFold applies the EqualFold predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldUnsubscribeToken, v))
+}
+
+// UnsubscribeTokenContainsFold applies the ContainsFold predicate on the "unsubscribe_token" field.
+func UnsubscribeTokenContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldUnsubscribeToken, v))
+}
+
 // LastLoginAtEQ applies the EQ predicate on the "last_login_at" field.
 func LastLoginAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldLastLoginAt, v))
@@ -780,6 +1035,26 @@ func AccountStatusNotIn(vs ...AccountStatus) predicate.User {
 	return predicate.User(sql.FieldNotIn(FieldAccountStatus, vs...))
 }
 
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.User {
+	return predicate.User(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldRole, vs...))
+}
+
 // VerificationTokenEQ applies the EQ predicate on the "verification_token" field.
 func VerificationTokenEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldVerificationToken, v))
@@ -1185,6 +1460,351 @@ func HasCreatedTagsWith(preds ...predicate.Tag) predicate.User {
 	})
 }
 
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatusChanges applies the HasEdge predicate on the "status_changes" edge.
+func HasStatusChanges() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusChangesTable, StatusChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusChangesWith applies the HasEdge predicate on the "status_changes" edge with a given conditions (other predicates).
+func HasStatusChangesWith(preds ...predicate.ProjectStatusChange) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newStatusChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMemberships applies the HasEdge predicate on the "memberships" edge.
+func HasMemberships() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembershipsTable, MembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembershipsWith applies the HasEdge predicate on the "memberships" edge with a given conditions (other predicates).
+func HasMembershipsWith(preds ...predicate.ProjectMember) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReceivedInvitations applies the HasEdge predicate on the "received_invitations" edge.
+func HasReceivedInvitations() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReceivedInvitationsTable, ReceivedInvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceivedInvitationsWith applies the HasEdge predicate on the "received_invitations" edge with a given conditions (other predicates).
+func HasReceivedInvitationsWith(preds ...predicate.ProjectInvitation) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newReceivedInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSentInvitations applies the HasEdge predicate on the "sent_invitations" edge.
+func HasSentInvitations() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SentInvitationsTable, SentInvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSentInvitationsWith applies the HasEdge predicate on the "sent_invitations" edge with a given conditions (other predicates).
+func HasSentInvitationsWith(preds ...predicate.ProjectInvitation) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSentInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasJoinRequests applies the HasEdge predicate on the "join_requests" edge.
+func HasJoinRequests() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JoinRequestsTable, JoinRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJoinRequestsWith applies the HasEdge predicate on the "join_requests" edge with a given conditions (other predicates).
+func HasJoinRequestsWith(preds ...predicate.JoinRequest) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newJoinRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProjectRevisions applies the HasEdge predicate on the "project_revisions" edge.
+func HasProjectRevisions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ProjectRevisionsTable, ProjectRevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectRevisionsWith applies the HasEdge predicate on the "project_revisions" edge with a given conditions (other predicates).
+func HasProjectRevisionsWith(preds ...predicate.ProjectRevision) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newProjectRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUploadedMedia applies the HasEdge predicate on the "uploaded_media" edge.
+func HasUploadedMedia() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UploadedMediaTable, UploadedMediaColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUploadedMediaWith applies the HasEdge predicate on the "uploaded_media" edge with a given conditions (other predicates).
+func HasUploadedMediaWith(preds ...predicate.ProjectMedia) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newUploadedMediaStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCollections applies the HasEdge predicate on the "collections" edge.
+func HasCollections() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CollectionsTable, CollectionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollectionsWith applies the HasEdge predicate on the "collections" edge with a given conditions (other predicates).
+func HasCollectionsWith(preds ...predicate.Collection) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCollectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEventMemberships applies the HasEdge predicate on the "event_memberships" edge.
+func HasEventMemberships() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventMembershipsTable, EventMembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventMembershipsWith applies the HasEdge predicate on the "event_memberships" edge with a given conditions (other predicates).
+func HasEventMembershipsWith(preds ...predicate.EventMember) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newEventMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEventSubmissions applies the HasEdge predicate on the "event_submissions" edge.
+func HasEventSubmissions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventSubmissionsTable, EventSubmissionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventSubmissionsWith applies the HasEdge predicate on the "event_submissions" edge with a given conditions (other predicates).
+func HasEventSubmissionsWith(preds ...predicate.EventSubmission) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newEventSubmissionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEventScores applies the HasEdge predicate on the "event_scores" edge.
+func HasEventScores() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventScoresTable, EventScoresColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventScoresWith applies the HasEdge predicate on the "event_scores" edge with a given conditions (other predicates).
+func HasEventScoresWith(preds ...predicate.EventScore) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newEventScoresStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotifications applies the HasEdge predicate on the "notifications" edge.
+func HasNotifications() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationsWith applies the HasEdge predicate on the "notifications" edge with a given conditions (other predicates).
+func HasNotificationsWith(preds ...predicate.Notification) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotificationPreferences applies the HasEdge predicate on the "notification_preferences" edge.
+func HasNotificationPreferences() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationPreferencesTable, NotificationPreferencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationPreferencesWith applies the HasEdge predicate on the "notification_preferences" edge with a given conditions (other predicates).
+func HasNotificationPreferencesWith(preds ...predicate.NotificationPreference) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotificationPreferencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newWebhooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasLikes applies the HasEdge predicate on the "likes" edge.
 func HasLikes() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -10,12 +10,26 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jorge-j1m/hackspark_server/ent/collection"
+	"github.com/jorge-j1m/hackspark_server/ent/comment"
+	"github.com/jorge-j1m/hackspark_server/ent/eventmember"
+	"github.com/jorge-j1m/hackspark_server/ent/eventscore"
+	"github.com/jorge-j1m/hackspark_server/ent/eventsubmission"
+	"github.com/jorge-j1m/hackspark_server/ent/joinrequest"
 	"github.com/jorge-j1m/hackspark_server/ent/like"
+	"github.com/jorge-j1m/hackspark_server/ent/notification"
+	"github.com/jorge-j1m/hackspark_server/ent/notificationpreference"
 	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectinvitation"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmedia"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrevision"
+	"github.com/jorge-j1m/hackspark_server/ent/projectstatuschange"
 	"github.com/jorge-j1m/hackspark_server/ent/session"
 	"github.com/jorge-j1m/hackspark_server/ent/tag"
 	"github.com/jorge-j1m/hackspark_server/ent/user"
 	"github.com/jorge-j1m/hackspark_server/ent/usertechnology"
+	"github.com/jorge-j1m/hackspark_server/ent/webhook"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -125,6 +139,90 @@ func (_c *UserCreate) SetNillableAvatarURL(v *string) *UserCreate {
 	return _c
 }
 
+// SetDiscoverable sets the "discoverable" field.
+func (_c *UserCreate) SetDiscoverable(v bool) *UserCreate {
+	_c.mutation.SetDiscoverable(v)
+	return _c
+}
+
+// SetNillableDiscoverable sets the "discoverable" field if the given value is not nil.
+func (_c *UserCreate) SetNillableDiscoverable(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetDiscoverable(*v)
+	}
+	return _c
+}
+
+// SetHideLikes sets the "hide_likes" field.
+func (_c *UserCreate) SetHideLikes(v bool) *UserCreate {
+	_c.mutation.SetHideLikes(v)
+	return _c
+}
+
+// SetNillableHideLikes sets the "hide_likes" field if the given value is not nil.
+func (_c *UserCreate) SetNillableHideLikes(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetHideLikes(*v)
+	}
+	return _c
+}
+
+// SetDigestFrequency sets the "digest_frequency" field.
+func (_c *UserCreate) SetDigestFrequency(v user.DigestFrequency) *UserCreate {
+	_c.mutation.SetDigestFrequency(v)
+	return _c
+}
+
+// SetNillableDigestFrequency sets the "digest_frequency" field if the given value is not nil.
+func (_c *UserCreate) SetNillableDigestFrequency(v *user.DigestFrequency) *UserCreate {
+	if v != nil {
+		_c.SetDigestFrequency(*v)
+	}
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *UserCreate) SetTimezone(v string) *UserCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTimezone(v *string) *UserCreate {
+	if v != nil {
+		_c.SetTimezone(*v)
+	}
+	return _c
+}
+
+// SetLastDigestAt sets the "last_digest_at" field.
+func (_c *UserCreate) SetLastDigestAt(v time.Time) *UserCreate {
+	_c.mutation.SetLastDigestAt(v)
+	return _c
+}
+
+// SetNillableLastDigestAt sets the "last_digest_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableLastDigestAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetLastDigestAt(*v)
+	}
+	return _c
+}
+
+// SetUnsubscribeToken sets the "unsubscribe_token" field.
+func (_c *UserCreate) SetUnsubscribeToken(v string) *UserCreate {
+	_c.mutation.SetUnsubscribeToken(v)
+	return _c
+}
+
+// SetNillableUnsubscribeToken sets the "unsubscribe_token" field if the given value is not nil.
+func (_c *UserCreate) SetNillableUnsubscribeToken(v *string) *UserCreate {
+	if v != nil {
+		_c.SetUnsubscribeToken(*v)
+	}
+	return _c
+}
+
 // SetLastLoginAt sets the "last_login_at" field.
 func (_c *UserCreate) SetLastLoginAt(v time.Time) *UserCreate {
 	_c.mutation.SetLastLoginAt(v)
@@ -153,6 +251,20 @@ func (_c *UserCreate) SetNillableAccountStatus(v *user.AccountStatus) *UserCreat
 	return _c
 }
 
+// SetRole sets the "role" field.
+func (_c *UserCreate) SetRole(v user.Role) *UserCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *UserCreate) SetNillableRole(v *user.Role) *UserCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
 // SetVerificationToken sets the "verification_token" field.
 func (_c *UserCreate) SetVerificationToken(v string) *UserCreate {
 	_c.mutation.SetVerificationToken(v)
@@ -312,6 +424,231 @@ func (_c *UserCreate) AddCreatedTags(v ...*Tag) *UserCreate {
 	return _c.AddCreatedTagIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_c *UserCreate) AddCommentIDs(ids ...string) *UserCreate {
+	_c.mutation.AddCommentIDs(ids...)
+	return _c
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_c *UserCreate) AddComments(v ...*Comment) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCommentIDs(ids...)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the ProjectStatusChange entity by IDs.
+func (_c *UserCreate) AddStatusChangeIDs(ids ...string) *UserCreate {
+	_c.mutation.AddStatusChangeIDs(ids...)
+	return _c
+}
+
+// AddStatusChanges adds the "status_changes" edges to the ProjectStatusChange entity.
+func (_c *UserCreate) AddStatusChanges(v ...*ProjectStatusChange) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStatusChangeIDs(ids...)
+}
+
+// AddMembershipIDs adds the "memberships" edge to the ProjectMember entity by IDs.
+func (_c *UserCreate) AddMembershipIDs(ids ...string) *UserCreate {
+	_c.mutation.AddMembershipIDs(ids...)
+	return _c
+}
+
+// AddMemberships adds the "memberships" edges to the ProjectMember entity.
+func (_c *UserCreate) AddMemberships(v ...*ProjectMember) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMembershipIDs(ids...)
+}
+
+// AddReceivedInvitationIDs adds the "received_invitations" edge to the ProjectInvitation entity by IDs.
+func (_c *UserCreate) AddReceivedInvitationIDs(ids ...string) *UserCreate {
+	_c.mutation.AddReceivedInvitationIDs(ids...)
+	return _c
+}
+
+// AddReceivedInvitations adds the "received_invitations" edges to the ProjectInvitation entity.
+func (_c *UserCreate) AddReceivedInvitations(v ...*ProjectInvitation) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReceivedInvitationIDs(ids...)
+}
+
+// AddSentInvitationIDs adds the "sent_invitations" edge to the ProjectInvitation entity by IDs.
+func (_c *UserCreate) AddSentInvitationIDs(ids ...string) *UserCreate {
+	_c.mutation.AddSentInvitationIDs(ids...)
+	return _c
+}
+
+// AddSentInvitations adds the "sent_invitations" edges to the ProjectInvitation entity.
+func (_c *UserCreate) AddSentInvitations(v ...*ProjectInvitation) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSentInvitationIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by IDs.
+func (_c *UserCreate) AddJoinRequestIDs(ids ...string) *UserCreate {
+	_c.mutation.AddJoinRequestIDs(ids...)
+	return _c
+}
+
+// AddJoinRequests adds the "join_requests" edges to the JoinRequest entity.
+func (_c *UserCreate) AddJoinRequests(v ...*JoinRequest) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddJoinRequestIDs(ids...)
+}
+
+// AddProjectRevisionIDs adds the "project_revisions" edge to the ProjectRevision entity by IDs.
+func (_c *UserCreate) AddProjectRevisionIDs(ids ...string) *UserCreate {
+	_c.mutation.AddProjectRevisionIDs(ids...)
+	return _c
+}
+
+// AddProjectRevisions adds the "project_revisions" edges to the ProjectRevision entity.
+func (_c *UserCreate) AddProjectRevisions(v ...*ProjectRevision) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddProjectRevisionIDs(ids...)
+}
+
+// AddUploadedMediumIDs adds the "uploaded_media" edge to the ProjectMedia entity by IDs.
+func (_c *UserCreate) AddUploadedMediumIDs(ids ...string) *UserCreate {
+	_c.mutation.AddUploadedMediumIDs(ids...)
+	return _c
+}
+
+// AddUploadedMedia adds the "uploaded_media" edges to the ProjectMedia entity.
+func (_c *UserCreate) AddUploadedMedia(v ...*ProjectMedia) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUploadedMediumIDs(ids...)
+}
+
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (_c *UserCreate) AddCollectionIDs(ids ...string) *UserCreate {
+	_c.mutation.AddCollectionIDs(ids...)
+	return _c
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (_c *UserCreate) AddCollections(v ...*Collection) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCollectionIDs(ids...)
+}
+
+// AddEventMembershipIDs adds the "event_memberships" edge to the EventMember entity by IDs.
+func (_c *UserCreate) AddEventMembershipIDs(ids ...string) *UserCreate {
+	_c.mutation.AddEventMembershipIDs(ids...)
+	return _c
+}
+
+// AddEventMemberships adds the "event_memberships" edges to the EventMember entity.
+func (_c *UserCreate) AddEventMemberships(v ...*EventMember) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEventMembershipIDs(ids...)
+}
+
+// AddEventSubmissionIDs adds the "event_submissions" edge to the EventSubmission entity by IDs.
+func (_c *UserCreate) AddEventSubmissionIDs(ids ...string) *UserCreate {
+	_c.mutation.AddEventSubmissionIDs(ids...)
+	return _c
+}
+
+// AddEventSubmissions adds the "event_submissions" edges to the EventSubmission entity.
+func (_c *UserCreate) AddEventSubmissions(v ...*EventSubmission) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEventSubmissionIDs(ids...)
+}
+
+// AddEventScoreIDs adds the "event_scores" edge to the EventScore entity by IDs.
+func (_c *UserCreate) AddEventScoreIDs(ids ...string) *UserCreate {
+	_c.mutation.AddEventScoreIDs(ids...)
+	return _c
+}
+
+// AddEventScores adds the "event_scores" edges to the EventScore entity.
+func (_c *UserCreate) AddEventScores(v ...*EventScore) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEventScoreIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_c *UserCreate) AddNotificationIDs(ids ...string) *UserCreate {
+	_c.mutation.AddNotificationIDs(ids...)
+	return _c
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_c *UserCreate) AddNotifications(v ...*Notification) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationIDs(ids...)
+}
+
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the NotificationPreference entity by IDs.
+func (_c *UserCreate) AddNotificationPreferenceIDs(ids ...string) *UserCreate {
+	_c.mutation.AddNotificationPreferenceIDs(ids...)
+	return _c
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the NotificationPreference entity.
+func (_c *UserCreate) AddNotificationPreferences(v ...*NotificationPreference) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNotificationPreferenceIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_c *UserCreate) AddWebhookIDs(ids ...string) *UserCreate {
+	_c.mutation.AddWebhookIDs(ids...)
+	return _c
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_c *UserCreate) AddWebhooks(v ...*Webhook) *UserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookIDs(ids...)
+}
+
 // AddLikeIDs adds the "likes" edge to the Like entity by IDs.
 func (_c *UserCreate) AddLikeIDs(ids ...string) *UserCreate {
 	_c.mutation.AddLikeIDs(ids...)
@@ -397,10 +734,30 @@ func (_c *UserCreate) defaults() error {
 		v := user.DefaultEmailVerified
 		_c.mutation.SetEmailVerified(v)
 	}
+	if _, ok := _c.mutation.Discoverable(); !ok {
+		v := user.DefaultDiscoverable
+		_c.mutation.SetDiscoverable(v)
+	}
+	if _, ok := _c.mutation.HideLikes(); !ok {
+		v := user.DefaultHideLikes
+		_c.mutation.SetHideLikes(v)
+	}
+	if _, ok := _c.mutation.DigestFrequency(); !ok {
+		v := user.DefaultDigestFrequency
+		_c.mutation.SetDigestFrequency(v)
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		v := user.DefaultTimezone
+		_c.mutation.SetTimezone(v)
+	}
 	if _, ok := _c.mutation.AccountStatus(); !ok {
 		v := user.DefaultAccountStatus
 		_c.mutation.SetAccountStatus(v)
 	}
+	if _, ok := _c.mutation.Role(); !ok {
+		v := user.DefaultRole
+		_c.mutation.SetRole(v)
+	}
 	if _, ok := _c.mutation.FailedLoginAttempts(); !ok {
 		v := user.DefaultFailedLoginAttempts
 		_c.mutation.SetFailedLoginAttempts(v)
@@ -466,6 +823,23 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "last_name", err: fmt.Errorf(`ent: validator failed for field "User.last_name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Discoverable(); !ok {
+		return &ValidationError{Name: "discoverable", err: errors.New(`ent: missing required field "User.discoverable"`)}
+	}
+	if _, ok := _c.mutation.HideLikes(); !ok {
+		return &ValidationError{Name: "hide_likes", err: errors.New(`ent: missing required field "User.hide_likes"`)}
+	}
+	if _, ok := _c.mutation.DigestFrequency(); !ok {
+		return &ValidationError{Name: "digest_frequency", err: errors.New(`ent: missing required field "User.digest_frequency"`)}
+	}
+	if v, ok := _c.mutation.DigestFrequency(); ok {
+		if err := user.DigestFrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "digest_frequency", err: fmt.Errorf(`ent: validator failed for field "User.digest_frequency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "User.timezone"`)}
+	}
 	if _, ok := _c.mutation.AccountStatus(); !ok {
 		return &ValidationError{Name: "account_status", err: errors.New(`ent: missing required field "User.account_status"`)}
 	}
@@ -474,6 +848,14 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "account_status", err: fmt.Errorf(`ent: validator failed for field "User.account_status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.FailedLoginAttempts(); !ok {
 		return &ValidationError{Name: "failed_login_attempts", err: errors.New(`ent: missing required field "User.failed_login_attempts"`)}
 	}
@@ -557,6 +939,30 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
 		_node.AvatarURL = &value
 	}
+	if value, ok := _c.mutation.Discoverable(); ok {
+		_spec.SetField(user.FieldDiscoverable, field.TypeBool, value)
+		_node.Discoverable = value
+	}
+	if value, ok := _c.mutation.HideLikes(); ok {
+		_spec.SetField(user.FieldHideLikes, field.TypeBool, value)
+		_node.HideLikes = value
+	}
+	if value, ok := _c.mutation.DigestFrequency(); ok {
+		_spec.SetField(user.FieldDigestFrequency, field.TypeEnum, value)
+		_node.DigestFrequency = value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := _c.mutation.LastDigestAt(); ok {
+		_spec.SetField(user.FieldLastDigestAt, field.TypeTime, value)
+		_node.LastDigestAt = &value
+	}
+	if value, ok := _c.mutation.UnsubscribeToken(); ok {
+		_spec.SetField(user.FieldUnsubscribeToken, field.TypeString, value)
+		_node.UnsubscribeToken = &value
+	}
 	if value, ok := _c.mutation.LastLoginAt(); ok {
 		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
 		_node.LastLoginAt = &value
@@ -565,6 +971,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldAccountStatus, field.TypeEnum, value)
 		_node.AccountStatus = value
 	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
 	if value, ok := _c.mutation.VerificationToken(); ok {
 		_spec.SetField(user.FieldVerificationToken, field.TypeString, value)
 		_node.VerificationToken = &value
@@ -679,6 +1089,246 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StatusChangesTable,
+			Columns: []string{user.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReceivedInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedInvitationsTable,
+			Columns: []string{user.ReceivedInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SentInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProjectRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ProjectRevisionsTable,
+			Columns: []string{user.ProjectRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UploadedMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CollectionsTable,
+			Columns: []string{user.CollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EventMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventMembershipsTable,
+			Columns: []string{user.EventMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EventSubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventSubmissionsTable,
+			Columns: []string{user.EventSubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EventScoresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventScoresTable,
+			Columns: []string{user.EventScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventscore.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.LikesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
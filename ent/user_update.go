@@ -11,13 +11,27 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jorge-j1m/hackspark_server/ent/collection"
+	"github.com/jorge-j1m/hackspark_server/ent/comment"
+	"github.com/jorge-j1m/hackspark_server/ent/eventmember"
+	"github.com/jorge-j1m/hackspark_server/ent/eventscore"
+	"github.com/jorge-j1m/hackspark_server/ent/eventsubmission"
+	"github.com/jorge-j1m/hackspark_server/ent/joinrequest"
 	"github.com/jorge-j1m/hackspark_server/ent/like"
+	"github.com/jorge-j1m/hackspark_server/ent/notification"
+	"github.com/jorge-j1m/hackspark_server/ent/notificationpreference"
 	"github.com/jorge-j1m/hackspark_server/ent/predicate"
 	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectinvitation"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmedia"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrevision"
+	"github.com/jorge-j1m/hackspark_server/ent/projectstatuschange"
 	"github.com/jorge-j1m/hackspark_server/ent/session"
 	"github.com/jorge-j1m/hackspark_server/ent/tag"
 	"github.com/jorge-j1m/hackspark_server/ent/user"
 	"github.com/jorge-j1m/hackspark_server/ent/usertechnology"
+	"github.com/jorge-j1m/hackspark_server/ent/webhook"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -163,6 +177,102 @@ func (_u *UserUpdate) ClearAvatarURL() *UserUpdate {
 	return _u
 }
 
+// SetDiscoverable sets the "discoverable" field.
+func (_u *UserUpdate) SetDiscoverable(v bool) *UserUpdate {
+	_u.mutation.SetDiscoverable(v)
+	return _u
+}
+
+// SetNillableDiscoverable sets the "discoverable" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableDiscoverable(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetDiscoverable(*v)
+	}
+	return _u
+}
+
+// SetHideLikes sets the "hide_likes" field.
+func (_u *UserUpdate) SetHideLikes(v bool) *UserUpdate {
+	_u.mutation.SetHideLikes(v)
+	return _u
+}
+
+// SetNillableHideLikes sets the "hide_likes" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableHideLikes(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetHideLikes(*v)
+	}
+	return _u
+}
+
+// SetDigestFrequency sets the "digest_frequency" field.
+func (_u *UserUpdate) SetDigestFrequency(v user.DigestFrequency) *UserUpdate {
+	_u.mutation.SetDigestFrequency(v)
+	return _u
+}
+
+// SetNillableDigestFrequency sets the "digest_frequency" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableDigestFrequency(v *user.DigestFrequency) *UserUpdate {
+	if v != nil {
+		_u.SetDigestFrequency(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *UserUpdate) SetTimezone(v string) *UserUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableTimezone(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetLastDigestAt sets the "last_digest_at" field.
+func (_u *UserUpdate) SetLastDigestAt(v time.Time) *UserUpdate {
+	_u.mutation.SetLastDigestAt(v)
+	return _u
+}
+
+// SetNillableLastDigestAt sets the "last_digest_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableLastDigestAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetLastDigestAt(*v)
+	}
+	return _u
+}
+
+// ClearLastDigestAt clears the value of the "last_digest_at" field.
+func (_u *UserUpdate) ClearLastDigestAt() *UserUpdate {
+	_u.mutation.ClearLastDigestAt()
+	return _u
+}
+
+// SetUnsubscribeToken sets the "unsubscribe_token" field.
+func (_u *UserUpdate) SetUnsubscribeToken(v string) *UserUpdate {
+	_u.mutation.SetUnsubscribeToken(v)
+	return _u
+}
+
+// SetNillableUnsubscribeToken sets the "unsubscribe_token" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableUnsubscribeToken(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetUnsubscribeToken(*v)
+	}
+	return _u
+}
+
+// ClearUnsubscribeToken clears the value of the "unsubscribe_token" field.
+func (_u *UserUpdate) ClearUnsubscribeToken() *UserUpdate {
+	_u.mutation.ClearUnsubscribeToken()
+	return _u
+}
+
 // SetLastLoginAt sets the "last_login_at" field.
 func (_u *UserUpdate) SetLastLoginAt(v time.Time) *UserUpdate {
 	_u.mutation.SetLastLoginAt(v)
@@ -197,6 +307,20 @@ func (_u *UserUpdate) SetNillableAccountStatus(v *user.AccountStatus) *UserUpdat
 	return _u
 }
 
+// SetRole sets the "role" field.
+func (_u *UserUpdate) SetRole(v user.Role) *UserUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableRole(v *user.Role) *UserUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
 // SetVerificationToken sets the "verification_token" field.
 func (_u *UserUpdate) SetVerificationToken(v string) *UserUpdate {
 	_u.mutation.SetVerificationToken(v)
@@ -373,6 +497,231 @@ func (_u *UserUpdate) AddCreatedTags(v ...*Tag) *UserUpdate {
 	return _u.AddCreatedTagIDs(ids...)
 }
 
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *UserUpdate) AddCommentIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *UserUpdate) AddComments(v ...*Comment) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the ProjectStatusChange entity by IDs.
+func (_u *UserUpdate) AddStatusChangeIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddStatusChangeIDs(ids...)
+	return _u
+}
+
+// AddStatusChanges adds the "status_changes" edges to the ProjectStatusChange entity.
+func (_u *UserUpdate) AddStatusChanges(v ...*ProjectStatusChange) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusChangeIDs(ids...)
+}
+
+// AddMembershipIDs adds the "memberships" edge to the ProjectMember entity by IDs.
+func (_u *UserUpdate) AddMembershipIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddMembershipIDs(ids...)
+	return _u
+}
+
+// AddMemberships adds the "memberships" edges to the ProjectMember entity.
+func (_u *UserUpdate) AddMemberships(v ...*ProjectMember) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMembershipIDs(ids...)
+}
+
+// AddReceivedInvitationIDs adds the "received_invitations" edge to the ProjectInvitation entity by IDs.
+func (_u *UserUpdate) AddReceivedInvitationIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddReceivedInvitationIDs(ids...)
+	return _u
+}
+
+// AddReceivedInvitations adds the "received_invitations" edges to the ProjectInvitation entity.
+func (_u *UserUpdate) AddReceivedInvitations(v ...*ProjectInvitation) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceivedInvitationIDs(ids...)
+}
+
+// AddSentInvitationIDs adds the "sent_invitations" edge to the ProjectInvitation entity by IDs.
+func (_u *UserUpdate) AddSentInvitationIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddSentInvitationIDs(ids...)
+	return _u
+}
+
+// AddSentInvitations adds the "sent_invitations" edges to the ProjectInvitation entity.
+func (_u *UserUpdate) AddSentInvitations(v ...*ProjectInvitation) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSentInvitationIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by IDs.
+func (_u *UserUpdate) AddJoinRequestIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddJoinRequestIDs(ids...)
+	return _u
+}
+
+// AddJoinRequests adds the "join_requests" edges to the JoinRequest entity.
+func (_u *UserUpdate) AddJoinRequests(v ...*JoinRequest) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJoinRequestIDs(ids...)
+}
+
+// AddProjectRevisionIDs adds the "project_revisions" edge to the ProjectRevision entity by IDs.
+func (_u *UserUpdate) AddProjectRevisionIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddProjectRevisionIDs(ids...)
+	return _u
+}
+
+// AddProjectRevisions adds the "project_revisions" edges to the ProjectRevision entity.
+func (_u *UserUpdate) AddProjectRevisions(v ...*ProjectRevision) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddProjectRevisionIDs(ids...)
+}
+
+// AddUploadedMediumIDs adds the "uploaded_media" edge to the ProjectMedia entity by IDs.
+func (_u *UserUpdate) AddUploadedMediumIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddUploadedMediumIDs(ids...)
+	return _u
+}
+
+// AddUploadedMedia adds the "uploaded_media" edges to the ProjectMedia entity.
+func (_u *UserUpdate) AddUploadedMedia(v ...*ProjectMedia) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUploadedMediumIDs(ids...)
+}
+
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (_u *UserUpdate) AddCollectionIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddCollectionIDs(ids...)
+	return _u
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (_u *UserUpdate) AddCollections(v ...*Collection) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCollectionIDs(ids...)
+}
+
+// AddEventMembershipIDs adds the "event_memberships" edge to the EventMember entity by IDs.
+func (_u *UserUpdate) AddEventMembershipIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddEventMembershipIDs(ids...)
+	return _u
+}
+
+// AddEventMemberships adds the "event_memberships" edges to the EventMember entity.
+func (_u *UserUpdate) AddEventMemberships(v ...*EventMember) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventMembershipIDs(ids...)
+}
+
+// AddEventSubmissionIDs adds the "event_submissions" edge to the EventSubmission entity by IDs.
+func (_u *UserUpdate) AddEventSubmissionIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddEventSubmissionIDs(ids...)
+	return _u
+}
+
+// AddEventSubmissions adds the "event_submissions" edges to the EventSubmission entity.
+func (_u *UserUpdate) AddEventSubmissions(v ...*EventSubmission) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventSubmissionIDs(ids...)
+}
+
+// AddEventScoreIDs adds the "event_scores" edge to the EventScore entity by IDs.
+func (_u *UserUpdate) AddEventScoreIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddEventScoreIDs(ids...)
+	return _u
+}
+
+// AddEventScores adds the "event_scores" edges to the EventScore entity.
+func (_u *UserUpdate) AddEventScores(v ...*EventScore) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventScoreIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *UserUpdate) AddNotificationIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *UserUpdate) AddNotifications(v ...*Notification) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the NotificationPreference entity by IDs.
+func (_u *UserUpdate) AddNotificationPreferenceIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the NotificationPreference entity.
+func (_u *UserUpdate) AddNotificationPreferences(v ...*NotificationPreference) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationPreferenceIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *UserUpdate) AddWebhookIDs(ids ...string) *UserUpdate {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *UserUpdate) AddWebhooks(v ...*Webhook) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
 // AddLikeIDs adds the "likes" edge to the Like entity by IDs.
 func (_u *UserUpdate) AddLikeIDs(ids ...string) *UserUpdate {
 	_u.mutation.AddLikeIDs(ids...)
@@ -513,91 +862,406 @@ func (_u *UserUpdate) RemoveCreatedTags(v ...*Tag) *UserUpdate {
 	return _u.RemoveCreatedTagIDs(ids...)
 }
 
-// ClearLikes clears all "likes" edges to the Like entity.
-func (_u *UserUpdate) ClearLikes() *UserUpdate {
-	_u.mutation.ClearLikes()
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *UserUpdate) ClearComments() *UserUpdate {
+	_u.mutation.ClearComments()
 	return _u
 }
 
-// RemoveLikeIDs removes the "likes" edge to Like entities by IDs.
-func (_u *UserUpdate) RemoveLikeIDs(ids ...string) *UserUpdate {
-	_u.mutation.RemoveLikeIDs(ids...)
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *UserUpdate) RemoveCommentIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveCommentIDs(ids...)
 	return _u
 }
 
-// RemoveLikes removes "likes" edges to Like entities.
-func (_u *UserUpdate) RemoveLikes(v ...*Like) *UserUpdate {
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *UserUpdate) RemoveComments(v ...*Comment) *UserUpdate {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLikeIDs(ids...)
+	return _u.RemoveCommentIDs(ids...)
 }
 
-// ClearUserTechnologies clears all "user_technologies" edges to the UserTechnology entity.
-func (_u *UserUpdate) ClearUserTechnologies() *UserUpdate {
-	_u.mutation.ClearUserTechnologies()
+// ClearStatusChanges clears all "status_changes" edges to the ProjectStatusChange entity.
+func (_u *UserUpdate) ClearStatusChanges() *UserUpdate {
+	_u.mutation.ClearStatusChanges()
 	return _u
 }
 
-// RemoveUserTechnologyIDs removes the "user_technologies" edge to UserTechnology entities by IDs.
-func (_u *UserUpdate) RemoveUserTechnologyIDs(ids ...string) *UserUpdate {
-	_u.mutation.RemoveUserTechnologyIDs(ids...)
+// RemoveStatusChangeIDs removes the "status_changes" edge to ProjectStatusChange entities by IDs.
+func (_u *UserUpdate) RemoveStatusChangeIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveStatusChangeIDs(ids...)
 	return _u
 }
 
-// RemoveUserTechnologies removes "user_technologies" edges to UserTechnology entities.
-func (_u *UserUpdate) RemoveUserTechnologies(v ...*UserTechnology) *UserUpdate {
+// RemoveStatusChanges removes "status_changes" edges to ProjectStatusChange entities.
+func (_u *UserUpdate) RemoveStatusChanges(v ...*ProjectStatusChange) *UserUpdate {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveUserTechnologyIDs(ids...)
+	return _u.RemoveStatusChangeIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
-	if err := _u.defaults(); err != nil {
-		return 0, err
-	}
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearMemberships clears all "memberships" edges to the ProjectMember entity.
+func (_u *UserUpdate) ClearMemberships() *UserUpdate {
+	_u.mutation.ClearMemberships()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// RemoveMembershipIDs removes the "memberships" edge to ProjectMember entities by IDs.
+func (_u *UserUpdate) RemoveMembershipIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveMembershipIDs(ids...)
+	return _u
 }
 
-// Exec executes the query.
-func (_u *UserUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// RemoveMemberships removes "memberships" edges to ProjectMember entities.
+func (_u *UserUpdate) RemoveMemberships(v ...*ProjectMember) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMembershipIDs(ids...)
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *UserUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// ClearReceivedInvitations clears all "received_invitations" edges to the ProjectInvitation entity.
+func (_u *UserUpdate) ClearReceivedInvitations() *UserUpdate {
+	_u.mutation.ClearReceivedInvitations()
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *UserUpdate) defaults() error {
-	if _, ok := _u.mutation.UpdateTime(); !ok {
-		if user.UpdateDefaultUpdateTime == nil {
-			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
-		}
-		v := user.UpdateDefaultUpdateTime()
-		_u.mutation.SetUpdateTime(v)
-	}
-	return nil
+// RemoveReceivedInvitationIDs removes the "received_invitations" edge to ProjectInvitation entities by IDs.
+func (_u *UserUpdate) RemoveReceivedInvitationIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveReceivedInvitationIDs(ids...)
+	return _u
 }
 
-// check runs all checks and user-defined validators on the builder.
+// RemoveReceivedInvitations removes "received_invitations" edges to ProjectInvitation entities.
+func (_u *UserUpdate) RemoveReceivedInvitations(v ...*ProjectInvitation) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceivedInvitationIDs(ids...)
+}
+
+// ClearSentInvitations clears all "sent_invitations" edges to the ProjectInvitation entity.
+func (_u *UserUpdate) ClearSentInvitations() *UserUpdate {
+	_u.mutation.ClearSentInvitations()
+	return _u
+}
+
+// RemoveSentInvitationIDs removes the "sent_invitations" edge to ProjectInvitation entities by IDs.
+func (_u *UserUpdate) RemoveSentInvitationIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveSentInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveSentInvitations removes "sent_invitations" edges to ProjectInvitation entities.
+func (_u *UserUpdate) RemoveSentInvitations(v ...*ProjectInvitation) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSentInvitationIDs(ids...)
+}
+
+// ClearJoinRequests clears all "join_requests" edges to the JoinRequest entity.
+func (_u *UserUpdate) ClearJoinRequests() *UserUpdate {
+	_u.mutation.ClearJoinRequests()
+	return _u
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to JoinRequest entities by IDs.
+func (_u *UserUpdate) RemoveJoinRequestIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveJoinRequestIDs(ids...)
+	return _u
+}
+
+// RemoveJoinRequests removes "join_requests" edges to JoinRequest entities.
+func (_u *UserUpdate) RemoveJoinRequests(v ...*JoinRequest) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJoinRequestIDs(ids...)
+}
+
+// ClearProjectRevisions clears all "project_revisions" edges to the ProjectRevision entity.
+func (_u *UserUpdate) ClearProjectRevisions() *UserUpdate {
+	_u.mutation.ClearProjectRevisions()
+	return _u
+}
+
+// RemoveProjectRevisionIDs removes the "project_revisions" edge to ProjectRevision entities by IDs.
+func (_u *UserUpdate) RemoveProjectRevisionIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveProjectRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveProjectRevisions removes "project_revisions" edges to ProjectRevision entities.
+func (_u *UserUpdate) RemoveProjectRevisions(v ...*ProjectRevision) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveProjectRevisionIDs(ids...)
+}
+
+// ClearUploadedMedia clears all "uploaded_media" edges to the ProjectMedia entity.
+func (_u *UserUpdate) ClearUploadedMedia() *UserUpdate {
+	_u.mutation.ClearUploadedMedia()
+	return _u
+}
+
+// RemoveUploadedMediumIDs removes the "uploaded_media" edge to ProjectMedia entities by IDs.
+func (_u *UserUpdate) RemoveUploadedMediumIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveUploadedMediumIDs(ids...)
+	return _u
+}
+
+// RemoveUploadedMedia removes "uploaded_media" edges to ProjectMedia entities.
+func (_u *UserUpdate) RemoveUploadedMedia(v ...*ProjectMedia) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUploadedMediumIDs(ids...)
+}
+
+// ClearCollections clears all "collections" edges to the Collection entity.
+func (_u *UserUpdate) ClearCollections() *UserUpdate {
+	_u.mutation.ClearCollections()
+	return _u
+}
+
+// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
+func (_u *UserUpdate) RemoveCollectionIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveCollectionIDs(ids...)
+	return _u
+}
+
+// RemoveCollections removes "collections" edges to Collection entities.
+func (_u *UserUpdate) RemoveCollections(v ...*Collection) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCollectionIDs(ids...)
+}
+
+// ClearEventMemberships clears all "event_memberships" edges to the EventMember entity.
+func (_u *UserUpdate) ClearEventMemberships() *UserUpdate {
+	_u.mutation.ClearEventMemberships()
+	return _u
+}
+
+// RemoveEventMembershipIDs removes the "event_memberships" edge to EventMember entities by IDs.
+func (_u *UserUpdate) RemoveEventMembershipIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveEventMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveEventMemberships removes "event_memberships" edges to EventMember entities.
+func (_u *UserUpdate) RemoveEventMemberships(v ...*EventMember) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventMembershipIDs(ids...)
+}
+
+// ClearEventSubmissions clears all "event_submissions" edges to the EventSubmission entity.
+func (_u *UserUpdate) ClearEventSubmissions() *UserUpdate {
+	_u.mutation.ClearEventSubmissions()
+	return _u
+}
+
+// RemoveEventSubmissionIDs removes the "event_submissions" edge to EventSubmission entities by IDs.
+func (_u *UserUpdate) RemoveEventSubmissionIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveEventSubmissionIDs(ids...)
+	return _u
+}
+
+// RemoveEventSubmissions removes "event_submissions" edges to EventSubmission entities.
+func (_u *UserUpdate) RemoveEventSubmissions(v ...*EventSubmission) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventSubmissionIDs(ids...)
+}
+
+// ClearEventScores clears all "event_scores" edges to the EventScore entity.
+func (_u *UserUpdate) ClearEventScores() *UserUpdate {
+	_u.mutation.ClearEventScores()
+	return _u
+}
+
+// RemoveEventScoreIDs removes the "event_scores" edge to EventScore entities by IDs.
+func (_u *UserUpdate) RemoveEventScoreIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveEventScoreIDs(ids...)
+	return _u
+}
+
+// RemoveEventScores removes "event_scores" edges to EventScore entities.
+func (_u *UserUpdate) RemoveEventScores(v ...*EventScore) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventScoreIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *UserUpdate) ClearNotifications() *UserUpdate {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *UserUpdate) RemoveNotificationIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *UserUpdate) RemoveNotifications(v ...*Notification) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
+// ClearNotificationPreferences clears all "notification_preferences" edges to the NotificationPreference entity.
+func (_u *UserUpdate) ClearNotificationPreferences() *UserUpdate {
+	_u.mutation.ClearNotificationPreferences()
+	return _u
+}
+
+// RemoveNotificationPreferenceIDs removes the "notification_preferences" edge to NotificationPreference entities by IDs.
+func (_u *UserUpdate) RemoveNotificationPreferenceIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationPreferences removes "notification_preferences" edges to NotificationPreference entities.
+func (_u *UserUpdate) RemoveNotificationPreferences(v ...*NotificationPreference) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationPreferenceIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *UserUpdate) ClearWebhooks() *UserUpdate {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *UserUpdate) RemoveWebhookIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *UserUpdate) RemoveWebhooks(v ...*Webhook) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
+// ClearLikes clears all "likes" edges to the Like entity.
+func (_u *UserUpdate) ClearLikes() *UserUpdate {
+	_u.mutation.ClearLikes()
+	return _u
+}
+
+// RemoveLikeIDs removes the "likes" edge to Like entities by IDs.
+func (_u *UserUpdate) RemoveLikeIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveLikeIDs(ids...)
+	return _u
+}
+
+// RemoveLikes removes "likes" edges to Like entities.
+func (_u *UserUpdate) RemoveLikes(v ...*Like) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLikeIDs(ids...)
+}
+
+// ClearUserTechnologies clears all "user_technologies" edges to the UserTechnology entity.
+func (_u *UserUpdate) ClearUserTechnologies() *UserUpdate {
+	_u.mutation.ClearUserTechnologies()
+	return _u
+}
+
+// RemoveUserTechnologyIDs removes the "user_technologies" edge to UserTechnology entities by IDs.
+func (_u *UserUpdate) RemoveUserTechnologyIDs(ids ...string) *UserUpdate {
+	_u.mutation.RemoveUserTechnologyIDs(ids...)
+	return _u
+}
+
+// RemoveUserTechnologies removes "user_technologies" edges to UserTechnology entities.
+func (_u *UserUpdate) RemoveUserTechnologies(v ...*UserTechnology) *UserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserTechnologyIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if user.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := user.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
 func (_u *UserUpdate) check() error {
 	if v, ok := _u.mutation.Username(); ok {
 		if err := user.UsernameValidator(v); err != nil {
@@ -624,11 +1288,21 @@ func (_u *UserUpdate) check() error {
 			return &ValidationError{Name: "last_name", err: fmt.Errorf(`ent: validator failed for field "User.last_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DigestFrequency(); ok {
+		if err := user.DigestFrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "digest_frequency", err: fmt.Errorf(`ent: validator failed for field "User.digest_frequency": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.AccountStatus(); ok {
 		if err := user.AccountStatusValidator(v); err != nil {
 			return &ValidationError{Name: "account_status", err: fmt.Errorf(`ent: validator failed for field "User.account_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -677,6 +1351,30 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.AvatarURLCleared() {
 		_spec.ClearField(user.FieldAvatarURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.Discoverable(); ok {
+		_spec.SetField(user.FieldDiscoverable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HideLikes(); ok {
+		_spec.SetField(user.FieldHideLikes, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DigestFrequency(); ok {
+		_spec.SetField(user.FieldDigestFrequency, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastDigestAt(); ok {
+		_spec.SetField(user.FieldLastDigestAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastDigestAtCleared() {
+		_spec.ClearField(user.FieldLastDigestAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnsubscribeToken(); ok {
+		_spec.SetField(user.FieldUnsubscribeToken, field.TypeString, value)
+	}
+	if _u.mutation.UnsubscribeTokenCleared() {
+		_spec.ClearField(user.FieldUnsubscribeToken, field.TypeString)
+	}
 	if value, ok := _u.mutation.LastLoginAt(); ok {
 		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
 	}
@@ -686,6 +1384,9 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AccountStatus(); ok {
 		_spec.SetField(user.FieldAccountStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.VerificationToken(); ok {
 		_spec.SetField(user.FieldVerificationToken, field.TypeString, value)
 	}
@@ -983,28 +1684,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LikesCleared() {
+	if _u.mutation.CommentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.LikesTable,
-			Columns: []string{user.LikesColumn},
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLikesIDs(); len(nodes) > 0 && !_u.mutation.LikesCleared() {
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.LikesTable,
-			Columns: []string{user.LikesColumn},
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1012,15 +1713,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LikesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.LikesTable,
-			Columns: []string{user.LikesColumn},
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1028,28 +1729,28 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.UserTechnologiesCleared() {
+	if _u.mutation.StatusChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.UserTechnologiesTable,
-			Columns: []string{user.UserTechnologiesColumn},
+			Inverse: false,
+			Table:   user.StatusChangesTable,
+			Columns: []string{user.StatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(usertechnology.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedUserTechnologiesIDs(); len(nodes) > 0 && !_u.mutation.UserTechnologiesCleared() {
+	if nodes := _u.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !_u.mutation.StatusChangesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.UserTechnologiesTable,
-			Columns: []string{user.UserTechnologiesColumn},
+			Inverse: false,
+			Table:   user.StatusChangesTable,
+			Columns: []string{user.StatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(usertechnology.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1057,15 +1758,15 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.UserTechnologiesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.StatusChangesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   user.UserTechnologiesTable,
-			Columns: []string{user.UserTechnologiesColumn},
+			Inverse: false,
+			Table:   user.StatusChangesTable,
+			Columns: []string{user.StatusChangesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(usertechnology.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1073,761 +1774,2750 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
 		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (_u *UserUpdateOne) SetUpdateTime(v time.Time) *UserUpdateOne {
-	_u.mutation.SetUpdateTime(v)
-	return _u
-}
-
-// SetUsername sets the "username" field.
-func (_u *UserUpdateOne) SetUsername(v string) *UserUpdateOne {
-	_u.mutation.SetUsername(v)
-	return _u
-}
-
-// SetNillableUsername sets the "username" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableUsername(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetUsername(*v)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetEmail sets the "email" field.
-func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
-	_u.mutation.SetEmail(v)
-	return _u
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetEmail(*v)
+	if nodes := _u.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !_u.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetEmailVerified sets the "email_verified" field.
-func (_u *UserUpdateOne) SetEmailVerified(v bool) *UserUpdateOne {
-	_u.mutation.SetEmailVerified(v)
-	return _u
-}
-
-// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableEmailVerified(v *bool) *UserUpdateOne {
-	if v != nil {
-		_u.SetEmailVerified(*v)
+	if nodes := _u.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetPassword sets the "password" field.
-func (_u *UserUpdateOne) SetPassword(v string) *UserUpdateOne {
-	_u.mutation.SetPassword(v)
-	return _u
-}
-
-// SetNillablePassword sets the "password" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillablePassword(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetPassword(*v)
+	if _u.mutation.ReceivedInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedInvitationsTable,
+			Columns: []string{user.ReceivedInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetFirstName sets the "first_name" field.
-func (_u *UserUpdateOne) SetFirstName(v string) *UserUpdateOne {
-	_u.mutation.SetFirstName(v)
-	return _u
-}
-
-// SetNillableFirstName sets the "first_name" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableFirstName(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetFirstName(*v)
+	if nodes := _u.mutation.RemovedReceivedInvitationsIDs(); len(nodes) > 0 && !_u.mutation.ReceivedInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedInvitationsTable,
+			Columns: []string{user.ReceivedInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetLastName sets the "last_name" field.
-func (_u *UserUpdateOne) SetLastName(v string) *UserUpdateOne {
-	_u.mutation.SetLastName(v)
-	return _u
-}
-
-// SetNillableLastName sets the "last_name" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableLastName(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetLastName(*v)
+	if nodes := _u.mutation.ReceivedInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedInvitationsTable,
+			Columns: []string{user.ReceivedInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetBio sets the "bio" field.
-func (_u *UserUpdateOne) SetBio(v string) *UserUpdateOne {
-	_u.mutation.SetBio(v)
-	return _u
-}
-
-// SetNillableBio sets the "bio" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableBio(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetBio(*v)
+	if _u.mutation.SentInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearBio clears the value of the "bio" field.
-func (_u *UserUpdateOne) ClearBio() *UserUpdateOne {
-	_u.mutation.ClearBio()
-	return _u
-}
-
-// SetAvatarURL sets the "avatar_url" field.
-func (_u *UserUpdateOne) SetAvatarURL(v string) *UserUpdateOne {
-	_u.mutation.SetAvatarURL(v)
-	return _u
-}
-
-// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableAvatarURL(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetAvatarURL(*v)
+	if nodes := _u.mutation.RemovedSentInvitationsIDs(); len(nodes) > 0 && !_u.mutation.SentInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearAvatarURL clears the value of the "avatar_url" field.
-func (_u *UserUpdateOne) ClearAvatarURL() *UserUpdateOne {
-	_u.mutation.ClearAvatarURL()
-	return _u
-}
-
-// SetLastLoginAt sets the "last_login_at" field.
-func (_u *UserUpdateOne) SetLastLoginAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetLastLoginAt(v)
-	return _u
-}
-
-// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableLastLoginAt(v *time.Time) *UserUpdateOne {
-	if v != nil {
-		_u.SetLastLoginAt(*v)
+	if nodes := _u.mutation.SentInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// ClearLastLoginAt clears the value of the "last_login_at" field.
-func (_u *UserUpdateOne) ClearLastLoginAt() *UserUpdateOne {
-	_u.mutation.ClearLastLoginAt()
-	return _u
-}
-
-// SetAccountStatus sets the "account_status" field.
-func (_u *UserUpdateOne) SetAccountStatus(v user.AccountStatus) *UserUpdateOne {
-	_u.mutation.SetAccountStatus(v)
-	return _u
-}
-
-// SetNillableAccountStatus sets the "account_status" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableAccountStatus(v *user.AccountStatus) *UserUpdateOne {
-	if v != nil {
-		_u.SetAccountStatus(*v)
+	if _u.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetVerificationToken sets the "verification_token" field.
-func (_u *UserUpdateOne) SetVerificationToken(v string) *UserUpdateOne {
-	_u.mutation.SetVerificationToken(v)
-	return _u
-}
-
-// SetNillableVerificationToken sets the "verification_token" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableVerificationToken(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetVerificationToken(*v)
+	if nodes := _u.mutation.RemovedJoinRequestsIDs(); len(nodes) > 0 && !_u.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearVerificationToken clears the value of the "verification_token" field.
-func (_u *UserUpdateOne) ClearVerificationToken() *UserUpdateOne {
-	_u.mutation.ClearVerificationToken()
-	return _u
-}
-
-// SetVerificationTokenExpiryAt sets the "verification_token_expiry_at" field.
-func (_u *UserUpdateOne) SetVerificationTokenExpiryAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetVerificationTokenExpiryAt(v)
-	return _u
-}
-
-// SetNillableVerificationTokenExpiryAt sets the "verification_token_expiry_at" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableVerificationTokenExpiryAt(v *time.Time) *UserUpdateOne {
-	if v != nil {
-		_u.SetVerificationTokenExpiryAt(*v)
+	if nodes := _u.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// ClearVerificationTokenExpiryAt clears the value of the "verification_token_expiry_at" field.
-func (_u *UserUpdateOne) ClearVerificationTokenExpiryAt() *UserUpdateOne {
-	_u.mutation.ClearVerificationTokenExpiryAt()
-	return _u
-}
-
-// SetFailedLoginAttempts sets the "failed_login_attempts" field.
-func (_u *UserUpdateOne) SetFailedLoginAttempts(v int) *UserUpdateOne {
-	_u.mutation.ResetFailedLoginAttempts()
-	_u.mutation.SetFailedLoginAttempts(v)
-	return _u
-}
-
-// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableFailedLoginAttempts(v *int) *UserUpdateOne {
-	if v != nil {
-		_u.SetFailedLoginAttempts(*v)
+	if _u.mutation.ProjectRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ProjectRevisionsTable,
+			Columns: []string{user.ProjectRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// AddFailedLoginAttempts adds value to the "failed_login_attempts" field.
-func (_u *UserUpdateOne) AddFailedLoginAttempts(v int) *UserUpdateOne {
-	_u.mutation.AddFailedLoginAttempts(v)
-	return _u
-}
-
-// SetResetPasswordToken sets the "reset_password_token" field.
-func (_u *UserUpdateOne) SetResetPasswordToken(v string) *UserUpdateOne {
-	_u.mutation.SetResetPasswordToken(v)
-	return _u
-}
-
-// SetNillableResetPasswordToken sets the "reset_password_token" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableResetPasswordToken(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetResetPasswordToken(*v)
+	if nodes := _u.mutation.RemovedProjectRevisionsIDs(); len(nodes) > 0 && !_u.mutation.ProjectRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ProjectRevisionsTable,
+			Columns: []string{user.ProjectRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearResetPasswordToken clears the value of the "reset_password_token" field.
-func (_u *UserUpdateOne) ClearResetPasswordToken() *UserUpdateOne {
-	_u.mutation.ClearResetPasswordToken()
-	return _u
-}
-
-// SetResetPasswordTokenExpiryAt sets the "reset_password_token_expiry_at" field.
-func (_u *UserUpdateOne) SetResetPasswordTokenExpiryAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetResetPasswordTokenExpiryAt(v)
-	return _u
-}
-
-// SetNillableResetPasswordTokenExpiryAt sets the "reset_password_token_expiry_at" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableResetPasswordTokenExpiryAt(v *time.Time) *UserUpdateOne {
-	if v != nil {
-		_u.SetResetPasswordTokenExpiryAt(*v)
+	if nodes := _u.mutation.ProjectRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ProjectRevisionsTable,
+			Columns: []string{user.ProjectRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// ClearResetPasswordTokenExpiryAt clears the value of the "reset_password_token_expiry_at" field.
-func (_u *UserUpdateOne) ClearResetPasswordTokenExpiryAt() *UserUpdateOne {
-	_u.mutation.ClearResetPasswordTokenExpiryAt()
-	return _u
-}
-
-// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
-func (_u *UserUpdateOne) AddSessionIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.AddSessionIDs(ids...)
-	return _u
-}
-
-// AddSessions adds the "sessions" edges to the Session entity.
-func (_u *UserUpdateOne) AddSessions(v ...*Session) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.UploadedMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddSessionIDs(ids...)
-}
-
-// AddOwnedProjectIDs adds the "owned_projects" edge to the Project entity by IDs.
-func (_u *UserUpdateOne) AddOwnedProjectIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.AddOwnedProjectIDs(ids...)
-	return _u
-}
-
-// AddOwnedProjects adds the "owned_projects" edges to the Project entity.
-func (_u *UserUpdateOne) AddOwnedProjects(v ...*Project) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedUploadedMediaIDs(); len(nodes) > 0 && !_u.mutation.UploadedMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddOwnedProjectIDs(ids...)
-}
-
-// AddLikedProjectIDs adds the "liked_projects" edge to the Project entity by IDs.
-func (_u *UserUpdateOne) AddLikedProjectIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.AddLikedProjectIDs(ids...)
-	return _u
-}
-
-// AddLikedProjects adds the "liked_projects" edges to the Project entity.
-func (_u *UserUpdateOne) AddLikedProjects(v ...*Project) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.UploadedMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddLikedProjectIDs(ids...)
-}
-
-// AddTechnologyIDs adds the "technologies" edge to the Tag entity by IDs.
-func (_u *UserUpdateOne) AddTechnologyIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.AddTechnologyIDs(ids...)
-	return _u
-}
-
-// AddTechnologies adds the "technologies" edges to the Tag entity.
-func (_u *UserUpdateOne) AddTechnologies(v ...*Tag) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CollectionsTable,
+			Columns: []string{user.CollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddTechnologyIDs(ids...)
-}
-
-// AddCreatedTagIDs adds the "created_tags" edge to the Tag entity by IDs.
-func (_u *UserUpdateOne) AddCreatedTagIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.AddCreatedTagIDs(ids...)
-	return _u
-}
-
-// AddCreatedTags adds the "created_tags" edges to the Tag entity.
-func (_u *UserUpdateOne) AddCreatedTags(v ...*Tag) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !_u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CollectionsTable,
+			Columns: []string{user.CollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddCreatedTagIDs(ids...)
-}
-
-// AddLikeIDs adds the "likes" edge to the Like entity by IDs.
-func (_u *UserUpdateOne) AddLikeIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.AddLikeIDs(ids...)
-	return _u
-}
-
-// AddLikes adds the "likes" edges to the Like entity.
-func (_u *UserUpdateOne) AddLikes(v ...*Like) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CollectionsTable,
+			Columns: []string{user.CollectionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddLikeIDs(ids...)
-}
-
-// AddUserTechnologyIDs adds the "user_technologies" edge to the UserTechnology entity by IDs.
-func (_u *UserUpdateOne) AddUserTechnologyIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.AddUserTechnologyIDs(ids...)
-	return _u
-}
-
-// AddUserTechnologies adds the "user_technologies" edges to the UserTechnology entity.
-func (_u *UserUpdateOne) AddUserTechnologies(v ...*UserTechnology) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.EventMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventMembershipsTable,
+			Columns: []string{user.EventMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventmember.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddUserTechnologyIDs(ids...)
-}
-
-// Mutation returns the UserMutation object of the builder.
-func (_u *UserUpdateOne) Mutation() *UserMutation {
-	return _u.mutation
-}
-
-// ClearSessions clears all "sessions" edges to the Session entity.
-func (_u *UserUpdateOne) ClearSessions() *UserUpdateOne {
-	_u.mutation.ClearSessions()
-	return _u
-}
-
-// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
-func (_u *UserUpdateOne) RemoveSessionIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemoveSessionIDs(ids...)
-	return _u
-}
-
-// RemoveSessions removes "sessions" edges to Session entities.
-func (_u *UserUpdateOne) RemoveSessions(v ...*Session) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedEventMembershipsIDs(); len(nodes) > 0 && !_u.mutation.EventMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventMembershipsTable,
+			Columns: []string{user.EventMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveSessionIDs(ids...)
-}
-
-// ClearOwnedProjects clears all "owned_projects" edges to the Project entity.
-func (_u *UserUpdateOne) ClearOwnedProjects() *UserUpdateOne {
-	_u.mutation.ClearOwnedProjects()
-	return _u
-}
-
-// RemoveOwnedProjectIDs removes the "owned_projects" edge to Project entities by IDs.
-func (_u *UserUpdateOne) RemoveOwnedProjectIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemoveOwnedProjectIDs(ids...)
-	return _u
-}
-
-// RemoveOwnedProjects removes "owned_projects" edges to Project entities.
-func (_u *UserUpdateOne) RemoveOwnedProjects(v ...*Project) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.EventMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventMembershipsTable,
+			Columns: []string{user.EventMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveOwnedProjectIDs(ids...)
-}
-
-// ClearLikedProjects clears all "liked_projects" edges to the Project entity.
-func (_u *UserUpdateOne) ClearLikedProjects() *UserUpdateOne {
-	_u.mutation.ClearLikedProjects()
-	return _u
-}
-
-// RemoveLikedProjectIDs removes the "liked_projects" edge to Project entities by IDs.
-func (_u *UserUpdateOne) RemoveLikedProjectIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemoveLikedProjectIDs(ids...)
-	return _u
-}
-
-// RemoveLikedProjects removes "liked_projects" edges to Project entities.
-func (_u *UserUpdateOne) RemoveLikedProjects(v ...*Project) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.EventSubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventSubmissionsTable,
+			Columns: []string{user.EventSubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveLikedProjectIDs(ids...)
-}
-
-// ClearTechnologies clears all "technologies" edges to the Tag entity.
-func (_u *UserUpdateOne) ClearTechnologies() *UserUpdateOne {
-	_u.mutation.ClearTechnologies()
-	return _u
-}
-
-// RemoveTechnologyIDs removes the "technologies" edge to Tag entities by IDs.
-func (_u *UserUpdateOne) RemoveTechnologyIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemoveTechnologyIDs(ids...)
-	return _u
-}
-
-// RemoveTechnologies removes "technologies" edges to Tag entities.
-func (_u *UserUpdateOne) RemoveTechnologies(v ...*Tag) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedEventSubmissionsIDs(); len(nodes) > 0 && !_u.mutation.EventSubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventSubmissionsTable,
+			Columns: []string{user.EventSubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveTechnologyIDs(ids...)
-}
-
-// ClearCreatedTags clears all "created_tags" edges to the Tag entity.
-func (_u *UserUpdateOne) ClearCreatedTags() *UserUpdateOne {
-	_u.mutation.ClearCreatedTags()
-	return _u
-}
-
-// RemoveCreatedTagIDs removes the "created_tags" edge to Tag entities by IDs.
-func (_u *UserUpdateOne) RemoveCreatedTagIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemoveCreatedTagIDs(ids...)
-	return _u
-}
-
-// RemoveCreatedTags removes "created_tags" edges to Tag entities.
-func (_u *UserUpdateOne) RemoveCreatedTags(v ...*Tag) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.EventSubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventSubmissionsTable,
+			Columns: []string{user.EventSubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveCreatedTagIDs(ids...)
-}
-
-// ClearLikes clears all "likes" edges to the Like entity.
-func (_u *UserUpdateOne) ClearLikes() *UserUpdateOne {
-	_u.mutation.ClearLikes()
-	return _u
-}
-
-// RemoveLikeIDs removes the "likes" edge to Like entities by IDs.
-func (_u *UserUpdateOne) RemoveLikeIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemoveLikeIDs(ids...)
-	return _u
-}
-
-// RemoveLikes removes "likes" edges to Like entities.
-func (_u *UserUpdateOne) RemoveLikes(v ...*Like) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.EventScoresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventScoresTable,
+			Columns: []string{user.EventScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventscore.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveLikeIDs(ids...)
+	if nodes := _u.mutation.RemovedEventScoresIDs(); len(nodes) > 0 && !_u.mutation.EventScoresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventScoresTable,
+			Columns: []string{user.EventScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventscore.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventScoresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventScoresTable,
+			Columns: []string{user.EventScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventscore.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationPreferencesIDs(); len(nodes) > 0 && !_u.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LikesTable,
+			Columns: []string{user.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLikesIDs(); len(nodes) > 0 && !_u.mutation.LikesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LikesTable,
+			Columns: []string{user.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LikesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.LikesTable,
+			Columns: []string{user.LikesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(like.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserTechnologiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.UserTechnologiesTable,
+			Columns: []string{user.UserTechnologiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usertechnology.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUserTechnologiesIDs(); len(nodes) > 0 && !_u.mutation.UserTechnologiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.UserTechnologiesTable,
+			Columns: []string{user.UserTechnologiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usertechnology.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserTechnologiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.UserTechnologiesTable,
+			Columns: []string{user.UserTechnologiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usertechnology.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
 }
 
-// ClearUserTechnologies clears all "user_technologies" edges to the UserTechnology entity.
-func (_u *UserUpdateOne) ClearUserTechnologies() *UserUpdateOne {
-	_u.mutation.ClearUserTechnologies()
-	return _u
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
 }
 
-// RemoveUserTechnologyIDs removes the "user_technologies" edge to UserTechnology entities by IDs.
-func (_u *UserUpdateOne) RemoveUserTechnologyIDs(ids ...string) *UserUpdateOne {
-	_u.mutation.RemoveUserTechnologyIDs(ids...)
+// SetUpdateTime sets the "update_time" field.
+func (_u *UserUpdateOne) SetUpdateTime(v time.Time) *UserUpdateOne {
+	_u.mutation.SetUpdateTime(v)
 	return _u
 }
 
-// RemoveUserTechnologies removes "user_technologies" edges to UserTechnology entities.
-func (_u *UserUpdateOne) RemoveUserTechnologies(v ...*UserTechnology) *UserUpdateOne {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetUsername sets the "username" field.
+func (_u *UserUpdateOne) SetUsername(v string) *UserUpdateOne {
+	_u.mutation.SetUsername(v)
+	return _u
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableUsername(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetUsername(*v)
 	}
-	return _u.RemoveUserTechnologyIDs(ids...)
+	return _u
 }
 
-// Where appends a list predicates to the UserUpdate builder.
-func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
-	_u.mutation.Where(ps...)
+// SetEmail sets the "email" field.
+func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
+	_u.mutation.SetEmail(v)
 	return _u
 }
 
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
-	_u.fields = append([]string{field}, fields...)
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
 	return _u
 }
 
-// Save executes the query and returns the updated User entity.
-func (_u *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	if err := _u.defaults(); err != nil {
-		return nil, err
+// SetEmailVerified sets the "email_verified" field.
+func (_u *UserUpdateOne) SetEmailVerified(v bool) *UserUpdateOne {
+	_u.mutation.SetEmailVerified(v)
+	return _u
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailVerified(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailVerified(*v)
 	}
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *UserUpdateOne) SaveX(ctx context.Context) *User {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// SetPassword sets the "password" field.
+func (_u *UserUpdateOne) SetPassword(v string) *UserUpdateOne {
+	_u.mutation.SetPassword(v)
+	return _u
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePassword(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPassword(*v)
 	}
-	return node
+	return _u
 }
 
-// Exec executes the query on the entity.
-func (_u *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// SetFirstName sets the "first_name" field.
+func (_u *UserUpdateOne) SetFirstName(v string) *UserUpdateOne {
+	_u.mutation.SetFirstName(v)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// SetNillableFirstName sets the "first_name" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableFirstName(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetFirstName(*v)
 	}
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *UserUpdateOne) defaults() error {
-	if _, ok := _u.mutation.UpdateTime(); !ok {
-		if user.UpdateDefaultUpdateTime == nil {
-			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+// SetLastName sets the "last_name" field.
+func (_u *UserUpdateOne) SetLastName(v string) *UserUpdateOne {
+	_u.mutation.SetLastName(v)
+	return _u
+}
+
+// SetNillableLastName sets the "last_name" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLastName(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetLastName(*v)
+	}
+	return _u
+}
+
+// SetBio sets the "bio" field.
+func (_u *UserUpdateOne) SetBio(v string) *UserUpdateOne {
+	_u.mutation.SetBio(v)
+	return _u
+}
+
+// SetNillableBio sets the "bio" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableBio(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetBio(*v)
+	}
+	return _u
+}
+
+// ClearBio clears the value of the "bio" field.
+func (_u *UserUpdateOne) ClearBio() *UserUpdateOne {
+	_u.mutation.ClearBio()
+	return _u
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (_u *UserUpdateOne) SetAvatarURL(v string) *UserUpdateOne {
+	_u.mutation.SetAvatarURL(v)
+	return _u
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAvatarURL(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetAvatarURL(*v)
+	}
+	return _u
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (_u *UserUpdateOne) ClearAvatarURL() *UserUpdateOne {
+	_u.mutation.ClearAvatarURL()
+	return _u
+}
+
+// SetDiscoverable sets the "discoverable" field.
+func (_u *UserUpdateOne) SetDiscoverable(v bool) *UserUpdateOne {
+	_u.mutation.SetDiscoverable(v)
+	return _u
+}
+
+// SetNillableDiscoverable sets the "discoverable" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableDiscoverable(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetDiscoverable(*v)
+	}
+	return _u
+}
+
+// SetHideLikes sets the "hide_likes" field.
+func (_u *UserUpdateOne) SetHideLikes(v bool) *UserUpdateOne {
+	_u.mutation.SetHideLikes(v)
+	return _u
+}
+
+// SetNillableHideLikes sets the "hide_likes" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableHideLikes(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetHideLikes(*v)
+	}
+	return _u
+}
+
+// SetDigestFrequency sets the "digest_frequency" field.
+func (_u *UserUpdateOne) SetDigestFrequency(v user.DigestFrequency) *UserUpdateOne {
+	_u.mutation.SetDigestFrequency(v)
+	return _u
+}
+
+// SetNillableDigestFrequency sets the "digest_frequency" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableDigestFrequency(v *user.DigestFrequency) *UserUpdateOne {
+	if v != nil {
+		_u.SetDigestFrequency(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *UserUpdateOne) SetTimezone(v string) *UserUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTimezone(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetLastDigestAt sets the "last_digest_at" field.
+func (_u *UserUpdateOne) SetLastDigestAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetLastDigestAt(v)
+	return _u
+}
+
+// SetNillableLastDigestAt sets the "last_digest_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLastDigestAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetLastDigestAt(*v)
+	}
+	return _u
+}
+
+// ClearLastDigestAt clears the value of the "last_digest_at" field.
+func (_u *UserUpdateOne) ClearLastDigestAt() *UserUpdateOne {
+	_u.mutation.ClearLastDigestAt()
+	return _u
+}
+
+// SetUnsubscribeToken sets the "unsubscribe_token" field.
+func (_u *UserUpdateOne) SetUnsubscribeToken(v string) *UserUpdateOne {
+	_u.mutation.SetUnsubscribeToken(v)
+	return _u
+}
+
+// SetNillableUnsubscribeToken sets the "unsubscribe_token" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableUnsubscribeToken(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetUnsubscribeToken(*v)
+	}
+	return _u
+}
+
+// ClearUnsubscribeToken clears the value of the "unsubscribe_token" field.
+func (_u *UserUpdateOne) ClearUnsubscribeToken() *UserUpdateOne {
+	_u.mutation.ClearUnsubscribeToken()
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *UserUpdateOne) SetLastLoginAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLastLoginAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *UserUpdateOne) ClearLastLoginAt() *UserUpdateOne {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
+// SetAccountStatus sets the "account_status" field.
+func (_u *UserUpdateOne) SetAccountStatus(v user.AccountStatus) *UserUpdateOne {
+	_u.mutation.SetAccountStatus(v)
+	return _u
+}
+
+// SetNillableAccountStatus sets the "account_status" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableAccountStatus(v *user.AccountStatus) *UserUpdateOne {
+	if v != nil {
+		_u.SetAccountStatus(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *UserUpdateOne) SetRole(v user.Role) *UserUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableRole(v *user.Role) *UserUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (_u *UserUpdateOne) SetVerificationToken(v string) *UserUpdateOne {
+	_u.mutation.SetVerificationToken(v)
+	return _u
+}
+
+// SetNillableVerificationToken sets the "verification_token" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableVerificationToken(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetVerificationToken(*v)
+	}
+	return _u
+}
+
+// ClearVerificationToken clears the value of the "verification_token" field.
+func (_u *UserUpdateOne) ClearVerificationToken() *UserUpdateOne {
+	_u.mutation.ClearVerificationToken()
+	return _u
+}
+
+// SetVerificationTokenExpiryAt sets the "verification_token_expiry_at" field.
+func (_u *UserUpdateOne) SetVerificationTokenExpiryAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetVerificationTokenExpiryAt(v)
+	return _u
+}
+
+// SetNillableVerificationTokenExpiryAt sets the "verification_token_expiry_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableVerificationTokenExpiryAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetVerificationTokenExpiryAt(*v)
+	}
+	return _u
+}
+
+// ClearVerificationTokenExpiryAt clears the value of the "verification_token_expiry_at" field.
+func (_u *UserUpdateOne) ClearVerificationTokenExpiryAt() *UserUpdateOne {
+	_u.mutation.ClearVerificationTokenExpiryAt()
+	return _u
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (_u *UserUpdateOne) SetFailedLoginAttempts(v int) *UserUpdateOne {
+	_u.mutation.ResetFailedLoginAttempts()
+	_u.mutation.SetFailedLoginAttempts(v)
+	return _u
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableFailedLoginAttempts(v *int) *UserUpdateOne {
+	if v != nil {
+		_u.SetFailedLoginAttempts(*v)
+	}
+	return _u
+}
+
+// AddFailedLoginAttempts adds value to the "failed_login_attempts" field.
+func (_u *UserUpdateOne) AddFailedLoginAttempts(v int) *UserUpdateOne {
+	_u.mutation.AddFailedLoginAttempts(v)
+	return _u
+}
+
+// SetResetPasswordToken sets the "reset_password_token" field.
+func (_u *UserUpdateOne) SetResetPasswordToken(v string) *UserUpdateOne {
+	_u.mutation.SetResetPasswordToken(v)
+	return _u
+}
+
+// SetNillableResetPasswordToken sets the "reset_password_token" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableResetPasswordToken(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetResetPasswordToken(*v)
+	}
+	return _u
+}
+
+// ClearResetPasswordToken clears the value of the "reset_password_token" field.
+func (_u *UserUpdateOne) ClearResetPasswordToken() *UserUpdateOne {
+	_u.mutation.ClearResetPasswordToken()
+	return _u
+}
+
+// SetResetPasswordTokenExpiryAt sets the "reset_password_token_expiry_at" field.
+func (_u *UserUpdateOne) SetResetPasswordTokenExpiryAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetResetPasswordTokenExpiryAt(v)
+	return _u
+}
+
+// SetNillableResetPasswordTokenExpiryAt sets the "reset_password_token_expiry_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableResetPasswordTokenExpiryAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetResetPasswordTokenExpiryAt(*v)
+	}
+	return _u
+}
+
+// ClearResetPasswordTokenExpiryAt clears the value of the "reset_password_token_expiry_at" field.
+func (_u *UserUpdateOne) ClearResetPasswordTokenExpiryAt() *UserUpdateOne {
+	_u.mutation.ClearResetPasswordTokenExpiryAt()
+	return _u
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *UserUpdateOne) AddSessionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *UserUpdateOne) AddSessions(v ...*Session) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddOwnedProjectIDs adds the "owned_projects" edge to the Project entity by IDs.
+func (_u *UserUpdateOne) AddOwnedProjectIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddOwnedProjectIDs(ids...)
+	return _u
+}
+
+// AddOwnedProjects adds the "owned_projects" edges to the Project entity.
+func (_u *UserUpdateOne) AddOwnedProjects(v ...*Project) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOwnedProjectIDs(ids...)
+}
+
+// AddLikedProjectIDs adds the "liked_projects" edge to the Project entity by IDs.
+func (_u *UserUpdateOne) AddLikedProjectIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddLikedProjectIDs(ids...)
+	return _u
+}
+
+// AddLikedProjects adds the "liked_projects" edges to the Project entity.
+func (_u *UserUpdateOne) AddLikedProjects(v ...*Project) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLikedProjectIDs(ids...)
+}
+
+// AddTechnologyIDs adds the "technologies" edge to the Tag entity by IDs.
+func (_u *UserUpdateOne) AddTechnologyIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddTechnologyIDs(ids...)
+	return _u
+}
+
+// AddTechnologies adds the "technologies" edges to the Tag entity.
+func (_u *UserUpdateOne) AddTechnologies(v ...*Tag) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTechnologyIDs(ids...)
+}
+
+// AddCreatedTagIDs adds the "created_tags" edge to the Tag entity by IDs.
+func (_u *UserUpdateOne) AddCreatedTagIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddCreatedTagIDs(ids...)
+	return _u
+}
+
+// AddCreatedTags adds the "created_tags" edges to the Tag entity.
+func (_u *UserUpdateOne) AddCreatedTags(v ...*Tag) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCreatedTagIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *UserUpdateOne) AddCommentIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *UserUpdateOne) AddComments(v ...*Comment) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCommentIDs(ids...)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the ProjectStatusChange entity by IDs.
+func (_u *UserUpdateOne) AddStatusChangeIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddStatusChangeIDs(ids...)
+	return _u
+}
+
+// AddStatusChanges adds the "status_changes" edges to the ProjectStatusChange entity.
+func (_u *UserUpdateOne) AddStatusChanges(v ...*ProjectStatusChange) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusChangeIDs(ids...)
+}
+
+// AddMembershipIDs adds the "memberships" edge to the ProjectMember entity by IDs.
+func (_u *UserUpdateOne) AddMembershipIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddMembershipIDs(ids...)
+	return _u
+}
+
+// AddMemberships adds the "memberships" edges to the ProjectMember entity.
+func (_u *UserUpdateOne) AddMemberships(v ...*ProjectMember) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMembershipIDs(ids...)
+}
+
+// AddReceivedInvitationIDs adds the "received_invitations" edge to the ProjectInvitation entity by IDs.
+func (_u *UserUpdateOne) AddReceivedInvitationIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddReceivedInvitationIDs(ids...)
+	return _u
+}
+
+// AddReceivedInvitations adds the "received_invitations" edges to the ProjectInvitation entity.
+func (_u *UserUpdateOne) AddReceivedInvitations(v ...*ProjectInvitation) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceivedInvitationIDs(ids...)
+}
+
+// AddSentInvitationIDs adds the "sent_invitations" edge to the ProjectInvitation entity by IDs.
+func (_u *UserUpdateOne) AddSentInvitationIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddSentInvitationIDs(ids...)
+	return _u
+}
+
+// AddSentInvitations adds the "sent_invitations" edges to the ProjectInvitation entity.
+func (_u *UserUpdateOne) AddSentInvitations(v ...*ProjectInvitation) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSentInvitationIDs(ids...)
+}
+
+// AddJoinRequestIDs adds the "join_requests" edge to the JoinRequest entity by IDs.
+func (_u *UserUpdateOne) AddJoinRequestIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddJoinRequestIDs(ids...)
+	return _u
+}
+
+// AddJoinRequests adds the "join_requests" edges to the JoinRequest entity.
+func (_u *UserUpdateOne) AddJoinRequests(v ...*JoinRequest) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJoinRequestIDs(ids...)
+}
+
+// AddProjectRevisionIDs adds the "project_revisions" edge to the ProjectRevision entity by IDs.
+func (_u *UserUpdateOne) AddProjectRevisionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddProjectRevisionIDs(ids...)
+	return _u
+}
+
+// AddProjectRevisions adds the "project_revisions" edges to the ProjectRevision entity.
+func (_u *UserUpdateOne) AddProjectRevisions(v ...*ProjectRevision) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddProjectRevisionIDs(ids...)
+}
+
+// AddUploadedMediumIDs adds the "uploaded_media" edge to the ProjectMedia entity by IDs.
+func (_u *UserUpdateOne) AddUploadedMediumIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddUploadedMediumIDs(ids...)
+	return _u
+}
+
+// AddUploadedMedia adds the "uploaded_media" edges to the ProjectMedia entity.
+func (_u *UserUpdateOne) AddUploadedMedia(v ...*ProjectMedia) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUploadedMediumIDs(ids...)
+}
+
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (_u *UserUpdateOne) AddCollectionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddCollectionIDs(ids...)
+	return _u
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (_u *UserUpdateOne) AddCollections(v ...*Collection) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCollectionIDs(ids...)
+}
+
+// AddEventMembershipIDs adds the "event_memberships" edge to the EventMember entity by IDs.
+func (_u *UserUpdateOne) AddEventMembershipIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddEventMembershipIDs(ids...)
+	return _u
+}
+
+// AddEventMemberships adds the "event_memberships" edges to the EventMember entity.
+func (_u *UserUpdateOne) AddEventMemberships(v ...*EventMember) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventMembershipIDs(ids...)
+}
+
+// AddEventSubmissionIDs adds the "event_submissions" edge to the EventSubmission entity by IDs.
+func (_u *UserUpdateOne) AddEventSubmissionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddEventSubmissionIDs(ids...)
+	return _u
+}
+
+// AddEventSubmissions adds the "event_submissions" edges to the EventSubmission entity.
+func (_u *UserUpdateOne) AddEventSubmissions(v ...*EventSubmission) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventSubmissionIDs(ids...)
+}
+
+// AddEventScoreIDs adds the "event_scores" edge to the EventScore entity by IDs.
+func (_u *UserUpdateOne) AddEventScoreIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddEventScoreIDs(ids...)
+	return _u
+}
+
+// AddEventScores adds the "event_scores" edges to the EventScore entity.
+func (_u *UserUpdateOne) AddEventScores(v ...*EventScore) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventScoreIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (_u *UserUpdateOne) AddNotificationIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddNotificationIDs(ids...)
+	return _u
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (_u *UserUpdateOne) AddNotifications(v ...*Notification) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationIDs(ids...)
+}
+
+// AddNotificationPreferenceIDs adds the "notification_preferences" edge to the NotificationPreference entity by IDs.
+func (_u *UserUpdateOne) AddNotificationPreferenceIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// AddNotificationPreferences adds the "notification_preferences" edges to the NotificationPreference entity.
+func (_u *UserUpdateOne) AddNotificationPreferences(v ...*NotificationPreference) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNotificationPreferenceIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *UserUpdateOne) AddWebhookIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *UserUpdateOne) AddWebhooks(v ...*Webhook) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
+// AddLikeIDs adds the "likes" edge to the Like entity by IDs.
+func (_u *UserUpdateOne) AddLikeIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddLikeIDs(ids...)
+	return _u
+}
+
+// AddLikes adds the "likes" edges to the Like entity.
+func (_u *UserUpdateOne) AddLikes(v ...*Like) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLikeIDs(ids...)
+}
+
+// AddUserTechnologyIDs adds the "user_technologies" edge to the UserTechnology entity by IDs.
+func (_u *UserUpdateOne) AddUserTechnologyIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.AddUserTechnologyIDs(ids...)
+	return _u
+}
+
+// AddUserTechnologies adds the "user_technologies" edges to the UserTechnology entity.
+func (_u *UserUpdateOne) AddUserTechnologies(v ...*UserTechnology) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUserTechnologyIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (_u *UserUpdateOne) Mutation() *UserMutation {
+	return _u.mutation
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *UserUpdateOne) ClearSessions() *UserUpdateOne {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *UserUpdateOne) RemoveSessionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *UserUpdateOne) RemoveSessions(v ...*Session) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearOwnedProjects clears all "owned_projects" edges to the Project entity.
+func (_u *UserUpdateOne) ClearOwnedProjects() *UserUpdateOne {
+	_u.mutation.ClearOwnedProjects()
+	return _u
+}
+
+// RemoveOwnedProjectIDs removes the "owned_projects" edge to Project entities by IDs.
+func (_u *UserUpdateOne) RemoveOwnedProjectIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveOwnedProjectIDs(ids...)
+	return _u
+}
+
+// RemoveOwnedProjects removes "owned_projects" edges to Project entities.
+func (_u *UserUpdateOne) RemoveOwnedProjects(v ...*Project) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOwnedProjectIDs(ids...)
+}
+
+// ClearLikedProjects clears all "liked_projects" edges to the Project entity.
+func (_u *UserUpdateOne) ClearLikedProjects() *UserUpdateOne {
+	_u.mutation.ClearLikedProjects()
+	return _u
+}
+
+// RemoveLikedProjectIDs removes the "liked_projects" edge to Project entities by IDs.
+func (_u *UserUpdateOne) RemoveLikedProjectIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveLikedProjectIDs(ids...)
+	return _u
+}
+
+// RemoveLikedProjects removes "liked_projects" edges to Project entities.
+func (_u *UserUpdateOne) RemoveLikedProjects(v ...*Project) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLikedProjectIDs(ids...)
+}
+
+// ClearTechnologies clears all "technologies" edges to the Tag entity.
+func (_u *UserUpdateOne) ClearTechnologies() *UserUpdateOne {
+	_u.mutation.ClearTechnologies()
+	return _u
+}
+
+// RemoveTechnologyIDs removes the "technologies" edge to Tag entities by IDs.
+func (_u *UserUpdateOne) RemoveTechnologyIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveTechnologyIDs(ids...)
+	return _u
+}
+
+// RemoveTechnologies removes "technologies" edges to Tag entities.
+func (_u *UserUpdateOne) RemoveTechnologies(v ...*Tag) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTechnologyIDs(ids...)
+}
+
+// ClearCreatedTags clears all "created_tags" edges to the Tag entity.
+func (_u *UserUpdateOne) ClearCreatedTags() *UserUpdateOne {
+	_u.mutation.ClearCreatedTags()
+	return _u
+}
+
+// RemoveCreatedTagIDs removes the "created_tags" edge to Tag entities by IDs.
+func (_u *UserUpdateOne) RemoveCreatedTagIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveCreatedTagIDs(ids...)
+	return _u
+}
+
+// RemoveCreatedTags removes "created_tags" edges to Tag entities.
+func (_u *UserUpdateOne) RemoveCreatedTags(v ...*Tag) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCreatedTagIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *UserUpdateOne) ClearComments() *UserUpdateOne {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *UserUpdateOne) RemoveCommentIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveCommentIDs(ids...)
+	return _u
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *UserUpdateOne) RemoveComments(v ...*Comment) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearStatusChanges clears all "status_changes" edges to the ProjectStatusChange entity.
+func (_u *UserUpdateOne) ClearStatusChanges() *UserUpdateOne {
+	_u.mutation.ClearStatusChanges()
+	return _u
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to ProjectStatusChange entities by IDs.
+func (_u *UserUpdateOne) RemoveStatusChangeIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveStatusChangeIDs(ids...)
+	return _u
+}
+
+// RemoveStatusChanges removes "status_changes" edges to ProjectStatusChange entities.
+func (_u *UserUpdateOne) RemoveStatusChanges(v ...*ProjectStatusChange) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatusChangeIDs(ids...)
+}
+
+// ClearMemberships clears all "memberships" edges to the ProjectMember entity.
+func (_u *UserUpdateOne) ClearMemberships() *UserUpdateOne {
+	_u.mutation.ClearMemberships()
+	return _u
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to ProjectMember entities by IDs.
+func (_u *UserUpdateOne) RemoveMembershipIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveMemberships removes "memberships" edges to ProjectMember entities.
+func (_u *UserUpdateOne) RemoveMemberships(v ...*ProjectMember) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMembershipIDs(ids...)
+}
+
+// ClearReceivedInvitations clears all "received_invitations" edges to the ProjectInvitation entity.
+func (_u *UserUpdateOne) ClearReceivedInvitations() *UserUpdateOne {
+	_u.mutation.ClearReceivedInvitations()
+	return _u
+}
+
+// RemoveReceivedInvitationIDs removes the "received_invitations" edge to ProjectInvitation entities by IDs.
+func (_u *UserUpdateOne) RemoveReceivedInvitationIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveReceivedInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveReceivedInvitations removes "received_invitations" edges to ProjectInvitation entities.
+func (_u *UserUpdateOne) RemoveReceivedInvitations(v ...*ProjectInvitation) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceivedInvitationIDs(ids...)
+}
+
+// ClearSentInvitations clears all "sent_invitations" edges to the ProjectInvitation entity.
+func (_u *UserUpdateOne) ClearSentInvitations() *UserUpdateOne {
+	_u.mutation.ClearSentInvitations()
+	return _u
+}
+
+// RemoveSentInvitationIDs removes the "sent_invitations" edge to ProjectInvitation entities by IDs.
+func (_u *UserUpdateOne) RemoveSentInvitationIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveSentInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveSentInvitations removes "sent_invitations" edges to ProjectInvitation entities.
+func (_u *UserUpdateOne) RemoveSentInvitations(v ...*ProjectInvitation) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSentInvitationIDs(ids...)
+}
+
+// ClearJoinRequests clears all "join_requests" edges to the JoinRequest entity.
+func (_u *UserUpdateOne) ClearJoinRequests() *UserUpdateOne {
+	_u.mutation.ClearJoinRequests()
+	return _u
+}
+
+// RemoveJoinRequestIDs removes the "join_requests" edge to JoinRequest entities by IDs.
+func (_u *UserUpdateOne) RemoveJoinRequestIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveJoinRequestIDs(ids...)
+	return _u
+}
+
+// RemoveJoinRequests removes "join_requests" edges to JoinRequest entities.
+func (_u *UserUpdateOne) RemoveJoinRequests(v ...*JoinRequest) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJoinRequestIDs(ids...)
+}
+
+// ClearProjectRevisions clears all "project_revisions" edges to the ProjectRevision entity.
+func (_u *UserUpdateOne) ClearProjectRevisions() *UserUpdateOne {
+	_u.mutation.ClearProjectRevisions()
+	return _u
+}
+
+// RemoveProjectRevisionIDs removes the "project_revisions" edge to ProjectRevision entities by IDs.
+func (_u *UserUpdateOne) RemoveProjectRevisionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveProjectRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveProjectRevisions removes "project_revisions" edges to ProjectRevision entities.
+func (_u *UserUpdateOne) RemoveProjectRevisions(v ...*ProjectRevision) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveProjectRevisionIDs(ids...)
+}
+
+// ClearUploadedMedia clears all "uploaded_media" edges to the ProjectMedia entity.
+func (_u *UserUpdateOne) ClearUploadedMedia() *UserUpdateOne {
+	_u.mutation.ClearUploadedMedia()
+	return _u
+}
+
+// RemoveUploadedMediumIDs removes the "uploaded_media" edge to ProjectMedia entities by IDs.
+func (_u *UserUpdateOne) RemoveUploadedMediumIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveUploadedMediumIDs(ids...)
+	return _u
+}
+
+// RemoveUploadedMedia removes "uploaded_media" edges to ProjectMedia entities.
+func (_u *UserUpdateOne) RemoveUploadedMedia(v ...*ProjectMedia) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUploadedMediumIDs(ids...)
+}
+
+// ClearCollections clears all "collections" edges to the Collection entity.
+func (_u *UserUpdateOne) ClearCollections() *UserUpdateOne {
+	_u.mutation.ClearCollections()
+	return _u
+}
+
+// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
+func (_u *UserUpdateOne) RemoveCollectionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveCollectionIDs(ids...)
+	return _u
+}
+
+// RemoveCollections removes "collections" edges to Collection entities.
+func (_u *UserUpdateOne) RemoveCollections(v ...*Collection) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCollectionIDs(ids...)
+}
+
+// ClearEventMemberships clears all "event_memberships" edges to the EventMember entity.
+func (_u *UserUpdateOne) ClearEventMemberships() *UserUpdateOne {
+	_u.mutation.ClearEventMemberships()
+	return _u
+}
+
+// RemoveEventMembershipIDs removes the "event_memberships" edge to EventMember entities by IDs.
+func (_u *UserUpdateOne) RemoveEventMembershipIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveEventMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveEventMemberships removes "event_memberships" edges to EventMember entities.
+func (_u *UserUpdateOne) RemoveEventMemberships(v ...*EventMember) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventMembershipIDs(ids...)
+}
+
+// ClearEventSubmissions clears all "event_submissions" edges to the EventSubmission entity.
+func (_u *UserUpdateOne) ClearEventSubmissions() *UserUpdateOne {
+	_u.mutation.ClearEventSubmissions()
+	return _u
+}
+
+// RemoveEventSubmissionIDs removes the "event_submissions" edge to EventSubmission entities by IDs.
+func (_u *UserUpdateOne) RemoveEventSubmissionIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveEventSubmissionIDs(ids...)
+	return _u
+}
+
+// RemoveEventSubmissions removes "event_submissions" edges to EventSubmission entities.
+func (_u *UserUpdateOne) RemoveEventSubmissions(v ...*EventSubmission) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventSubmissionIDs(ids...)
+}
+
+// ClearEventScores clears all "event_scores" edges to the EventScore entity.
+func (_u *UserUpdateOne) ClearEventScores() *UserUpdateOne {
+	_u.mutation.ClearEventScores()
+	return _u
+}
+
+// RemoveEventScoreIDs removes the "event_scores" edge to EventScore entities by IDs.
+func (_u *UserUpdateOne) RemoveEventScoreIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveEventScoreIDs(ids...)
+	return _u
+}
+
+// RemoveEventScores removes "event_scores" edges to EventScore entities.
+func (_u *UserUpdateOne) RemoveEventScores(v ...*EventScore) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventScoreIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (_u *UserUpdateOne) ClearNotifications() *UserUpdateOne {
+	_u.mutation.ClearNotifications()
+	return _u
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (_u *UserUpdateOne) RemoveNotificationIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveNotificationIDs(ids...)
+	return _u
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (_u *UserUpdateOne) RemoveNotifications(v ...*Notification) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationIDs(ids...)
+}
+
+// ClearNotificationPreferences clears all "notification_preferences" edges to the NotificationPreference entity.
+func (_u *UserUpdateOne) ClearNotificationPreferences() *UserUpdateOne {
+	_u.mutation.ClearNotificationPreferences()
+	return _u
+}
+
+// RemoveNotificationPreferenceIDs removes the "notification_preferences" edge to NotificationPreference entities by IDs.
+func (_u *UserUpdateOne) RemoveNotificationPreferenceIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveNotificationPreferenceIDs(ids...)
+	return _u
+}
+
+// RemoveNotificationPreferences removes "notification_preferences" edges to NotificationPreference entities.
+func (_u *UserUpdateOne) RemoveNotificationPreferences(v ...*NotificationPreference) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNotificationPreferenceIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *UserUpdateOne) ClearWebhooks() *UserUpdateOne {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *UserUpdateOne) RemoveWebhookIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *UserUpdateOne) RemoveWebhooks(v ...*Webhook) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
+// ClearLikes clears all "likes" edges to the Like entity.
+func (_u *UserUpdateOne) ClearLikes() *UserUpdateOne {
+	_u.mutation.ClearLikes()
+	return _u
+}
+
+// RemoveLikeIDs removes the "likes" edge to Like entities by IDs.
+func (_u *UserUpdateOne) RemoveLikeIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveLikeIDs(ids...)
+	return _u
+}
+
+// RemoveLikes removes "likes" edges to Like entities.
+func (_u *UserUpdateOne) RemoveLikes(v ...*Like) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLikeIDs(ids...)
+}
+
+// ClearUserTechnologies clears all "user_technologies" edges to the UserTechnology entity.
+func (_u *UserUpdateOne) ClearUserTechnologies() *UserUpdateOne {
+	_u.mutation.ClearUserTechnologies()
+	return _u
+}
+
+// RemoveUserTechnologyIDs removes the "user_technologies" edge to UserTechnology entities by IDs.
+func (_u *UserUpdateOne) RemoveUserTechnologyIDs(ids ...string) *UserUpdateOne {
+	_u.mutation.RemoveUserTechnologyIDs(ids...)
+	return _u
+}
+
+// RemoveUserTechnologies removes "user_technologies" edges to UserTechnology entities.
+func (_u *UserUpdateOne) RemoveUserTechnologies(v ...*UserTechnology) *UserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserTechnologyIDs(ids...)
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated User entity.
+func (_u *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if user.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := user.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserUpdateOne) check() error {
+	if v, ok := _u.mutation.Username(); ok {
+		if err := user.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Password(); ok {
+		if err := user.PasswordValidator(v); err != nil {
+			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FirstName(); ok {
+		if err := user.FirstNameValidator(v); err != nil {
+			return &ValidationError{Name: "first_name", err: fmt.Errorf(`ent: validator failed for field "User.first_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastName(); ok {
+		if err := user.LastNameValidator(v); err != nil {
+			return &ValidationError{Name: "last_name", err: fmt.Errorf(`ent: validator failed for field "User.last_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DigestFrequency(); ok {
+		if err := user.DigestFrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "digest_frequency", err: fmt.Errorf(`ent: validator failed for field "User.digest_frequency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountStatus(); ok {
+		if err := user.AccountStatusValidator(v); err != nil {
+			return &ValidationError{Name: "account_status", err: fmt.Errorf(`ent: validator failed for field "User.account_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(user.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Username(); ok {
+		_spec.SetField(user.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EmailVerified(); ok {
+		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Password(); ok {
+		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FirstName(); ok {
+		_spec.SetField(user.FieldFirstName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastName(); ok {
+		_spec.SetField(user.FieldLastName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bio(); ok {
+		_spec.SetField(user.FieldBio, field.TypeString, value)
+	}
+	if _u.mutation.BioCleared() {
+		_spec.ClearField(user.FieldBio, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvatarURL(); ok {
+		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
+	}
+	if _u.mutation.AvatarURLCleared() {
+		_spec.ClearField(user.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Discoverable(); ok {
+		_spec.SetField(user.FieldDiscoverable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HideLikes(); ok {
+		_spec.SetField(user.FieldHideLikes, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DigestFrequency(); ok {
+		_spec.SetField(user.FieldDigestFrequency, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LastDigestAt(); ok {
+		_spec.SetField(user.FieldLastDigestAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastDigestAtCleared() {
+		_spec.ClearField(user.FieldLastDigestAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnsubscribeToken(); ok {
+		_spec.SetField(user.FieldUnsubscribeToken, field.TypeString, value)
+	}
+	if _u.mutation.UnsubscribeTokenCleared() {
+		_spec.ClearField(user.FieldUnsubscribeToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AccountStatus(); ok {
+		_spec.SetField(user.FieldAccountStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(user.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VerificationToken(); ok {
+		_spec.SetField(user.FieldVerificationToken, field.TypeString, value)
+	}
+	if _u.mutation.VerificationTokenCleared() {
+		_spec.ClearField(user.FieldVerificationToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.VerificationTokenExpiryAt(); ok {
+		_spec.SetField(user.FieldVerificationTokenExpiryAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerificationTokenExpiryAtCleared() {
+		_spec.ClearField(user.FieldVerificationTokenExpiryAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(user.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedLoginAttempts(); ok {
+		_spec.AddField(user.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResetPasswordToken(); ok {
+		_spec.SetField(user.FieldResetPasswordToken, field.TypeString, value)
+	}
+	if _u.mutation.ResetPasswordTokenCleared() {
+		_spec.ClearField(user.FieldResetPasswordToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResetPasswordTokenExpiryAt(); ok {
+		_spec.SetField(user.FieldResetPasswordTokenExpiryAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResetPasswordTokenExpiryAtCleared() {
+		_spec.ClearField(user.FieldResetPasswordTokenExpiryAt, field.TypeTime)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OwnedProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OwnedProjectsTable,
+			Columns: []string{user.OwnedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOwnedProjectsIDs(); len(nodes) > 0 && !_u.mutation.OwnedProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OwnedProjectsTable,
+			Columns: []string{user.OwnedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnedProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OwnedProjectsTable,
+			Columns: []string{user.OwnedProjectsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LikedProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.LikedProjectsTable,
+			Columns: user.LikedProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		createE := &LikeCreate{config: _u.config, mutation: newLikeMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLikedProjectsIDs(); len(nodes) > 0 && !_u.mutation.LikedProjectsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.LikedProjectsTable,
+			Columns: user.LikedProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &LikeCreate{config: _u.config, mutation: newLikeMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LikedProjectsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.LikedProjectsTable,
+			Columns: user.LikedProjectsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &LikeCreate{config: _u.config, mutation: newLikeMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TechnologiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.TechnologiesTable,
+			Columns: user.TechnologiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		createE := &UserTechnologyCreate{config: _u.config, mutation: newUserTechnologyMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTechnologiesIDs(); len(nodes) > 0 && !_u.mutation.TechnologiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.TechnologiesTable,
+			Columns: user.TechnologiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &UserTechnologyCreate{config: _u.config, mutation: newUserTechnologyMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TechnologiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.TechnologiesTable,
+			Columns: user.TechnologiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &UserTechnologyCreate{config: _u.config, mutation: newUserTechnologyMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		if specE.ID.Value != nil {
+			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreatedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedTagsTable,
+			Columns: []string{user.CreatedTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCreatedTagsIDs(); len(nodes) > 0 && !_u.mutation.CreatedTagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedTagsTable,
+			Columns: []string{user.CreatedTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CreatedTagsTable,
+			Columns: []string{user.CreatedTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		v := user.UpdateDefaultUpdateTime()
-		_u.mutation.SetUpdateTime(v)
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return nil
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (_u *UserUpdateOne) check() error {
-	if v, ok := _u.mutation.Username(); ok {
-		if err := user.UsernameValidator(v); err != nil {
-			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "User.username": %w`, err)}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
 		}
-	}
-	if v, ok := _u.mutation.Password(); ok {
-		if err := user.PasswordValidator(v); err != nil {
-			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.FirstName(); ok {
-		if err := user.FirstNameValidator(v); err != nil {
-			return &ValidationError{Name: "first_name", err: fmt.Errorf(`ent: validator failed for field "User.first_name": %w`, err)}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentsTable,
+			Columns: []string{user.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeString),
+			},
 		}
-	}
-	if v, ok := _u.mutation.LastName(); ok {
-		if err := user.LastNameValidator(v); err != nil {
-			return &ValidationError{Name: "last_name", err: fmt.Errorf(`ent: validator failed for field "User.last_name": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := _u.mutation.AccountStatus(); ok {
-		if err := user.AccountStatusValidator(v); err != nil {
-			return &ValidationError{Name: "account_status", err: fmt.Errorf(`ent: validator failed for field "User.account_status": %w`, err)}
+	if _u.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StatusChangesTable,
+			Columns: []string{user.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeString))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
-	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := _u.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !_u.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StatusChangesTable,
+			Columns: []string{user.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
+			},
 		}
-	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.UpdateTime(); ok {
-		_spec.SetField(user.FieldUpdateTime, field.TypeTime, value)
-	}
-	if value, ok := _u.mutation.Username(); ok {
-		_spec.SetField(user.FieldUsername, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.EmailVerified(); ok {
-		_spec.SetField(user.FieldEmailVerified, field.TypeBool, value)
-	}
-	if value, ok := _u.mutation.Password(); ok {
-		_spec.SetField(user.FieldPassword, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.FirstName(); ok {
-		_spec.SetField(user.FieldFirstName, field.TypeString, value)
+	if nodes := _u.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.StatusChangesTable,
+			Columns: []string{user.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectstatuschange.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.LastName(); ok {
-		_spec.SetField(user.FieldLastName, field.TypeString, value)
+	if _u.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Bio(); ok {
-		_spec.SetField(user.FieldBio, field.TypeString, value)
+	if nodes := _u.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !_u.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.BioCleared() {
-		_spec.ClearField(user.FieldBio, field.TypeString)
+	if nodes := _u.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MembershipsTable,
+			Columns: []string{user.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmember.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.AvatarURL(); ok {
-		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
+	if _u.mutation.ReceivedInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedInvitationsTable,
+			Columns: []string{user.ReceivedInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.AvatarURLCleared() {
-		_spec.ClearField(user.FieldAvatarURL, field.TypeString)
+	if nodes := _u.mutation.RemovedReceivedInvitationsIDs(); len(nodes) > 0 && !_u.mutation.ReceivedInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedInvitationsTable,
+			Columns: []string{user.ReceivedInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.LastLoginAt(); ok {
-		_spec.SetField(user.FieldLastLoginAt, field.TypeTime, value)
+	if nodes := _u.mutation.ReceivedInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedInvitationsTable,
+			Columns: []string{user.ReceivedInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LastLoginAtCleared() {
-		_spec.ClearField(user.FieldLastLoginAt, field.TypeTime)
+	if _u.mutation.SentInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.AccountStatus(); ok {
-		_spec.SetField(user.FieldAccountStatus, field.TypeEnum, value)
+	if nodes := _u.mutation.RemovedSentInvitationsIDs(); len(nodes) > 0 && !_u.mutation.SentInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.VerificationToken(); ok {
-		_spec.SetField(user.FieldVerificationToken, field.TypeString, value)
+	if nodes := _u.mutation.SentInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentInvitationsTable,
+			Columns: []string{user.SentInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectinvitation.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.VerificationTokenCleared() {
-		_spec.ClearField(user.FieldVerificationToken, field.TypeString)
+	if _u.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.VerificationTokenExpiryAt(); ok {
-		_spec.SetField(user.FieldVerificationTokenExpiryAt, field.TypeTime, value)
+	if nodes := _u.mutation.RemovedJoinRequestsIDs(); len(nodes) > 0 && !_u.mutation.JoinRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.VerificationTokenExpiryAtCleared() {
-		_spec.ClearField(user.FieldVerificationTokenExpiryAt, field.TypeTime)
+	if nodes := _u.mutation.JoinRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.JoinRequestsTable,
+			Columns: []string{user.JoinRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(joinrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.FailedLoginAttempts(); ok {
-		_spec.SetField(user.FieldFailedLoginAttempts, field.TypeInt, value)
+	if _u.mutation.ProjectRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ProjectRevisionsTable,
+			Columns: []string{user.ProjectRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.AddedFailedLoginAttempts(); ok {
-		_spec.AddField(user.FieldFailedLoginAttempts, field.TypeInt, value)
+	if nodes := _u.mutation.RemovedProjectRevisionsIDs(); len(nodes) > 0 && !_u.mutation.ProjectRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ProjectRevisionsTable,
+			Columns: []string{user.ProjectRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.ResetPasswordToken(); ok {
-		_spec.SetField(user.FieldResetPasswordToken, field.TypeString, value)
+	if nodes := _u.mutation.ProjectRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ProjectRevisionsTable,
+			Columns: []string{user.ProjectRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectrevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ResetPasswordTokenCleared() {
-		_spec.ClearField(user.FieldResetPasswordToken, field.TypeString)
+	if _u.mutation.UploadedMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.ResetPasswordTokenExpiryAt(); ok {
-		_spec.SetField(user.FieldResetPasswordTokenExpiryAt, field.TypeTime, value)
+	if nodes := _u.mutation.RemovedUploadedMediaIDs(); len(nodes) > 0 && !_u.mutation.UploadedMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.ResetPasswordTokenExpiryAtCleared() {
-		_spec.ClearField(user.FieldResetPasswordTokenExpiryAt, field.TypeTime)
+	if nodes := _u.mutation.UploadedMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.UploadedMediaTable,
+			Columns: []string{user.UploadedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(projectmedia.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.SessionsCleared() {
+	if _u.mutation.CollectionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SessionsTable,
-			Columns: []string{user.SessionsColumn},
+			Table:   user.CollectionsTable,
+			Columns: []string{user.CollectionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+	if nodes := _u.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !_u.mutation.CollectionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SessionsTable,
-			Columns: []string{user.SessionsColumn},
+			Table:   user.CollectionsTable,
+			Columns: []string{user.CollectionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1835,15 +4525,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.CollectionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SessionsTable,
-			Columns: []string{user.SessionsColumn},
+			Table:   user.CollectionsTable,
+			Columns: []string{user.CollectionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1851,28 +4541,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.OwnedProjectsCleared() {
+	if _u.mutation.EventMembershipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OwnedProjectsTable,
-			Columns: []string{user.OwnedProjectsColumn},
+			Table:   user.EventMembershipsTable,
+			Columns: []string{user.EventMembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(eventmember.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedOwnedProjectsIDs(); len(nodes) > 0 && !_u.mutation.OwnedProjectsCleared() {
+	if nodes := _u.mutation.RemovedEventMembershipsIDs(); len(nodes) > 0 && !_u.mutation.EventMembershipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OwnedProjectsTable,
-			Columns: []string{user.OwnedProjectsColumn},
+			Table:   user.EventMembershipsTable,
+			Columns: []string{user.EventMembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(eventmember.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1880,15 +4570,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.OwnedProjectsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.EventMembershipsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.OwnedProjectsTable,
-			Columns: []string{user.OwnedProjectsColumn},
+			Table:   user.EventMembershipsTable,
+			Columns: []string{user.EventMembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(eventmember.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -1896,160 +4586,208 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.LikedProjectsCleared() {
+	if _u.mutation.EventSubmissionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LikedProjectsTable,
-			Columns: user.LikedProjectsPrimaryKey,
+			Table:   user.EventSubmissionsTable,
+			Columns: []string{user.EventSubmissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
 			},
 		}
-		createE := &LikeCreate{config: _u.config, mutation: newLikeMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventSubmissionsIDs(); len(nodes) > 0 && !_u.mutation.EventSubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventSubmissionsTable,
+			Columns: []string{user.EventSubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedLikedProjectsIDs(); len(nodes) > 0 && !_u.mutation.LikedProjectsCleared() {
+	if nodes := _u.mutation.EventSubmissionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LikedProjectsTable,
-			Columns: user.LikedProjectsPrimaryKey,
+			Table:   user.EventSubmissionsTable,
+			Columns: []string{user.EventSubmissionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(eventsubmission.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &LikeCreate{config: _u.config, mutation: newLikeMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventScoresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventScoresTable,
+			Columns: []string{user.EventScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventscore.FieldID, field.TypeString),
+			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.LikedProjectsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedEventScoresIDs(); len(nodes) > 0 && !_u.mutation.EventScoresCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.LikedProjectsTable,
-			Columns: user.LikedProjectsPrimaryKey,
+			Table:   user.EventScoresTable,
+			Columns: []string{user.EventScoresColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(eventscore.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &LikeCreate{config: _u.config, mutation: newLikeMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventScoresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EventScoresTable,
+			Columns: []string{user.EventScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventscore.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.TechnologiesCleared() {
+	if _u.mutation.NotificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TechnologiesTable,
-			Columns: user.TechnologiesPrimaryKey,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
 			},
 		}
-		createE := &UserTechnologyCreate{config: _u.config, mutation: newUserTechnologyMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !_u.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedTechnologiesIDs(); len(nodes) > 0 && !_u.mutation.TechnologiesCleared() {
+	if nodes := _u.mutation.NotificationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TechnologiesTable,
-			Columns: user.TechnologiesPrimaryKey,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &UserTechnologyCreate{config: _u.config, mutation: newUserTechnologyMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotificationPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.TechnologiesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RemovedNotificationPreferencesIDs(); len(nodes) > 0 && !_u.mutation.NotificationPreferencesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TechnologiesTable,
-			Columns: user.TechnologiesPrimaryKey,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		createE := &UserTechnologyCreate{config: _u.config, mutation: newUserTechnologyMutation(_u.config, OpCreate)}
-		createE.defaults()
-		_, specE := createE.createSpec()
-		edge.Target.Fields = specE.Fields
-		if specE.ID.Value != nil {
-			edge.Target.Fields = append(edge.Target.Fields, specE.ID)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotificationPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationPreferencesTable,
+			Columns: []string{user.NotificationPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationpreference.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CreatedTagsCleared() {
+	if _u.mutation.WebhooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CreatedTagsTable,
-			Columns: []string{user.CreatedTagsColumn},
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCreatedTagsIDs(); len(nodes) > 0 && !_u.mutation.CreatedTagsCleared() {
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CreatedTagsTable,
-			Columns: []string{user.CreatedTagsColumn},
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2057,15 +4795,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CreatedTagsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.CreatedTagsTable,
-			Columns: []string{user.CreatedTagsColumn},
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
 			},
 		}
 		for _, k := range nodes {
@@ -2,10 +2,14 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/cron"
 )
 
 // Config holds all configuration for the application
@@ -22,6 +26,59 @@ type Config struct {
 	AllowedOrigins []string
 	AllowedMethods []string
 	AllowedHeaders []string
+
+	// Background jobs
+	EventFreezeInterval     time.Duration
+	WebhookDeliveryInterval time.Duration
+
+	// Schedules are the cron expressions of the maintenance tasks, in UTC, by task name.
+	// Tasks scheduled "off" only run when triggered by an admin.
+	Schedules map[string]string
+
+	// Job runner, running the side effects of requests
+	JobWorkers      int
+	JobPollInterval time.Duration // How often idle workers look for new jobs
+
+	// TrashRetention is how long deleted projects and comments can be restored before being purged
+	TrashRetention time.Duration
+
+	// Repository import, from a forge exposing the GitHub REST API
+	ForgeHost   string // Host of the repository URLs, e.g. github.com
+	ForgeAPIURL string // Base URL of the API, pointed at a local fake forge in tests
+	ForgeToken  string // Optional, raises the rate limit of the forge
+
+	// Storage of uploaded files
+	StorageDriver    string // local or s3
+	StorageLocalDir  string
+	StoragePublicURL string // Base URL stored files are served from
+	S3Endpoint       string
+	S3Region         string
+	S3Bucket         string
+	S3AccessKeyID    string
+	S3SecretKey      string
+	MediaMaxBytes    int64
+
+	// Email, sent through SMTP or written to a directory as .eml files in development
+	MailDriver   string // smtp or file
+	MailFrom     string // Sender of the emails, e.g. HackSpark <no-reply@example.com>
+	MailDropDir  string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+
+	// PubSubDriver carries real-time updates between server instances: postgres, or memory
+	// for single instance deployments
+	PubSubDriver string
+
+	// Notification digests
+	DigestHour int    // Local hour of the day digests are sent at
+	PublicURL  string // Base URL of the API, used in the links of emails
+	AppURL     string // Base URL of the web app, used in the links of emails
+
+	// WebhookAllowPrivateNetworks lets webhooks reach private and loopback addresses, which
+	// they cannot by default so that users cannot probe the server's network
+	WebhookAllowPrivateNetworks bool
 }
 
 // Load reads configuration from environment variables
@@ -43,6 +100,58 @@ func Load() (*Config, error) {
 		AllowedHeaders: getSliceEnv("CORS_ALLOWED_HEADERS", []string{
 			"Accept", "Authorization", "Content-Type", "X-CSRF-Token",
 		}),
+
+		EventFreezeInterval:     getDurationEnv("EVENT_FREEZE_INTERVAL", time.Minute),
+		WebhookDeliveryInterval: getDurationEnv("WEBHOOK_DELIVERY_INTERVAL", 5*time.Second),
+		JobWorkers:              int(getInt64Env("JOB_WORKERS", 4)),
+		JobPollInterval:         getDurationEnv("JOB_POLL_INTERVAL", time.Second),
+		TrashRetention:          getDurationEnv("TRASH_RETENTION", 30*24*time.Hour),
+
+		Schedules: map[string]string{
+			"session_cleanup":        getEnv("SCHEDULE_SESSION_CLEANUP", "0 * * * *"),
+			"counter_reconciliation": getEnv("SCHEDULE_COUNTER_RECONCILIATION", "30 3 * * *"),
+			"trending_refresh":       getEnv("SCHEDULE_TRENDING_REFRESH", "*/15 * * * *"),
+			"digest":                 getEnv("SCHEDULE_DIGEST", "*/15 * * * *"),
+			"purge":                  getEnv("SCHEDULE_PURGE", "0 * * * *"),
+		},
+
+		ForgeHost:   getEnv("FORGE_HOST", "github.com"),
+		ForgeAPIURL: getEnv("FORGE_API_URL", "https://api.github.com"),
+		ForgeToken:  getEnv("FORGE_TOKEN", ""),
+
+		StorageDriver:    getEnv("STORAGE_DRIVER", "local"),
+		StorageLocalDir:  getEnv("STORAGE_LOCAL_DIR", "data/media"),
+		StoragePublicURL: getEnv("STORAGE_PUBLIC_URL", ""),
+		S3Endpoint:       getEnv("S3_ENDPOINT", "https://s3.amazonaws.com"),
+		S3Region:         getEnv("S3_REGION", "us-east-1"),
+		S3Bucket:         getEnv("S3_BUCKET", ""),
+		S3AccessKeyID:    getEnv("S3_ACCESS_KEY_ID", ""),
+		S3SecretKey:      getEnv("S3_SECRET_ACCESS_KEY", ""),
+		MediaMaxBytes:    getInt64Env("MEDIA_MAX_BYTES", 10<<20),
+
+		MailDriver:   getEnv("MAIL_DRIVER", "file"),
+		MailFrom:     getEnv("MAIL_FROM", "HackSpark <no-reply@hackspark.local>"),
+		MailDropDir:  getEnv("MAIL_DROP_DIR", "data/mail"),
+		SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+		SMTPPort:     int(getInt64Env("SMTP_PORT", 1025)),
+		SMTPUsername: getEnv("SMTP_USERNAME", ""),
+		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+
+		PubSubDriver: getEnv("PUBSUB_DRIVER", "postgres"),
+
+		DigestHour: int(getInt64Env("DIGEST_HOUR", 8)),
+		PublicURL:  getEnv("PUBLIC_URL", ""),
+		AppURL:     getEnv("APP_URL", "http://localhost:3000"),
+
+		WebhookAllowPrivateNetworks: getEnv("WEBHOOK_ALLOW_PRIVATE_NETWORKS", "false") == "true",
+	}
+
+	// Local files are served by the API itself
+	if cfg.StoragePublicURL == "" && cfg.StorageDriver == "local" {
+		cfg.StoragePublicURL = "http://localhost:" + cfg.Port + "/media"
+	}
+	if cfg.PublicURL == "" {
+		cfg.PublicURL = "http://localhost:" + cfg.Port
 	}
 
 	// Validate configuration
@@ -82,6 +191,83 @@ func (c *Config) validate() error {
 		return fmt.Errorf("invalid log level: %s", c.LogLevel)
 	}
 
+	if c.EventFreezeInterval <= 0 {
+		return fmt.Errorf("invalid event freeze interval: %s", c.EventFreezeInterval)
+	}
+
+	if c.WebhookDeliveryInterval <= 0 {
+		return fmt.Errorf("invalid webhook delivery interval: %s", c.WebhookDeliveryInterval)
+	}
+
+	if c.JobWorkers <= 0 {
+		return fmt.Errorf("invalid number of job workers: %d", c.JobWorkers)
+	}
+
+	if c.JobPollInterval <= 0 {
+		return fmt.Errorf("invalid job poll interval: %s", c.JobPollInterval)
+	}
+
+	for name, expr := range c.Schedules {
+		if expr == "off" {
+			continue
+		}
+		if _, err := cron.Parse(expr); err != nil {
+			return fmt.Errorf("invalid schedule of %s: %w", name, err)
+		}
+	}
+
+	if c.TrashRetention <= 0 {
+		return fmt.Errorf("invalid trash retention: %s", c.TrashRetention)
+	}
+
+	if u, err := url.Parse(c.ForgeAPIURL); err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("invalid forge API URL: %s", c.ForgeAPIURL)
+	}
+
+	switch c.StorageDriver {
+	case "local":
+		if c.StorageLocalDir == "" {
+			return fmt.Errorf("local storage requires STORAGE_LOCAL_DIR")
+		}
+	case "s3":
+		if c.S3Bucket == "" || c.S3AccessKeyID == "" || c.S3SecretKey == "" {
+			return fmt.Errorf("s3 storage requires S3_BUCKET, S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY")
+		}
+	default:
+		return fmt.Errorf("invalid storage driver: %s", c.StorageDriver)
+	}
+
+	if c.MediaMaxBytes <= 0 {
+		return fmt.Errorf("invalid media max bytes: %d", c.MediaMaxBytes)
+	}
+
+	switch c.MailDriver {
+	case "file":
+		if c.MailDropDir == "" {
+			return fmt.Errorf("file mail driver requires MAIL_DROP_DIR")
+		}
+	case "smtp":
+		if c.SMTPHost == "" || c.SMTPPort <= 0 {
+			return fmt.Errorf("smtp mail driver requires SMTP_HOST and SMTP_PORT")
+		}
+	default:
+		return fmt.Errorf("invalid mail driver: %s", c.MailDriver)
+	}
+
+	if c.PubSubDriver != "postgres" && c.PubSubDriver != "memory" {
+		return fmt.Errorf("invalid pub/sub driver: %s", c.PubSubDriver)
+	}
+
+	if c.DigestHour < 0 || c.DigestHour > 23 {
+		return fmt.Errorf("invalid digest hour: %d", c.DigestHour)
+	}
+
+	for _, u := range []string{c.PublicURL, c.AppURL} {
+		if parsed, err := url.Parse(u); err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+			return fmt.Errorf("invalid base URL: %s", u)
+		}
+	}
+
 	return nil
 }
 
@@ -99,3 +285,21 @@ func getSliceEnv(key string, defaultValue []string) []string {
 	}
 	return defaultValue
 }
+
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	if value, exists := os.LookupEnv(key); exists {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return defaultValue
+}
+
+func getInt64Env(key string, defaultValue int64) int64 {
+	if value, exists := os.LookupEnv(key); exists {
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return n
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,53 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+)
+
+// searchStatements add what ent cannot describe in the schema: the tsvector columns
+// and the full-text and trigram indexes queried by the search service. They are
+// generated columns, so PostgreSQL keeps them in sync on every write and ent never
+// needs to know about them. Every statement is idempotent.
+var searchStatements = []string{
+	`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+
+	`ALTER TABLE projects ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+		setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+		setweight(to_tsvector('english', coalesce(description, '')), 'B')
+	) STORED`,
+	`CREATE INDEX IF NOT EXISTS projects_search_vector_idx ON projects USING GIN (search_vector)`,
+	`CREATE INDEX IF NOT EXISTS projects_name_trgm_idx ON projects USING GIN (name gin_trgm_ops)`,
+
+	// Names are not natural language, so they are indexed without stemming
+	`ALTER TABLE users ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+		setweight(to_tsvector('simple', coalesce(username, '')), 'A') ||
+		setweight(to_tsvector('simple', coalesce(first_name, '') || ' ' || coalesce(last_name, '')), 'A') ||
+		setweight(to_tsvector('english', coalesce(bio, '')), 'B')
+	) STORED`,
+	`CREATE INDEX IF NOT EXISTS users_search_vector_idx ON users USING GIN (search_vector)`,
+	`CREATE INDEX IF NOT EXISTS users_username_trgm_idx ON users USING GIN (username gin_trgm_ops)`,
+
+	`ALTER TABLE tags ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+		setweight(to_tsvector('simple', coalesce(name, '')), 'A') ||
+		setweight(to_tsvector('english', coalesce(description, '')), 'B')
+	) STORED`,
+	`CREATE INDEX IF NOT EXISTS tags_search_vector_idx ON tags USING GIN (search_vector)`,
+	`CREATE INDEX IF NOT EXISTS tags_name_trgm_idx ON tags USING GIN (name gin_trgm_ops)`,
+}
+
+// Migrate runs the ent auto migration followed by the PostgreSQL specific statements
+func Migrate(ctx context.Context, client *ent.Client) error {
+	if err := client.Schema.Create(ctx); err != nil {
+		return fmt.Errorf("creating schema resources: %w", err)
+	}
+
+	for _, stmt := range searchStatements {
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("creating search resources: %w", err)
+		}
+	}
+	return nil
+}
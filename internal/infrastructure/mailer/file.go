@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileDrop writes emails as .eml files to a directory instead of sending them. It suits
+// development, where the files can be opened with any mail client.
+type FileDrop struct {
+	dir  string
+	from string
+}
+
+// NewFileDrop creates a sender writing to dir
+func NewFileDrop(dir, from string) (*FileDrop, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating mail directory: %w", err)
+	}
+	return &FileDrop{
+		dir:  dir,
+		from: from,
+	}, nil
+}
+
+// Send writes the message to a temporary file first, so readers never see a partial email
+func (f *FileDrop) Send(_ context.Context, msg Message) error {
+	raw, err := build(f.from, msg)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(f.dir, ".mail-*")
+	if err != nil {
+		return fmt.Errorf("creating file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing file: %w", err)
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405.000000000"), filepath.Base(tmp.Name())[len(".mail-"):])
+	return os.Rename(tmp.Name(), filepath.Join(f.dir, name))
+}
@@ -0,0 +1,69 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+)
+
+// SMTPConfig holds the settings of an SMTP server
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username and Password are optional, servers like local stand-ins accept anonymous mail
+	Username string
+	Password string
+	From     string
+}
+
+// SMTP sends emails through an SMTP server, upgrading the connection with STARTTLS when the
+// server supports it
+type SMTP struct {
+	addr string
+	auth smtp.Auth
+	from string
+	// envelopeFrom is the bare address of From, used for the SMTP envelope
+	envelopeFrom string
+}
+
+// NewSMTP creates a sender for the SMTP server
+func NewSMTP(cfg SMTPConfig) (*SMTP, error) {
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", cfg.From, err)
+	}
+
+	s := &SMTP{
+		addr:         net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		from:         from.String(),
+		envelopeFrom: from.Address,
+	}
+	if cfg.Username != "" {
+		s.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return s, nil
+}
+
+// Send delivers the message. net/smtp does not take a context, so ctx only stops sends that
+// have not started yet.
+func (s *SMTP) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	raw, err := build(s.from, msg)
+	if err != nil {
+		return err
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return err
+	}
+	if err := smtp.SendMail(s.addr, s.auth, s.envelopeFrom, []string{to.Address}, raw); err != nil {
+		return fmt.Errorf("sending email: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// received is what the local SMTP server got from a client
+type received struct {
+	from string
+	to   []string
+	data []byte
+}
+
+// listenSMTP starts an SMTP server accepting a single anonymous email, without STARTTLS
+func listenSMTP(t *testing.T) (SMTPConfig, <-chan received) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listening: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	out := make(chan received, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		tp := textproto.NewConn(conn)
+
+		var r received
+		_ = tp.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			verb, arg, _ := strings.Cut(line, " ")
+			switch strings.ToUpper(verb) {
+			case "EHLO", "HELO":
+				_ = tp.PrintfLine("250-localhost")
+				_ = tp.PrintfLine("250 8BITMIME")
+			case "MAIL":
+				// Parameters, such as BODY=8BITMIME, follow the address
+				addr, _, _ := strings.Cut(strings.TrimPrefix(arg, "FROM:"), " ")
+				r.from = strings.Trim(addr, "<>")
+				_ = tp.PrintfLine("250 OK")
+			case "RCPT":
+				r.to = append(r.to, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+				_ = tp.PrintfLine("250 OK")
+			case "DATA":
+				_ = tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+				r.data, err = tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				_ = tp.PrintfLine("250 OK")
+			case "QUIT":
+				_ = tp.PrintfLine("221 Bye")
+				out <- r
+				return
+			default:
+				_ = tp.PrintfLine("502 Command not implemented")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return SMTPConfig{Host: host, Port: p, From: "HackSpark <digest@hackspark.dev>"}, out
+}
+
+func TestSMTPSend(t *testing.T) {
+	cfg, out := listenSMTP(t)
+	s, err := NewSMTP(cfg)
+	if err != nil {
+		t.Fatalf("NewSMTP() error = %v", err)
+	}
+
+	err = s.Send(context.Background(), Message{
+		To:      "Ada <ada@example.com>",
+		Subject: "Your daily HackSpark digest: 2 new notifications ✨",
+		Text:    "Hello Ada,\n.\nbob liked your project",
+		HTML:    "<p>Hello Ada,</p><p>bob liked your project</p>",
+		Headers: map[string]string{
+			"list-unsubscribe":      "<https://api.hackspark.dev/api/v1/notifications/unsubscribe?token=abc>",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	r := <-out
+
+	if r.from != "digest@hackspark.dev" {
+		t.Errorf("envelope sender = %q, want the bare address of From", r.from)
+	}
+	if len(r.to) != 1 || r.to[0] != "ada@example.com" {
+		t.Errorf("envelope recipients = %v, want the bare address of To", r.to)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(r.data)))
+	if err != nil {
+		t.Fatalf("parsing email: %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("decoding subject: %v", err)
+	}
+	headers := map[string]string{
+		"From":                  "\"HackSpark\" <digest@hackspark.dev>",
+		"To":                    "Ada <ada@example.com>",
+		"Subject":               "Your daily HackSpark digest: 2 new notifications ✨",
+		"MIME-Version":          "1.0",
+		"List-Unsubscribe":      "<https://api.hackspark.dev/api/v1/notifications/unsubscribe?token=abc>",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+	for name, want := range headers {
+		got := msg.Header.Get(name)
+		if name == "Subject" {
+			got = subject
+		}
+		if got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+	if id := msg.Header.Get("Message-ID"); !strings.HasSuffix(id, "@hackspark.dev>") {
+		t.Errorf("Message-ID = %q, want an ID of the sender domain", id)
+	}
+	if _, err := msg.Header.Date(); err != nil {
+		t.Errorf("Date header: %v", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q, want multipart/alternative", msg.Header.Get("Content-Type"))
+	}
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	for _, want := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", "Hello Ada,\n.\nbob liked your project"},
+		{"text/html; charset=utf-8", "<p>Hello Ada,</p><p>bob liked your project</p>"},
+	} {
+		part, err := parts.NextPart()
+		if err != nil {
+			t.Fatalf("reading %s part: %v", want.contentType, err)
+		}
+		body, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("reading %s part: %v", want.contentType, err)
+		}
+		if ct := part.Header.Get("Content-Type"); ct != want.contentType {
+			t.Errorf("part Content-Type = %q, want %q", ct, want.contentType)
+		}
+		if string(body) != want.body {
+			t.Errorf("%s part = %q, want %q", want.contentType, body, want.body)
+		}
+	}
+	if _, err := parts.NextPart(); err != io.EOF {
+		t.Errorf("extra part after the HTML one, error = %v", err)
+	}
+}
+
+func TestSMTPInvalidRecipient(t *testing.T) {
+	s, err := NewSMTP(SMTPConfig{Host: "127.0.0.1", Port: 1, From: "digest@hackspark.dev"})
+	if err != nil {
+		t.Fatalf("NewSMTP() error = %v", err)
+	}
+	// The message is refused before connecting, so the closed port does not matter
+	if err := s.Send(context.Background(), Message{To: "not an address"}); err == nil || strings.Contains(err.Error(), "sending email") {
+		t.Errorf("Send() error = %v, want an invalid recipient error", err)
+	}
+}
+
+func TestBuildHeaderInjection(t *testing.T) {
+	raw, err := build("digest@hackspark.dev", Message{
+		To:      "ada@example.com",
+		Subject: "Hello\r\nBcc: eve@example.com",
+		Headers: map[string]string{"List-Unsubscribe": "<https://example.com>\r\nBcc: eve@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("build() error = %v", err)
+	}
+
+	msg, err := mail.ReadMessage(bufio.NewReader(strings.NewReader(string(raw))))
+	if err != nil {
+		t.Fatalf("parsing email: %v", err)
+	}
+	if bcc := msg.Header.Get("Bcc"); bcc != "" {
+		t.Errorf("Bcc = %q, want no injected header", bcc)
+	}
+}
+
+func TestNewSMTPInvalidSender(t *testing.T) {
+	if _, err := NewSMTP(SMTPConfig{Host: "localhost", Port: 25, From: "not an address"}); err == nil {
+		t.Error("NewSMTP() succeeded, want an invalid sender error")
+	}
+}
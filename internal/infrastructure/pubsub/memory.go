@@ -0,0 +1,26 @@
+package pubsub
+
+import (
+	"context"
+)
+
+// Memory delivers messages within the process. It suits single instance deployments.
+type Memory struct {
+	hub *hub
+}
+
+// NewMemory creates an in-process pub/sub
+func NewMemory() *Memory {
+	return &Memory{
+		hub: newHub(),
+	}
+}
+
+func (m *Memory) Publish(_ context.Context, topic string, payload []byte) error {
+	m.hub.deliver(Message{Topic: topic, Payload: payload})
+	return nil
+}
+
+func (m *Memory) Subscribe(topics ...string) *Subscription {
+	return m.hub.subscribe(topics...)
+}
@@ -0,0 +1,104 @@
+package pubsub
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/lib/pq"
+)
+
+const (
+	// channel is the PostgreSQL notification channel every instance listens on
+	channel = "hackspark_pubsub"
+
+	// maxPayload keeps notifications under the 8000 bytes PostgreSQL accepts
+	maxPayload = 7900
+)
+
+// Execer runs a statement, as the ent client and database/sql do
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// Postgres delivers messages to every server instance through PostgreSQL LISTEN/NOTIFY.
+// Messages published while an instance reconnects to the database are lost for it.
+type Postgres struct {
+	hub      *hub
+	db       Execer
+	listener *pq.Listener
+}
+
+// NewPostgres creates a pub/sub publishing through db and listening on a dedicated connection
+// opened with the connection string. Run must be called for messages to be delivered.
+func NewPostgres(db Execer, connString string) (*Postgres, error) {
+	listener := pq.NewListener(connString, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Error(context.Background()).Err(err).Msg("Pub/sub listener connection error")
+		}
+	})
+	if err := listener.Listen(channel); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("listening on %s: %w", channel, err)
+	}
+
+	return &Postgres{
+		hub:      newHub(),
+		db:       db,
+		listener: listener,
+	}, nil
+}
+
+// Publish notifies every instance, this one included once the notification comes back
+func (p *Postgres) Publish(ctx context.Context, topic string, payload []byte) error {
+	raw, err := json.Marshal(Message{Topic: topic, Payload: payload})
+	if err != nil {
+		return err
+	}
+	if len(raw) > maxPayload {
+		return fmt.Errorf("message of %d bytes on %s is too large to publish", len(raw), topic)
+	}
+
+	if _, err := p.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", channel, string(raw)); err != nil {
+		return fmt.Errorf("publishing on %s: %w", topic, err)
+	}
+	return nil
+}
+
+func (p *Postgres) Subscribe(topics ...string) *Subscription {
+	return p.hub.subscribe(topics...)
+}
+
+// Run delivers the notifications received to the subscriptions until ctx is cancelled
+func (p *Postgres) Run(ctx context.Context) {
+	defer p.listener.Close()
+
+	// Pinging now and then detects connections that died silently
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.listener.Ping(); err != nil {
+				log.Warn(ctx).Err(err).Msg("Pub/sub listener ping failed")
+			}
+		case n := <-p.listener.Notify:
+			// A nil notification means the connection was re-established
+			if n == nil {
+				continue
+			}
+			var msg Message
+			if err := json.Unmarshal([]byte(n.Extra), &msg); err != nil {
+				log.Error(ctx).Err(err).Msg("Failed to decode pub/sub message")
+				continue
+			}
+			p.hub.deliver(msg)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Package pubsub carries messages between the parts of the API that produce real-time updates
+// and the connections streaming them to clients, possibly across server instances.
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// subscriptionBuffer is the number of messages a subscription holds before dropping new ones
+const subscriptionBuffer = 64
+
+// Message is a payload published on a topic
+type Message struct {
+	Topic   string `json:"topic"`
+	Payload []byte `json:"payload"`
+}
+
+// PubSub delivers the messages published on a topic to its subscriptions. Delivery is best
+// effort: a subscription that does not keep up misses messages rather than slowing publishers.
+type PubSub interface {
+	Publish(ctx context.Context, topic string, payload []byte) error
+	Subscribe(topics ...string) *Subscription
+}
+
+// hub fans messages out to the subscriptions of this process, whichever transport brought them
+type hub struct {
+	mu   sync.RWMutex
+	subs map[string]map[*Subscription]struct{}
+}
+
+func newHub() *hub {
+	return &hub{
+		subs: make(map[string]map[*Subscription]struct{}),
+	}
+}
+
+func (h *hub) subscribe(topics ...string) *Subscription {
+	s := &Subscription{
+		hub:    h,
+		ch:     make(chan Message, subscriptionBuffer),
+		topics: make(map[string]struct{}),
+	}
+	s.Add(topics...)
+	return s
+}
+
+func (h *hub) deliver(msg Message) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for s := range h.subs[msg.Topic] {
+		select {
+		case s.ch <- msg:
+		default:
+		}
+	}
+}
+
+// Subscription receives the messages of the topics it is subscribed to on C
+type Subscription struct {
+	hub    *hub
+	ch     chan Message
+	topics map[string]struct{}
+	closed bool
+}
+
+// C returns the channel messages are received on. It is closed by Close.
+func (s *Subscription) C() <-chan Message {
+	return s.ch
+}
+
+// Add subscribes to more topics
+func (s *Subscription) Add(topics ...string) {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	for _, t := range topics {
+		if s.hub.subs[t] == nil {
+			s.hub.subs[t] = make(map[*Subscription]struct{})
+		}
+		s.hub.subs[t][s] = struct{}{}
+		s.topics[t] = struct{}{}
+	}
+}
+
+// Remove unsubscribes from topics
+func (s *Subscription) Remove(topics ...string) {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	for _, t := range topics {
+		s.hub.unsubscribe(s, t)
+	}
+}
+
+// Topics returns the number of topics the subscription is subscribed to
+func (s *Subscription) Topics() int {
+	s.hub.mu.RLock()
+	defer s.hub.mu.RUnlock()
+
+	return len(s.topics)
+}
+
+// Close unsubscribes from every topic and closes C
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	for t := range s.topics {
+		s.hub.unsubscribe(s, t)
+	}
+	s.closed = true
+	close(s.ch)
+}
+
+// unsubscribe must be called with the lock held
+func (h *hub) unsubscribe(s *Subscription, topic string) {
+	delete(s.topics, topic)
+	delete(h.subs[topic], s)
+	if len(h.subs[topic]) == 0 {
+		delete(h.subs, topic)
+	}
+}
@@ -0,0 +1,244 @@
+package pubsub
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/testdb"
+)
+
+// receive returns the next message of the subscription, failing after a while
+func receive(t *testing.T, s *Subscription) Message {
+	t.Helper()
+	select {
+	case msg, ok := <-s.C():
+		if !ok {
+			t.Fatal("subscription closed")
+		}
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received")
+	}
+	return Message{}
+}
+
+// pending returns the number of messages waiting on the subscription
+func pending(s *Subscription) int {
+	return len(s.ch)
+}
+
+func TestMemoryFanOut(t *testing.T) {
+	ps := NewMemory()
+	ctx := context.Background()
+
+	a := ps.Subscribe("project:1", "user:1")
+	b := ps.Subscribe("project:1")
+	other := ps.Subscribe("project:2")
+	defer a.Close()
+	defer b.Close()
+	defer other.Close()
+
+	if err := ps.Publish(ctx, "project:1", []byte("liked")); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	for name, s := range map[string]*Subscription{"a": a, "b": b} {
+		if msg := receive(t, s); msg.Topic != "project:1" || string(msg.Payload) != "liked" {
+			t.Errorf("subscription %s received %+v", name, msg)
+		}
+	}
+	if n := pending(other); n != 0 {
+		t.Errorf("subscription of another topic received %d messages", n)
+	}
+
+	if err := ps.Publish(ctx, "user:1", []byte("notified")); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	if msg := receive(t, a); msg.Topic != "user:1" {
+		t.Errorf("received %+v, want the message of user:1", msg)
+	}
+	if n := pending(b); n != 0 {
+		t.Errorf("subscription without the topic received %d messages", n)
+	}
+}
+
+func TestMemoryDropsWhenFull(t *testing.T) {
+	ps := NewMemory()
+	slow := ps.Subscribe("t")
+	defer slow.Close()
+
+	// Publishing never blocks on a subscription that does not keep up
+	for i := range subscriptionBuffer + 10 {
+		if err := ps.Publish(context.Background(), "t", []byte(fmt.Sprint(i))); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+	if n := pending(slow); n != subscriptionBuffer {
+		t.Fatalf("pending = %d, want %d", n, subscriptionBuffer)
+	}
+	// The oldest messages are kept, the newest dropped
+	if msg := receive(t, slow); string(msg.Payload) != "0" {
+		t.Errorf("first message = %s, want 0", msg.Payload)
+	}
+}
+
+func TestSubscriptionAddRemove(t *testing.T) {
+	ps := NewMemory()
+	s := ps.Subscribe("a")
+	defer s.Close()
+
+	s.Add("b", "c")
+	if got := s.Topics(); got != 3 {
+		t.Errorf("Topics() = %d, want 3", got)
+	}
+	s.Remove("a", "c", "unknown")
+	if got := s.Topics(); got != 1 {
+		t.Errorf("Topics() = %d, want 1", got)
+	}
+
+	_ = ps.Publish(context.Background(), "a", []byte("removed"))
+	_ = ps.Publish(context.Background(), "b", []byte("kept"))
+	if msg := receive(t, s); msg.Topic != "b" {
+		t.Errorf("received %+v, want the message of b only", msg)
+	}
+
+	// Topics nobody listens to are forgotten
+	ps.hub.mu.RLock()
+	_, known := ps.hub.subs["a"]
+	ps.hub.mu.RUnlock()
+	if known {
+		t.Error("hub still holds the removed topic")
+	}
+}
+
+func TestSubscriptionClose(t *testing.T) {
+	ps := NewMemory()
+	s := ps.Subscribe("a")
+
+	s.Close()
+	s.Close()
+	if _, ok := <-s.C(); ok {
+		t.Error("C() still open after Close")
+	}
+
+	// Adding to a closed subscription must not make the hub send on its closed channel
+	s.Add("a", "b")
+	if got := s.Topics(); got != 0 {
+		t.Errorf("Topics() = %d after Close, want 0", got)
+	}
+	if err := ps.Publish(context.Background(), "a", []byte("x")); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	ps.hub.mu.RLock()
+	n := len(ps.hub.subs)
+	ps.hub.mu.RUnlock()
+	if n != 0 {
+		t.Errorf("hub holds %d topics after Close, want 0", n)
+	}
+}
+
+func TestMemoryConcurrentUse(t *testing.T) {
+	ps := NewMemory()
+	ctx := context.Background()
+
+	// Publishing while subscriptions change and close must neither race nor panic
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := range 200 {
+				_ = ps.Publish(ctx, fmt.Sprintf("t%d", j%4), []byte("x"))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				s := ps.Subscribe(fmt.Sprintf("t%d", i%4))
+				s.Add("t0", "t1")
+				s.Remove("t1")
+				s.Close()
+				s.Add("t2")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// execer records the statements run by the Postgres pub/sub
+type execer struct {
+	args [][]any
+}
+
+func (e *execer) ExecContext(_ context.Context, _ string, args ...any) (sql.Result, error) {
+	e.args = append(e.args, args)
+	return nil, nil
+}
+
+func TestPostgresPublish(t *testing.T) {
+	db := &execer{}
+	p := &Postgres{hub: newHub(), db: db}
+
+	if err := p.Publish(context.Background(), "user:1", []byte(`{"type":"notification.received"}`)); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	if len(db.args) != 1 || db.args[0][0] != channel {
+		t.Fatalf("statements = %v, want a notification on %s", db.args, channel)
+	}
+	var msg Message
+	if err := json.Unmarshal([]byte(db.args[0][1].(string)), &msg); err != nil {
+		t.Fatalf("decoding notification: %v", err)
+	}
+	if msg.Topic != "user:1" || string(msg.Payload) != `{"type":"notification.received"}` {
+		t.Errorf("notification = %+v", msg)
+	}
+
+	// PostgreSQL refuses notifications of 8000 bytes or more, so they are not even sent
+	err := p.Publish(context.Background(), "user:1", []byte(strings.Repeat("x", maxPayload)))
+	if err == nil || !strings.Contains(err.Error(), "too large") {
+		t.Errorf("Publish() of a large payload error = %v, want too large", err)
+	}
+	if len(db.args) != 1 {
+		t.Errorf("large payload was sent")
+	}
+}
+
+func TestPostgresRoundTrip(t *testing.T) {
+	client, dsn := testdb.OpenDSN(t)
+
+	p, err := NewPostgres(client, dsn)
+	if err != nil {
+		t.Fatalf("NewPostgres() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go p.Run(ctx)
+
+	// Other tests may share the database, the topic is left to this one
+	topic := fmt.Sprintf("test:%d", time.Now().UnixNano())
+	s := p.Subscribe(topic)
+	defer s.Close()
+
+	// The listener may still be connecting, messages published before it listens are lost
+	deadline := time.After(5 * time.Second)
+	for {
+		if err := p.Publish(ctx, topic, []byte("hello")); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+		select {
+		case msg := <-s.C():
+			if msg.Topic != topic || string(msg.Payload) != "hello" {
+				t.Errorf("received %+v, want the published message", msg)
+			}
+			return
+		case <-time.After(100 * time.Millisecond):
+		case <-deadline:
+			t.Fatal("no message received")
+		}
+	}
+}
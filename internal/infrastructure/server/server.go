@@ -13,7 +13,23 @@ import (
 
 	"github.com/jorge-j1m/hackspark_server/ent"
 	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/config"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/database"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/mailer"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/pubsub"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/storage"
 	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/router"
+	"github.com/jorge-j1m/hackspark_server/internal/service/counters"
+	"github.com/jorge-j1m/hackspark_server/internal/service/digest"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"github.com/jorge-j1m/hackspark_server/internal/service/hackathon"
+	"github.com/jorge-j1m/hackspark_server/internal/service/jobs"
+	"github.com/jorge-j1m/hackspark_server/internal/service/notify"
+	"github.com/jorge-j1m/hackspark_server/internal/service/purge"
+	"github.com/jorge-j1m/hackspark_server/internal/service/realtime"
+	"github.com/jorge-j1m/hackspark_server/internal/service/scheduler"
+	"github.com/jorge-j1m/hackspark_server/internal/service/sessions"
+	"github.com/jorge-j1m/hackspark_server/internal/service/trending"
+	"github.com/jorge-j1m/hackspark_server/internal/service/webhook"
 
 	"github.com/rs/zerolog/log"
 )
@@ -23,6 +39,15 @@ type Server struct {
 	server *http.Server
 	client *ent.Client
 	config *config.Config
+
+	// cancel stops the background workers started alongside the HTTP server
+	cancel context.CancelFunc
+
+	// jobs runs the side effects of requests, it is drained on shutdown
+	jobs *jobs.Runner
+
+	// scheduler runs the maintenance tasks, those running are waited for on shutdown
+	scheduler *scheduler.Scheduler
 }
 
 // New creates a new server instance
@@ -45,18 +70,60 @@ func (s *Server) Start() error {
 	// Run the auto migration tool.
 	// NOTE: In a production environment, it's recommended to use
 	// versioned migrations instead of auto-migration.
-	if err := client.Schema.Create(ctx); err != nil {
+	if err := database.Migrate(ctx, client); err != nil {
 		log.Fatal().Err(err).Msg("failed creating schema resources")
 	}
 
+	blob, err := newStorage(s.config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed initializing file storage")
+	}
+
+	sender, err := newMailer(s.config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed initializing mailer")
+	}
+
+	// Start background workers
+	workerCtx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	go hackathon.NewFreezer(client, s.config.EventFreezeInterval).Run(workerCtx)
+	s.scheduler, err = newScheduler(s.config, client, blob, sender)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed initializing scheduler")
+	}
+	go s.scheduler.Run(workerCtx)
+
+	// Jobs tell connected clients about their notifications through pub/sub
+	var ps pubsub.PubSub = pubsub.NewMemory()
+	if s.config.PubSubDriver == "postgres" {
+		pg, err := pubsub.NewPostgres(client, s.config.DatabaseString)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed initializing pub/sub")
+		}
+		go pg.Run(workerCtx)
+		ps = pg
+	}
+	s.jobs = jobs.NewRunner(client, s.config.JobWorkers, s.config.JobPollInterval)
+	counters.Register(s.jobs, client)
+	notify.Register(s.jobs, notify.NewDispatcher(client, ps))
+	s.jobs.Start()
+	go webhook.NewDeliverer(client, s.config.WebhookDeliveryInterval, s.config.WebhookAllowPrivateNetworks).Run(workerCtx)
+
 	// Initialize router
-	r := router.New(s.config, client)
+	bus := eventbus.New()
+	realtime.NewRelay(client, ps).Subscribe(bus)
+	// Shutting down does not cancel the requests, so the streams, which never end on their
+	// own, are ended on shutdown instead of holding it up until it times out
+	streams, stopStreams := context.WithCancel(context.Background())
+	r := router.New(s.config, client, bus, blob, ps, webhook.NewEnqueuer(client), s.scheduler, streams)
 
 	// Configure HTTP server
 	s.server = &http.Server{
 		Addr:    ":" + s.config.Port,
 		Handler: r,
 	}
+	s.server.RegisterOnShutdown(stopStreams)
 
 	// Start server in a goroutine
 	go func() {
@@ -69,6 +136,79 @@ func (s *Server) Start() error {
 	return nil
 }
 
+// newScheduler creates the scheduler of the maintenance tasks, on the schedules of the config
+func newScheduler(cfg *config.Config, client *ent.Client, blob storage.Blob, sender mailer.Sender) (*scheduler.Scheduler, error) {
+	refresher := trending.NewRefresher(client)
+	purger := purge.NewPurger(client, blob, cfg.TrashRetention)
+	digests := digest.NewScheduler(client, sender, digest.Config{
+		Hour:      cfg.DigestHour,
+		PublicURL: cfg.PublicURL,
+		AppURL:    cfg.AppURL,
+	})
+
+	tasks := []struct {
+		name string
+		run  scheduler.Func
+	}{
+		{"session_cleanup", func(ctx context.Context) error {
+			n, err := sessions.DeleteExpired(ctx, client)
+			if n > 0 {
+				log.Info().Msgf("Deleted %d expired sessions", n)
+			}
+			return err
+		}},
+		{"counter_reconciliation", func(ctx context.Context) error {
+			n, err := counters.Reconcile(ctx, client)
+			if n > 0 {
+				log.Warn().Msgf("Fixed the counters of %d rows", n)
+			}
+			return err
+		}},
+		{"trending_refresh", refresher.Refresh},
+		{"digest", func(ctx context.Context) error {
+			return digests.SendDue(ctx, time.Now())
+		}},
+		{"purge", purger.Purge},
+	}
+
+	sched := scheduler.New(client)
+	for _, t := range tasks {
+		if err := sched.Add(t.name, cfg.Schedules[t.name], t.run); err != nil {
+			return nil, err
+		}
+	}
+	return sched, nil
+}
+
+// newStorage creates the storage of uploaded files configured by the storage driver
+func newStorage(cfg *config.Config) (storage.Blob, error) {
+	if cfg.StorageDriver == "s3" {
+		return storage.NewS3(storage.S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretKey,
+			PublicURL:       cfg.StoragePublicURL,
+		})
+	}
+	return storage.NewLocal(cfg.StorageLocalDir, cfg.StoragePublicURL)
+}
+
+// newMailer creates the sender of emails configured by the mail driver
+func newMailer(cfg *config.Config) (mailer.Sender, error) {
+	if cfg.MailDriver == "smtp" {
+		return mailer.NewSMTP(mailer.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.MailFrom,
+		})
+	}
+	return mailer.NewFileDrop(cfg.MailDropDir, cfg.MailFrom)
+}
+
 // Shutdown gracefully shuts down the server
 func (s *Server) Shutdown() {
 	// Create shutdown context with timeout
@@ -82,6 +222,24 @@ func (s *Server) Shutdown() {
 		log.Error().Err(err).Msg("Server shutdown error")
 	}
 
+	// Let the running jobs finish, the others stay queued for the next start. The drain has
+	// its own timeout, whatever time the HTTP server took to shut down
+	if s.jobs != nil {
+		jobsCtx, cancelJobs := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancelJobs()
+		if err := s.jobs.Shutdown(jobsCtx); err != nil {
+			log.Error().Err(err).Msg("Job runner shutdown error")
+		}
+	}
+
+	// Stop background workers
+	if s.cancel != nil {
+		s.cancel()
+	}
+	if s.scheduler != nil {
+		s.scheduler.Wait()
+	}
+
 	// Close database connection
 	if s.client != nil {
 		// Blocks until all connections are returned to the pool. i.e. all transactions are committed.
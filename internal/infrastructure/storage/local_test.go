@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestLocal(t *testing.T) (*Local, string) {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), "media")
+	l, err := NewLocal(dir, "http://localhost:8080/media/")
+	if err != nil {
+		t.Fatalf("NewLocal() error = %v", err)
+	}
+	return l, dir
+}
+
+func TestLocalPut(t *testing.T) {
+	l, dir := newTestLocal(t)
+	ctx := context.Background()
+
+	if err := l.Put(ctx, "projects/p1/a.png", strings.NewReader("first"), 5, "image/png"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err := l.Put(ctx, "projects/p1/a.png", strings.NewReader("second"), 6, "image/png"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "projects", "p1", "a.png"))
+	if err != nil {
+		t.Fatalf("reading stored file: %v", err)
+	}
+	if string(got) != "second" {
+		t.Errorf("stored content = %q, want the last one put", got)
+	}
+
+	// Nothing but the file is left behind, temporary files included
+	entries, err := os.ReadDir(filepath.Join(dir, "projects", "p1"))
+	if err != nil {
+		t.Fatalf("listing directory: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("directory holds %d entries, want 1", len(entries))
+	}
+}
+
+func TestLocalDelete(t *testing.T) {
+	l, dir := newTestLocal(t)
+	ctx := context.Background()
+
+	if err := l.Put(ctx, "a.png", strings.NewReader("data"), 4, "image/png"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err := l.Delete(ctx, "a.png"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "a.png")); !os.IsNotExist(err) {
+		t.Errorf("file still exists after Delete(), stat error = %v", err)
+	}
+	if err := l.Delete(ctx, "a.png"); err != nil {
+		t.Errorf("Delete() of a missing key error = %v, want nil", err)
+	}
+}
+
+func TestLocalInvalidKeys(t *testing.T) {
+	l, _ := newTestLocal(t)
+	ctx := context.Background()
+
+	for _, key := range []string{"", "/etc/passwd", "../outside", "a/../../outside", "a//b", "a/./b", `a\b`} {
+		t.Run(key, func(t *testing.T) {
+			if err := l.Put(ctx, key, strings.NewReader("data"), 4, "image/png"); err == nil {
+				t.Errorf("Put(%q) succeeded, want an error", key)
+			}
+			if err := l.Delete(ctx, key); err == nil {
+				t.Errorf("Delete(%q) succeeded, want an error", key)
+			}
+		})
+	}
+}
+
+func TestLocalURL(t *testing.T) {
+	l, _ := newTestLocal(t)
+	if got, want := l.URL("projects/p1/a.png"), "http://localhost:8080/media/projects/p1/a.png"; got != want {
+		t.Errorf("URL() = %q, want %q", got, want)
+	}
+}
+
+func TestLocalHandler(t *testing.T) {
+	l, _ := newTestLocal(t)
+	if err := l.Put(context.Background(), "projects/p1/a.png", strings.NewReader("data"), 4, "image/png"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	srv := httptest.NewServer(l.Handler())
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "/projects/p1/a.png")
+	if err != nil {
+		t.Fatalf("GET file: %v", err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK || string(body) != "data" {
+		t.Errorf("GET file = %d %q, want 200 %q", res.StatusCode, body, "data")
+	}
+	if cc := res.Header.Get("Cache-Control"); !strings.Contains(cc, "immutable") {
+		t.Errorf("Cache-Control = %q, want immutable files", cc)
+	}
+
+	for _, path := range []string{"/", "/projects/", "/projects/p1/", "/projects/p1/missing.png"} {
+		res, err := http.Get(srv.URL + path)
+		if err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusNotFound {
+			t.Errorf("GET %s = %d, want %d", path, res.StatusCode, http.StatusNotFound)
+		}
+	}
+}
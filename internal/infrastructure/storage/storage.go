@@ -0,0 +1,32 @@
+// Package storage stores the files uploaded to the API, such as project media.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Blob stores files under slash separated keys, e.g. projects/<id>/<file>.jpg
+type Blob interface {
+	// Put stores the content under the key, replacing any previous content
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Delete removes the content stored under the key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+	// URL returns the public address of the content stored under the key
+	URL(key string) string
+}
+
+// validateKey rejects the keys that could escape the storage root
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("invalid storage key %q", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return fmt.Errorf("invalid storage key %q", key)
+		}
+	}
+	return nil
+}
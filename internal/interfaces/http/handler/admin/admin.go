@@ -0,0 +1,18 @@
+package admin
+
+import (
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/service/scheduler"
+)
+
+type AdminHandler struct {
+	client    *ent.Client
+	scheduler *scheduler.Scheduler
+}
+
+func NewAdminHandler(client *ent.Client, scheduler *scheduler.Scheduler) *AdminHandler {
+	return &AdminHandler{
+		client:    client,
+		scheduler: scheduler,
+	}
+}
@@ -0,0 +1,187 @@
+package admin
+
+import (
+	stderrors "errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/scheduledrun"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/service/scheduler"
+)
+
+type TaskResponse struct {
+	Name string `json:"name"`
+	// Schedule is "off" for the tasks that only run when triggered
+	Schedule  string       `json:"schedule"`
+	NextRunAt *string      `json:"next_run_at"`
+	LastRun   *RunResponse `json:"last_run"`
+}
+
+type RunResponse struct {
+	ID            string  `json:"id"`
+	Task          string  `json:"task"`
+	Trigger       string  `json:"trigger"`
+	ScheduledFor  *string `json:"scheduled_for"`
+	TriggeredByID *string `json:"triggered_by_id"`
+	Instance      string  `json:"instance"`
+	Status        string  `json:"status"`
+	StartedAt     string  `json:"started_at"`
+	FinishedAt    *string `json:"finished_at"`
+	DurationMs    *int    `json:"duration_ms"`
+	Error         *string `json:"error"`
+}
+
+// ListTasks returns the scheduled tasks, with when they run next and how their last run went
+func (h *AdminHandler) ListTasks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	now := time.Now().UTC()
+
+	tasks := h.scheduler.Tasks()
+	resps := make([]TaskResponse, len(tasks))
+	for i, t := range tasks {
+		resp := &resps[i]
+		resp.Name = t.Name
+		resp.Schedule = scheduler.Off
+		if t.Schedule != nil {
+			resp.Schedule = t.Schedule.String()
+			next := t.Schedule.Next(now).Format("2006-01-02T15:04:05Z")
+			resp.NextRunAt = &next
+		}
+
+		last, err := h.client.ScheduledRun.Query().
+			Where(scheduledrun.Task(t.Name)).
+			Order(ent.Desc(scheduledrun.FieldStartedAt)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Failed to get last task run")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		if last != nil {
+			run := buildRunResponse(last)
+			resp.LastRun = &run
+		}
+	}
+
+	response.JSON(w, http.StatusOK, "Tasks retrieved successfully", resps)
+}
+
+// RunTask runs a task right away, whatever its schedule. It returns as soon as the run has
+// started, its outcome shows in the run history.
+func (h *AdminHandler) RunTask(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := chi.URLParam(r, "name")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	run, err := h.scheduler.Trigger(ctx, name, userID)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, scheduler.ErrUnknownTask):
+			log.Error(ctx).Err(err).Msg("Task not found")
+			response.Error(w, errors.ErrNotFound)
+		case stderrors.Is(err, scheduler.ErrTaskRunning):
+			log.Error(ctx).Err(err).Msg("Task already running")
+			response.Error(w, errors.ErrTaskAlreadyRunning)
+		default:
+			log.Error(ctx).Err(err).Msg("Failed to run task")
+			response.Error(w, errors.ErrInternalServerError)
+		}
+		return
+	}
+
+	log.Info(ctx).Msgf("Task %s triggered by user %s", name, userID)
+	response.JSON(w, http.StatusOK, "Task started successfully", buildRunResponse(run))
+}
+
+// ListRuns returns the run history of a task, the most recent first
+func (h *AdminHandler) ListRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := chi.URLParam(r, "name")
+
+	if _, ok := h.scheduler.Task(name); !ok {
+		log.Error(ctx).Str("task", name).Msg("Task not found")
+		response.Error(w, errors.ErrNotFound)
+		return
+	}
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	query := h.client.ScheduledRun.Query().
+		Where(scheduledrun.Task(name)).
+		Order(ent.Desc(scheduledrun.FieldStartedAt), ent.Desc(scheduledrun.FieldID)).
+		Limit(params.Fetch())
+	if c := params.After; c != nil {
+		query = query.Where(scheduledrun.Or(
+			scheduledrun.StartedAtLT(c.Time),
+			scheduledrun.And(scheduledrun.StartedAt(c.Time), scheduledrun.IDLT(c.ID)),
+		))
+	}
+
+	runs, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list task runs")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	runs, next := pagination.Trim(runs, params, func(run *ent.ScheduledRun) pagination.Cursor {
+		return pagination.Cursor{Time: run.StartedAt, ID: run.ID}
+	})
+
+	resps := make([]RunResponse, len(runs))
+	for i, run := range runs {
+		resps[i] = buildRunResponse(run)
+	}
+
+	page := response.NewPage(resps, next)
+	if params.WithTotal {
+		total, err := h.client.ScheduledRun.Query().Where(scheduledrun.Task(name)).Count(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count task runs")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "Task runs retrieved successfully", page)
+}
+
+func buildRunResponse(run *ent.ScheduledRun) RunResponse {
+	resp := RunResponse{
+		ID:            run.ID,
+		Task:          run.Task,
+		Trigger:       string(run.Trigger),
+		TriggeredByID: run.TriggeredByID,
+		Instance:      run.Instance,
+		Status:        string(run.Status),
+		StartedAt:     run.StartedAt.Format("2006-01-02T15:04:05Z"),
+		DurationMs:    run.DurationMs,
+		Error:         run.Error,
+	}
+	if run.ScheduledFor != nil {
+		scheduledFor := run.ScheduledFor.Format("2006-01-02T15:04:05Z")
+		resp.ScheduledFor = &scheduledFor
+	}
+	if run.FinishedAt != nil {
+		finishedAt := run.FinishedAt.Format("2006-01-02T15:04:05Z")
+		resp.FinishedAt = &finishedAt
+	}
+	return resp
+}
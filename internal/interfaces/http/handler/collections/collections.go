@@ -0,0 +1,15 @@
+package collections
+
+import (
+	"github.com/jorge-j1m/hackspark_server/ent"
+)
+
+type CollectionsHandler struct {
+	client *ent.Client
+}
+
+func NewCollectionsHandler(client *ent.Client) *CollectionsHandler {
+	return &CollectionsHandler{
+		client: client,
+	}
+}
@@ -0,0 +1,732 @@
+package collections
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/collection"
+	"github.com/jorge-j1m/hackspark_server/ent/collectionitem"
+	"github.com/jorge-j1m/hackspark_server/ent/predicate"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/projects"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	collectionsvc "github.com/jorge-j1m/hackspark_server/internal/service/collections"
+)
+
+type CreateCollectionRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	Visibility  string  `json:"visibility"`
+}
+
+func (r CreateCollectionRequest) Validate() error {
+	if r.Name == "" || len(r.Name) > 255 {
+		return errors.ErrInvalidRequest
+	}
+	if r.Description != nil && len(*r.Description) > 1000 {
+		return errors.ErrInvalidRequest
+	}
+	if r.Visibility != "" && collection.VisibilityValidator(collection.Visibility(r.Visibility)) != nil {
+		return errors.ErrInvalidCollectionVisibility
+	}
+	return nil
+}
+
+type UpdateCollectionRequest struct {
+	Name *string `json:"name"`
+	// Description is left untouched when omitted and cleared when empty
+	Description *string `json:"description"`
+	Visibility  *string `json:"visibility"`
+}
+
+func (r UpdateCollectionRequest) Validate() error {
+	if r.Name != nil && (*r.Name == "" || len(*r.Name) > 255) {
+		return errors.ErrInvalidRequest
+	}
+	if r.Description != nil && len(*r.Description) > 1000 {
+		return errors.ErrInvalidRequest
+	}
+	if r.Visibility != nil && collection.VisibilityValidator(collection.Visibility(*r.Visibility)) != nil {
+		return errors.ErrInvalidCollectionVisibility
+	}
+	return nil
+}
+
+type AddItemRequest struct {
+	ProjectID string  `json:"project_id"`
+	Note      *string `json:"note"`
+	// Position is where the project goes, the end of the collection when omitted
+	Position *int `json:"position"`
+}
+
+func (r AddItemRequest) Validate() error {
+	if r.ProjectID == "" {
+		return errors.ErrInvalidRequest
+	}
+	if r.Position != nil && *r.Position < 0 {
+		return errors.ErrInvalidRequest
+	}
+	if r.Note != nil && len(*r.Note) > 500 {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+type UpdateItemRequest struct {
+	// Note is cleared when empty
+	Note string `json:"note"`
+}
+
+func (r UpdateItemRequest) Validate() error {
+	if len(r.Note) > 500 {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+type ReorderRequest struct {
+	IDs []string `json:"ids"`
+}
+
+func (r ReorderRequest) Validate() error {
+	if len(r.IDs) == 0 {
+		return errors.ErrInvalidOrder
+	}
+	return nil
+}
+
+type CollectionResponse struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	Visibility  string  `json:"visibility"`
+	// ItemCount only counts the projects the viewer can see
+	ItemCount int `json:"item_count"`
+	Owner     struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"owner"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type ItemResponse struct {
+	ID       string  `json:"id"`
+	Position int     `json:"position"`
+	Note     *string `json:"note"`
+	// Project is null when the owner of the collection can no longer see it
+	Project *projects.ProjectResponse `json:"project"`
+	AddedAt string                    `json:"added_at"`
+}
+
+type CollectionDetailResponse struct {
+	CollectionResponse
+	Items []ItemResponse `json:"items"`
+}
+
+func (h *CollectionsHandler) CreateCollection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req CreateCollectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	create := h.client.Collection.Create().
+		SetOwnerID(userID).
+		SetName(req.Name).
+		SetNillableDescription(req.Description)
+	if req.Visibility != "" {
+		create.SetVisibility(collection.Visibility(req.Visibility))
+	}
+	c, err := create.Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to create collection")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp, err := h.getCollectionResponse(ctx, c.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get collection response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Collection created successfully: %s", c.ID)
+	response.JSON(w, http.StatusOK, "Collection created successfully", resp)
+}
+
+func (h *CollectionsHandler) GetCollection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	c, ok := h.getCollection(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.getCollectionResponse(ctx, c.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get collection response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, "Collection retrieved successfully", resp)
+}
+
+func (h *CollectionsHandler) UpdateCollection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req UpdateCollectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	c, ok := h.getOwnCollection(w, r)
+	if !ok {
+		return
+	}
+
+	update := h.client.Collection.UpdateOneID(c.ID)
+	if req.Name != nil {
+		update.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		if *req.Description == "" {
+			update.ClearDescription()
+		} else {
+			update.SetDescription(*req.Description)
+		}
+	}
+	if req.Visibility != nil {
+		update.SetVisibility(collection.Visibility(*req.Visibility))
+	}
+	if err := update.Exec(ctx); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update collection")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp, err := h.getCollectionResponse(ctx, c.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get collection response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Collection updated successfully: %s", c.ID)
+	response.JSON(w, http.StatusOK, "Collection updated successfully", resp)
+}
+
+func (h *CollectionsHandler) DeleteCollection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	c, ok := h.getOwnCollection(w, r)
+	if !ok {
+		return
+	}
+
+	err := database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if _, err := tx.CollectionItem.Delete().Where(collectionitem.CollectionID(c.ID)).Exec(ctx); err != nil {
+			return err
+		}
+		return tx.Collection.DeleteOneID(c.ID).Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to delete collection")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Collection deleted successfully: %s", c.ID)
+	response.JSON(w, http.StatusOK, "Collection deleted successfully", nil)
+}
+
+// AddItem inserts a project the owner can see in their collection, at the end unless a position is given
+func (h *CollectionsHandler) AddItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req AddItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	c, ok := h.getOwnCollection(w, r)
+	if !ok {
+		return
+	}
+
+	// The project is addressed by its ID, so unlisted projects can be collected too
+	exists, err := h.client.Project.Query().
+		Where(project.ID(req.ProjectID)).
+		Exist(visibility.WithUnlisted(ctx))
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if !exists {
+		log.Error(ctx).Msg("Project not found")
+		response.Error(w, errors.ErrNotFound)
+		return
+	}
+
+	item, err := collectionsvc.Add(ctx, h.client, c.ID, req.ProjectID, req.Note, req.Position)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to add project to collection")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	resp, err := h.getCollectionResponse(ctx, c.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get collection response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project %s added to collection %s as %s", req.ProjectID, c.ID, item.ID)
+	response.JSON(w, http.StatusOK, "Project added to collection successfully", resp)
+}
+
+func (h *CollectionsHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	itemID := chi.URLParam(r, "itemID")
+
+	var req UpdateItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	c, ok := h.getOwnCollection(w, r)
+	if !ok {
+		return
+	}
+
+	it, ok := h.getItem(w, r, c.ID, itemID)
+	if !ok {
+		return
+	}
+
+	update := h.client.CollectionItem.UpdateOneID(it.ID)
+	if req.Note == "" {
+		update.ClearNote()
+	} else {
+		update.SetNote(req.Note)
+	}
+	if err := update.Exec(ctx); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update collection item")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp, err := h.getCollectionResponse(ctx, c.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get collection response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Collection item updated successfully: %s", it.ID)
+	response.JSON(w, http.StatusOK, "Collection item updated successfully", resp)
+}
+
+func (h *CollectionsHandler) RemoveItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	itemID := chi.URLParam(r, "itemID")
+
+	c, ok := h.getOwnCollection(w, r)
+	if !ok {
+		return
+	}
+
+	it, ok := h.getItem(w, r, c.ID, itemID)
+	if !ok {
+		return
+	}
+
+	err := collectionsvc.Remove(ctx, h.client, c.ID, it.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to remove project from collection")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project %s removed from collection %s", it.ProjectID, c.ID)
+	response.JSON(w, http.StatusOK, "Project removed from collection successfully", nil)
+}
+
+func (h *CollectionsHandler) ReorderItems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req ReorderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	c, ok := h.getOwnCollection(w, r)
+	if !ok {
+		return
+	}
+
+	err := collectionsvc.Reorder(ctx, h.client, c.ID, req.IDs)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to reorder collection items")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	resp, err := h.getCollectionResponse(ctx, c.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get collection response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Collection items reordered successfully on collection %s", c.ID)
+	response.JSON(w, http.StatusOK, "Collection items reordered successfully", resp)
+}
+
+// GetUserCollections lists the collections of a user, private ones included for the user themselves
+func (h *CollectionsHandler) GetUserCollections(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	username := chi.URLParam(r, "username")
+
+	exists, err := h.client.User.Query().Where(user.Username(username)).Exist(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if !exists {
+		log.Error(ctx).Msg("User not found")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	h.listCollections(w, r, "User collections retrieved successfully",
+		collection.HasOwnerWith(user.Username(username)),
+	)
+}
+
+// GetTagCollections lists the public collections holding a listed project with the tag
+func (h *CollectionsHandler) GetTagCollections(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	slug := chi.URLParam(r, "slug")
+
+	exists, err := h.client.Tag.Query().Where(tag.Slug(slug)).Exist(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get tag")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if !exists {
+		log.Error(ctx).Msg("Tag not found")
+		response.Error(w, errors.ErrNotFound)
+		return
+	}
+
+	h.listCollections(w, r, "Tag collections retrieved successfully",
+		collection.VisibilityEQ(collection.VisibilityPublic),
+		collection.HasItemsWith(collectionitem.HasProjectWith(
+			visibility.Listed(),
+			project.HasTagsWith(tag.Slug(slug)),
+		)),
+	)
+}
+
+// listCollections writes a page of the collections matching the predicates that the viewer can
+// see, the most recent first
+func (h *CollectionsHandler) listCollections(w http.ResponseWriter, r *http.Request, msg string, ps ...predicate.Collection) {
+	ctx := r.Context()
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	ps = append(ps, visibleTo(ctx))
+	query := h.client.Collection.Query().
+		Where(ps...).
+		WithOwner().
+		Order(ent.Desc(collection.FieldCreateTime), ent.Desc(collection.FieldID)).
+		Limit(params.Fetch())
+	if c := params.After; c != nil {
+		query = query.Where(collection.Or(
+			collection.CreateTimeLT(c.Time),
+			collection.And(collection.CreateTime(c.Time), collection.IDLT(c.ID)),
+		))
+	}
+
+	collections, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list collections")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	collections, next := pagination.Trim(collections, params, func(c *ent.Collection) pagination.Cursor {
+		return pagination.Cursor{Time: c.CreateTime, ID: c.ID}
+	})
+
+	counts, err := h.countItems(ctx, collections...)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to count collection items")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	collectionResponses := make([]CollectionResponse, len(collections))
+	for i, c := range collections {
+		collectionResponses[i] = buildCollectionResponse(c, counts[c.ID])
+	}
+
+	page := response.NewPage(collectionResponses, next)
+	if params.WithTotal {
+		total, err := h.client.Collection.Query().Where(ps...).Count(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count collections")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, msg, page)
+}
+
+// visibleTo matches the collections the viewer of the context can see: public ones and their own
+func visibleTo(ctx context.Context) predicate.Collection {
+	public := collection.VisibilityEQ(collection.VisibilityPublic)
+	if userID := visibility.Viewer(ctx); userID != "" {
+		return collection.Or(public, collection.OwnerID(userID))
+	}
+	return public
+}
+
+// visibleItems matches the items whose project the viewer of the context can see
+func visibleItems(ctx context.Context) predicate.CollectionItem {
+	visible := []predicate.Project{project.DeletedAtIsNil()}
+	if p, ok := visibility.Filter(ctx); ok {
+		visible = append(visible, p)
+	}
+	return collectionitem.HasProjectWith(visible...)
+}
+
+// getCollection returns the collection of the route if the viewer can see it,
+// writing a not found error otherwise
+func (h *CollectionsHandler) getCollection(w http.ResponseWriter, r *http.Request) (*ent.Collection, bool) {
+	ctx := r.Context()
+	collectionID := chi.URLParam(r, "id")
+
+	c, err := h.client.Collection.Query().
+		Where(collection.ID(collectionID), visibleTo(ctx)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Collection not found")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get collection")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, false
+	}
+
+	return c, true
+}
+
+// getOwnCollection returns the collection of the route if the authenticated user owns it,
+// writing the error response otherwise
+func (h *CollectionsHandler) getOwnCollection(w http.ResponseWriter, r *http.Request) (*ent.Collection, bool) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return nil, false
+	}
+
+	c, ok := h.getCollection(w, r)
+	if !ok {
+		return nil, false
+	}
+	if c.OwnerID != userID {
+		log.Error(ctx).Msg("User does not own the collection")
+		response.Error(w, errors.ErrNoPermission)
+		return nil, false
+	}
+
+	return c, true
+}
+
+func (h *CollectionsHandler) getItem(w http.ResponseWriter, r *http.Request, collectionID, itemID string) (*ent.CollectionItem, bool) {
+	ctx := r.Context()
+
+	it, err := h.client.CollectionItem.Query().
+		Where(collectionitem.ID(itemID), collectionitem.CollectionID(collectionID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Collection item not found")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get collection item")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, false
+	}
+
+	return it, true
+}
+
+// getCollectionResponse returns the collection with its items in order. Items whose project the
+// viewer cannot see are left out, except for the owner who gets them without their project, so
+// they can still reorder or remove them.
+func (h *CollectionsHandler) getCollectionResponse(ctx context.Context, collectionID string) (*CollectionDetailResponse, error) {
+	c, err := h.client.Collection.Query().
+		Where(collection.ID(collectionID)).
+		WithOwner().
+		WithItems(func(q *ent.CollectionItemQuery) {
+			q.Order(ent.Asc(collectionitem.FieldPosition), ent.Asc(collectionitem.FieldCreateTime))
+			q.WithProject(func(q *ent.ProjectQuery) {
+				q.WithOwner().WithTags()
+			})
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := c.OwnerID == visibility.Viewer(ctx)
+	resp := &CollectionDetailResponse{Items: []ItemResponse{}}
+	var projectResps []*projects.ProjectResponse
+	for _, it := range c.Edges.Items {
+		item := ItemResponse{
+			ID:       it.ID,
+			Position: it.Position,
+			Note:     it.Note,
+			AddedAt:  it.CreateTime.Format("2006-01-02T15:04:05Z"),
+		}
+		if p := it.Edges.Project; p != nil {
+			projectResp := projects.BuildProjectResponse(p)
+			item.Project = &projectResp
+			projectResps = append(projectResps, item.Project)
+		} else if !owner {
+			continue
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	if err := projects.FillProgress(ctx, h.client, projectResps...); err != nil {
+		return nil, err
+	}
+	if err := projects.FillMedia(ctx, h.client, projectResps...); err != nil {
+		return nil, err
+	}
+
+	resp.CollectionResponse = buildCollectionResponse(c, len(projectResps))
+	return resp, nil
+}
+
+// countItems counts the items of the collections whose project the viewer can see, with a single query
+func (h *CollectionsHandler) countItems(ctx context.Context, collections ...*ent.Collection) (map[string]int, error) {
+	ids := make([]string, len(collections))
+	for i, c := range collections {
+		ids[i] = c.ID
+	}
+
+	var rows []struct {
+		CollectionID string `json:"collection_id"`
+		Count        int    `json:"count"`
+	}
+	if err := h.client.CollectionItem.Query().
+		Where(collectionitem.CollectionIDIn(ids...), visibleItems(ctx)).
+		GroupBy(collectionitem.FieldCollectionID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.CollectionID] = row.Count
+	}
+	return counts, nil
+}
+
+func buildCollectionResponse(c *ent.Collection, itemCount int) CollectionResponse {
+	resp := CollectionResponse{
+		ID:          c.ID,
+		Name:        c.Name,
+		Description: c.Description,
+		Visibility:  string(c.Visibility),
+		ItemCount:   itemCount,
+		CreatedAt:   c.CreateTime.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:   c.UpdateTime.Format("2006-01-02T15:04:05Z"),
+	}
+	if c.Edges.Owner != nil {
+		resp.Owner.ID = c.Edges.Owner.ID
+		resp.Owner.Username = c.Edges.Owner.Username
+	}
+	return resp
+}
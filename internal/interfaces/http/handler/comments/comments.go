@@ -0,0 +1,27 @@
+package comments
+
+import (
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+)
+
+type CommentsHandler struct {
+	client *ent.Client
+	bus    *eventbus.Bus
+	authz  *authz.Authorizer
+
+	// retention is how long deleted comments can be restored before they are purged
+	retention time.Duration
+}
+
+func NewCommentsHandler(client *ent.Client, bus *eventbus.Bus, authorizer *authz.Authorizer, retention time.Duration) *CommentsHandler {
+	return &CommentsHandler{
+		client:    client,
+		bus:       bus,
+		authz:     authorizer,
+		retention: retention,
+	}
+}
@@ -0,0 +1,603 @@
+package comments
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/comment"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"github.com/jorge-j1m/hackspark_server/internal/service/notify"
+	"github.com/jorge-j1m/hackspark_server/internal/service/purge"
+	"github.com/jorge-j1m/hackspark_server/internal/service/webhook"
+)
+
+const maxCommentLength = 10000
+
+type CreateCommentRequest struct {
+	Body     string  `json:"body"`
+	ParentID *string `json:"parent_id"`
+}
+
+func (r CreateCommentRequest) Validate() error {
+	return validateBody(r.Body)
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body"`
+}
+
+func (r UpdateCommentRequest) Validate() error {
+	return validateBody(r.Body)
+}
+
+func validateBody(body string) error {
+	if strings.TrimSpace(body) == "" || len(body) > maxCommentLength {
+		return errors.ErrInvalidComment
+	}
+	return nil
+}
+
+type CommentAuthor struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+type CommentResponse struct {
+	ID        string            `json:"id"`
+	ProjectID string            `json:"project_id"`
+	ParentID  *string           `json:"parent_id"`
+	Body      string            `json:"body"`
+	Author    *CommentAuthor    `json:"author"`
+	Edited    bool              `json:"edited"`
+	Hidden    bool              `json:"hidden"`
+	Deleted   bool              `json:"deleted"`
+	Replies   []CommentResponse `json:"replies,omitempty"`
+	CreatedAt string            `json:"created_at"`
+	UpdatedAt string            `json:"updated_at"`
+}
+
+func (h *CommentsHandler) ListComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	// Anonymous readers are allowed, the viewer only matters for moderation
+	viewerID, _ := middleware.GetUserIDFromContext(ctx)
+
+	proj, err := h.getProject(ctx, projectID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Project not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	query := h.client.Comment.Query().
+		Where(
+			comment.ProjectID(projectID),
+			comment.ParentIDIsNil(),
+		).
+		WithAuthor().
+		WithReplies(func(q *ent.CommentQuery) {
+			q.WithAuthor().Order(ent.Asc(comment.FieldCreateTime), ent.Asc(comment.FieldID))
+		}).
+		Order(ent.Asc(comment.FieldCreateTime), ent.Asc(comment.FieldID)).
+		Limit(params.Fetch())
+
+	if c := params.After; c != nil {
+		query = query.Where(comment.Or(
+			comment.CreateTimeGT(c.Time),
+			comment.And(comment.CreateTime(c.Time), comment.IDGT(c.ID)),
+		))
+	}
+
+	comments, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list comments")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	comments, next := pagination.Trim(comments, params, func(c *ent.Comment) pagination.Cursor {
+		return pagination.Cursor{Time: c.CreateTime, ID: c.ID}
+	})
+
+	isModerator, err := h.authz.Can(ctx, proj, viewerID, authz.ActionModerateComments)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to check moderation rights")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	commentResponses := []CommentResponse{}
+	for _, c := range comments {
+		resp, visible := buildCommentResponse(c, viewerID, isModerator)
+		for _, reply := range c.Edges.Replies {
+			if replyResp, ok := buildCommentResponse(reply, viewerID, isModerator); ok {
+				resp.Replies = append(resp.Replies, replyResp)
+			}
+		}
+		// Removed comments are kept as placeholders only when they still anchor a thread
+		if !visible && len(resp.Replies) == 0 {
+			continue
+		}
+		commentResponses = append(commentResponses, resp)
+	}
+
+	response.Paginated(w, r, "Comments retrieved successfully", response.NewPage(commentResponses, next))
+}
+
+func (h *CommentsHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req CreateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if _, err := h.getProject(ctx, projectID); err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Project not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	if req.ParentID != nil {
+		parent, err := h.getComment(ctx, projectID, *req.ParentID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				log.Error(ctx).Err(err).Msg("Parent comment not found")
+				response.Error(w, errors.ErrInvalidParentComment)
+				return
+			}
+			log.Error(ctx).Err(err).Msg("Failed to get parent comment")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		if parent.ParentID != nil {
+			log.Error(ctx).Msg("Cannot reply to a reply")
+			response.Error(w, errors.ErrInvalidParentComment)
+			return
+		}
+		if parent.DeletedAt != nil {
+			log.Error(ctx).Msg("Cannot reply to a deleted comment")
+			response.Error(w, errors.ErrCommentDeleted)
+			return
+		}
+	}
+
+	var (
+		created   *ent.Comment
+		commented eventbus.Event
+	)
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		created, err = tx.Comment.Create().
+			SetProjectID(projectID).
+			SetAuthorID(userID).
+			SetNillableParentID(req.ParentID).
+			SetBody(req.Body).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if err := tx.Project.UpdateOneID(projectID).AddCommentCount(1).Exec(ctx); err != nil {
+			return err
+		}
+
+		payload := map[string]any{"comment_id": created.ID}
+		if req.ParentID != nil {
+			payload["parent_id"] = *req.ParentID
+		}
+		commented = eventbus.Event{
+			Type:      eventbus.CommentCreated,
+			ActorID:   userID,
+			ProjectID: projectID,
+			Payload:   payload,
+		}
+		if err := webhook.Enqueue(ctx, tx, commented); err != nil {
+			return err
+		}
+		return notify.Enqueue(ctx, tx, commented)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to create comment")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	h.bus.Publish(ctx, commented)
+
+	resp, err := h.getCommentResponse(ctx, projectID, created.ID, userID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get comment response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Comment created successfully: %s on project %s", created.ID, projectID)
+	response.JSON(w, http.StatusCreated, "Comment created successfully", resp)
+}
+
+func (h *CommentsHandler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	commentID := chi.URLParam(r, "commentID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req UpdateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	c, ok := h.getAuthoredComment(w, r, projectID, commentID, userID)
+	if !ok {
+		return
+	}
+
+	if _, err := h.client.Comment.UpdateOneID(c.ID).
+		SetBody(req.Body).
+		SetEdited(true).
+		Save(ctx); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update comment")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp, err := h.getCommentResponse(ctx, projectID, c.ID, userID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get comment response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Comment updated successfully: %s", c.ID)
+	response.JSON(w, http.StatusOK, "Comment updated successfully", resp)
+}
+
+func (h *CommentsHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	commentID := chi.URLParam(r, "commentID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	c, ok := h.getAuthoredComment(w, r, projectID, commentID, userID)
+	if !ok {
+		return
+	}
+
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		c, err := lockComment(ctx, tx, c.ID)
+		if err != nil {
+			return err
+		}
+		// A concurrent request already deleted it
+		if c.DeletedAt != nil {
+			return nil
+		}
+		if err := tx.Comment.UpdateOneID(c.ID).SetDeletedAt(time.Now()).Exec(ctx); err != nil {
+			return err
+		}
+		// Hidden comments were already discounted when they were hidden
+		if c.Hidden {
+			return nil
+		}
+		return tx.Project.UpdateOneID(projectID).AddCommentCount(-1).Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to delete comment")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Comment deleted successfully: %s", c.ID)
+	response.JSON(w, http.StatusOK, "Comment deleted successfully", nil)
+}
+
+func (h *CommentsHandler) RestoreComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	commentID := chi.URLParam(r, "commentID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	c, err := h.getComment(ctx, projectID, commentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Comment not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get comment")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	if c.AuthorID != userID {
+		log.Error(ctx).Msg("User is not the author of the comment")
+		response.Error(w, errors.ErrForbidden)
+		return
+	}
+	if c.DeletedAt == nil {
+		response.Error(w, errors.ErrCommentNotDeleted)
+		return
+	}
+	if !purge.Restorable(*c.DeletedAt, h.retention, time.Now()) {
+		response.Error(w, errors.ErrRestoreWindowExpired)
+		return
+	}
+
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		c, err := lockComment(ctx, tx, c.ID)
+		if err != nil {
+			return err
+		}
+		// A concurrent request already restored it
+		if c.DeletedAt == nil {
+			return nil
+		}
+		if err := tx.Comment.UpdateOneID(c.ID).ClearDeletedAt().Exec(ctx); err != nil {
+			return err
+		}
+		// Hidden comments stay discounted until they are unhidden
+		if c.Hidden {
+			return nil
+		}
+		return tx.Project.UpdateOneID(projectID).AddCommentCount(1).Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to restore comment")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp, err := h.getCommentResponse(ctx, projectID, c.ID, userID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get comment response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Comment restored successfully: %s", c.ID)
+	response.JSON(w, http.StatusOK, "Comment restored successfully", resp)
+}
+
+func (h *CommentsHandler) HideComment(w http.ResponseWriter, r *http.Request) {
+	h.setHidden(w, r, true)
+}
+
+func (h *CommentsHandler) UnhideComment(w http.ResponseWriter, r *http.Request) {
+	h.setHidden(w, r, false)
+}
+
+// setHidden lets the project owner and maintainers moderate comments on the project
+func (h *CommentsHandler) setHidden(w http.ResponseWriter, r *http.Request, hidden bool) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	commentID := chi.URLParam(r, "commentID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionModerateComments); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot moderate comments on the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	c, err := h.getComment(ctx, projectID, commentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Comment not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get comment")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	delta := 1
+	if hidden {
+		delta = -1
+	}
+	changed := false
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		c, err := lockComment(ctx, tx, c.ID)
+		if err != nil {
+			return err
+		}
+		if c.DeletedAt != nil {
+			return errors.ErrCommentDeleted
+		}
+		if c.Hidden == hidden {
+			return nil
+		}
+		if err := tx.Comment.UpdateOneID(c.ID).SetHidden(hidden).Exec(ctx); err != nil {
+			return err
+		}
+		changed = true
+		return tx.Project.UpdateOneID(projectID).AddCommentCount(delta).Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update comment visibility")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if !changed {
+		response.JSON(w, http.StatusOK, "Comment visibility unchanged", nil)
+		return
+	}
+
+	log.Info(ctx).Msgf("Comment visibility updated: %s hidden=%t", c.ID, hidden)
+	response.JSON(w, http.StatusOK, "Comment visibility updated successfully", nil)
+}
+
+// getAuthoredComment loads a live comment and checks that userID wrote it, writing the error response otherwise
+func (h *CommentsHandler) getAuthoredComment(w http.ResponseWriter, r *http.Request, projectID, commentID, userID string) (*ent.Comment, bool) {
+	ctx := r.Context()
+
+	c, err := h.getComment(ctx, projectID, commentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Comment not found")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get comment")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, false
+	}
+
+	if c.AuthorID != userID {
+		log.Error(ctx).Msg("User is not the author of the comment")
+		response.Error(w, errors.ErrForbidden)
+		return nil, false
+	}
+
+	if c.DeletedAt != nil {
+		response.Error(w, errors.ErrCommentDeleted)
+		return nil, false
+	}
+
+	return c, true
+}
+
+func (h *CommentsHandler) getProject(ctx context.Context, projectID string) (*ent.Project, error) {
+	return h.client.Project.Query().
+		Where(project.ID(projectID)).
+		WithOwner().
+		Only(ctx)
+}
+
+func (h *CommentsHandler) getComment(ctx context.Context, projectID, commentID string) (*ent.Comment, error) {
+	return h.client.Comment.Query().
+		Where(
+			comment.ID(commentID),
+			comment.ProjectID(projectID),
+		).
+		Only(ctx)
+}
+
+// lockComment reads the comment again inside the transaction and locks its row, so that
+// concurrent deletes and moderation of the comment apply one after the other and adjust the
+// comment count once
+func lockComment(ctx context.Context, tx *ent.Tx, commentID string) (*ent.Comment, error) {
+	return tx.Comment.Query().
+		Where(comment.ID(commentID)).
+		ForUpdate().
+		Only(ctx)
+}
+
+func (h *CommentsHandler) getCommentResponse(ctx context.Context, projectID, commentID, viewerID string) (*CommentResponse, error) {
+	c, err := h.client.Comment.Query().
+		Where(
+			comment.ID(commentID),
+			comment.ProjectID(projectID),
+		).
+		WithAuthor().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, _ := buildCommentResponse(c, viewerID, false)
+	return &resp, nil
+}
+
+// buildCommentResponse renders a comment for the given viewer. Deleted comments, and hidden
+// comments for anyone but their author and the project owner, are reduced to a placeholder
+// and reported as not visible.
+func buildCommentResponse(c *ent.Comment, viewerID string, isModerator bool) (CommentResponse, bool) {
+	resp := CommentResponse{
+		ID:        c.ID,
+		ProjectID: c.ProjectID,
+		ParentID:  c.ParentID,
+		Edited:    c.Edited,
+		Hidden:    c.Hidden,
+		Deleted:   c.DeletedAt != nil,
+		CreatedAt: c.CreateTime.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: c.UpdateTime.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if resp.Deleted {
+		return resp, false
+	}
+	if c.Hidden && !isModerator && c.AuthorID != viewerID {
+		return resp, false
+	}
+
+	resp.Body = c.Body
+	if c.Edges.Author != nil {
+		resp.Author = &CommentAuthor{
+			ID:       c.Edges.Author.ID,
+			Username: c.Edges.Author.Username,
+		}
+	}
+	return resp, true
+}
@@ -0,0 +1,18 @@
+package events
+
+import (
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+)
+
+type EventsHandler struct {
+	client *ent.Client
+	authz  *authz.Authorizer
+}
+
+func NewEventsHandler(client *ent.Client, authorizer *authz.Authorizer) *EventsHandler {
+	return &EventsHandler{
+		client: client,
+		authz:  authorizer,
+	}
+}
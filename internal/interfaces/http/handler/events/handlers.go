@@ -0,0 +1,783 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/event"
+	"github.com/jorge-j1m/hackspark_server/ent/eventmember"
+	"github.com/jorge-j1m/hackspark_server/ent/eventsubmission"
+	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/hackathon"
+)
+
+const timeLayout = "2006-01-02T15:04:05Z"
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+type CreateEventRequest struct {
+	Name         string   `json:"name"`
+	Slug         string   `json:"slug"` // Derived from the name when empty
+	Description  *string  `json:"description"`
+	Rules        *string  `json:"rules"`
+	StartsAt     string   `json:"starts_at"`
+	EndsAt       string   `json:"ends_at"`
+	RequiredTags []string `json:"required_tags"`
+}
+
+func (r CreateEventRequest) Validate() error {
+	if r.Name == "" || len(r.Name) > 255 || len(r.Slug) > 255 {
+		return errors.ErrInvalidEvent
+	}
+	startsAt, err := time.Parse(time.RFC3339, r.StartsAt)
+	if err != nil {
+		return errors.ErrInvalidEvent
+	}
+	endsAt, err := time.Parse(time.RFC3339, r.EndsAt)
+	if err != nil || !endsAt.After(startsAt) {
+		return errors.ErrInvalidEvent
+	}
+	return nil
+}
+
+type UpdateEventRequest struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	Rules       *string `json:"rules"`
+	// The schedule and required tags are left untouched when omitted, and can no longer change
+	// once the submissions are frozen
+	StartsAt     *string   `json:"starts_at"`
+	EndsAt       *string   `json:"ends_at"`
+	RequiredTags *[]string `json:"required_tags"`
+}
+
+func (r UpdateEventRequest) Validate() error {
+	if r.Name != nil && (*r.Name == "" || len(*r.Name) > 255) {
+		return errors.ErrInvalidEvent
+	}
+	for _, value := range []*string{r.StartsAt, r.EndsAt} {
+		if value == nil {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, *value); err != nil {
+			return errors.ErrInvalidEvent
+		}
+	}
+	return nil
+}
+
+type SetMemberRoleRequest struct {
+	Role string `json:"role"`
+}
+
+func (r SetMemberRoleRequest) Validate() error {
+	if eventmember.RoleValidator(eventmember.Role(r.Role)) != nil {
+		return errors.ErrInvalidEventRole
+	}
+	return nil
+}
+
+type EventResponse struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Slug         string   `json:"slug"`
+	Description  *string  `json:"description"`
+	Rules        *string  `json:"rules"`
+	Status       string   `json:"status"`
+	StartsAt     string   `json:"starts_at"`
+	EndsAt       string   `json:"ends_at"`
+	RequiredTags []string `json:"required_tags"`
+	// FrozenAt is null until the submissions are frozen at the end of the event
+	FrozenAt           *string `json:"frozen_at"`
+	ResultsPublishedAt *string `json:"results_published_at"`
+	CreatedAt          string  `json:"created_at"`
+}
+
+type EventDetailResponse struct {
+	EventResponse
+	ParticipantCount int `json:"participant_count"`
+	SubmissionCount  int `json:"submission_count"`
+	// Role is the role of the authenticated user in the event, null when they do not take part in it
+	Role *string `json:"role"`
+}
+
+type MemberResponse struct {
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Role     string `json:"role"`
+	JoinedAt string `json:"joined_at"`
+}
+
+// CreateEvent creates an event organized by the authenticated user
+func (h *EventsHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req CreateEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	slug := normalizeSlug(req.Slug)
+	if slug == "" {
+		slug = normalizeSlug(req.Name)
+	}
+	if slug == "" {
+		response.Error(w, errors.ErrInvalidEvent)
+		return
+	}
+
+	tagIDs, err := h.resolveTags(ctx, req.RequiredTags)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to resolve required tags")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	startsAt, _ := time.Parse(time.RFC3339, req.StartsAt)
+	endsAt, _ := time.Parse(time.RFC3339, req.EndsAt)
+
+	var e *ent.Event
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		e, err = tx.Event.Create().
+			SetName(req.Name).
+			SetSlug(slug).
+			SetNillableDescription(req.Description).
+			SetNillableRules(req.Rules).
+			SetStartsAt(startsAt).
+			SetEndsAt(endsAt).
+			AddRequiredTagIDs(tagIDs...).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		return tx.EventMember.Create().
+			SetEventID(e.ID).
+			SetUserID(userID).
+			SetRole(eventmember.RoleOrganizer).
+			Exec(ctx)
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			log.Error(ctx).Err(err).Msg("Event slug already taken")
+			response.Error(w, errors.ErrEventSlugTaken)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to create event")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp, err := h.getEventResponse(ctx, e.Slug)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get event response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Event created successfully: %s", e.ID)
+	response.JSON(w, http.StatusOK, "Event created successfully", resp)
+}
+
+// ListEvents lists the events, the latest to start first, optionally restricted to a status
+func (h *EventsHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	now := time.Now()
+	query := h.client.Event.Query()
+	switch hackathon.Status(r.URL.Query().Get("status")) {
+	case "":
+	case hackathon.StatusUpcoming:
+		query = query.Where(event.StartsAtGT(now))
+	case hackathon.StatusOngoing:
+		query = query.Where(event.StartsAtLTE(now), event.EndsAtGT(now))
+	case hackathon.StatusJudging:
+		query = query.Where(event.EndsAtLTE(now), event.ResultsPublishedAtIsNil())
+	case hackathon.StatusFinished:
+		query = query.Where(event.ResultsPublishedAtNotNil())
+	default:
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+	countQuery := query.Clone()
+
+	query = query.
+		WithRequiredTags().
+		Order(ent.Desc(event.FieldStartsAt), ent.Desc(event.FieldID)).
+		Limit(params.Fetch())
+	if c := params.After; c != nil {
+		query = query.Where(event.Or(
+			event.StartsAtLT(c.Time),
+			event.And(event.StartsAt(c.Time), event.IDLT(c.ID)),
+		))
+	}
+
+	events, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list events")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	events, next := pagination.Trim(events, params, func(e *ent.Event) pagination.Cursor {
+		return pagination.Cursor{Time: e.StartsAt, ID: e.ID}
+	})
+
+	eventResponses := make([]EventResponse, len(events))
+	for i, e := range events {
+		eventResponses[i] = buildEventResponse(e, now)
+	}
+
+	page := response.NewPage(eventResponses, next)
+	if params.WithTotal {
+		total, err := countQuery.Count(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count events")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "Events retrieved successfully", page)
+}
+
+func (h *EventsHandler) GetEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.getEventResponse(ctx, chi.URLParam(r, "slug"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Event not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get event")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, "Event retrieved successfully", resp)
+}
+
+func (h *EventsHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req UpdateEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+	if _, ok := h.requireRole(w, r, e, eventmember.RoleOrganizer); !ok {
+		return
+	}
+
+	update := h.client.Event.UpdateOneID(e.ID)
+	if req.Name != nil {
+		update.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+	}
+	if req.Rules != nil {
+		update.SetRules(*req.Rules)
+	}
+
+	if req.StartsAt != nil || req.EndsAt != nil || req.RequiredTags != nil {
+		// What was submitted is judged against the schedule and tags it was submitted under
+		if e.FrozenAt != nil {
+			response.Error(w, errors.ErrEventEnded)
+			return
+		}
+
+		startsAt, endsAt := e.StartsAt, e.EndsAt
+		if req.StartsAt != nil {
+			startsAt, _ = time.Parse(time.RFC3339, *req.StartsAt)
+		}
+		if req.EndsAt != nil {
+			endsAt, _ = time.Parse(time.RFC3339, *req.EndsAt)
+		}
+		if !endsAt.After(startsAt) {
+			response.Error(w, errors.ErrInvalidEvent)
+			return
+		}
+		update.SetStartsAt(startsAt).SetEndsAt(endsAt)
+
+		if req.RequiredTags != nil {
+			tagIDs, err := h.resolveTags(ctx, *req.RequiredTags)
+			if err != nil {
+				log.Error(ctx).Err(err).Msg("Failed to resolve required tags")
+				response.Error(w, errors.AsAppError(err))
+				return
+			}
+			update.ClearRequiredTags().AddRequiredTagIDs(tagIDs...)
+		}
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update event")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp, err := h.getEventResponse(ctx, e.Slug)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get event response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Event updated successfully: %s", e.ID)
+	response.JSON(w, http.StatusOK, "Event updated successfully", resp)
+}
+
+// Register makes the authenticated user a participant of the event, until it ends
+func (h *EventsHandler) Register(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+	if !time.Now().Before(e.EndsAt) {
+		response.Error(w, errors.ErrRegistrationClosed)
+		return
+	}
+
+	err = h.client.EventMember.Create().
+		SetEventID(e.ID).
+		SetUserID(userID).
+		SetRole(eventmember.RoleParticipant).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			log.Error(ctx).Err(err).Msg("User already takes part in the event")
+			response.Error(w, errors.ErrAlreadyRegistered)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to register to event")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("User %s registered to event %s", userID, e.ID)
+	response.JSON(w, http.StatusOK, "Registered to event successfully", nil)
+}
+
+// Unregister removes the authenticated participant from the event, once they withdrew their submissions
+func (h *EventsHandler) Unregister(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+	userID, ok := h.requireRole(w, r, e, eventmember.RoleParticipant)
+	if !ok {
+		return
+	}
+	if !time.Now().Before(e.EndsAt) {
+		response.Error(w, errors.ErrRegistrationClosed)
+		return
+	}
+
+	submitted, err := h.client.EventSubmission.Query().
+		Where(eventsubmission.EventID(e.ID), eventsubmission.SubmitterID(userID)).
+		Exist(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get submissions")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if submitted {
+		response.Error(w, errors.ErrEventHasSubmissions)
+		return
+	}
+
+	if _, err := h.client.EventMember.Delete().
+		Where(eventmember.EventID(e.ID), eventmember.UserID(userID)).
+		Exec(ctx); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to unregister from event")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("User %s unregistered from event %s", userID, e.ID)
+	response.JSON(w, http.StatusOK, "Unregistered from event successfully", nil)
+}
+
+func (h *EventsHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+
+	members, err := h.client.EventMember.Query().
+		Where(eventmember.EventID(e.ID)).
+		WithUser().
+		Order(ent.Asc(eventmember.FieldCreateTime), ent.Asc(eventmember.FieldID)).
+		All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get event members")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	memberResponses := make([]MemberResponse, len(members))
+	for i, m := range members {
+		memberResponses[i] = buildMemberResponse(m)
+	}
+
+	response.JSON(w, http.StatusOK, "Event members retrieved successfully", memberResponses)
+}
+
+// SetMemberRole appoints a user as organizer, judge or participant of the event
+func (h *EventsHandler) SetMemberRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	username := chi.URLParam(r, "username")
+
+	var req SetMemberRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+	if _, ok := h.requireRole(w, r, e, eventmember.RoleOrganizer); !ok {
+		return
+	}
+
+	u, err := h.client.User.Query().Where(user.Username(username)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("User not found")
+			response.Error(w, errors.ErrUserNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get user")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	role := eventmember.Role(req.Role)
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		m, err := tx.EventMember.Query().
+			Where(eventmember.EventID(e.ID), eventmember.UserID(u.ID)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return tx.EventMember.Create().
+				SetEventID(e.ID).
+				SetUserID(u.ID).
+				SetRole(role).
+				Exec(ctx)
+		}
+		if err != nil {
+			return err
+		}
+
+		if m.Role == eventmember.RoleOrganizer && role != eventmember.RoleOrganizer {
+			if err := ensureOtherOrganizer(ctx, tx, e.ID, u.ID); err != nil {
+				return err
+			}
+		}
+		return tx.EventMember.UpdateOneID(m.ID).SetRole(role).Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to set event member role")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	log.Info(ctx).Msgf("User %s is now %s of event %s", u.ID, role, e.ID)
+	response.JSON(w, http.StatusOK, "Event member updated successfully", nil)
+}
+
+func (h *EventsHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	username := chi.URLParam(r, "username")
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+	if _, ok := h.requireRole(w, r, e, eventmember.RoleOrganizer); !ok {
+		return
+	}
+
+	m, err := h.client.EventMember.Query().
+		Where(
+			eventmember.EventID(e.ID),
+			eventmember.HasUserWith(user.Username(username)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Event member not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get event member")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if m.Role == eventmember.RoleOrganizer {
+			if err := ensureOtherOrganizer(ctx, tx, e.ID, m.UserID); err != nil {
+				return err
+			}
+		}
+		return tx.EventMember.DeleteOneID(m.ID).Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to remove event member")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	log.Info(ctx).Msgf("User %s removed from event %s", m.UserID, e.ID)
+	response.JSON(w, http.StatusOK, "Event member removed successfully", nil)
+}
+
+// ensureOtherOrganizer fails when the user is the only organizer of the event
+func ensureOtherOrganizer(ctx context.Context, tx *ent.Tx, eventID, userID string) error {
+	others, err := tx.EventMember.Query().
+		Where(
+			eventmember.EventID(eventID),
+			eventmember.RoleEQ(eventmember.RoleOrganizer),
+			eventmember.UserIDNEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !others {
+		return errors.ErrLastOrganizer
+	}
+	return nil
+}
+
+// getEvent returns the event of the route, writing a not found error when it does not exist
+func (h *EventsHandler) getEvent(w http.ResponseWriter, r *http.Request) (*ent.Event, bool) {
+	ctx := r.Context()
+
+	e, err := h.client.Event.Query().
+		Where(event.Slug(chi.URLParam(r, "slug"))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Event not found")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get event")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, false
+	}
+
+	return e, true
+}
+
+// requireRole checks that the authenticated user has one of the roles in the event, returning
+// their ID. It writes the error response otherwise.
+func (h *EventsHandler) requireRole(w http.ResponseWriter, r *http.Request, e *ent.Event, roles ...eventmember.Role) (string, bool) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return "", false
+	}
+
+	role, err := hackathon.RoleOf(ctx, h.client, e.ID, userID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get event role")
+		response.Error(w, errors.ErrInternalServerError)
+		return "", false
+	}
+	if !slices.Contains(roles, role) {
+		log.Error(ctx).Msgf("User %s has role %q in event %s, expected one of %v", userID, role, e.ID, roles)
+		if slices.Equal(roles, []eventmember.Role{eventmember.RoleParticipant}) {
+			response.Error(w, errors.ErrNotRegistered)
+		} else {
+			response.Error(w, errors.ErrNoPermission)
+		}
+		return "", false
+	}
+
+	return userID, true
+}
+
+// resolveTags returns the IDs of the tags with the given slugs, which must all exist
+func (h *EventsHandler) resolveTags(ctx context.Context, slugs []string) ([]string, error) {
+	if len(slugs) == 0 {
+		return nil, nil
+	}
+
+	tags, err := h.client.Tag.Query().
+		Where(tag.SlugIn(slugs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(tags))
+	for _, t := range tags {
+		ids = append(ids, t.ID)
+	}
+	for _, slug := range slugs {
+		if !slices.ContainsFunc(tags, func(t *ent.Tag) bool { return t.Slug == slug }) {
+			return nil, errors.ErrUnknownEventTags
+		}
+	}
+	return ids, nil
+}
+
+func (h *EventsHandler) getEventResponse(ctx context.Context, slug string) (*EventDetailResponse, error) {
+	e, err := h.client.Event.Query().
+		Where(event.Slug(slug)).
+		WithRequiredTags().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &EventDetailResponse{EventResponse: buildEventResponse(e, time.Now())}
+
+	resp.ParticipantCount, err = h.client.EventMember.Query().
+		Where(eventmember.EventID(e.ID), eventmember.RoleEQ(eventmember.RoleParticipant)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp.SubmissionCount, err = h.client.EventSubmission.Query().
+		Where(eventsubmission.EventID(e.ID)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := hackathon.RoleOf(ctx, h.client, e.ID, visibility.Viewer(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if role != "" {
+		value := string(role)
+		resp.Role = &value
+	}
+	return resp, nil
+}
+
+func buildEventResponse(e *ent.Event, now time.Time) EventResponse {
+	resp := EventResponse{
+		ID:                 e.ID,
+		Name:               e.Name,
+		Slug:               e.Slug,
+		Description:        e.Description,
+		Rules:              e.Rules,
+		Status:             string(hackathon.StatusOf(e, now)),
+		StartsAt:           e.StartsAt.UTC().Format(timeLayout),
+		EndsAt:             e.EndsAt.UTC().Format(timeLayout),
+		RequiredTags:       []string{},
+		FrozenAt:           formatTime(e.FrozenAt),
+		ResultsPublishedAt: formatTime(e.ResultsPublishedAt),
+		CreatedAt:          e.CreateTime.Format(timeLayout),
+	}
+	for _, t := range e.Edges.RequiredTags {
+		resp.RequiredTags = append(resp.RequiredTags, t.Slug)
+	}
+	slices.Sort(resp.RequiredTags)
+	return resp
+}
+
+func buildMemberResponse(m *ent.EventMember) MemberResponse {
+	resp := MemberResponse{
+		Role:     string(m.Role),
+		JoinedAt: m.CreateTime.Format(timeLayout),
+	}
+	if m.Edges.User != nil {
+		resp.User.ID = m.Edges.User.ID
+		resp.User.Username = m.Edges.User.Username
+	}
+	return resp
+}
+
+// normalizeSlug turns a name into a slug of lowercase letters and digits separated by dashes
+func normalizeSlug(input string) string {
+	return strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(input), "-"), "-")
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.UTC().Format(timeLayout)
+	return &formatted
+}
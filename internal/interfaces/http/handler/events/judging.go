@@ -0,0 +1,564 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/eventcriterion"
+	"github.com/jorge-j1m/hackspark_server/ent/eventmember"
+	"github.com/jorge-j1m/hackspark_server/ent/eventscore"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/hackathon"
+)
+
+type CreateCriterionRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	Weight      *int    `json:"weight"`    // Defaults to 1
+	MaxScore    *int    `json:"max_score"` // Defaults to 10
+}
+
+func (r CreateCriterionRequest) Validate() error {
+	return validateCriterion(&r.Name, r.Description, r.Weight, r.MaxScore)
+}
+
+type UpdateCriterionRequest struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	Weight      *int    `json:"weight"`
+	MaxScore    *int    `json:"max_score"`
+}
+
+func (r UpdateCriterionRequest) Validate() error {
+	return validateCriterion(r.Name, r.Description, r.Weight, r.MaxScore)
+}
+
+func validateCriterion(name, description *string, weight, maxScore *int) error {
+	if name != nil && (*name == "" || len(*name) > 255) {
+		return errors.ErrInvalidCriterion
+	}
+	if description != nil && len(*description) > 1000 {
+		return errors.ErrInvalidCriterion
+	}
+	if weight != nil && (*weight < 1 || *weight > 10) {
+		return errors.ErrInvalidCriterion
+	}
+	if maxScore != nil && (*maxScore < 1 || *maxScore > 100) {
+		return errors.ErrInvalidCriterion
+	}
+	return nil
+}
+
+type ScoreRequest struct {
+	Scores []struct {
+		CriterionID string  `json:"criterion_id"`
+		Score       int     `json:"score"`
+		Comment     *string `json:"comment"`
+	} `json:"scores"`
+}
+
+func (r ScoreRequest) Validate() error {
+	if len(r.Scores) == 0 {
+		return errors.ErrInvalidRequest
+	}
+	for _, s := range r.Scores {
+		if s.CriterionID == "" || s.Score < 0 {
+			return errors.ErrInvalidScore
+		}
+		if s.Comment != nil && len(*s.Comment) > 1000 {
+			return errors.ErrInvalidRequest
+		}
+	}
+	return nil
+}
+
+type CriterionResponse struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	Weight      int     `json:"weight"`
+	MaxScore    int     `json:"max_score"`
+	Position    int     `json:"position"`
+}
+
+type ScoreResponse struct {
+	CriterionID string  `json:"criterion_id"`
+	Score       int     `json:"score"`
+	Comment     *string `json:"comment"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+type CriterionResultResponse struct {
+	CriterionResponse
+	// Average is the average score of the judges, from 0 to the maximum score of the criterion
+	Average float64 `json:"average"`
+	Scores  int     `json:"scores"`
+}
+
+type ResultResponse struct {
+	Rank         int    `json:"rank"`
+	SubmissionID string `json:"submission_id"`
+	Project      struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"project"`
+	// Score is the weighted average of the criteria, from 0 to 100
+	Score    float64                   `json:"score"`
+	Judges   int                       `json:"judges"`
+	Criteria []CriterionResultResponse `json:"criteria"`
+}
+
+func (h *EventsHandler) ListCriteria(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+
+	criteria, err := h.client.EventCriterion.Query().
+		Where(eventcriterion.EventID(e.ID)).
+		Order(ent.Asc(eventcriterion.FieldPosition), ent.Asc(eventcriterion.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get criteria")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	criterionResponses := make([]CriterionResponse, len(criteria))
+	for i, c := range criteria {
+		criterionResponses[i] = buildCriterionResponse(c)
+	}
+
+	response.JSON(w, http.StatusOK, "Criteria retrieved successfully", criterionResponses)
+}
+
+func (h *EventsHandler) CreateCriterion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req CreateCriterionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	e, ok := h.getEvent(w, r)
+	if !ok || !h.requireCriteriaOpen(w, r, e) {
+		return
+	}
+
+	position, err := h.client.EventCriterion.Query().Where(eventcriterion.EventID(e.ID)).Count(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to count criteria")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	c, err := h.client.EventCriterion.Create().
+		SetEventID(e.ID).
+		SetName(req.Name).
+		SetNillableDescription(req.Description).
+		SetNillableWeight(req.Weight).
+		SetNillableMaxScore(req.MaxScore).
+		SetPosition(position).
+		Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to create criterion")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Criterion created successfully: %s", c.ID)
+	response.JSON(w, http.StatusOK, "Criterion created successfully", buildCriterionResponse(c))
+}
+
+func (h *EventsHandler) UpdateCriterion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req UpdateCriterionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	e, ok := h.getEvent(w, r)
+	if !ok || !h.requireCriteriaOpen(w, r, e) {
+		return
+	}
+
+	c, ok := h.getCriterion(w, r, e.ID)
+	if !ok {
+		return
+	}
+
+	update := h.client.EventCriterion.UpdateOneID(c.ID)
+	if req.Name != nil {
+		update.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		if *req.Description == "" {
+			update.ClearDescription()
+		} else {
+			update.SetDescription(*req.Description)
+		}
+	}
+	if req.Weight != nil {
+		update.SetWeight(*req.Weight)
+	}
+	if req.MaxScore != nil {
+		update.SetMaxScore(*req.MaxScore)
+	}
+
+	c, err := update.Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update criterion")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Criterion updated successfully: %s", c.ID)
+	response.JSON(w, http.StatusOK, "Criterion updated successfully", buildCriterionResponse(c))
+}
+
+func (h *EventsHandler) DeleteCriterion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	e, ok := h.getEvent(w, r)
+	if !ok || !h.requireCriteriaOpen(w, r, e) {
+		return
+	}
+
+	c, ok := h.getCriterion(w, r, e.ID)
+	if !ok {
+		return
+	}
+
+	err := database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if _, err := tx.EventScore.Delete().Where(eventscore.CriterionID(c.ID)).Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.EventCriterion.DeleteOneID(c.ID).Exec(ctx); err != nil {
+			return err
+		}
+		// Close the gap left in the ordering
+		return tx.EventCriterion.Update().
+			Where(eventcriterion.EventID(e.ID), eventcriterion.PositionGT(c.Position)).
+			AddPosition(-1).
+			Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to delete criterion")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Criterion deleted successfully: %s", c.ID)
+	response.JSON(w, http.StatusOK, "Criterion deleted successfully", nil)
+}
+
+// ScoreSubmission records the scores of the authenticated judge for a submission, replacing the
+// scores they gave before on the same criteria. Judging opens once the event has ended.
+func (h *EventsHandler) ScoreSubmission(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req ScoreRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+	judgeID, ok := h.requireRole(w, r, e, eventmember.RoleJudge, eventmember.RoleOrganizer)
+	if !ok {
+		return
+	}
+	if hackathon.StatusOf(e, time.Now()) != hackathon.StatusJudging {
+		response.Error(w, errors.ErrJudgingClosed)
+		return
+	}
+	if err := hackathon.Freeze(ctx, h.client, e); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to freeze submissions")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	s, ok := h.getSubmission(w, r, e.ID)
+	if !ok {
+		return
+	}
+
+	criteria, err := h.client.EventCriterion.Query().
+		Where(eventcriterion.EventID(e.ID)).
+		All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get criteria")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	maxScores := make(map[string]int, len(criteria))
+	for _, c := range criteria {
+		maxScores[c.ID] = c.MaxScore
+	}
+	for _, score := range req.Scores {
+		maxScore, ok := maxScores[score.CriterionID]
+		if !ok || score.Score > maxScore {
+			response.Error(w, errors.ErrInvalidScore)
+			return
+		}
+	}
+
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		for _, score := range req.Scores {
+			existing, err := tx.EventScore.Query().
+				Where(
+					eventscore.SubmissionID(s.ID),
+					eventscore.CriterionID(score.CriterionID),
+					eventscore.JudgeID(judgeID),
+				).
+				Only(ctx)
+			switch {
+			case err == nil:
+				err = tx.EventScore.UpdateOneID(existing.ID).
+					SetScore(score.Score).
+					SetNillableComment(score.Comment).
+					Exec(ctx)
+			case ent.IsNotFound(err):
+				err = tx.EventScore.Create().
+					SetSubmissionID(s.ID).
+					SetCriterionID(score.CriterionID).
+					SetJudgeID(judgeID).
+					SetScore(score.Score).
+					SetNillableComment(score.Comment).
+					Exec(ctx)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to score submission")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	scores, err := h.client.EventScore.Query().
+		Where(eventscore.SubmissionID(s.ID), eventscore.JudgeID(judgeID)).
+		All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get scores")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	scoreResponses := make([]ScoreResponse, len(scores))
+	for i, score := range scores {
+		scoreResponses[i] = ScoreResponse{
+			CriterionID: score.CriterionID,
+			Score:       score.Score,
+			Comment:     score.Comment,
+			UpdatedAt:   score.UpdateTime.Format(timeLayout),
+		}
+	}
+
+	log.Info(ctx).Msgf("Submission %s scored by %s", s.ID, judgeID)
+	response.JSON(w, http.StatusOK, "Submission scored successfully", scoreResponses)
+}
+
+// GetResults returns the leaderboard of the event. Organizers and judges can follow it as judging
+// goes, everyone else once the results are published.
+func (h *EventsHandler) GetResults(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+
+	if e.ResultsPublishedAt == nil {
+		role, err := hackathon.RoleOf(ctx, h.client, e.ID, visibility.Viewer(ctx))
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to get event role")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		if !hackathon.CanJudge(role) || hackathon.StatusOf(e, time.Now()) != hackathon.StatusJudging {
+			response.Error(w, errors.ErrResultsNotPublished)
+			return
+		}
+	}
+	if err := hackathon.Freeze(ctx, h.client, e); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to freeze submissions")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp, err := h.getResults(ctx, e)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to compute results")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, "Results retrieved successfully", resp)
+}
+
+// PublishResults makes the results of the event visible to everyone and closes judging
+func (h *EventsHandler) PublishResults(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+	if _, ok := h.requireRole(w, r, e, eventmember.RoleOrganizer); !ok {
+		return
+	}
+	switch hackathon.StatusOf(e, time.Now()) {
+	case hackathon.StatusFinished:
+		response.Error(w, errors.ErrResultsPublished)
+		return
+	case hackathon.StatusJudging:
+	default:
+		response.Error(w, errors.ErrJudgingClosed)
+		return
+	}
+	if err := hackathon.Freeze(ctx, h.client, e); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to freeze submissions")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	if err := h.client.Event.UpdateOneID(e.ID).SetResultsPublishedAt(time.Now()).Exec(ctx); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to publish results")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp, err := h.getResults(ctx, e)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to compute results")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Results published successfully for event %s", e.ID)
+	response.JSON(w, http.StatusOK, "Results published successfully", resp)
+}
+
+// requireCriteriaOpen checks that the authenticated user organizes the event and that its results
+// are not published yet, writing the error response otherwise
+func (h *EventsHandler) requireCriteriaOpen(w http.ResponseWriter, r *http.Request, e *ent.Event) bool {
+	if _, ok := h.requireRole(w, r, e, eventmember.RoleOrganizer); !ok {
+		return false
+	}
+	if e.ResultsPublishedAt != nil {
+		response.Error(w, errors.ErrResultsPublished)
+		return false
+	}
+	return true
+}
+
+func (h *EventsHandler) getCriterion(w http.ResponseWriter, r *http.Request, eventID string) (*ent.EventCriterion, bool) {
+	ctx := r.Context()
+
+	c, err := h.client.EventCriterion.Query().
+		Where(
+			eventcriterion.ID(chi.URLParam(r, "criterionID")),
+			eventcriterion.EventID(eventID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Criterion not found")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get criterion")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, false
+	}
+
+	return c, true
+}
+
+func (h *EventsHandler) getResults(ctx context.Context, e *ent.Event) ([]ResultResponse, error) {
+	entries, err := hackathon.Leaderboard(ctx, h.client, e.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	submissions := make([]*ent.EventSubmission, len(entries))
+	for i, entry := range entries {
+		submissions[i] = entry.Submission
+	}
+	snapshots, err := hackathon.Snapshots(ctx, h.client, submissions)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ResultResponse, len(entries))
+	for i, entry := range entries {
+		result := &results[i]
+		result.Rank = entry.Rank
+		result.SubmissionID = entry.Submission.ID
+		result.Project.ID = entry.Submission.ProjectID
+		result.Project.Name = snapshots[entry.Submission.ID].Name
+		result.Score = entry.Score
+		result.Judges = entry.Judges
+		result.Criteria = make([]CriterionResultResponse, len(entry.Criteria))
+		for j, c := range entry.Criteria {
+			result.Criteria[j] = CriterionResultResponse{
+				CriterionResponse: buildCriterionResponse(c.Criterion),
+				Average:           c.Average,
+				Scores:            c.Scores,
+			}
+		}
+	}
+	return results, nil
+}
+
+func buildCriterionResponse(c *ent.EventCriterion) CriterionResponse {
+	return CriterionResponse{
+		ID:          c.ID,
+		Name:        c.Name,
+		Description: c.Description,
+		Weight:      c.Weight,
+		MaxScore:    c.MaxScore,
+		Position:    c.Position,
+	}
+}
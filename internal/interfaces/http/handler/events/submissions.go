@@ -0,0 +1,299 @@
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/eventmember"
+	"github.com/jorge-j1m/hackspark_server/ent/eventsubmission"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/hackathon"
+)
+
+type SubmitProjectRequest struct {
+	ProjectID string `json:"project_id"`
+}
+
+func (r SubmitProjectRequest) Validate() error {
+	if r.ProjectID == "" {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+type SubmissionResponse struct {
+	ID string `json:"id"`
+	// Project is the content submitted: the live project until the event ends, then what it
+	// was at the deadline
+	Project struct {
+		ID          string   `json:"id"`
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		Tags        []string `json:"tags"`
+	} `json:"project"`
+	// Revision is the project revision the submission was frozen at, null while the event runs
+	// or when the project had no revision at the deadline
+	Revision  *int `json:"revision"`
+	Frozen    bool `json:"frozen"`
+	Submitter struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"submitter"`
+	SubmittedAt string `json:"submitted_at"`
+}
+
+// SubmitProject submits a project the participant can edit to the event, while it runs
+func (h *EventsHandler) SubmitProject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req SubmitProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+	userID, ok := h.requireRole(w, r, e, eventmember.RoleParticipant)
+	if !ok {
+		return
+	}
+	if hackathon.StatusOf(e, time.Now()) != hackathon.StatusOngoing {
+		response.Error(w, errors.ErrSubmissionsClosed)
+		return
+	}
+
+	p, err := h.authz.Authorize(ctx, req.ProjectID, userID, authz.ActionEditProject)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot submit the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+	if p.Draft || p.Visibility == project.VisibilityPrivate {
+		response.Error(w, errors.ErrSubmissionNotAllowed)
+		return
+	}
+
+	required, err := e.QueryRequiredTags().IDs(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get required tags")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if len(required) > 0 {
+		tagIDs, err := p.QueryTags().IDs(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to get project tags")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		for _, id := range required {
+			if !slices.Contains(tagIDs, id) {
+				response.Error(w, errors.ErrMissingRequiredTags)
+				return
+			}
+		}
+	}
+
+	var s *ent.EventSubmission
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := hackathon.LockOngoing(ctx, tx, e.ID); err != nil {
+			return err
+		}
+		s, err = tx.EventSubmission.Create().
+			SetEventID(e.ID).
+			SetProjectID(p.ID).
+			SetSubmitterID(userID).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			log.Error(ctx).Err(err).Msg("Project already submitted")
+			response.Error(w, errors.ErrProjectAlreadySubmitted)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to submit project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	log.Info(ctx).Msgf("Project %s submitted to event %s as %s", p.ID, e.ID, s.ID)
+	response.JSON(w, http.StatusOK, "Project submitted successfully", nil)
+}
+
+// WithdrawSubmission removes a submission from the event while it runs. The submitter and whoever
+// can edit the project may withdraw it.
+func (h *EventsHandler) WithdrawSubmission(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+
+	s, ok := h.getSubmission(w, r, e.ID)
+	if !ok {
+		return
+	}
+
+	if s.SubmitterID != userID {
+		if _, err := h.authz.Authorize(ctx, s.ProjectID, userID, authz.ActionEditProject); err != nil {
+			log.Error(ctx).Err(err).Msg("User cannot withdraw the submission")
+			response.Error(w, errors.AsAppError(err))
+			return
+		}
+	}
+	if hackathon.StatusOf(e, time.Now()) != hackathon.StatusOngoing {
+		response.Error(w, errors.ErrSubmissionsClosed)
+		return
+	}
+
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := hackathon.LockOngoing(ctx, tx, e.ID); err != nil {
+			return err
+		}
+		return tx.EventSubmission.DeleteOneID(s.ID).Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to withdraw submission")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	log.Info(ctx).Msgf("Submission withdrawn successfully: %s", s.ID)
+	response.JSON(w, http.StatusOK, "Submission withdrawn successfully", nil)
+}
+
+// ListSubmissions lists the submissions of the event in submission order. Once the event has ended
+// they are frozen, and show the content their project had at the deadline.
+func (h *EventsHandler) ListSubmissions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	e, ok := h.getEvent(w, r)
+	if !ok {
+		return
+	}
+	if err := hackathon.Freeze(ctx, h.client, e); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to freeze submissions")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	// While the event runs submissions show their live project, as far as the viewer can see it
+	submissions, err := h.client.EventSubmission.Query().
+		Where(
+			eventsubmission.EventID(e.ID),
+			eventsubmission.HasProjectWith(project.DeletedAtIsNil()),
+		).
+		WithSubmitter().
+		WithProject(func(q *ent.ProjectQuery) {
+			q.WithTags()
+		}).
+		Order(ent.Asc(eventsubmission.FieldCreateTime), ent.Asc(eventsubmission.FieldID)).
+		All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get submissions")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	snapshots, err := hackathon.Snapshots(ctx, h.client, submissions)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get submission snapshots")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	submissionResponses := []SubmissionResponse{}
+	for _, s := range submissions {
+		resp, ok := buildSubmissionResponse(s, snapshots)
+		if ok {
+			submissionResponses = append(submissionResponses, resp)
+		}
+	}
+
+	response.JSON(w, http.StatusOK, "Submissions retrieved successfully", submissionResponses)
+}
+
+func (h *EventsHandler) getSubmission(w http.ResponseWriter, r *http.Request, eventID string) (*ent.EventSubmission, bool) {
+	ctx := r.Context()
+
+	s, err := h.client.EventSubmission.Query().
+		Where(
+			eventsubmission.ID(chi.URLParam(r, "submissionID")),
+			eventsubmission.EventID(eventID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Submission not found")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get submission")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, false
+	}
+
+	return s, true
+}
+
+// buildSubmissionResponse converts a submission, with its submitter and project loaded. It reports
+// false for submissions that are not frozen yet and whose project the viewer cannot see.
+func buildSubmissionResponse(s *ent.EventSubmission, snapshots map[string]hackathon.Snapshot) (SubmissionResponse, bool) {
+	resp := SubmissionResponse{
+		ID:          s.ID,
+		Frozen:      s.FrozenAt != nil,
+		SubmittedAt: s.CreateTime.Format(timeLayout),
+	}
+	resp.Project.ID = s.ProjectID
+
+	if snapshot, ok := snapshots[s.ID]; ok {
+		resp.Project.Name = snapshot.Name
+		resp.Project.Description = snapshot.Description
+		resp.Project.Tags = snapshot.Tags
+		resp.Revision = snapshot.Revision
+	} else if p := s.Edges.Project; p != nil {
+		resp.Project.Name = p.Name
+		resp.Project.Description = p.Description
+		resp.Project.Tags = []string{}
+		for _, t := range p.Edges.Tags {
+			resp.Project.Tags = append(resp.Project.Tags, t.Slug)
+		}
+		slices.Sort(resp.Project.Tags)
+	} else {
+		return resp, false
+	}
+
+	if s.Edges.Submitter != nil {
+		resp.Submitter.ID = s.Edges.Submitter.ID
+		resp.Submitter.Username = s.Edges.Submitter.Username
+	}
+	return resp, true
+}
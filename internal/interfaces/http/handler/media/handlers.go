@@ -0,0 +1,390 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.jetify.com/typeid/v2"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmedia"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/projects"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	mediasvc "github.com/jorge-j1m/hackspark_server/internal/service/media"
+)
+
+const (
+	// maxMediaPerProject bounds the number of media of a project
+	maxMediaPerProject = 20
+	// maxCaptionLength bounds the length of captions
+	maxCaptionLength = 500
+	// multipartOverhead is the room left for the other parts and boundaries of upload requests
+	multipartOverhead = 64 << 10
+	// multipartMemory is how much of an upload is kept in memory before spilling to disk
+	multipartMemory = 1 << 20
+)
+
+type UpdateMediaRequest struct {
+	// Caption is left untouched when omitted and cleared when empty
+	Caption *string `json:"caption"`
+	Cover   *bool   `json:"cover"`
+}
+
+func (r UpdateMediaRequest) Validate() error {
+	if r.Caption != nil && len(*r.Caption) > maxCaptionLength {
+		return errors.ErrInvalidMedia
+	}
+	return nil
+}
+
+func (h *MediaHandler) ListMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+
+	exists, err := h.client.Project.Query().Where(project.ID(projectID)).Exist(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if !exists {
+		log.Error(ctx).Msg("Project not found")
+		response.Error(w, errors.ErrNotFound)
+		return
+	}
+
+	media, err := h.client.ProjectMedia.Query().
+		Where(projectmedia.ProjectID(projectID)).
+		Order(ent.Asc(projectmedia.FieldCreateTime), ent.Asc(projectmedia.FieldID)).
+		All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project media")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	mediaResponses := make([]projects.MediaResponse, len(media))
+	for i, m := range media {
+		mediaResponses[i] = projects.BuildMediaResponse(m)
+	}
+
+	response.JSON(w, http.StatusOK, "Project media retrieved successfully", mediaResponses)
+}
+
+// UploadMedia adds an image to the project from a multipart form with a file part, and optional
+// caption and cover parts. The first image of a project becomes its cover.
+func (h *MediaHandler) UploadMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	// Checked before reading the upload, so rejected requests cost nothing
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionEditProject); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot edit the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxBytes+multipartOverhead)
+	if err := r.ParseMultipartForm(multipartMemory); err != nil {
+		var tooLarge *http.MaxBytesError
+		if stderrors.As(err, &tooLarge) {
+			response.Error(w, errors.ErrMediaTooLarge)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to parse multipart form")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Missing file in upload")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+	defer file.Close()
+	if header.Size > h.maxBytes {
+		response.Error(w, errors.ErrMediaTooLarge)
+		return
+	}
+
+	var caption *string
+	if c := r.FormValue("caption"); c != "" {
+		if len(c) > maxCaptionLength {
+			response.Error(w, errors.ErrInvalidMedia)
+			return
+		}
+		caption = &c
+	}
+	cover := r.FormValue("cover") == "true"
+
+	count, err := h.client.ProjectMedia.Query().
+		Where(projectmedia.ProjectID(projectID)).
+		Count(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to count project media")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if count >= maxMediaPerProject {
+		response.Error(w, errors.ErrTooManyMedia)
+		return
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to read upload")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	img, err := mediasvc.Process(data)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid image")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	// Keys are never reused, so stored files can be cached forever
+	mediaID := typeid.MustGenerate("pmed").String()
+	key := "projects/" + projectID + "/" + mediaID + "." + img.Extension
+	thumbnailKey := "projects/" + projectID + "/" + mediaID + "_thumb.jpg"
+
+	if err := h.blob.Put(ctx, key, bytes.NewReader(data), int64(len(data)), img.ContentType); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to store image")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if err := h.blob.Put(ctx, thumbnailKey, bytes.NewReader(img.Thumbnail), int64(len(img.Thumbnail)), "image/jpeg"); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to store thumbnail")
+		h.deleteFiles(ctx, key)
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	var media *ent.ProjectMedia
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		hasCover, err := tx.ProjectMedia.Query().
+			Where(
+				projectmedia.ProjectID(projectID),
+				projectmedia.Cover(true),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if cover && hasCover {
+			if err := unsetCover(ctx, tx, projectID); err != nil {
+				return err
+			}
+		}
+
+		media, err = tx.ProjectMedia.Create().
+			SetID(mediaID).
+			SetProjectID(projectID).
+			SetUploaderID(userID).
+			SetKey(key).
+			SetURL(h.blob.URL(key)).
+			SetThumbnailKey(thumbnailKey).
+			SetThumbnailURL(h.blob.URL(thumbnailKey)).
+			SetContentType(img.ContentType).
+			SetSize(int64(len(data))).
+			SetWidth(img.Width).
+			SetHeight(img.Height).
+			SetNillableCaption(caption).
+			SetCover(cover || !hasCover).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to save project media")
+		h.deleteFiles(ctx, key, thumbnailKey)
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project media uploaded successfully: %s", media.ID)
+	response.JSON(w, http.StatusCreated, "Project media uploaded successfully", projects.BuildMediaResponse(media))
+}
+
+func (h *MediaHandler) UpdateMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	mediaID := chi.URLParam(r, "mediaID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req UpdateMediaRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionEditProject); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot edit the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	var media *ent.ProjectMedia
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		m, err := getMedia(ctx, tx.Client(), projectID, mediaID)
+		if err != nil {
+			return err
+		}
+
+		update := tx.ProjectMedia.UpdateOne(m)
+		if req.Caption != nil {
+			if *req.Caption == "" {
+				update = update.ClearCaption()
+			} else {
+				update = update.SetCaption(*req.Caption)
+			}
+		}
+		if req.Cover != nil && *req.Cover != m.Cover {
+			// A project has a single cover, setting one replaces the previous one
+			if *req.Cover {
+				if err := unsetCover(ctx, tx, projectID); err != nil {
+					return err
+				}
+			}
+			update = update.SetCover(*req.Cover)
+		}
+		media, err = update.Save(ctx)
+		return err
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Project media not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to update project media")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project media updated successfully: %s", media.ID)
+	response.JSON(w, http.StatusOK, "Project media updated successfully", projects.BuildMediaResponse(media))
+}
+
+// DeleteMedia removes an image of the project. When it was the cover, the oldest remaining image
+// becomes the cover.
+func (h *MediaHandler) DeleteMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	mediaID := chi.URLParam(r, "mediaID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionEditProject); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot edit the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	var media *ent.ProjectMedia
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		m, err := getMedia(ctx, tx.Client(), projectID, mediaID)
+		if err != nil {
+			return err
+		}
+		media = m
+
+		if err := tx.ProjectMedia.DeleteOne(m).Exec(ctx); err != nil {
+			return err
+		}
+		if !m.Cover {
+			return nil
+		}
+
+		next, err := tx.ProjectMedia.Query().
+			Where(projectmedia.ProjectID(projectID)).
+			Order(ent.Asc(projectmedia.FieldCreateTime), ent.Asc(projectmedia.FieldID)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return tx.ProjectMedia.UpdateOne(next).SetCover(true).Exec(ctx)
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Project media not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to delete project media")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	h.deleteFiles(ctx, media.Key, media.ThumbnailKey)
+
+	log.Info(ctx).Msgf("Project media deleted successfully: %s", mediaID)
+	response.JSON(w, http.StatusOK, "Project media deleted successfully", nil)
+}
+
+func getMedia(ctx context.Context, client *ent.Client, projectID, mediaID string) (*ent.ProjectMedia, error) {
+	return client.ProjectMedia.Query().
+		Where(
+			projectmedia.ID(mediaID),
+			projectmedia.ProjectID(projectID),
+		).
+		Only(ctx)
+}
+
+func unsetCover(ctx context.Context, tx *ent.Tx, projectID string) error {
+	return tx.ProjectMedia.Update().
+		Where(
+			projectmedia.ProjectID(projectID),
+			projectmedia.Cover(true),
+		).
+		SetCover(false).
+		Exec(ctx)
+}
+
+// deleteFiles removes stored files. Failures only leave orphan files behind, so they are logged
+// rather than reported.
+func (h *MediaHandler) deleteFiles(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := h.blob.Delete(ctx, key); err != nil {
+			log.Error(ctx).Err(err).Msgf("Failed to delete stored file %s", key)
+		}
+	}
+}
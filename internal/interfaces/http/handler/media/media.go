@@ -0,0 +1,25 @@
+package media
+
+import (
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/storage"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+)
+
+type MediaHandler struct {
+	client *ent.Client
+	authz  *authz.Authorizer
+	blob   storage.Blob
+
+	// maxBytes is the size limit of uploaded files
+	maxBytes int64
+}
+
+func NewMediaHandler(client *ent.Client, authorizer *authz.Authorizer, blob storage.Blob, maxBytes int64) *MediaHandler {
+	return &MediaHandler{
+		client:   client,
+		authz:    authorizer,
+		blob:     blob,
+		maxBytes: maxBytes,
+	}
+}
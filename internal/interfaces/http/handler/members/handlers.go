@@ -0,0 +1,839 @@
+package members
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectinvitation"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"github.com/jorge-j1m/hackspark_server/internal/service/notify"
+	"github.com/jorge-j1m/hackspark_server/internal/service/webhook"
+)
+
+type CreateInvitationRequest struct {
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+func (r CreateInvitationRequest) Validate() error {
+	if r.Username == "" {
+		return errors.ErrInvalidRequest
+	}
+	if r.Role != "" && projectinvitation.RoleValidator(projectinvitation.Role(r.Role)) != nil {
+		return errors.ErrInvalidMemberRole
+	}
+	return nil
+}
+
+type UpdateMemberRequest struct {
+	Role string `json:"role"`
+}
+
+func (r UpdateMemberRequest) Validate() error {
+	// Owner is not an option, ownership only moves through a transfer
+	if r.Role != string(projectmember.RoleMaintainer) && r.Role != string(projectmember.RoleContributor) {
+		return errors.ErrInvalidMemberRole
+	}
+	return nil
+}
+
+type TransferOwnershipRequest struct {
+	Username string `json:"username"`
+}
+
+func (r TransferOwnershipRequest) Validate() error {
+	if r.Username == "" {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+type MemberUser struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+type MemberResponse struct {
+	User     MemberUser `json:"user"`
+	Role     string     `json:"role"`
+	JoinedAt string     `json:"joined_at"`
+}
+
+type InvitationResponse struct {
+	ID      string `json:"id"`
+	Project struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"project"`
+	Invitee     MemberUser `json:"invitee"`
+	Inviter     MemberUser `json:"inviter"`
+	Role        string     `json:"role"`
+	Status      string     `json:"status"`
+	RespondedAt *string    `json:"responded_at"`
+	CreatedAt   string     `json:"created_at"`
+}
+
+func (h *MembersHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+
+	p, err := h.getProject(ctx, projectID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Project not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	memberResponses, err := h.getMemberResponses(ctx, p)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list project members")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, "Project members retrieved successfully", memberResponses)
+}
+
+func (h *MembersHandler) CreateInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req CreateInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	role := projectinvitation.DefaultRole
+	if req.Role != "" {
+		role = projectinvitation.Role(req.Role)
+	}
+
+	p, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionInviteMembers)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot invite members to the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	// Maintainers can bring in contributors, only those managing members can grant maintainer
+	if role == projectinvitation.RoleMaintainer {
+		allowed, err := h.authz.Can(ctx, p, userID, authz.ActionManageMembers)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to check member management rights")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		if !allowed {
+			log.Error(ctx).Msg("User cannot invite maintainers to the project")
+			response.Error(w, errors.ErrForbidden)
+			return
+		}
+	}
+
+	invitee, err := h.client.User.Query().Where(user.Username(req.Username)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Invitee not found")
+			response.Error(w, errors.ErrUserNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get invitee")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	inviteeRole, err := h.authz.RoleOf(ctx, p, invitee.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get invitee role")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if inviteeRole != "" {
+		log.Error(ctx).Msg("Invitee is already a member of the project")
+		response.Error(w, errors.ErrAlreadyMember)
+		return
+	}
+
+	pending, err := h.client.ProjectInvitation.Query().
+		Where(
+			projectinvitation.ProjectID(projectID),
+			projectinvitation.InviteeID(invitee.ID),
+			projectinvitation.StatusEQ(projectinvitation.StatusPending),
+		).
+		Exist(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to check pending invitations")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if pending {
+		log.Error(ctx).Msg("Invitee already has a pending invitation")
+		response.Error(w, errors.ErrInvitationPending)
+		return
+	}
+
+	var (
+		inv     *ent.ProjectInvitation
+		invited eventbus.Event
+	)
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		inv, err = tx.ProjectInvitation.Create().
+			SetProjectID(projectID).
+			SetInviteeID(invitee.ID).
+			SetInviterID(userID).
+			SetRole(role).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		invited = eventbus.Event{
+			Type:      eventbus.ProjectMemberInvited,
+			ActorID:   userID,
+			ProjectID: projectID,
+			Payload: map[string]any{
+				"invitation_id": inv.ID,
+				"invitee_id":    invitee.ID,
+				"role":          string(role),
+			},
+		}
+		if err := webhook.Enqueue(ctx, tx, invited); err != nil {
+			return err
+		}
+		return notify.Enqueue(ctx, tx, invited)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to create invitation")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	h.bus.Publish(ctx, invited)
+
+	invitationResp, err := h.getInvitationResponse(ctx, inv.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get invitation response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Invitation created successfully: %s on project %s", inv.ID, projectID)
+	response.JSON(w, http.StatusCreated, "Invitation created successfully", invitationResp)
+}
+
+func (h *MembersHandler) ListProjectInvitations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionInviteMembers); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot see the project invitations")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	invitations, err := h.invitationQuery().
+		Where(
+			projectinvitation.ProjectID(projectID),
+			projectinvitation.StatusEQ(projectinvitation.StatusPending),
+		).
+		All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list project invitations")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, "Project invitations retrieved successfully", buildInvitationResponses(invitations))
+}
+
+func (h *MembersHandler) RevokeInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	invitationID := chi.URLParam(r, "invitationID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionInviteMembers); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot revoke project invitations")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	inv, err := h.client.ProjectInvitation.Query().
+		Where(
+			projectinvitation.ID(invitationID),
+			projectinvitation.ProjectID(projectID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Invitation not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get invitation")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if inv.Status != projectinvitation.StatusPending {
+		log.Error(ctx).Msg("Invitation is no longer pending")
+		response.Error(w, errors.ErrInvitationNotPending)
+		return
+	}
+
+	if err := h.client.ProjectInvitation.UpdateOneID(inv.ID).
+		SetStatus(projectinvitation.StatusRevoked).
+		Exec(ctx); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to revoke invitation")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Invitation revoked successfully: %s on project %s", inv.ID, projectID)
+	response.JSON(w, http.StatusOK, "Invitation revoked successfully", nil)
+}
+
+func (h *MembersHandler) UpdateMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	username := chi.URLParam(r, "username")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req UpdateMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	p, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionManageMembers)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot manage the project members")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	member, role, ok := h.getMember(w, r, p, username)
+	if !ok {
+		return
+	}
+	if role == projectmember.RoleOwner {
+		log.Error(ctx).Msg("Cannot change the role of the owner")
+		response.Error(w, errors.ErrOwnerRoleChange)
+		return
+	}
+
+	if _, err := h.client.ProjectMember.Update().
+		Where(
+			projectmember.ProjectID(projectID),
+			projectmember.UserID(member.ID),
+		).
+		SetRole(projectmember.Role(req.Role)).
+		Save(ctx); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update member role")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	memberResponses, err := h.getMemberResponses(ctx, p)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list project members")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Member role updated successfully: %s is now %s on project %s", member.Username, req.Role, projectID)
+	response.JSON(w, http.StatusOK, "Member role updated successfully", memberResponses)
+}
+
+// RemoveMember removes a member from the project. Members can always remove themselves,
+// removing anyone else requires managing members.
+func (h *MembersHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	username := chi.URLParam(r, "username")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	p, err := h.getProject(ctx, projectID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Project not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	member, role, ok := h.getMember(w, r, p, username)
+	if !ok {
+		return
+	}
+
+	if member.ID != userID {
+		allowed, err := h.authz.Can(ctx, p, userID, authz.ActionManageMembers)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to check member management rights")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		if !allowed {
+			log.Error(ctx).Msg("User cannot remove members from the project")
+			response.Error(w, errors.ErrForbidden)
+			return
+		}
+	}
+
+	if role == projectmember.RoleOwner {
+		log.Error(ctx).Msg("Owner cannot leave the project")
+		response.Error(w, errors.ErrOwnerCannotLeave)
+		return
+	}
+
+	if _, err := h.client.ProjectMember.Delete().
+		Where(
+			projectmember.ProjectID(projectID),
+			projectmember.UserID(member.ID),
+		).
+		Exec(ctx); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to remove member")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Member removed successfully: %s from project %s", member.Username, projectID)
+	response.JSON(w, http.StatusOK, "Member removed successfully", nil)
+}
+
+// TransferOwnership hands the project over to another member. The previous owner stays on as a maintainer.
+func (h *MembersHandler) TransferOwnership(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req TransferOwnershipRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	p, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionTransferOwnership)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot transfer the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	newOwner, role, ok := h.getMember(w, r, p, req.Username)
+	if !ok {
+		return
+	}
+	if role == projectmember.RoleOwner {
+		log.Error(ctx).Msg("User already owns the project")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	transferred := eventbus.Event{
+		Type:      eventbus.ProjectOwnershipTransferred,
+		ActorID:   userID,
+		ProjectID: projectID,
+		Payload: map[string]any{
+			"from_owner_id": userID,
+			"to_owner_id":   newOwner.ID,
+		},
+	}
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := tx.Project.UpdateOneID(projectID).SetOwnerID(newOwner.ID).Exec(ctx); err != nil {
+			return err
+		}
+
+		if err := tx.ProjectMember.Update().
+			Where(
+				projectmember.ProjectID(projectID),
+				projectmember.UserID(newOwner.ID),
+			).
+			SetRole(projectmember.RoleOwner).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Projects created before memberships existed have no row for their owner
+		demoted, err := tx.ProjectMember.Update().
+			Where(
+				projectmember.ProjectID(projectID),
+				projectmember.UserID(userID),
+			).
+			SetRole(projectmember.RoleMaintainer).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if demoted == 0 {
+			if err := tx.ProjectMember.Create().
+				SetProjectID(projectID).
+				SetUserID(userID).
+				SetRole(projectmember.RoleMaintainer).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		if err := webhook.Enqueue(ctx, tx, transferred); err != nil {
+			return err
+		}
+		return notify.Enqueue(ctx, tx, transferred)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to transfer project ownership")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	h.bus.Publish(ctx, transferred)
+
+	p, err = h.getProject(ctx, projectID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	memberResponses, err := h.getMemberResponses(ctx, p)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list project members")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project ownership transferred successfully: %s to %s", projectID, newOwner.Username)
+	response.JSON(w, http.StatusOK, "Project ownership transferred successfully", memberResponses)
+}
+
+func (h *MembersHandler) ListMyInvitations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	invitations, err := h.invitationQuery().
+		Where(
+			projectinvitation.InviteeID(userID),
+			projectinvitation.StatusEQ(projectinvitation.StatusPending),
+		).
+		All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list invitations")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, "Invitations retrieved successfully", buildInvitationResponses(invitations))
+}
+
+func (h *MembersHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	h.respondToInvitation(w, r, projectinvitation.StatusAccepted)
+}
+
+func (h *MembersHandler) DeclineInvitation(w http.ResponseWriter, r *http.Request) {
+	h.respondToInvitation(w, r, projectinvitation.StatusDeclined)
+}
+
+// respondToInvitation lets the invitee accept or decline a pending invitation.
+// Accepting makes them a member with the invited role.
+func (h *MembersHandler) respondToInvitation(w http.ResponseWriter, r *http.Request, status projectinvitation.Status) {
+	ctx := r.Context()
+	invitationID := chi.URLParam(r, "invitationID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	// Invitations of other users are reported as missing rather than forbidden
+	inv, err := h.client.ProjectInvitation.Query().
+		Where(
+			projectinvitation.ID(invitationID),
+			projectinvitation.InviteeID(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Invitation not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get invitation")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if inv.Status != projectinvitation.StatusPending {
+		log.Error(ctx).Msg("Invitation is no longer pending")
+		response.Error(w, errors.ErrInvitationNotPending)
+		return
+	}
+
+	joined := eventbus.Event{
+		Type:      eventbus.ProjectMemberJoined,
+		ActorID:   userID,
+		ProjectID: inv.ProjectID,
+		Payload: map[string]any{
+			"invitation_id": inv.ID,
+			"role":          string(inv.Role),
+		},
+	}
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := tx.ProjectInvitation.UpdateOneID(inv.ID).
+			SetStatus(status).
+			SetRespondedAt(time.Now()).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if status != projectinvitation.StatusAccepted {
+			return nil
+		}
+
+		isMember, err := tx.ProjectMember.Query().
+			Where(
+				projectmember.ProjectID(inv.ProjectID),
+				projectmember.UserID(userID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			if err := tx.ProjectMember.Create().
+				SetProjectID(inv.ProjectID).
+				SetUserID(userID).
+				SetRole(projectmember.Role(inv.Role)).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		if err := webhook.Enqueue(ctx, tx, joined); err != nil {
+			return err
+		}
+		return notify.Enqueue(ctx, tx, joined)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msgf("Failed to mark invitation as %s", status)
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	if status == projectinvitation.StatusAccepted {
+		h.bus.Publish(ctx, joined)
+	}
+
+	invitationResp, err := h.getInvitationResponse(ctx, inv.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get invitation response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Invitation %s successfully: %s", status, inv.ID)
+	response.JSON(w, http.StatusOK, "Invitation "+string(status)+" successfully", invitationResp)
+}
+
+func (h *MembersHandler) getProject(ctx context.Context, projectID string) (*ent.Project, error) {
+	return h.client.Project.Query().
+		Where(project.ID(projectID)).
+		WithOwner().
+		Only(ctx)
+}
+
+// getMember looks up a user by username and their role in the project,
+// writing the error response when the user does not exist or is not a member
+func (h *MembersHandler) getMember(w http.ResponseWriter, r *http.Request, p *ent.Project, username string) (*ent.User, projectmember.Role, bool) {
+	ctx := r.Context()
+
+	u, err := h.client.User.Query().Where(user.Username(username)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("User not found")
+			response.Error(w, errors.ErrUserNotFound)
+			return nil, "", false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get user")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, "", false
+	}
+
+	role, err := h.authz.RoleOf(ctx, p, u.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get member role")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, "", false
+	}
+	if role == "" {
+		log.Error(ctx).Msg("User is not a member of the project")
+		response.Error(w, errors.ErrNotAMember)
+		return nil, "", false
+	}
+
+	return u, role, true
+}
+
+// getMemberResponses lists the members of the project, owner first.
+// The project must have its owner edge loaded.
+func (h *MembersHandler) getMemberResponses(ctx context.Context, p *ent.Project) ([]MemberResponse, error) {
+	memberships, err := h.client.ProjectMember.Query().
+		Where(projectmember.ProjectID(p.ID)).
+		WithUser().
+		Order(ent.Asc(projectmember.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := p.Edges.Owner
+	memberResponses := []MemberResponse{{
+		User:     MemberUser{ID: owner.ID, Username: owner.Username},
+		Role:     string(projectmember.RoleOwner),
+		JoinedAt: p.CreateTime.Format("2006-01-02T15:04:05Z"),
+	}}
+	for _, m := range memberships {
+		if m.UserID == owner.ID {
+			memberResponses[0].JoinedAt = m.CreateTime.Format("2006-01-02T15:04:05Z")
+			continue
+		}
+		role := m.Role
+		// Mirrors authz.RoleOf, the owner edge is authoritative
+		if role == projectmember.RoleOwner {
+			role = projectmember.RoleMaintainer
+		}
+		resp := MemberResponse{
+			Role:     string(role),
+			JoinedAt: m.CreateTime.Format("2006-01-02T15:04:05Z"),
+		}
+		if m.Edges.User != nil {
+			resp.User = MemberUser{ID: m.Edges.User.ID, Username: m.Edges.User.Username}
+		}
+		memberResponses = append(memberResponses, resp)
+	}
+
+	return memberResponses, nil
+}
+
+func (h *MembersHandler) invitationQuery() *ent.ProjectInvitationQuery {
+	return h.client.ProjectInvitation.Query().
+		WithProject().
+		WithInvitee().
+		WithInviter().
+		Order(ent.Desc(projectinvitation.FieldCreateTime))
+}
+
+func (h *MembersHandler) getInvitationResponse(ctx context.Context, invitationID string) (InvitationResponse, error) {
+	inv, err := h.invitationQuery().Where(projectinvitation.ID(invitationID)).Only(ctx)
+	if err != nil {
+		return InvitationResponse{}, err
+	}
+	return buildInvitationResponse(inv), nil
+}
+
+func buildInvitationResponses(invitations []*ent.ProjectInvitation) []InvitationResponse {
+	invitationResponses := make([]InvitationResponse, len(invitations))
+	for i, inv := range invitations {
+		invitationResponses[i] = buildInvitationResponse(inv)
+	}
+	return invitationResponses
+}
+
+func buildInvitationResponse(inv *ent.ProjectInvitation) InvitationResponse {
+	resp := InvitationResponse{
+		ID:        inv.ID,
+		Role:      string(inv.Role),
+		Status:    string(inv.Status),
+		CreatedAt: inv.CreateTime.Format("2006-01-02T15:04:05Z"),
+	}
+	if inv.RespondedAt != nil {
+		respondedAt := inv.RespondedAt.Format("2006-01-02T15:04:05Z")
+		resp.RespondedAt = &respondedAt
+	}
+	if p := inv.Edges.Project; p != nil {
+		resp.Project.ID = p.ID
+		resp.Project.Name = p.Name
+	}
+	if u := inv.Edges.Invitee; u != nil {
+		resp.Invitee = MemberUser{ID: u.ID, Username: u.Username}
+	}
+	if u := inv.Edges.Inviter; u != nil {
+		resp.Inviter = MemberUser{ID: u.ID, Username: u.Username}
+	}
+	return resp
+}
@@ -0,0 +1,21 @@
+package members
+
+import (
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+)
+
+type MembersHandler struct {
+	client *ent.Client
+	bus    *eventbus.Bus
+	authz  *authz.Authorizer
+}
+
+func NewMembersHandler(client *ent.Client, bus *eventbus.Bus, authorizer *authz.Authorizer) *MembersHandler {
+	return &MembersHandler{
+		client: client,
+		bus:    bus,
+		authz:  authorizer,
+	}
+}
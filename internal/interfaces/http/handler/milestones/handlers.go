@@ -0,0 +1,772 @@
+package milestones
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/milestone"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/task"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/progress"
+)
+
+const dateLayout = "2006-01-02"
+
+type CreateMilestoneRequest struct {
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+	DueDate     *string `json:"due_date"`
+}
+
+func (r CreateMilestoneRequest) Validate() error {
+	if r.Title == "" || len(r.Title) > 255 {
+		return errors.ErrInvalidRequest
+	}
+	if r.Description != nil && len(*r.Description) > 1000 {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+type UpdateMilestoneRequest struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	// DueDate is left untouched when omitted and cleared when empty
+	DueDate   *string `json:"due_date"`
+	Completed *bool   `json:"completed"`
+}
+
+func (r UpdateMilestoneRequest) Validate() error {
+	if r.Title != nil && (*r.Title == "" || len(*r.Title) > 255) {
+		return errors.ErrInvalidRequest
+	}
+	if r.Description != nil && len(*r.Description) > 1000 {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+type CreateTaskRequest struct {
+	Title string `json:"title"`
+}
+
+func (r CreateTaskRequest) Validate() error {
+	if r.Title == "" || len(r.Title) > 255 {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+type UpdateTaskRequest struct {
+	Title     *string `json:"title"`
+	Completed *bool   `json:"completed"`
+}
+
+func (r UpdateTaskRequest) Validate() error {
+	if r.Title != nil && (*r.Title == "" || len(*r.Title) > 255) {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+type ReorderRequest struct {
+	IDs []string `json:"ids"`
+}
+
+func (r ReorderRequest) Validate() error {
+	if len(r.IDs) == 0 {
+		return errors.ErrInvalidOrder
+	}
+	return nil
+}
+
+type TaskResponse struct {
+	ID          string  `json:"id"`
+	Title       string  `json:"title"`
+	Position    int     `json:"position"`
+	Completed   bool    `json:"completed"`
+	CompletedAt *string `json:"completed_at"`
+	CreatedAt   string  `json:"created_at"`
+}
+
+type MilestoneResponse struct {
+	ID          string         `json:"id"`
+	Title       string         `json:"title"`
+	Description *string        `json:"description"`
+	Position    int            `json:"position"`
+	DueDate     *string        `json:"due_date"`
+	Completed   bool           `json:"completed"`
+	CompletedAt *string        `json:"completed_at"`
+	Progress    int            `json:"progress"`
+	Tasks       []TaskResponse `json:"tasks"`
+	CreatedAt   string         `json:"created_at"`
+}
+
+func (h *MilestonesHandler) ListMilestones(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+
+	exists, err := h.client.Project.Query().Where(project.ID(projectID)).Exist(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if !exists {
+		log.Error(ctx).Msg("Project not found")
+		response.Error(w, errors.ErrNotFound)
+		return
+	}
+
+	milestones, err := h.client.Milestone.Query().
+		Where(milestone.ProjectID(projectID)).
+		WithTasks(func(q *ent.TaskQuery) {
+			q.Order(ent.Asc(task.FieldPosition), ent.Asc(task.FieldCreateTime))
+		}).
+		Order(ent.Asc(milestone.FieldPosition), ent.Asc(milestone.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list milestones")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	milestoneResponses := make([]MilestoneResponse, len(milestones))
+	for i, m := range milestones {
+		milestoneResponses[i] = buildMilestoneResponse(m)
+	}
+
+	response.JSON(w, http.StatusOK, "Milestones retrieved successfully", milestoneResponses)
+}
+
+func (h *MilestonesHandler) CreateMilestone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+
+	var req CreateMilestoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	dueDate, _, err := parseDate(req.DueDate)
+	if err != nil {
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if !h.authorize(w, r, projectID) {
+		return
+	}
+
+	var m *ent.Milestone
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := lockProject(ctx, tx, projectID); err != nil {
+			return err
+		}
+		// New milestones go last
+		position, err := tx.Milestone.Query().Where(milestone.ProjectID(projectID)).Count(ctx)
+		if err != nil {
+			return err
+		}
+
+		m, err = tx.Milestone.Create().
+			SetProjectID(projectID).
+			SetTitle(req.Title).
+			SetNillableDescription(req.Description).
+			SetNillableDueDate(dueDate).
+			SetPosition(position).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to create milestone")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Milestone created successfully: %s on project %s", m.ID, projectID)
+	response.JSON(w, http.StatusCreated, "Milestone created successfully", buildMilestoneResponse(m))
+}
+
+func (h *MilestonesHandler) UpdateMilestone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	milestoneID := chi.URLParam(r, "milestoneID")
+
+	var req UpdateMilestoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	dueDate, clearDueDate, err := parseDate(req.DueDate)
+	if err != nil {
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if !h.authorize(w, r, projectID) {
+		return
+	}
+
+	m, ok := h.getMilestone(w, r, projectID, milestoneID)
+	if !ok {
+		return
+	}
+
+	update := h.client.Milestone.UpdateOneID(m.ID)
+	if req.Title != nil {
+		update = update.SetTitle(*req.Title)
+	}
+	if req.Description != nil {
+		update = update.SetDescription(*req.Description)
+	}
+	if clearDueDate {
+		update = update.ClearDueDate()
+	} else {
+		update = update.SetNillableDueDate(dueDate)
+	}
+	if req.Completed != nil {
+		if *req.Completed && m.CompletedAt == nil {
+			update = update.SetCompletedAt(time.Now())
+		} else if !*req.Completed {
+			update = update.ClearCompletedAt()
+		}
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update milestone")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp, err := h.getMilestoneResponse(ctx, m.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get milestone response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Milestone updated successfully: %s", m.ID)
+	response.JSON(w, http.StatusOK, "Milestone updated successfully", resp)
+}
+
+func (h *MilestonesHandler) DeleteMilestone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	milestoneID := chi.URLParam(r, "milestoneID")
+
+	if !h.authorize(w, r, projectID) {
+		return
+	}
+
+	m, ok := h.getMilestone(w, r, projectID, milestoneID)
+	if !ok {
+		return
+	}
+
+	err := database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := lockProject(ctx, tx, projectID); err != nil {
+			return err
+		}
+		// The position may have moved since the milestone was read
+		m, err := tx.Milestone.Get(ctx, m.ID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if _, err := tx.Task.Delete().Where(task.MilestoneID(m.ID)).Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.Milestone.DeleteOneID(m.ID).Exec(ctx); err != nil {
+			return err
+		}
+		// Close the gap left in the ordering
+		return tx.Milestone.Update().
+			Where(milestone.ProjectID(projectID), milestone.PositionGT(m.Position)).
+			AddPosition(-1).
+			Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to delete milestone")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Milestone deleted successfully: %s", m.ID)
+	response.JSON(w, http.StatusOK, "Milestone deleted successfully", nil)
+}
+
+func (h *MilestonesHandler) ReorderMilestones(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+
+	var req ReorderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if !h.authorize(w, r, projectID) {
+		return
+	}
+
+	err := database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := lockProject(ctx, tx, projectID); err != nil {
+			return err
+		}
+		existing, err := tx.Milestone.Query().
+			Where(milestone.ProjectID(projectID)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if !samePermutation(existing, req.IDs) {
+			return errors.ErrInvalidOrder
+		}
+
+		for i, id := range req.IDs {
+			if err := tx.Milestone.UpdateOneID(id).SetPosition(i).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to reorder milestones")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	log.Info(ctx).Msgf("Milestones reordered successfully on project %s", projectID)
+	response.JSON(w, http.StatusOK, "Milestones reordered successfully", nil)
+}
+
+func (h *MilestonesHandler) CreateTask(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	milestoneID := chi.URLParam(r, "milestoneID")
+
+	var req CreateTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if !h.authorize(w, r, projectID) {
+		return
+	}
+
+	m, ok := h.getMilestone(w, r, projectID, milestoneID)
+	if !ok {
+		return
+	}
+
+	var t *ent.Task
+	err := database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := lockMilestone(ctx, tx, m.ID); err != nil {
+			return err
+		}
+		// New tasks go last
+		position, err := tx.Task.Query().Where(task.MilestoneID(m.ID)).Count(ctx)
+		if err != nil {
+			return err
+		}
+
+		t, err = tx.Task.Create().
+			SetProjectID(projectID).
+			SetMilestoneID(m.ID).
+			SetTitle(req.Title).
+			SetPosition(position).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to create task")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	log.Info(ctx).Msgf("Task created successfully: %s on milestone %s", t.ID, m.ID)
+	response.JSON(w, http.StatusCreated, "Task created successfully", buildTaskResponse(t))
+}
+
+func (h *MilestonesHandler) UpdateTask(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	milestoneID := chi.URLParam(r, "milestoneID")
+	taskID := chi.URLParam(r, "taskID")
+
+	var req UpdateTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if !h.authorize(w, r, projectID) {
+		return
+	}
+
+	t, ok := h.getTask(w, r, projectID, milestoneID, taskID)
+	if !ok {
+		return
+	}
+
+	update := h.client.Task.UpdateOneID(t.ID)
+	if req.Title != nil {
+		update = update.SetTitle(*req.Title)
+	}
+	if req.Completed != nil {
+		if *req.Completed && t.CompletedAt == nil {
+			update = update.SetCompletedAt(time.Now())
+		} else if !*req.Completed {
+			update = update.ClearCompletedAt()
+		}
+	}
+
+	t, err := update.Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update task")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Task updated successfully: %s", t.ID)
+	response.JSON(w, http.StatusOK, "Task updated successfully", buildTaskResponse(t))
+}
+
+func (h *MilestonesHandler) DeleteTask(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	milestoneID := chi.URLParam(r, "milestoneID")
+	taskID := chi.URLParam(r, "taskID")
+
+	if !h.authorize(w, r, projectID) {
+		return
+	}
+
+	t, ok := h.getTask(w, r, projectID, milestoneID, taskID)
+	if !ok {
+		return
+	}
+
+	err := database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := lockMilestone(ctx, tx, t.MilestoneID); err != nil {
+			return err
+		}
+		// The position may have moved since the task was read
+		t, err := tx.Task.Get(ctx, t.ID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if err := tx.Task.DeleteOneID(t.ID).Exec(ctx); err != nil {
+			return err
+		}
+		// Close the gap left in the ordering
+		return tx.Task.Update().
+			Where(task.MilestoneID(t.MilestoneID), task.PositionGT(t.Position)).
+			AddPosition(-1).
+			Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to delete task")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Task deleted successfully: %s", t.ID)
+	response.JSON(w, http.StatusOK, "Task deleted successfully", nil)
+}
+
+func (h *MilestonesHandler) ReorderTasks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	milestoneID := chi.URLParam(r, "milestoneID")
+
+	var req ReorderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if !h.authorize(w, r, projectID) {
+		return
+	}
+
+	m, ok := h.getMilestone(w, r, projectID, milestoneID)
+	if !ok {
+		return
+	}
+
+	err := database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := lockMilestone(ctx, tx, m.ID); err != nil {
+			return err
+		}
+		existing, err := tx.Task.Query().
+			Where(task.MilestoneID(m.ID)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if !samePermutation(existing, req.IDs) {
+			return errors.ErrInvalidOrder
+		}
+
+		for i, id := range req.IDs {
+			if err := tx.Task.UpdateOneID(id).SetPosition(i).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to reorder tasks")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	log.Info(ctx).Msgf("Tasks reordered successfully on milestone %s", m.ID)
+	response.JSON(w, http.StatusOK, "Tasks reordered successfully", nil)
+}
+
+// authorize checks that the authenticated user can edit the plan of the project,
+// writing the error response otherwise
+func (h *MilestonesHandler) authorize(w http.ResponseWriter, r *http.Request, projectID string) bool {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return false
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionEditPlan); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot edit the project plan")
+		response.Error(w, errors.AsAppError(err))
+		return false
+	}
+
+	return true
+}
+
+// lockProject locks the project until the end of the transaction, so that concurrent changes
+// to its milestones apply one after the other and keep their positions from 0 without gaps
+func lockProject(ctx context.Context, tx *ent.Tx, projectID string) error {
+	_, err := tx.Project.Query().
+		Where(project.ID(projectID)).
+		ForUpdate().
+		OnlyID(ctx)
+	return err
+}
+
+// lockMilestone locks the milestone until the end of the transaction, so that concurrent
+// changes to its tasks apply one after the other and keep their positions from 0 without gaps.
+// A milestone deleted in the meantime is not found.
+func lockMilestone(ctx context.Context, tx *ent.Tx, milestoneID string) error {
+	_, err := tx.Milestone.Query().
+		Where(milestone.ID(milestoneID)).
+		ForUpdate().
+		OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		return errors.ErrNotFound
+	}
+	return err
+}
+
+func (h *MilestonesHandler) getMilestone(w http.ResponseWriter, r *http.Request, projectID, milestoneID string) (*ent.Milestone, bool) {
+	ctx := r.Context()
+
+	m, err := h.client.Milestone.Query().
+		Where(milestone.ID(milestoneID), milestone.ProjectID(projectID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Milestone not found")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get milestone")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, false
+	}
+
+	return m, true
+}
+
+func (h *MilestonesHandler) getTask(w http.ResponseWriter, r *http.Request, projectID, milestoneID, taskID string) (*ent.Task, bool) {
+	ctx := r.Context()
+
+	t, err := h.client.Task.Query().
+		Where(
+			task.ID(taskID),
+			task.MilestoneID(milestoneID),
+			task.ProjectID(projectID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Task not found")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get task")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, false
+	}
+
+	return t, true
+}
+
+func (h *MilestonesHandler) getMilestoneResponse(ctx context.Context, milestoneID string) (*MilestoneResponse, error) {
+	m, err := h.client.Milestone.Query().
+		Where(milestone.ID(milestoneID)).
+		WithTasks(func(q *ent.TaskQuery) {
+			q.Order(ent.Asc(task.FieldPosition), ent.Asc(task.FieldCreateTime))
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := buildMilestoneResponse(m)
+	return &resp, nil
+}
+
+func buildMilestoneResponse(m *ent.Milestone) MilestoneResponse {
+	resp := MilestoneResponse{
+		ID:          m.ID,
+		Title:       m.Title,
+		Description: m.Description,
+		Position:    m.Position,
+		DueDate:     formatDate(m.DueDate),
+		Completed:   m.CompletedAt != nil,
+		CompletedAt: formatTime(m.CompletedAt),
+		Progress:    progress.ForMilestone(m, m.Edges.Tasks),
+		Tasks:       make([]TaskResponse, len(m.Edges.Tasks)),
+		CreatedAt:   m.CreateTime.Format("2006-01-02T15:04:05Z"),
+	}
+
+	for i, t := range m.Edges.Tasks {
+		resp.Tasks[i] = buildTaskResponse(t)
+	}
+
+	return resp
+}
+
+func buildTaskResponse(t *ent.Task) TaskResponse {
+	return TaskResponse{
+		ID:          t.ID,
+		Title:       t.Title,
+		Position:    t.Position,
+		Completed:   t.CompletedAt != nil,
+		CompletedAt: formatTime(t.CompletedAt),
+		CreatedAt:   t.CreateTime.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+// samePermutation reports whether ordered lists every ID of existing exactly once
+func samePermutation(existing, ordered []string) bool {
+	if len(existing) != len(ordered) {
+		return false
+	}
+	remaining := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		remaining[id] = true
+	}
+	for _, id := range ordered {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+	return true
+}
+
+// parseDate parses an optional YYYY-MM-DD date. An empty string means the date should be cleared.
+func parseDate(value *string) (date *time.Time, clear bool, err error) {
+	if value == nil {
+		return nil, false, nil
+	}
+	if *value == "" {
+		return nil, true, nil
+	}
+	t, err := time.Parse(dateLayout, *value)
+	if err != nil {
+		return nil, false, errors.ErrInvalidDueDate
+	}
+	return &t, false, nil
+}
+
+func formatDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(dateLayout)
+	return &s
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format("2006-01-02T15:04:05Z")
+	return &s
+}
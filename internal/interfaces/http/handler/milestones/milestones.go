@@ -0,0 +1,18 @@
+package milestones
+
+import (
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+)
+
+type MilestonesHandler struct {
+	client *ent.Client
+	authz  *authz.Authorizer
+}
+
+func NewMilestonesHandler(client *ent.Client, authorizer *authz.Authorizer) *MilestonesHandler {
+	return &MilestonesHandler{
+		client: client,
+		authz:  authorizer,
+	}
+}
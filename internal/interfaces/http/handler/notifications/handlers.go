@@ -0,0 +1,454 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/notification"
+	"github.com/jorge-j1m/hackspark_server/ent/notificationpreference"
+	"github.com/jorge-j1m/hackspark_server/ent/predicate"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/service/notify"
+)
+
+// shownActors is the number of actors listed with each notification, the others are only counted
+const shownActors = 3
+
+// unsubscribePage asks to confirm unsubscribing, posting the token back to the same address
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Unsubscribe from HackSpark digests</title></head>
+<body>
+<h1>Unsubscribe from HackSpark digests</h1>
+<p>You will no longer get the email digests of your notifications. You can turn them back on from your notification preferences.</p>
+<form method="post" action="?token={{.}}">
+<button type="submit">Unsubscribe</button>
+</form>
+</body>
+</html>
+`))
+
+// UpdatePreferencesRequest turns types of notifications on or off, types left out are unchanged
+type UpdatePreferencesRequest struct {
+	Preferences map[string]bool `json:"preferences"`
+}
+
+func (r UpdatePreferencesRequest) Validate() error {
+	if len(r.Preferences) == 0 {
+		return errors.ErrInvalidRequest
+	}
+	for typ := range r.Preferences {
+		if notification.TypeValidator(notification.Type(typ)) != nil {
+			return errors.ErrInvalidNotificationType
+		}
+	}
+	return nil
+}
+
+type ActorResponse struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+type NotificationProjectResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type NotificationResponse struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	// Project is null when the project is gone or the recipient cannot see it anymore
+	Project *NotificationProjectResponse `json:"project"`
+	// SubjectID is the comment, invitation or join request the notification is about, if any
+	SubjectID *string `json:"subject_id"`
+	// Actors are the most recent users who caused the notification, ActorCount counts them all
+	Actors     []ActorResponse `json:"actors"`
+	ActorCount int             `json:"actor_count"`
+	Read       bool            `json:"read"`
+	CreatedAt  string          `json:"created_at"`
+	UpdatedAt  string          `json:"updated_at"`
+	ReadAt     *string         `json:"read_at"`
+}
+
+type UnreadCountResponse struct {
+	Unread int `json:"unread"`
+}
+
+type PreferenceResponse struct {
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+}
+
+// ListNotifications returns the notifications of the authenticated user, the most recently
+// active first. With unread=true only the unread ones are listed.
+func (h *NotificationsHandler) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	filter := []predicate.Notification{notification.RecipientID(userID)}
+	if r.URL.Query().Get("unread") == "true" {
+		filter = append(filter, notification.ReadAtIsNil())
+	}
+
+	query := h.client.Notification.Query().
+		Where(filter...).
+		WithProject().
+		Order(ent.Desc(notification.FieldUpdateTime), ent.Desc(notification.FieldID)).
+		Limit(params.Fetch())
+	if c := params.After; c != nil {
+		query = query.Where(notification.Or(
+			notification.UpdateTimeLT(c.Time),
+			notification.And(notification.UpdateTime(c.Time), notification.IDLT(c.ID)),
+		))
+	}
+
+	notifications, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list notifications")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	notifications, next := pagination.Trim(notifications, params, func(n *ent.Notification) pagination.Cursor {
+		return pagination.Cursor{Time: n.UpdateTime, ID: n.ID}
+	})
+
+	notificationResponses, err := h.buildNotificationResponses(ctx, notifications)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get notification actors")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	page := response.NewPage(notificationResponses, next)
+	if params.WithTotal {
+		total, err := h.client.Notification.Query().Where(filter...).Count(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count notifications")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "Notifications retrieved successfully", page)
+}
+
+// GetUnreadCount returns the number of unread notifications of the authenticated user
+func (h *NotificationsHandler) GetUnreadCount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	unread, err := h.client.Notification.Query().
+		Where(notification.RecipientID(userID), notification.ReadAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to count unread notifications")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, "Unread count retrieved successfully", UnreadCountResponse{Unread: unread})
+}
+
+// MarkRead marks a notification of the authenticated user as read
+func (h *NotificationsHandler) MarkRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	notificationID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	n, err := h.client.Notification.Query().
+		Where(notification.ID(notificationID), notification.RecipientID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Notification not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get notification")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	if n.ReadAt == nil {
+		if err := h.client.Notification.UpdateOneID(n.ID).SetReadAt(time.Now()).Exec(ctx); err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to mark notification as read")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+	}
+
+	log.Info(ctx).Msgf("Notification marked as read: %s", n.ID)
+	response.JSON(w, http.StatusOK, "Notification marked as read", nil)
+}
+
+// MarkAllRead marks every notification of the authenticated user as read
+func (h *NotificationsHandler) MarkAllRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	n, err := h.client.Notification.Update().
+		Where(notification.RecipientID(userID), notification.ReadAtIsNil()).
+		SetReadAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to mark notifications as read")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("%d notifications marked as read for user %s", n, userID)
+	response.JSON(w, http.StatusOK, "Notifications marked as read", nil)
+}
+
+// GetPreferences returns whether the authenticated user gets each type of notification
+func (h *NotificationsHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	resp, err := h.getPreferences(ctx, userID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get notification preferences")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, "Notification preferences retrieved successfully", resp)
+}
+
+// UpdatePreferences turns types of notifications on or off for the authenticated user
+func (h *NotificationsHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req UpdatePreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		for typ, enabled := range req.Preferences {
+			n, err := tx.NotificationPreference.Update().
+				Where(
+					notificationpreference.UserID(userID),
+					notificationpreference.TypeEQ(notificationpreference.Type(typ)),
+				).
+				SetEnabled(enabled).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				continue
+			}
+			err = tx.NotificationPreference.Create().
+				SetUserID(userID).
+				SetType(notificationpreference.Type(typ)).
+				SetEnabled(enabled).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update notification preferences")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp, err := h.getPreferences(ctx, userID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get notification preferences")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Notification preferences updated for user %s", userID)
+	response.JSON(w, http.StatusOK, "Notification preferences updated successfully", resp)
+}
+
+// UnsubscribePage shows the page the unsubscribe link of the digests opens, which asks to confirm.
+// Opening the link changes nothing, since mail scanners and link previews follow it too.
+func (h *NotificationsHandler) UnsubscribePage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		response.Error(w, errors.ErrInvalidUnsubscribeToken)
+		return
+	}
+
+	exists, err := h.client.User.Query().Where(user.UnsubscribeToken(token)).Exist(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user of unsubscribe token")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if !exists {
+		log.Error(ctx).Msg("Unsubscribe token not found")
+		response.Error(w, errors.ErrInvalidUnsubscribeToken)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if err := unsubscribePage.Execute(w, token); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to render unsubscribe page")
+	}
+}
+
+// Unsubscribe turns the email digests of a user off, from the confirmation page of the link in
+// the digests. It needs no login: the token in the link identifies the user. Mail clients
+// offering one-click unsubscribe POST to the same address.
+func (h *NotificationsHandler) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		response.Error(w, errors.ErrInvalidUnsubscribeToken)
+		return
+	}
+
+	n, err := h.client.User.Update().
+		Where(user.UnsubscribeToken(token)).
+		SetDigestFrequency(user.DigestFrequencyOff).
+		Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to unsubscribe from digests")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if n == 0 {
+		log.Error(ctx).Msg("Unsubscribe token not found")
+		response.Error(w, errors.ErrInvalidUnsubscribeToken)
+		return
+	}
+
+	log.Info(ctx).Msg("User unsubscribed from digests")
+	response.JSON(w, http.StatusOK, "Unsubscribed from digests successfully", nil)
+}
+
+func (h *NotificationsHandler) getPreferences(ctx context.Context, userID string) ([]PreferenceResponse, error) {
+	prefs, err := h.client.NotificationPreference.Query().
+		Where(notificationpreference.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := make(map[string]bool, len(prefs))
+	for _, p := range prefs {
+		enabled[string(p.Type)] = p.Enabled
+	}
+
+	resps := make([]PreferenceResponse, len(notify.Types))
+	for i, typ := range notify.Types {
+		on, ok := enabled[string(typ)]
+		resps[i] = PreferenceResponse{
+			Type:    string(typ),
+			Enabled: on || !ok,
+		}
+	}
+	return resps, nil
+}
+
+// buildNotificationResponses converts notifications, with their project loaded
+func (h *NotificationsHandler) buildNotificationResponses(ctx context.Context, notifications []*ent.Notification) ([]NotificationResponse, error) {
+	actors, err := notify.Actors(ctx, h.client, notifications, shownActors)
+	if err != nil {
+		return nil, err
+	}
+
+	resps := make([]NotificationResponse, len(notifications))
+	for i, n := range notifications {
+		resp := &resps[i]
+		resp.ID = n.ID
+		resp.Type = string(n.Type)
+		resp.SubjectID = n.SubjectID
+		resp.Actors = []ActorResponse{}
+		resp.ActorCount = len(n.ActorIds)
+		resp.Read = n.ReadAt != nil
+		resp.CreatedAt = n.CreateTime.Format("2006-01-02T15:04:05Z")
+		resp.UpdatedAt = n.UpdateTime.Format("2006-01-02T15:04:05Z")
+		if n.ReadAt != nil {
+			readAt := n.ReadAt.Format("2006-01-02T15:04:05Z")
+			resp.ReadAt = &readAt
+		}
+
+		var names []string
+		for _, id := range notify.RecentActors(n, shownActors) {
+			actor, ok := actors[id]
+			if !ok {
+				continue
+			}
+			names = append(names, actor.Username)
+			resp.Actors = append(resp.Actors, ActorResponse{ID: actor.ID, Username: actor.Username})
+		}
+
+		projectName := ""
+		if p := n.Edges.Project; p != nil {
+			projectName = p.Name
+			resp.Project = &NotificationProjectResponse{ID: p.ID, Name: p.Name}
+		}
+		resp.Message = notify.Message(n.Type, names, resp.ActorCount, projectName)
+	}
+	return resps, nil
+}
@@ -0,0 +1,15 @@
+package notifications
+
+import (
+	"github.com/jorge-j1m/hackspark_server/ent"
+)
+
+type NotificationsHandler struct {
+	client *ent.Client
+}
+
+func NewNotificationsHandler(client *ent.Client) *NotificationsHandler {
+	return &NotificationsHandler{
+		client: client,
+	}
+}
@@ -4,26 +4,48 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
-	"strconv"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/jorge-j1m/hackspark_server/ent"
 	"github.com/jorge-j1m/hackspark_server/ent/like"
 	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmedia"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/ent/projectstatuschange"
 	"github.com/jorge-j1m/hackspark_server/ent/projecttag"
 	"github.com/jorge-j1m/hackspark_server/ent/tag"
-	"github.com/jorge-j1m/hackspark_server/ent/user"
+	"github.com/jorge-j1m/hackspark_server/ent/trendingsnapshot"
 	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
 	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
 	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
 	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/projectfilter"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/collections"
+	"github.com/jorge-j1m/hackspark_server/internal/service/counters"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"github.com/jorge-j1m/hackspark_server/internal/service/notify"
+	"github.com/jorge-j1m/hackspark_server/internal/service/progress"
+	"github.com/jorge-j1m/hackspark_server/internal/service/purge"
+	"github.com/jorge-j1m/hackspark_server/internal/service/trending"
+	"github.com/jorge-j1m/hackspark_server/internal/service/webhook"
 )
 
 type CreateProjectRequest struct {
-	Name        string   `json:"name"`
-	Description string   `json:"description"`
-	Tags        []string `json:"tags"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	Tags            []string `json:"tags"`
+	Status          string   `json:"status"`
+	TargetStartDate *string  `json:"target_start_date"`
+	TargetShipDate  *string  `json:"target_ship_date"`
+	Visibility      string   `json:"visibility"`
+	Draft           bool     `json:"draft"`
 }
 
 func (r CreateProjectRequest) Validate() error {
@@ -36,6 +58,12 @@ func (r CreateProjectRequest) Validate() error {
 	if len(r.Description) > 1000 {
 		return errors.ErrInvalidRequest
 	}
+	if r.Status != "" && project.StatusValidator(project.Status(r.Status)) != nil {
+		return errors.ErrInvalidProjectStatus
+	}
+	if r.Visibility != "" && project.VisibilityValidator(project.Visibility(r.Visibility)) != nil {
+		return errors.ErrInvalidProjectVisibility
+	}
 	return nil
 }
 
@@ -43,6 +71,12 @@ type UpdateProjectRequest struct {
 	Name        string   `json:"name"`
 	Description string   `json:"description"`
 	Tags        []string `json:"tags"`
+	// Target dates are left untouched when omitted and cleared when empty
+	TargetStartDate *string `json:"target_start_date"`
+	TargetShipDate  *string `json:"target_ship_date"`
+	// Visibility and draft state are left untouched when omitted
+	Visibility *string `json:"visibility"`
+	Draft      *bool   `json:"draft"`
 }
 
 func (r UpdateProjectRequest) Validate() error {
@@ -52,17 +86,34 @@ func (r UpdateProjectRequest) Validate() error {
 	if len(r.Description) > 1000 {
 		return errors.ErrInvalidRequest
 	}
+	if r.Visibility != nil && project.VisibilityValidator(project.Visibility(*r.Visibility)) != nil {
+		return errors.ErrInvalidProjectVisibility
+	}
 	return nil
 }
 
 type ProjectResponse struct {
-	ID          string   `json:"id"`
-	Name        string   `json:"name"`
-	Description string   `json:"description"`
-	LikeCount   int      `json:"like_count"`
-	StarCount   int      `json:"star_count"`
-	Tags        []string `json:"tags"`
-	Owner       struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	LikeCount       int      `json:"like_count"`
+	StarCount       int      `json:"star_count"`
+	CommentCount    int      `json:"comment_count"`
+	RemixCount      int      `json:"remix_count"`
+	Status          string   `json:"status"`
+	TargetStartDate *string  `json:"target_start_date"`
+	TargetShipDate  *string  `json:"target_ship_date"`
+	ShippedAt       *string  `json:"shipped_at"`
+	Visibility      string   `json:"visibility"`
+	Draft           bool     `json:"draft"`
+	Progress        int      `json:"progress"`
+	Tags            []string `json:"tags"`
+	// RemixedFromID is null unless the project is a remix of a project that still exists
+	RemixedFromID *string `json:"remixed_from_id"`
+	// CoverImageURL is null when the project has no media
+	CoverImageURL *string         `json:"cover_image_url"`
+	Media         []MediaResponse `json:"media"`
+	Owner         struct {
 		ID       string `json:"id"`
 		Username string `json:"username"`
 	} `json:"owner"`
@@ -88,25 +139,61 @@ func (h *ProjectsHandler) CreateProject(w http.ResponseWriter, r *http.Request)
 
 	if err := req.Validate(); err != nil {
 		log.Error(ctx).Err(err).Msg("Invalid request data")
-		response.Error(w, errors.ErrInvalidRequest)
+		response.Error(w, errors.AsAppError(err))
 		return
 	}
 
-	project, err := h.client.Project.Create().
-		SetName(req.Name).
-		SetDescription(req.Description).
-		SetOwnerID(userID).
-		Save(ctx)
+	targetStart, _, err := parseDate(req.TargetStartDate)
 	if err != nil {
-		log.Error(ctx).Err(err).Msg("Failed to create project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+	targetShip, _, err := parseDate(req.TargetShipDate)
+	if err != nil {
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	status := project.DefaultStatus
+	if req.Status != "" {
+		status = project.Status(req.Status)
+	}
+	shipped := status == project.StatusShipped
+
+	visibility := project.DefaultVisibility
+	if req.Visibility != "" {
+		visibility = project.Visibility(req.Visibility)
+	}
+
+	tagIDs, err := h.resolveTags(ctx, req.Tags)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to resolve project tags")
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
 
-	if len(req.Tags) > 0 {
-		if err := h.addTagsToProject(ctx, project.ID, req.Tags); err != nil {
-			log.Error(ctx).Err(err).Msg("Failed to add tags to project")
+	var project *ent.Project
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		create := tx.Project.Create().
+			SetName(req.Name).
+			SetDescription(req.Description).
+			SetOwnerID(userID).
+			SetStatus(status).
+			SetVisibility(visibility).
+			SetDraft(req.Draft).
+			SetNillableTargetStartDate(targetStart).
+			SetNillableTargetShipDate(targetShip).
+			AddTagIDs(tagIDs...)
+		if shipped {
+			create = create.SetShippedAt(time.Now())
 		}
+		project, err = createProject(ctx, tx, userID, status, create)
+		return err
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to create project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
 	}
 
 	projectResp, err := h.getProjectResponse(ctx, project.ID)
@@ -120,6 +207,37 @@ func (h *ProjectsHandler) CreateProject(w http.ResponseWriter, r *http.Request)
 	response.JSON(w, http.StatusOK, "Project created successfully", projectResp)
 }
 
+// createProject saves a new project owned by the user, along with the membership of the owner
+// and the first entry of its status history
+func createProject(ctx context.Context, tx *ent.Tx, userID string, status project.Status, create *ent.ProjectCreate) (*ent.Project, error) {
+	p, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.ProjectMember.Create().
+		SetProjectID(p.ID).
+		SetUserID(userID).
+		SetRole(projectmember.RoleOwner).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	// The initial status starts the history, so it is complete from day one
+	if err := tx.ProjectStatusChange.Create().
+		SetProjectID(p.ID).
+		SetChangedByID(userID).
+		SetToStatus(projectstatuschange.ToStatus(status)).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := counters.EnqueueRecountProjectTags(ctx, tx, p.ID); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
 func (h *ProjectsHandler) GetProject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	projectID := chi.URLParam(r, "id")
@@ -136,7 +254,17 @@ func (h *ProjectsHandler) GetProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response.JSON(w, http.StatusOK, "Project retrieved successfully", projectResp)
+	remixes, err := h.latestRemixes(ctx, projectID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project remixes")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, "Project retrieved successfully", ProjectDetailResponse{
+		ProjectResponse: *projectResp,
+		Remixes:         remixes,
+	})
 }
 
 func (h *ProjectsHandler) UpdateProject(w http.ResponseWriter, r *http.Request) {
@@ -158,51 +286,100 @@ func (h *ProjectsHandler) UpdateProject(w http.ResponseWriter, r *http.Request)
 
 	if err := req.Validate(); err != nil {
 		log.Error(ctx).Err(err).Msg("Invalid request data")
-		response.Error(w, errors.ErrInvalidRequest)
+		response.Error(w, errors.AsAppError(err))
 		return
 	}
 
-	project, err := h.client.Project.Query().
-		Where(project.ID(projectID)).
-		WithOwner().
-		First(ctx)
+	p, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionEditProject)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			log.Error(ctx).Err(err).Msg("Project not found")
-			response.Error(w, errors.ErrNotFound)
+		log.Error(ctx).Err(err).Msg("User cannot edit the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	// Only the owner decides who can see the project
+	if req.Visibility != nil || req.Draft != nil {
+		allowed, err := h.authz.Can(ctx, p, userID, authz.ActionChangeVisibility)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to check visibility rights")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		if !allowed {
+			log.Error(ctx).Msg("User cannot change the project visibility")
+			response.Error(w, errors.ErrForbidden)
 			return
 		}
-		log.Error(ctx).Err(err).Msg("Failed to get project")
-		response.Error(w, errors.ErrInternalServerError)
-		return
 	}
 
-	if project.Edges.Owner.ID != userID {
-		log.Error(ctx).Msg("User is not the owner of the project")
-		response.Error(w, errors.ErrForbidden)
+	targetStart, clearStart, err := parseDate(req.TargetStartDate)
+	if err != nil {
+		response.Error(w, errors.AsAppError(err))
 		return
 	}
-
-	updateQuery := h.client.Project.UpdateOneID(projectID)
-	if req.Name != "" {
-		updateQuery = updateQuery.SetName(req.Name)
+	targetShip, clearShip, err := parseDate(req.TargetShipDate)
+	if err != nil {
+		response.Error(w, errors.AsAppError(err))
+		return
 	}
-	if req.Description != "" {
-		updateQuery = updateQuery.SetDescription(req.Description)
+
+	var tagIDs []string
+	if len(req.Tags) > 0 {
+		tagIDs, err = h.resolveTags(ctx, req.Tags)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to resolve project tags")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
 	}
 
-	if _, err := updateQuery.Save(ctx); err != nil {
+	// The project and the revision recorded along with it are saved together
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		updateQuery := tx.Project.UpdateOneID(projectID)
+		if req.Name != "" {
+			updateQuery = updateQuery.SetName(req.Name)
+		}
+		if req.Description != "" {
+			updateQuery = updateQuery.SetDescription(req.Description)
+		}
+		if req.Visibility != nil {
+			updateQuery = updateQuery.SetVisibility(project.Visibility(*req.Visibility))
+		}
+		if req.Draft != nil {
+			updateQuery = updateQuery.SetDraft(*req.Draft)
+		}
+		if clearStart {
+			updateQuery = updateQuery.ClearTargetStartDate()
+		} else {
+			updateQuery = updateQuery.SetNillableTargetStartDate(targetStart)
+		}
+		if clearShip {
+			updateQuery = updateQuery.ClearTargetShipDate()
+		} else {
+			updateQuery = updateQuery.SetNillableTargetShipDate(targetShip)
+		}
+		if tagIDs != nil {
+			// The tags the project leaves are recounted along with those it gets
+			previous, err := tx.ProjectTag.Query().
+				Where(projecttag.ProjectID(projectID)).
+				Select(projecttag.FieldTagID).
+				Strings(ctx)
+			if err != nil {
+				return err
+			}
+			if err := counters.EnqueueRecountTags(ctx, tx, append(previous, tagIDs...)...); err != nil {
+				return err
+			}
+			updateQuery = updateQuery.ClearTags().AddTagIDs(tagIDs...)
+		}
+		return updateQuery.Exec(ctx)
+	})
+	if err != nil {
 		log.Error(ctx).Err(err).Msg("Failed to update project")
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
 
-	if len(req.Tags) > 0 {
-		if err := h.replaceProjectTags(ctx, projectID, req.Tags); err != nil {
-			log.Error(ctx).Err(err).Msg("Failed to update project tags")
-		}
-	}
-
 	projectResp, err := h.getProjectResponse(ctx, projectID)
 	if err != nil {
 		log.Error(ctx).Err(err).Msg("Failed to get project response")
@@ -224,72 +401,245 @@ func (h *ProjectsHandler) DeleteProject(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	project, err := h.client.Project.Query().
-		Where(project.ID(projectID)).
-		WithOwner().
-		First(ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			log.Error(ctx).Err(err).Msg("Project not found")
-			response.Error(w, errors.ErrNotFound)
-			return
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionDeleteProject); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot delete the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	// The project is only marked as deleted, it can be restored until it is purged.
+	// It leaves the collections it was in right away though.
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := collections.RemoveProjects(ctx, tx, []string{projectID}); err != nil {
+			return err
 		}
-		log.Error(ctx).Err(err).Msg("Failed to get project")
+		if err := counters.EnqueueRecountProjectTags(ctx, tx, projectID); err != nil {
+			return err
+		}
+		return tx.Project.DeleteOneID(projectID).Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to delete project")
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
 
-	if project.Edges.Owner.ID != userID {
-		log.Error(ctx).Msg("User is not the owner of the project")
-		response.Error(w, errors.ErrForbidden)
+	log.Info(ctx).Msgf("Project deleted successfully: %s", projectID)
+	response.JSON(w, http.StatusOK, "Project deleted successfully", nil)
+}
+
+func (h *ProjectsHandler) RestoreProject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	// Those who may delete a project may also restore it
+	p, err := h.authz.Authorize(visibility.IncludeDeleted(ctx), projectID, userID, authz.ActionDeleteProject)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot restore the project")
+		response.Error(w, errors.AsAppError(err))
 		return
 	}
 
-	if err := h.client.Project.DeleteOneID(projectID).Exec(ctx); err != nil {
-		log.Error(ctx).Err(err).Msg("Failed to delete project")
+	if p.DeletedAt == nil {
+		response.Error(w, errors.ErrProjectNotDeleted)
+		return
+	}
+	if !purge.Restorable(*p.DeletedAt, h.retention, time.Now()) {
+		response.Error(w, errors.ErrRestoreWindowExpired)
+		return
+	}
+
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := tx.Project.UpdateOneID(projectID).ClearDeletedAt().Exec(ctx); err != nil {
+			return err
+		}
+		return counters.EnqueueRecountProjectTags(ctx, tx, projectID)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to restore project")
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
 
-	log.Info(ctx).Msgf("Project deleted successfully: %s", projectID)
-	response.JSON(w, http.StatusOK, "Project deleted successfully", nil)
+	projectResp, err := h.getProjectResponse(ctx, projectID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project restored successfully: %s", projectID)
+	response.JSON(w, http.StatusOK, "Project restored successfully", projectResp)
 }
 
 func (h *ProjectsHandler) ListProjects(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	limit, offset := h.getPagination(r)
-
-	query := h.client.Project.Query().
-		WithOwner().
-		WithTags().
-		Limit(limit).
-		Offset(offset).
-		Order(ent.Desc(project.FieldCreateTime))
-
-	if tagsParam := r.URL.Query().Get("tags"); tagsParam != "" {
-		tagSlugs := strings.Split(tagsParam, ",")
-		query = query.Where(project.HasTagsWith(tag.SlugIn(tagSlugs...))).WithTags().WithOwner()
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
 	}
 
-	if ownerParam := r.URL.Query().Get("owner"); ownerParam != "" {
-		query = query.Where(project.HasOwnerWith(user.Username(ownerParam))).WithTags().WithOwner()
+	filter, err := projectfilter.Parse(ctx, r.URL.Query())
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid project filter")
+		response.Error(w, errors.AsAppError(err))
+		return
 	}
 
+	query := filter.Paginate(filter.Apply(h.client.Project.Query()), params).
+		WithOwner().
+		WithTags()
+
 	projects, err := query.All(ctx)
 	if err != nil {
 		log.Error(ctx).Err(err).Msg("Failed to list projects")
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
+	projects, next := pagination.Trim(projects, params, filter.Cursor(params))
 
-	var projectResponses []ProjectResponse
+	projectResponses := make([]ProjectResponse, 0, len(projects))
 	for _, p := range projects {
-		resp := h.buildProjectResponse(p)
+		resp := BuildProjectResponse(p)
 		projectResponses = append(projectResponses, resp)
 	}
 
-	response.JSON(w, http.StatusOK, "Projects retrieved successfully", projectResponses)
+	resps := make([]*ProjectResponse, len(projectResponses))
+	for i := range projectResponses {
+		resps[i] = &projectResponses[i]
+	}
+	if err := FillProgress(ctx, h.client, resps...); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to compute project progress")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if err := FillMedia(ctx, h.client, resps...); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to load project media")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	page := response.NewPage(projectResponses, next)
+	if params.WithTotal {
+		total, err := filter.Count(ctx, h.client)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count projects")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "Projects retrieved successfully", page)
+}
+
+func (h *ProjectsHandler) GetTrendingProjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	window, ok := trending.ParseWindow(r.URL.Query().Get("window"))
+	if !ok {
+		log.Error(ctx).Msg("Invalid trending window")
+		response.Error(w, errors.ErrInvalidTrendingWindow)
+		return
+	}
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+	if params.Limit > 50 {
+		params.Limit = 50
+	}
+
+	query := h.client.TrendingSnapshot.Query().
+		Where(
+			trendingsnapshot.SubjectTypeEQ(trendingsnapshot.SubjectTypeProject),
+			trendingsnapshot.PeriodEQ(window.Period),
+		).
+		Order(ent.Asc(trendingsnapshot.FieldRank)).
+		Limit(params.Fetch())
+	if params.After != nil {
+		query = query.Where(trendingsnapshot.RankGT(int(params.After.Int)))
+	}
+
+	snapshots, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get trending snapshot")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	snapshots, next := pagination.Trim(snapshots, params, func(s *ent.TrendingSnapshot) pagination.Cursor {
+		return pagination.Cursor{Int: int64(s.Rank)}
+	})
+
+	projectIDs := make([]string, len(snapshots))
+	for i, s := range snapshots {
+		projectIDs[i] = s.SubjectID
+	}
+
+	projects, err := h.client.Project.Query().
+		Where(project.IDIn(projectIDs...)).
+		WithOwner().
+		WithTags().
+		All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get trending projects")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	projectsByID := make(map[string]*ent.Project, len(projects))
+	for _, p := range projects {
+		projectsByID[p.ID] = p
+	}
+
+	type TrendingProjectResponse struct {
+		ProjectResponse
+		Rank  int     `json:"rank"`
+		Score float64 `json:"score"`
+	}
+
+	// Keep the snapshot ranking, skipping projects deleted since the last refresh
+	projectResponses := []TrendingProjectResponse{}
+	for _, s := range snapshots {
+		p, ok := projectsByID[s.SubjectID]
+		if !ok {
+			continue
+		}
+		projectResponses = append(projectResponses, TrendingProjectResponse{
+			ProjectResponse: BuildProjectResponse(p),
+			Rank:            s.Rank,
+			Score:           s.Score,
+		})
+	}
+
+	resps := make([]*ProjectResponse, len(projectResponses))
+	for i := range projectResponses {
+		resps[i] = &projectResponses[i].ProjectResponse
+	}
+	if err := FillProgress(ctx, h.client, resps...); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to compute project progress")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if err := FillMedia(ctx, h.client, resps...); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to load project media")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	response.Paginated(w, r, "Trending projects retrieved successfully", response.NewPage(projectResponses, next))
 }
 
 func (h *ProjectsHandler) getProjectResponse(ctx context.Context, projectID string) (*ProjectResponse, error) {
@@ -302,19 +652,89 @@ func (h *ProjectsHandler) getProjectResponse(ctx context.Context, projectID stri
 		return nil, err
 	}
 
-	resp := h.buildProjectResponse(project)
+	resp := BuildProjectResponse(project)
+	if err := FillProgress(ctx, h.client, &resp); err != nil {
+		return nil, err
+	}
+	if err := FillMedia(ctx, h.client, &resp); err != nil {
+		return nil, err
+	}
 	return &resp, nil
 }
 
-func (h *ProjectsHandler) buildProjectResponse(p *ent.Project) ProjectResponse {
+// FillProgress sets the progress of the given responses, with a fixed number of queries for the whole batch
+func FillProgress(ctx context.Context, client *ent.Client, resps ...*ProjectResponse) error {
+	ids := make([]string, len(resps))
+	for i, resp := range resps {
+		ids[i] = resp.ID
+	}
+
+	percentages, err := progress.ForProjects(ctx, client, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, resp := range resps {
+		resp.Progress = percentages[resp.ID]
+	}
+	return nil
+}
+
+// FillMedia sets the media and cover image of the given responses, with a single query for the whole batch
+func FillMedia(ctx context.Context, client *ent.Client, resps ...*ProjectResponse) error {
+	ids := make([]string, len(resps))
+	for i, resp := range resps {
+		ids[i] = resp.ID
+	}
+
+	media, err := client.ProjectMedia.Query().
+		Where(projectmedia.ProjectIDIn(ids...)).
+		Order(ent.Asc(projectmedia.FieldCreateTime), ent.Asc(projectmedia.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	byProject := make(map[string][]*ent.ProjectMedia, len(resps))
+	for _, m := range media {
+		byProject[m.ProjectID] = append(byProject[m.ProjectID], m)
+	}
+	for _, resp := range resps {
+		resp.Media = make([]MediaResponse, 0, len(byProject[resp.ID]))
+		for _, m := range byProject[resp.ID] {
+			resp.Media = append(resp.Media, BuildMediaResponse(m))
+			if m.Cover {
+				resp.CoverImageURL = &m.URL
+			}
+		}
+	}
+	return nil
+}
+
+// BuildProjectResponse converts a project, with its owner and tags loaded, to its API representation.
+// Progress and media are left to FillProgress and FillMedia, which work on whole batches.
+func BuildProjectResponse(p *ent.Project) ProjectResponse {
 	resp := ProjectResponse{
-		ID:          p.ID,
-		Name:        p.Name,
-		Description: p.Description,
-		LikeCount:   p.LikeCount,
-		StarCount:   p.StarCount,
-		CreatedAt:   p.CreateTime.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt:   p.UpdateTime.Format("2006-01-02T15:04:05Z"),
+		ID:              p.ID,
+		Name:            p.Name,
+		Description:     p.Description,
+		LikeCount:       p.LikeCount,
+		StarCount:       p.StarCount,
+		CommentCount:    p.CommentCount,
+		RemixCount:      p.RemixCount,
+		RemixedFromID:   p.RemixedFromID,
+		Status:          string(p.Status),
+		Visibility:      string(p.Visibility),
+		Draft:           p.Draft,
+		TargetStartDate: formatDate(p.TargetStartDate),
+		TargetShipDate:  formatDate(p.TargetShipDate),
+		CreatedAt:       p.CreateTime.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:       p.UpdateTime.Format("2006-01-02T15:04:05Z"),
+	}
+
+	if p.ShippedAt != nil {
+		shippedAt := p.ShippedAt.Format("2006-01-02T15:04:05Z")
+		resp.ShippedAt = &shippedAt
 	}
 
 	if p.Edges.Owner != nil {
@@ -336,61 +756,38 @@ func (h *ProjectsHandler) buildProjectResponse(p *ent.Project) ProjectResponse {
 	return resp
 }
 
-func (h *ProjectsHandler) getPagination(r *http.Request) (limit, offset int) {
-	limit = 20
-	offset = 0
-
-	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
-		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
-			limit = l
+// resolveTags returns the IDs of the tags with the given names, creating the missing ones.
+// Tags are set through the project mutation, so the revision it records includes them.
+func (h *ProjectsHandler) resolveTags(ctx context.Context, tagSlugs []string) ([]string, error) {
+	ids := make([]string, 0, len(tagSlugs))
+	for _, slug := range tagSlugs {
+		id, err := h.resolveTag(ctx, slug, tag.DefaultCategory)
+		if err != nil {
+			return nil, err
 		}
-	}
-
-	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
-		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
-			offset = o
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
 		}
 	}
-
-	return limit, offset
+	return ids, nil
 }
 
-func (h *ProjectsHandler) addTagsToProject(ctx context.Context, projectID string, tagSlugs []string) error {
-	for _, slug := range tagSlugs {
-		normalizedSlug := h.normalizeSlug(slug)
-
-		tag, err := h.client.Tag.Query().Where(tag.Slug(normalizedSlug)).First(ctx)
-		if ent.IsNotFound(err) {
-			tag, err = h.client.Tag.Create().
-				SetName(slug).
-				SetSlug(normalizedSlug).
-				Save(ctx)
-			if err != nil {
-				return err
-			}
-		} else if err != nil {
-			return err
-		}
+// resolveTag returns the ID of the tag with the given name, creating it in the category if it is missing
+func (h *ProjectsHandler) resolveTag(ctx context.Context, name string, category tag.Category) (string, error) {
+	normalizedSlug := h.normalizeSlug(name)
 
-		_, err = h.client.ProjectTag.Create().
-			SetProjectID(projectID).
-			SetTagID(tag.ID).
+	t, err := h.client.Tag.Query().Where(tag.Slug(normalizedSlug)).First(ctx)
+	if ent.IsNotFound(err) {
+		t, err = h.client.Tag.Create().
+			SetName(name).
+			SetSlug(normalizedSlug).
+			SetCategory(category).
 			Save(ctx)
-		if err != nil {
-			log.Error(ctx).Err(err).Msgf("Failed to add tag %s to project %s", slug, projectID)
-		}
 	}
-	return nil
-}
-
-func (h *ProjectsHandler) replaceProjectTags(ctx context.Context, projectID string, tagSlugs []string) error {
-	if _, err := h.client.ProjectTag.Delete().Where(
-		projecttag.ProjectID(projectID),
-	).Exec(ctx); err != nil {
-		return err
+	if err != nil {
+		return "", err
 	}
-
-	return h.addTagsToProject(ctx, projectID, tagSlugs)
+	return t.ID, nil
 }
 
 func (h *ProjectsHandler) normalizeSlug(input string) string {
@@ -439,21 +836,33 @@ func (h *ProjectsHandler) LikeProject(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err = h.client.Like.Create().
-		SetUserID(userID).
-		SetProjectID(projectID).
-		Save(ctx)
+	liked := eventbus.Event{
+		Type:      eventbus.ProjectLiked,
+		ActorID:   userID,
+		ProjectID: projectID,
+	}
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := tx.Like.Create().
+			SetUserID(userID).
+			SetProjectID(projectID).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if err := counters.EnqueueRecountLikes(ctx, tx, projectID); err != nil {
+			return err
+		}
+		if err := webhook.Enqueue(ctx, tx, liked); err != nil {
+			return err
+		}
+		return notify.Enqueue(ctx, tx, liked)
+	})
 	if err != nil {
 		log.Error(ctx).Err(err).Msg("Failed to create like")
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
 
-	if _, err := h.client.Project.UpdateOneID(projectID).
-		AddLikeCount(1).
-		Save(ctx); err != nil {
-		log.Error(ctx).Err(err).Msg("Failed to increment like count")
-	}
+	h.bus.Publish(ctx, liked)
 
 	log.Info(ctx).Msgf("Project liked successfully: %s by user %s", projectID, userID)
 	response.JSON(w, http.StatusOK, "Project liked successfully", nil)
@@ -483,17 +892,30 @@ func (h *ProjectsHandler) UnlikeProject(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if err := h.client.Like.DeleteOneID(existingLike.ID).Exec(ctx); err != nil {
+	unliked := eventbus.Event{
+		Type:      eventbus.ProjectUnliked,
+		ActorID:   userID,
+		ProjectID: projectID,
+	}
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := tx.Like.DeleteOneID(existingLike.ID).Exec(ctx); err != nil {
+			return err
+		}
+		if err := counters.EnqueueRecountLikes(ctx, tx, projectID); err != nil {
+			return err
+		}
+		if err := webhook.Enqueue(ctx, tx, unliked); err != nil {
+			return err
+		}
+		return notify.Enqueue(ctx, tx, unliked)
+	})
+	if err != nil {
 		log.Error(ctx).Err(err).Msg("Failed to delete like")
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
 
-	if _, err := h.client.Project.UpdateOneID(projectID).
-		AddLikeCount(-1).
-		Save(ctx); err != nil {
-		log.Error(ctx).Err(err).Msg("Failed to decrement like count")
-	}
+	h.bus.Publish(ctx, unliked)
 
 	log.Info(ctx).Msgf("Project unliked successfully: %s by user %s", projectID, userID)
 	response.JSON(w, http.StatusOK, "Project unliked successfully", nil)
@@ -503,20 +925,38 @@ func (h *ProjectsHandler) GetProjectLikes(w http.ResponseWriter, r *http.Request
 	ctx := r.Context()
 	projectID := chi.URLParam(r, "id")
 
-	limit, offset := h.getPagination(r)
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if !h.requireProject(w, r, projectID) {
+		return
+	}
 
-	likes, err := h.client.Like.Query().
+	query := h.client.Like.Query().
 		Where(like.ProjectID(projectID)).
 		WithUser().
-		Limit(limit).
-		Offset(offset).
-		Order(ent.Desc(like.FieldCreateTime)).
-		All(ctx)
+		Limit(params.Fetch()).
+		Order(ent.Desc(like.FieldCreateTime), ent.Desc(like.FieldID))
+	if c := params.After; c != nil {
+		query = query.Where(like.Or(
+			like.CreateTimeLT(c.Time),
+			like.And(like.CreateTime(c.Time), like.IDLT(c.ID)),
+		))
+	}
+
+	likes, err := query.All(ctx)
 	if err != nil {
 		log.Error(ctx).Err(err).Msg("Failed to get project likes")
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
+	likes, next := pagination.Trim(likes, params, func(l *ent.Like) pagination.Cursor {
+		return pagination.Cursor{Time: l.CreateTime, ID: l.ID}
+	})
 
 	type LikeResponse struct {
 		UserID    string `json:"user_id"`
@@ -524,7 +964,7 @@ func (h *ProjectsHandler) GetProjectLikes(w http.ResponseWriter, r *http.Request
 		CreatedAt string `json:"created_at"`
 	}
 
-	var likeResponses []LikeResponse
+	likeResponses := make([]LikeResponse, 0, len(likes))
 	for _, l := range likes {
 		resp := LikeResponse{
 			UserID:    l.UserID,
@@ -536,7 +976,18 @@ func (h *ProjectsHandler) GetProjectLikes(w http.ResponseWriter, r *http.Request
 		likeResponses = append(likeResponses, resp)
 	}
 
-	response.JSON(w, http.StatusOK, "Project likes retrieved successfully", likeResponses)
+	page := response.NewPage(likeResponses, next)
+	if params.WithTotal {
+		total, err := h.client.Like.Query().Where(like.ProjectID(projectID)).Count(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count project likes")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "Project likes retrieved successfully", page)
 }
 
 func (h *ProjectsHandler) CheckProjectLiked(w http.ResponseWriter, r *http.Request) {
@@ -549,6 +1000,10 @@ func (h *ProjectsHandler) CheckProjectLiked(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if !h.requireProject(w, r, projectID) {
+		return
+	}
+
 	existingLike, err := h.client.Like.Query().
 		Where(like.UserID(userID), like.ProjectID(projectID)).
 		First(ctx)
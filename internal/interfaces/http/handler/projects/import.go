@@ -0,0 +1,203 @@
+package projects
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectlink"
+	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/service/importer"
+)
+
+type ImportProjectRequest struct {
+	URL        string `json:"url"`
+	Visibility string `json:"visibility"`
+	Draft      bool   `json:"draft"`
+}
+
+func (r ImportProjectRequest) Validate() error {
+	if r.URL == "" {
+		return errors.ErrInvalidRepositoryURL
+	}
+	if r.Visibility != "" && project.VisibilityValidator(project.Visibility(r.Visibility)) != nil {
+		return errors.ErrInvalidProjectVisibility
+	}
+	return nil
+}
+
+type RepositoryResponse struct {
+	URL         string   `json:"url"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Homepage    string   `json:"homepage"`
+	Languages   []string `json:"languages"`
+	Topics      []string `json:"topics"`
+	// Tags are the slugs of the tags the project would get
+	Tags []string `json:"tags"`
+}
+
+// PreviewImport returns what importing a repository would fill the project with, so clients can
+// prefill a project form with it
+func (h *ProjectsHandler) PreviewImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	repo, err := h.importer.Fetch(ctx, r.URL.Query().Get("url"))
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to fetch repository")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	resp := RepositoryResponse{
+		URL:         repo.URL,
+		Name:        repo.Name,
+		Description: repo.Description,
+		Homepage:    repo.Homepage,
+		Languages:   repo.Languages,
+		Topics:      repo.Topics,
+		Tags:        []string{},
+	}
+	for _, t := range repo.Tags() {
+		if slug := h.normalizeSlug(t.Name); !slices.Contains(resp.Tags, slug) {
+			resp.Tags = append(resp.Tags, slug)
+		}
+	}
+
+	response.JSON(w, http.StatusOK, "Repository retrieved successfully", resp)
+}
+
+// ImportProject creates a project from the metadata of a repository, linking to the repository
+// and to its homepage as a demo
+func (h *ProjectsHandler) ImportProject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req ImportProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	repo, err := h.importer.Fetch(ctx, req.URL)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to fetch repository")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	tagIDs, err := h.resolveRepositoryTags(ctx, repo)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to resolve repository tags")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	// Projects require a description, which repositories often lack
+	description := repo.Description
+	if description == "" {
+		description = "Imported from " + repo.URL
+	}
+	visibility := project.DefaultVisibility
+	if req.Visibility != "" {
+		visibility = project.Visibility(req.Visibility)
+	}
+
+	var p *ent.Project
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		create := tx.Project.Create().
+			SetName(truncate(repo.Name, 255)).
+			SetDescription(truncate(description, 1000)).
+			SetOwnerID(userID).
+			SetStatus(project.DefaultStatus).
+			SetVisibility(visibility).
+			SetDraft(req.Draft).
+			AddTagIDs(tagIDs...)
+		p, err = createProject(ctx, tx, userID, project.DefaultStatus, create)
+		if err != nil {
+			return err
+		}
+
+		links := []*ent.ProjectLinkCreate{
+			tx.ProjectLink.Create().
+				SetProjectID(p.ID).
+				SetType(projectlink.TypeRepo).
+				SetURL(repo.URL),
+		}
+		if validLinkURL(repo.Homepage) {
+			links = append(links, tx.ProjectLink.Create().
+				SetProjectID(p.ID).
+				SetType(projectlink.TypeDemo).
+				SetURL(repo.Homepage))
+		}
+		return tx.ProjectLink.CreateBulk(links...).Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to import project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	projectResp, err := h.getProjectResponse(ctx, p.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project imported successfully: %s from %s", p.ID, repo.URL)
+	response.JSON(w, http.StatusOK, "Project imported successfully", projectResp)
+}
+
+// resolveRepositoryTags returns the IDs of the tags the repository maps to, creating the missing
+// ones. Languages are created in the language category.
+func (h *ProjectsHandler) resolveRepositoryTags(ctx context.Context, repo *importer.Repository) ([]string, error) {
+	tags := repo.Tags()
+	ids := make([]string, 0, len(tags))
+	for _, t := range tags {
+		category := tag.DefaultCategory
+		if t.Language {
+			category = tag.CategoryLanguage
+		}
+		id, err := h.resolveTag(ctx, t.Name, category)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// truncate cuts the value to at most n bytes, without splitting a character
+func truncate(value string, n int) string {
+	if len(value) <= n {
+		return value
+	}
+	for n > 0 && !utf8.RuneStart(value[n]) {
+		n--
+	}
+	return value[:n]
+}
@@ -0,0 +1,252 @@
+package projects
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/projectlink"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+)
+
+// maxLinks bounds the number of links of a project
+const maxLinks = 20
+
+type LinkRequest struct {
+	Type  string  `json:"type"`
+	URL   string  `json:"url"`
+	Title *string `json:"title"`
+}
+
+func (r LinkRequest) Validate() error {
+	if projectlink.TypeValidator(projectlink.Type(r.Type)) != nil || !validLinkURL(r.URL) {
+		return errors.ErrInvalidProjectLink
+	}
+	if r.Title != nil && len(*r.Title) > 255 {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+// validLinkURL reports whether the value is an absolute http or https URL
+func validLinkURL(value string) bool {
+	if len(value) > 2048 {
+		return false
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+type LinkResponse struct {
+	ID        string  `json:"id"`
+	Type      string  `json:"type"`
+	URL       string  `json:"url"`
+	Title     *string `json:"title"`
+	CreatedAt string  `json:"created_at"`
+}
+
+func (h *ProjectsHandler) ListLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+
+	if !h.requireProject(w, r, projectID) {
+		return
+	}
+
+	links, err := h.client.ProjectLink.Query().
+		Where(projectlink.ProjectID(projectID)).
+		Order(ent.Asc(projectlink.FieldType), ent.Asc(projectlink.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project links")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	linkResponses := make([]LinkResponse, len(links))
+	for i, l := range links {
+		linkResponses[i] = buildLinkResponse(l)
+	}
+
+	response.JSON(w, http.StatusOK, "Project links retrieved successfully", linkResponses)
+}
+
+func (h *ProjectsHandler) AddLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req LinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionEditProject); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot edit the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	count, err := h.client.ProjectLink.Query().
+		Where(projectlink.ProjectID(projectID)).
+		Count(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to count project links")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if count >= maxLinks {
+		response.Error(w, errors.ErrTooManyProjectLinks)
+		return
+	}
+
+	link, err := h.client.ProjectLink.Create().
+		SetProjectID(projectID).
+		SetType(projectlink.Type(req.Type)).
+		SetURL(req.URL).
+		SetNillableTitle(req.Title).
+		Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to add project link")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project link added successfully: %s", link.ID)
+	response.JSON(w, http.StatusCreated, "Project link added successfully", buildLinkResponse(link))
+}
+
+func (h *ProjectsHandler) UpdateLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	linkID := chi.URLParam(r, "linkID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req LinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionEditProject); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot edit the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	update := h.client.ProjectLink.Update().
+		Where(
+			projectlink.ID(linkID),
+			projectlink.ProjectID(projectID),
+		).
+		SetType(projectlink.Type(req.Type)).
+		SetURL(req.URL)
+	if req.Title != nil {
+		update = update.SetTitle(*req.Title)
+	} else {
+		update = update.ClearTitle()
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update project link")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if n == 0 {
+		log.Error(ctx).Msg("Project link not found")
+		response.Error(w, errors.ErrNotFound)
+		return
+	}
+
+	link, err := h.client.ProjectLink.Get(ctx, linkID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project link")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project link updated successfully: %s", linkID)
+	response.JSON(w, http.StatusOK, "Project link updated successfully", buildLinkResponse(link))
+}
+
+func (h *ProjectsHandler) DeleteLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	linkID := chi.URLParam(r, "linkID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionEditProject); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot edit the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	n, err := h.client.ProjectLink.Delete().
+		Where(
+			projectlink.ID(linkID),
+			projectlink.ProjectID(projectID),
+		).
+		Exec(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to delete project link")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if n == 0 {
+		log.Error(ctx).Msg("Project link not found")
+		response.Error(w, errors.ErrNotFound)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project link deleted successfully: %s", linkID)
+	response.JSON(w, http.StatusOK, "Project link deleted successfully", nil)
+}
+
+func buildLinkResponse(l *ent.ProjectLink) LinkResponse {
+	return LinkResponse{
+		ID:        l.ID,
+		Type:      string(l.Type),
+		URL:       l.URL,
+		Title:     l.Title,
+		CreatedAt: l.CreateTime.Format("2006-01-02T15:04:05Z"),
+	}
+}
@@ -0,0 +1,33 @@
+package projects
+
+import (
+	"github.com/jorge-j1m/hackspark_server/ent"
+)
+
+type MediaResponse struct {
+	ID           string  `json:"id"`
+	URL          string  `json:"url"`
+	ThumbnailURL string  `json:"thumbnail_url"`
+	ContentType  string  `json:"content_type"`
+	Size         int64   `json:"size"`
+	Width        int     `json:"width"`
+	Height       int     `json:"height"`
+	Caption      *string `json:"caption"`
+	Cover        bool    `json:"cover"`
+	CreatedAt    string  `json:"created_at"`
+}
+
+func BuildMediaResponse(m *ent.ProjectMedia) MediaResponse {
+	return MediaResponse{
+		ID:           m.ID,
+		URL:          m.URL,
+		ThumbnailURL: m.ThumbnailURL,
+		ContentType:  m.ContentType,
+		Size:         m.Size,
+		Width:        m.Width,
+		Height:       m.Height,
+		Caption:      m.Caption,
+		Cover:        m.Cover,
+		CreatedAt:    m.CreateTime.Format("2006-01-02T15:04:05Z"),
+	}
+}
@@ -1,15 +1,30 @@
 package projects
 
 import (
+	"time"
+
 	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"github.com/jorge-j1m/hackspark_server/internal/service/importer"
 )
 
 type ProjectsHandler struct {
-	client *ent.Client
+	client   *ent.Client
+	bus      *eventbus.Bus
+	authz    *authz.Authorizer
+	importer importer.Importer
+
+	// retention is how long deleted rows can be restored before they are purged
+	retention time.Duration
 }
 
-func NewProjectsHandler(client *ent.Client) *ProjectsHandler {
+func NewProjectsHandler(client *ent.Client, bus *eventbus.Bus, authorizer *authz.Authorizer, importer importer.Importer, retention time.Duration) *ProjectsHandler {
 	return &ProjectsHandler{
-		client: client,
+		client:    client,
+		bus:       bus,
+		authz:     authorizer,
+		importer:  importer,
+		retention: retention,
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,270 @@
+package projects
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"github.com/jorge-j1m/hackspark_server/internal/service/notify"
+	"github.com/jorge-j1m/hackspark_server/internal/service/webhook"
+)
+
+// latestRemixesLimit bounds the remixes listed along with a project, the rest are paginated
+const latestRemixesLimit = 10
+
+// RemixProjectRequest is optional, remixes start as private drafts unless told otherwise
+type RemixProjectRequest struct {
+	Visibility string `json:"visibility"`
+	Draft      *bool  `json:"draft"`
+}
+
+func (r RemixProjectRequest) Validate() error {
+	if r.Visibility != "" && project.VisibilityValidator(project.Visibility(r.Visibility)) != nil {
+		return errors.ErrInvalidProjectVisibility
+	}
+	return nil
+}
+
+type RemixResponse struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Owner struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"owner"`
+	CreatedAt string `json:"created_at"`
+}
+
+// ProjectDetailResponse is a project along with its latest remixes the viewer can see
+type ProjectDetailResponse struct {
+	ProjectResponse
+	Remixes []RemixResponse `json:"remixes"`
+}
+
+// RemixProject creates a project owned by the caller from the name, description and tags of
+// another one. Private, draft and deleted projects cannot be remixed.
+func (h *ProjectsHandler) RemixProject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req RemixProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	// Deleted projects are looked up too, so remixing one is refused rather than not found
+	source, err := h.client.Project.Query().
+		Where(project.ID(projectID)).
+		WithTags().
+		Only(visibility.IncludeDeleted(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Project not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	if source.DeletedAt != nil || source.Draft || source.Visibility == project.VisibilityPrivate {
+		log.Error(ctx).Msgf("Project %s cannot be remixed", projectID)
+		response.Error(w, errors.ErrRemixNotAllowed)
+		return
+	}
+
+	tagIDs := make([]string, len(source.Edges.Tags))
+	for i, t := range source.Edges.Tags {
+		tagIDs[i] = t.ID
+	}
+	vis := project.VisibilityPrivate
+	if req.Visibility != "" {
+		vis = project.Visibility(req.Visibility)
+	}
+	draft := true
+	if req.Draft != nil {
+		draft = *req.Draft
+	}
+
+	var (
+		p       *ent.Project
+		remixed eventbus.Event
+	)
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		create := tx.Project.Create().
+			SetName(source.Name).
+			SetDescription(source.Description).
+			SetOwnerID(userID).
+			SetStatus(project.DefaultStatus).
+			SetVisibility(vis).
+			SetDraft(draft).
+			SetRemixedFromID(source.ID).
+			AddTagIDs(tagIDs...)
+		p, err = createProject(ctx, tx, userID, project.DefaultStatus, create)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Project.UpdateOneID(source.ID).AddRemixCount(1).Exec(ctx); err != nil {
+			return err
+		}
+
+		remixed = eventbus.Event{
+			Type:      eventbus.ProjectRemixed,
+			ActorID:   userID,
+			ProjectID: source.ID,
+			Payload: map[string]any{
+				"remix_id": p.ID,
+			},
+		}
+		if err := webhook.Enqueue(ctx, tx, remixed); err != nil {
+			return err
+		}
+		return notify.Enqueue(ctx, tx, remixed)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to remix project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	h.bus.Publish(ctx, remixed)
+
+	projectResp, err := h.getProjectResponse(ctx, p.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project remixed successfully: %s from %s", p.ID, source.ID)
+	response.JSON(w, http.StatusOK, "Project remixed successfully", projectResp)
+}
+
+// ListRemixes returns the remixes of a project the viewer can see, the most recent first
+func (h *ProjectsHandler) ListRemixes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+
+	if !h.requireProject(w, r, projectID) {
+		return
+	}
+	// The project may be unlisted, its remixes are only listed when they are listed themselves
+	ctx = visibility.WithoutUnlisted(ctx)
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	query := h.client.Project.Query().
+		Where(project.RemixedFromID(projectID)).
+		WithOwner().
+		WithTags().
+		Order(ent.Desc(project.FieldCreateTime), ent.Desc(project.FieldID)).
+		Limit(params.Fetch())
+	if c := params.After; c != nil {
+		query = query.Where(project.Or(
+			project.CreateTimeLT(c.Time),
+			project.And(project.CreateTime(c.Time), project.IDLT(c.ID)),
+		))
+	}
+
+	remixes, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list remixes")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	remixes, next := pagination.Trim(remixes, params, func(p *ent.Project) pagination.Cursor {
+		return pagination.Cursor{Time: p.CreateTime, ID: p.ID}
+	})
+
+	projectResponses := make([]ProjectResponse, len(remixes))
+	resps := make([]*ProjectResponse, len(remixes))
+	for i, p := range remixes {
+		projectResponses[i] = BuildProjectResponse(p)
+		resps[i] = &projectResponses[i]
+	}
+	if err := FillProgress(ctx, h.client, resps...); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to compute project progress")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if err := FillMedia(ctx, h.client, resps...); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to load project media")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	page := response.NewPage(projectResponses, next)
+	if params.WithTotal {
+		total, err := h.client.Project.Query().
+			Where(project.RemixedFromID(projectID)).
+			Count(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count remixes")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "Remixes retrieved successfully", page)
+}
+
+// latestRemixes returns the most recent remixes of a project the viewer can see
+func (h *ProjectsHandler) latestRemixes(ctx context.Context, projectID string) ([]RemixResponse, error) {
+	remixes, err := h.client.Project.Query().
+		Where(project.RemixedFromID(projectID)).
+		WithOwner().
+		Order(ent.Desc(project.FieldCreateTime), ent.Desc(project.FieldID)).
+		Limit(latestRemixesLimit).
+		All(visibility.WithoutUnlisted(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	resps := make([]RemixResponse, len(remixes))
+	for i, p := range remixes {
+		resps[i] = RemixResponse{
+			ID:        p.ID,
+			Name:      p.Name,
+			CreatedAt: p.CreateTime.Format("2006-01-02T15:04:05Z"),
+		}
+		if p.Edges.Owner != nil {
+			resps[i].Owner.ID = p.Edges.Owner.ID
+			resps[i].Owner.Username = p.Edges.Owner.Username
+		}
+	}
+	return resps, nil
+}
@@ -0,0 +1,355 @@
+package projects
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrevision"
+	"github.com/jorge-j1m/hackspark_server/ent/projecttag"
+	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/counters"
+	"github.com/jorge-j1m/hackspark_server/internal/service/revision"
+)
+
+type RevisionResponse struct {
+	ID          string   `json:"id"`
+	Number      int      `json:"number"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+	// Author is null for the content a project had before revisions were recorded
+	Author *struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"author"`
+	CreatedAt string `json:"created_at"`
+}
+
+type DiffLineResponse struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+type RevisionDiffResponse struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+	// Name is null when the name did not change
+	Name *struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	} `json:"name"`
+	// Description is empty when the description did not change
+	Description []DiffLineResponse `json:"description"`
+	TagsAdded   []string           `json:"tags_added"`
+	TagsRemoved []string           `json:"tags_removed"`
+}
+
+func (h *ProjectsHandler) ListRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+
+	if !h.requireProject(w, r, projectID) {
+		return
+	}
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	query := h.client.ProjectRevision.Query().
+		Where(projectrevision.ProjectID(projectID)).
+		WithAuthor().
+		Order(ent.Desc(projectrevision.FieldNumber)).
+		Limit(params.Fetch())
+	if c := params.After; c != nil {
+		query = query.Where(projectrevision.NumberLT(int(c.Int)))
+	}
+
+	revisions, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project revisions")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	revisions, next := pagination.Trim(revisions, params, func(rev *ent.ProjectRevision) pagination.Cursor {
+		return pagination.Cursor{Int: int64(rev.Number)}
+	})
+
+	revisionResponses := make([]RevisionResponse, len(revisions))
+	for i, rev := range revisions {
+		revisionResponses[i] = buildRevisionResponse(rev)
+	}
+
+	page := response.NewPage(revisionResponses, next)
+	if params.WithTotal {
+		total, err := h.client.ProjectRevision.Query().
+			Where(projectrevision.ProjectID(projectID)).
+			Count(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count project revisions")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "Project revisions retrieved successfully", page)
+}
+
+func (h *ProjectsHandler) GetRevision(w http.ResponseWriter, r *http.Request) {
+	projectID := chi.URLParam(r, "id")
+
+	number, err := parseRevisionNumber(chi.URLParam(r, "number"))
+	if err != nil {
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if !h.requireProject(w, r, projectID) {
+		return
+	}
+
+	rev, ok := h.getRevision(w, r, projectID, number)
+	if !ok {
+		return
+	}
+
+	response.JSON(w, http.StatusOK, "Project revision retrieved successfully", buildRevisionResponse(rev))
+}
+
+// DiffRevisions compares two revisions of a project. The revision compared against defaults to the
+// latest one, and the revision compared from defaults to the one right before it.
+func (h *ProjectsHandler) DiffRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+
+	if !h.requireProject(w, r, projectID) {
+		return
+	}
+
+	var to *ent.ProjectRevision
+	if value := r.URL.Query().Get("to"); value != "" {
+		number, err := parseRevisionNumber(value)
+		if err != nil {
+			response.Error(w, errors.AsAppError(err))
+			return
+		}
+		rev, ok := h.getRevision(w, r, projectID, number)
+		if !ok {
+			return
+		}
+		to = rev
+	} else {
+		rev, err := h.client.ProjectRevision.Query().
+			Where(projectrevision.ProjectID(projectID)).
+			Order(ent.Desc(projectrevision.FieldNumber)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				log.Error(ctx).Err(err).Msg("Project has no revisions")
+				response.Error(w, errors.ErrNotFound)
+				return
+			}
+			log.Error(ctx).Err(err).Msg("Failed to get latest project revision")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		to = rev
+	}
+
+	fromNumber := to.Number - 1
+	if value := r.URL.Query().Get("from"); value != "" {
+		number, err := parseRevisionNumber(value)
+		if err != nil {
+			response.Error(w, errors.AsAppError(err))
+			return
+		}
+		fromNumber = number
+	}
+	if fromNumber < 1 {
+		response.Error(w, errors.ErrInvalidRevision)
+		return
+	}
+	from, ok := h.getRevision(w, r, projectID, fromNumber)
+	if !ok {
+		return
+	}
+
+	diff := revision.Compare(from, to)
+	resp := RevisionDiffResponse{
+		From:        from.Number,
+		To:          to.Number,
+		Description: make([]DiffLineResponse, len(diff.Description)),
+		TagsAdded:   diff.TagsAdded,
+		TagsRemoved: diff.TagsRemoved,
+	}
+	if diff.NameChanged {
+		resp.Name = &struct {
+			From string `json:"from"`
+			To   string `json:"to"`
+		}{From: diff.Name[0], To: diff.Name[1]}
+	}
+	for i, l := range diff.Description {
+		resp.Description[i] = DiffLineResponse{Op: string(l.Op), Text: l.Text}
+	}
+
+	response.JSON(w, http.StatusOK, "Project revision diff retrieved successfully", resp)
+}
+
+// RevertRevision brings the content of the project back to a revision. The revert is itself
+// recorded as a new revision, so it can be reverted too.
+func (h *ProjectsHandler) RevertRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	number, err := parseRevisionNumber(chi.URLParam(r, "number"))
+	if err != nil {
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionEditProject); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot edit the project")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	rev, ok := h.getRevision(w, r, projectID, number)
+	if !ok {
+		return
+	}
+
+	// Tags are never deleted, so every tag of the revision still exists
+	tagIDs, err := h.client.Tag.Query().
+		Where(tag.SlugIn(rev.Tags...)).
+		IDs(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get revision tags")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		previous, err := tx.ProjectTag.Query().
+			Where(projecttag.ProjectID(projectID)).
+			Select(projecttag.FieldTagID).
+			Strings(ctx)
+		if err != nil {
+			return err
+		}
+		if err := counters.EnqueueRecountTags(ctx, tx, append(previous, tagIDs...)...); err != nil {
+			return err
+		}
+
+		return tx.Project.UpdateOneID(projectID).
+			SetName(rev.Name).
+			SetDescription(rev.Description).
+			ClearTags().
+			AddTagIDs(tagIDs...).
+			Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to revert project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	projectResp, err := h.getProjectResponse(ctx, projectID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project reverted successfully: %s to revision %d", projectID, number)
+	response.JSON(w, http.StatusOK, "Project reverted successfully", projectResp)
+}
+
+// requireProject responds with a not found error when the project does not exist or cannot be seen
+func (h *ProjectsHandler) requireProject(w http.ResponseWriter, r *http.Request, projectID string) bool {
+	ctx := r.Context()
+
+	exists, err := h.client.Project.Query().Where(project.ID(projectID)).Exist(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return false
+	}
+	if !exists {
+		log.Error(ctx).Msg("Project not found")
+		response.Error(w, errors.ErrNotFound)
+		return false
+	}
+	return true
+}
+
+func (h *ProjectsHandler) getRevision(w http.ResponseWriter, r *http.Request, projectID string, number int) (*ent.ProjectRevision, bool) {
+	ctx := r.Context()
+
+	rev, err := h.client.ProjectRevision.Query().
+		Where(
+			projectrevision.ProjectID(projectID),
+			projectrevision.Number(number),
+		).
+		WithAuthor().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Project revision not found")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get project revision")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, false
+	}
+	return rev, true
+}
+
+func parseRevisionNumber(value string) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return 0, errors.ErrInvalidRevision
+	}
+	return number, nil
+}
+
+func buildRevisionResponse(rev *ent.ProjectRevision) RevisionResponse {
+	resp := RevisionResponse{
+		ID:          rev.ID,
+		Number:      rev.Number,
+		Name:        rev.Name,
+		Description: rev.Description,
+		Tags:        rev.Tags,
+		CreatedAt:   rev.CreateTime.Format("2006-01-02T15:04:05Z"),
+	}
+	if rev.Tags == nil {
+		resp.Tags = []string{}
+	}
+	if a := rev.Edges.Author; a != nil {
+		resp.Author = &struct {
+			ID       string `json:"id"`
+			Username string `json:"username"`
+		}{ID: a.ID, Username: a.Username}
+	}
+	return resp
+}
@@ -0,0 +1,268 @@
+package projects
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectstatuschange"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"github.com/jorge-j1m/hackspark_server/internal/service/notify"
+	"github.com/jorge-j1m/hackspark_server/internal/service/webhook"
+)
+
+const dateLayout = "2006-01-02"
+
+// statusTransitions lists, for every status, the statuses a project can move to.
+// Shipped and abandoned projects can be picked up again.
+var statusTransitions = map[project.Status][]project.Status{
+	project.StatusIdea:       {project.StatusPlanning, project.StatusInProgress, project.StatusAbandoned},
+	project.StatusPlanning:   {project.StatusIdea, project.StatusInProgress, project.StatusAbandoned},
+	project.StatusInProgress: {project.StatusPlanning, project.StatusShipped, project.StatusAbandoned},
+	project.StatusShipped:    {project.StatusInProgress},
+	project.StatusAbandoned:  {project.StatusIdea, project.StatusPlanning, project.StatusInProgress},
+}
+
+func canTransition(from, to project.Status) bool {
+	for _, s := range statusTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+type UpdateStatusRequest struct {
+	Status string  `json:"status"`
+	Note   *string `json:"note"`
+}
+
+func (r UpdateStatusRequest) Validate() error {
+	if project.StatusValidator(project.Status(r.Status)) != nil {
+		return errors.ErrInvalidProjectStatus
+	}
+	if r.Note != nil && len(*r.Note) > 500 {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+type StatusChangeResponse struct {
+	ID         string  `json:"id"`
+	FromStatus *string `json:"from_status"`
+	ToStatus   string  `json:"to_status"`
+	Note       *string `json:"note"`
+	ChangedBy  struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"changed_by"`
+	CreatedAt string `json:"created_at"`
+}
+
+func (h *ProjectsHandler) UpdateProjectStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req UpdateStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	p, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionChangeStatus)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot change the project status")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	// The status is read again under a lock, so that concurrent changes are checked against
+	// the status the one before them left and none makes a forbidden transition
+	to := project.Status(req.Status)
+	from := p.Status
+	var changed eventbus.Event
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		locked, err := tx.Project.Query().
+			Where(project.ID(projectID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		from = locked.Status
+		if from == to {
+			return nil
+		}
+		if !canTransition(from, to) {
+			return errors.ErrInvalidStatusTransition
+		}
+
+		update := tx.Project.UpdateOneID(projectID).SetStatus(to)
+		if to == project.StatusShipped {
+			update = update.SetShippedAt(time.Now())
+		} else {
+			update = update.ClearShippedAt()
+		}
+		if err := update.Exec(ctx); err != nil {
+			return err
+		}
+
+		if err := tx.ProjectStatusChange.Create().
+			SetProjectID(projectID).
+			SetChangedByID(userID).
+			SetFromStatus(projectstatuschange.FromStatus(from)).
+			SetToStatus(projectstatuschange.ToStatus(to)).
+			SetNillableNote(req.Note).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		changed = eventbus.Event{
+			Type:      eventbus.ProjectStatusChanged,
+			ActorID:   userID,
+			ProjectID: projectID,
+			Payload: map[string]any{
+				"from_status": string(from),
+				"to_status":   string(to),
+			},
+		}
+		if err := webhook.Enqueue(ctx, tx, changed); err != nil {
+			return err
+		}
+		return notify.Enqueue(ctx, tx, changed)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msgf("Failed to update project status %s -> %s", from, to)
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+	if from == to {
+		response.JSON(w, http.StatusOK, "Project status unchanged", nil)
+		return
+	}
+
+	h.bus.Publish(ctx, changed)
+
+	projectResp, err := h.getProjectResponse(ctx, projectID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Project status updated successfully: %s %s -> %s", projectID, from, to)
+	response.JSON(w, http.StatusOK, "Project status updated successfully", projectResp)
+}
+
+func (h *ProjectsHandler) GetProjectStatusHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+
+	exists, err := h.client.Project.Query().Where(project.ID(projectID)).Exist(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if !exists {
+		log.Error(ctx).Msg("Project not found")
+		response.Error(w, errors.ErrNotFound)
+		return
+	}
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	query := h.client.ProjectStatusChange.Query().
+		Where(projectstatuschange.ProjectID(projectID)).
+		WithChangedBy().
+		Order(ent.Asc(projectstatuschange.FieldCreateTime), ent.Asc(projectstatuschange.FieldID)).
+		Limit(params.Fetch())
+	if c := params.After; c != nil {
+		query = query.Where(projectstatuschange.Or(
+			projectstatuschange.CreateTimeGT(c.Time),
+			projectstatuschange.And(projectstatuschange.CreateTime(c.Time), projectstatuschange.IDGT(c.ID)),
+		))
+	}
+
+	changes, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project status history")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	changes, next := pagination.Trim(changes, params, func(c *ent.ProjectStatusChange) pagination.Cursor {
+		return pagination.Cursor{Time: c.CreateTime, ID: c.ID}
+	})
+
+	changeResponses := make([]StatusChangeResponse, len(changes))
+	for i, c := range changes {
+		resp := StatusChangeResponse{
+			ID:        c.ID,
+			ToStatus:  string(c.ToStatus),
+			Note:      c.Note,
+			CreatedAt: c.CreateTime.Format("2006-01-02T15:04:05Z"),
+		}
+		if c.FromStatus != nil {
+			from := string(*c.FromStatus)
+			resp.FromStatus = &from
+		}
+		if c.Edges.ChangedBy != nil {
+			resp.ChangedBy.ID = c.Edges.ChangedBy.ID
+			resp.ChangedBy.Username = c.Edges.ChangedBy.Username
+		}
+		changeResponses[i] = resp
+	}
+
+	response.Paginated(w, r, "Project status history retrieved successfully", response.NewPage(changeResponses, next))
+}
+
+// parseDate parses an optional YYYY-MM-DD date. An empty string means the date should be cleared.
+func parseDate(value *string) (date *time.Time, clear bool, err error) {
+	if value == nil {
+		return nil, false, nil
+	}
+	if *value == "" {
+		return nil, true, nil
+	}
+	t, err := time.Parse(dateLayout, *value)
+	if err != nil {
+		return nil, false, errors.ErrInvalidProjectDate
+	}
+	return &t, false, nil
+}
+
+func formatDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(dateLayout)
+	return &s
+}
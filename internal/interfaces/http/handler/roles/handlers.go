@@ -0,0 +1,490 @@
+package roles
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrole"
+	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/matching"
+)
+
+const maxRequiredTags = 10
+
+type CreateRoleRequest struct {
+	Title         string   `json:"title"`
+	Description   *string  `json:"description"`
+	MinSkillLevel string   `json:"min_skill_level"`
+	Tags          []string `json:"tags"`
+}
+
+func (r CreateRoleRequest) Validate() error {
+	if r.Title == "" || len(r.Title) > 255 {
+		return errors.ErrInvalidRequest
+	}
+	if r.Description != nil && len(*r.Description) > 1000 {
+		return errors.ErrInvalidRequest
+	}
+	if r.MinSkillLevel != "" && projectrole.MinSkillLevelValidator(projectrole.MinSkillLevel(r.MinSkillLevel)) != nil {
+		return errors.ErrInvalidSkillLevel
+	}
+	if len(r.Tags) == 0 || len(r.Tags) > maxRequiredTags {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+type UpdateRoleRequest struct {
+	Title         *string   `json:"title"`
+	Description   *string   `json:"description"`
+	MinSkillLevel *string   `json:"min_skill_level"`
+	Tags          *[]string `json:"tags"`
+	Open          *bool     `json:"open"`
+}
+
+func (r UpdateRoleRequest) Validate() error {
+	if r.Title != nil && (*r.Title == "" || len(*r.Title) > 255) {
+		return errors.ErrInvalidRequest
+	}
+	if r.Description != nil && len(*r.Description) > 1000 {
+		return errors.ErrInvalidRequest
+	}
+	if r.MinSkillLevel != nil && projectrole.MinSkillLevelValidator(projectrole.MinSkillLevel(*r.MinSkillLevel)) != nil {
+		return errors.ErrInvalidSkillLevel
+	}
+	if r.Tags != nil && (len(*r.Tags) == 0 || len(*r.Tags) > maxRequiredTags) {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+type TagResponse struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type RoleResponse struct {
+	ID            string        `json:"id"`
+	Title         string        `json:"title"`
+	Description   *string       `json:"description"`
+	MinSkillLevel string        `json:"min_skill_level"`
+	Open          bool          `json:"open"`
+	Tags          []TagResponse `json:"tags"`
+	CreatedAt     string        `json:"created_at"`
+}
+
+type MatchedTagResponse struct {
+	TagResponse
+	SkillLevel      string   `json:"skill_level"`
+	YearsExperience *float64 `json:"years_experience"`
+}
+
+type CandidateResponse struct {
+	User struct {
+		ID        string  `json:"id"`
+		Username  string  `json:"username"`
+		FirstName string  `json:"first_name"`
+		LastName  string  `json:"last_name"`
+		AvatarURL *string `json:"avatar_url"`
+	} `json:"user"`
+	Score       float64              `json:"score"`
+	Coverage    float64              `json:"coverage"`
+	MatchedTags []MatchedTagResponse `json:"matched_tags"`
+}
+
+func (h *RolesHandler) ListRoles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+
+	exists, err := h.client.Project.Query().Where(project.ID(projectID)).Exist(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if !exists {
+		log.Error(ctx).Msg("Project not found")
+		response.Error(w, errors.ErrNotFound)
+		return
+	}
+
+	query := h.client.ProjectRole.Query().
+		Where(projectrole.ProjectID(projectID)).
+		WithRequiredTags().
+		Order(ent.Asc(projectrole.FieldCreateTime))
+	if openParam := r.URL.Query().Get("open"); openParam != "" {
+		open, err := strconv.ParseBool(openParam)
+		if err != nil {
+			response.Error(w, errors.ErrInvalidRequest)
+			return
+		}
+		query = query.Where(projectrole.Open(open))
+	}
+
+	roles, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list roles")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	roleResponses := make([]RoleResponse, len(roles))
+	for i, role := range roles {
+		roleResponses[i] = buildRoleResponse(role)
+	}
+
+	response.JSON(w, http.StatusOK, "Project roles retrieved successfully", roleResponses)
+}
+
+func (h *RolesHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req CreateRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionManageRoles); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot manage the project roles")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	minSkillLevel := projectrole.DefaultMinSkillLevel
+	if req.MinSkillLevel != "" {
+		minSkillLevel = projectrole.MinSkillLevel(req.MinSkillLevel)
+	}
+
+	var role *ent.ProjectRole
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		tagIDs, err := resolveTags(ctx, tx.Client(), req.Tags)
+		if err != nil {
+			return err
+		}
+		role, err = tx.ProjectRole.Create().
+			SetProjectID(projectID).
+			SetTitle(req.Title).
+			SetNillableDescription(req.Description).
+			SetMinSkillLevel(minSkillLevel).
+			AddRequiredTagIDs(tagIDs...).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to create role")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	roleResp, err := h.getRoleResponse(ctx, role.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get role response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Role created successfully: %s on project %s", role.ID, projectID)
+	response.JSON(w, http.StatusCreated, "Role created successfully", roleResp)
+}
+
+func (h *RolesHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	roleID := chi.URLParam(r, "roleID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req UpdateRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionManageRoles); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot manage the project roles")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	role, ok := h.getRole(w, r, projectID, roleID)
+	if !ok {
+		return
+	}
+
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		update := tx.ProjectRole.UpdateOneID(role.ID)
+		if req.Title != nil {
+			update = update.SetTitle(*req.Title)
+		}
+		if req.Description != nil {
+			update = update.SetDescription(*req.Description)
+		}
+		if req.MinSkillLevel != nil {
+			update = update.SetMinSkillLevel(projectrole.MinSkillLevel(*req.MinSkillLevel))
+		}
+		if req.Open != nil {
+			update = update.SetOpen(*req.Open)
+		}
+		if req.Tags != nil {
+			tagIDs, err := resolveTags(ctx, tx.Client(), *req.Tags)
+			if err != nil {
+				return err
+			}
+			update = update.ClearRequiredTags().AddRequiredTagIDs(tagIDs...)
+		}
+		return update.Exec(ctx)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update role")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	roleResp, err := h.getRoleResponse(ctx, role.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get role response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Role updated successfully: %s on project %s", role.ID, projectID)
+	response.JSON(w, http.StatusOK, "Role updated successfully", roleResp)
+}
+
+func (h *RolesHandler) DeleteRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	roleID := chi.URLParam(r, "roleID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionManageRoles); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot manage the project roles")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	role, ok := h.getRole(w, r, projectID, roleID)
+	if !ok {
+		return
+	}
+
+	if err := h.client.ProjectRole.DeleteOneID(role.ID).Exec(ctx); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to delete role")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Role deleted successfully: %s on project %s", role.ID, projectID)
+	response.JSON(w, http.StatusOK, "Role deleted successfully", nil)
+}
+
+// GetRoleCandidates suggests users whose technologies match the required tags of the role.
+// Current members and users who opted out of suggestions are left out.
+func (h *RolesHandler) GetRoleCandidates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	roleID := chi.URLParam(r, "roleID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	p, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionManageRoles)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot manage the project roles")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	role, ok := h.getRole(w, r, projectID, roleID)
+	if !ok {
+		return
+	}
+
+	memberIDs, err := h.client.ProjectMember.Query().
+		Where(projectmember.ProjectID(projectID)).
+		Select(projectmember.FieldUserID).
+		Strings(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list project members")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	exclude := append(memberIDs, p.Edges.Owner.ID)
+
+	candidates, err := matching.Rank(ctx, h.client, role, exclude, h.getLimit(r))
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to rank candidates")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	candidateResponses := make([]CandidateResponse, len(candidates))
+	for i, c := range candidates {
+		candidateResponses[i] = buildCandidateResponse(c)
+	}
+
+	response.JSON(w, http.StatusOK, "Role candidates retrieved successfully", candidateResponses)
+}
+
+// getRole loads a role of the project with its required tags, writing the error response when it cannot
+func (h *RolesHandler) getRole(w http.ResponseWriter, r *http.Request, projectID, roleID string) (*ent.ProjectRole, bool) {
+	ctx := r.Context()
+	role, err := h.client.ProjectRole.Query().
+		Where(
+			projectrole.ID(roleID),
+			projectrole.ProjectID(projectID),
+		).
+		WithRequiredTags().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Role not found")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get role")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, false
+	}
+	return role, true
+}
+
+func (h *RolesHandler) getRoleResponse(ctx context.Context, roleID string) (RoleResponse, error) {
+	role, err := h.client.ProjectRole.Query().
+		Where(projectrole.ID(roleID)).
+		WithRequiredTags().
+		Only(ctx)
+	if err != nil {
+		return RoleResponse{}, err
+	}
+	return buildRoleResponse(role), nil
+}
+
+func (h *RolesHandler) getLimit(r *http.Request) int {
+	limit := 20
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
+			limit = l
+		}
+	}
+	return limit
+}
+
+// resolveTags returns the IDs of the tags with the given slugs, creating the missing ones
+// the same way adding a technology or tagging a project does
+func resolveTags(ctx context.Context, client *ent.Client, slugs []string) ([]string, error) {
+	seen := map[string]bool{}
+	var ids []string
+	for _, slug := range slugs {
+		normalizedSlug := normalizeSlug(slug)
+		if normalizedSlug == "" || seen[normalizedSlug] {
+			continue
+		}
+		seen[normalizedSlug] = true
+
+		t, err := client.Tag.Query().Where(tag.Slug(normalizedSlug)).First(ctx)
+		if ent.IsNotFound(err) {
+			t, err = client.Tag.Create().
+				SetName(slug).
+				SetSlug(normalizedSlug).
+				Save(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, t.ID)
+	}
+	return ids, nil
+}
+
+func normalizeSlug(input string) string {
+	slug := strings.ToLower(input)
+	slug = strings.ReplaceAll(slug, " ", "-")
+	slug = strings.ReplaceAll(slug, ".", "")
+	slug = strings.ReplaceAll(slug, "/", "")
+	return slug
+}
+
+func buildRoleResponse(role *ent.ProjectRole) RoleResponse {
+	resp := RoleResponse{
+		ID:            role.ID,
+		Title:         role.Title,
+		Description:   role.Description,
+		MinSkillLevel: string(role.MinSkillLevel),
+		Open:          role.Open,
+		Tags:          make([]TagResponse, len(role.Edges.RequiredTags)),
+		CreatedAt:     role.CreateTime.Format("2006-01-02T15:04:05Z"),
+	}
+	for i, t := range role.Edges.RequiredTags {
+		resp.Tags[i] = TagResponse{ID: t.ID, Name: t.Name, Slug: t.Slug}
+	}
+	return resp
+}
+
+func buildCandidateResponse(c matching.Candidate) CandidateResponse {
+	var resp CandidateResponse
+	resp.User.ID = c.User.ID
+	resp.User.Username = c.User.Username
+	resp.User.FirstName = c.User.FirstName
+	resp.User.LastName = c.User.LastName
+	resp.User.AvatarURL = c.User.AvatarURL
+	resp.Score = c.Score
+	resp.Coverage = c.Coverage
+	resp.MatchedTags = make([]MatchedTagResponse, len(c.Matches))
+	for i, m := range c.Matches {
+		resp.MatchedTags[i] = MatchedTagResponse{
+			TagResponse:     TagResponse{ID: m.Tag.ID, Name: m.Tag.Name, Slug: m.Tag.Slug},
+			SkillLevel:      string(m.SkillLevel),
+			YearsExperience: m.YearsExperience,
+		}
+	}
+	return resp
+}
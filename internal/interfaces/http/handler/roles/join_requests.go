@@ -0,0 +1,446 @@
+package roles
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/joinrequest"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrole"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"github.com/jorge-j1m/hackspark_server/internal/service/notify"
+	"github.com/jorge-j1m/hackspark_server/internal/service/webhook"
+)
+
+type CreateJoinRequestRequest struct {
+	RoleID  *string `json:"role_id"`
+	Message *string `json:"message"`
+}
+
+func (r CreateJoinRequestRequest) Validate() error {
+	if r.Message != nil && len(*r.Message) > 1000 {
+		return errors.ErrInvalidRequest
+	}
+	return nil
+}
+
+type JoinRequestRole struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+type JoinRequestResponse struct {
+	ID   string `json:"id"`
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Role        *JoinRequestRole `json:"role"`
+	Message     *string          `json:"message"`
+	Status      string           `json:"status"`
+	RespondedAt *string          `json:"responded_at"`
+	CreatedAt   string           `json:"created_at"`
+}
+
+func (h *RolesHandler) CreateJoinRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req CreateJoinRequestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	p, err := h.client.Project.Query().
+		Where(project.ID(projectID)).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Project not found")
+			response.Error(w, errors.ErrNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get project")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	role, err := h.authz.RoleOf(ctx, p, userID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user role")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if role != "" {
+		log.Error(ctx).Msg("User is already a member of the project")
+		response.Error(w, errors.ErrAlreadyMember)
+		return
+	}
+
+	if req.RoleID != nil {
+		open, err := h.client.ProjectRole.Query().
+			Where(
+				projectrole.ID(*req.RoleID),
+				projectrole.ProjectID(projectID),
+				projectrole.Open(true),
+			).
+			Exist(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to get role")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		if !open {
+			log.Error(ctx).Msg("Role is not open on the project")
+			response.Error(w, errors.ErrRoleNotOpen)
+			return
+		}
+	}
+
+	pending, err := h.client.JoinRequest.Query().
+		Where(
+			joinrequest.ProjectID(projectID),
+			joinrequest.UserID(userID),
+			joinrequest.StatusEQ(joinrequest.StatusPending),
+		).
+		Exist(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to check pending join requests")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if pending {
+		log.Error(ctx).Msg("User already has a pending join request")
+		response.Error(w, errors.ErrJoinRequestPending)
+		return
+	}
+
+	var (
+		jr        *ent.JoinRequest
+		requested eventbus.Event
+	)
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		jr, err = tx.JoinRequest.Create().
+			SetProjectID(projectID).
+			SetUserID(userID).
+			SetNillableRoleID(req.RoleID).
+			SetNillableMessage(req.Message).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		payload := map[string]any{"join_request_id": jr.ID}
+		if req.RoleID != nil {
+			payload["role_id"] = *req.RoleID
+		}
+		requested = eventbus.Event{
+			Type:      eventbus.ProjectJoinRequested,
+			ActorID:   userID,
+			ProjectID: projectID,
+			Payload:   payload,
+		}
+		if err := webhook.Enqueue(ctx, tx, requested); err != nil {
+			return err
+		}
+		return notify.Enqueue(ctx, tx, requested)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to create join request")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	h.bus.Publish(ctx, requested)
+
+	joinRequestResp, err := h.getJoinRequestResponse(ctx, jr.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get join request response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Join request created successfully: %s on project %s", jr.ID, projectID)
+	response.JSON(w, http.StatusCreated, "Join request created successfully", joinRequestResp)
+}
+
+func (h *RolesHandler) ListJoinRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionInviteMembers); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot see the project join requests")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	status := joinrequest.StatusPending
+	if s := r.URL.Query().Get("status"); s != "" {
+		status = joinrequest.Status(s)
+		if joinrequest.StatusValidator(status) != nil {
+			response.Error(w, errors.ErrInvalidRequest)
+			return
+		}
+	}
+
+	joinRequests, err := h.joinRequestQuery().
+		Where(
+			joinrequest.ProjectID(projectID),
+			joinrequest.StatusEQ(status),
+		).
+		All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list join requests")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	joinRequestResponses := make([]JoinRequestResponse, len(joinRequests))
+	for i, jr := range joinRequests {
+		joinRequestResponses[i] = buildJoinRequestResponse(jr)
+	}
+
+	response.JSON(w, http.StatusOK, "Join requests retrieved successfully", joinRequestResponses)
+}
+
+func (h *RolesHandler) AcceptJoinRequest(w http.ResponseWriter, r *http.Request) {
+	h.answerJoinRequest(w, r, joinrequest.StatusAccepted)
+}
+
+func (h *RolesHandler) DeclineJoinRequest(w http.ResponseWriter, r *http.Request) {
+	h.answerJoinRequest(w, r, joinrequest.StatusDeclined)
+}
+
+// answerJoinRequest accepts or declines a pending join request.
+// Accepting makes the requester a contributor of the project.
+func (h *RolesHandler) answerJoinRequest(w http.ResponseWriter, r *http.Request, status joinrequest.Status) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	requestID := chi.URLParam(r, "requestID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	// Admitting someone through a join request is the same as inviting them
+	if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionInviteMembers); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot answer the project join requests")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	jr, ok := h.getPendingJoinRequest(w, r, projectID, requestID)
+	if !ok {
+		return
+	}
+
+	joined := eventbus.Event{
+		Type:      eventbus.ProjectMemberJoined,
+		ActorID:   userID,
+		ProjectID: projectID,
+		Payload: map[string]any{
+			"join_request_id": jr.ID,
+			"user_id":         jr.UserID,
+			"role":            string(projectmember.RoleContributor),
+		},
+	}
+	err = database.WithTx(ctx, h.client, func(tx *ent.Tx) error {
+		// The request may have been answered or withdrawn since it was read
+		n, err := tx.JoinRequest.Update().
+			Where(joinrequest.ID(jr.ID), joinrequest.StatusEQ(joinrequest.StatusPending)).
+			SetStatus(status).
+			SetRespondedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errors.ErrJoinRequestNotPending
+		}
+		if status != joinrequest.StatusAccepted {
+			return nil
+		}
+
+		isMember, err := tx.ProjectMember.Query().
+			Where(
+				projectmember.ProjectID(projectID),
+				projectmember.UserID(jr.UserID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !isMember {
+			if err := tx.ProjectMember.Create().
+				SetProjectID(projectID).
+				SetUserID(jr.UserID).
+				SetRole(projectmember.RoleContributor).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		if err := webhook.Enqueue(ctx, tx, joined); err != nil {
+			return err
+		}
+		return notify.Enqueue(ctx, tx, joined)
+	})
+	if err != nil {
+		log.Error(ctx).Err(err).Msgf("Failed to mark join request as %s", status)
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	if status == joinrequest.StatusAccepted {
+		h.bus.Publish(ctx, joined)
+	}
+
+	joinRequestResp, err := h.getJoinRequestResponse(ctx, jr.ID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get join request response")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Join request %s successfully: %s on project %s", status, jr.ID, projectID)
+	response.JSON(w, http.StatusOK, "Join request "+string(status)+" successfully", joinRequestResp)
+}
+
+// WithdrawJoinRequest lets the requester take back their pending request
+func (h *RolesHandler) WithdrawJoinRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	projectID := chi.URLParam(r, "id")
+	requestID := chi.URLParam(r, "requestID")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	jr, ok := h.getPendingJoinRequest(w, r, projectID, requestID)
+	if !ok {
+		return
+	}
+	if jr.UserID != userID {
+		log.Error(ctx).Msg("User did not create the join request")
+		response.Error(w, errors.ErrForbidden)
+		return
+	}
+
+	// The request may have been answered since it was read
+	n, err := h.client.JoinRequest.Update().
+		Where(joinrequest.ID(jr.ID), joinrequest.StatusEQ(joinrequest.StatusPending)).
+		SetStatus(joinrequest.StatusWithdrawn).
+		Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to withdraw join request")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if n == 0 {
+		log.Error(ctx).Msg("Join request is no longer pending")
+		response.Error(w, errors.ErrJoinRequestNotPending)
+		return
+	}
+
+	log.Info(ctx).Msgf("Join request withdrawn successfully: %s on project %s", jr.ID, projectID)
+	response.JSON(w, http.StatusOK, "Join request withdrawn successfully", nil)
+}
+
+// getPendingJoinRequest loads a join request of the project, writing the error response
+// when it does not exist or was already answered
+func (h *RolesHandler) getPendingJoinRequest(w http.ResponseWriter, r *http.Request, projectID, requestID string) (*ent.JoinRequest, bool) {
+	ctx := r.Context()
+	jr, err := h.client.JoinRequest.Query().
+		Where(
+			joinrequest.ID(requestID),
+			joinrequest.ProjectID(projectID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Join request not found")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get join request")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, false
+	}
+	if jr.Status != joinrequest.StatusPending {
+		log.Error(ctx).Msg("Join request is no longer pending")
+		response.Error(w, errors.ErrJoinRequestNotPending)
+		return nil, false
+	}
+	return jr, true
+}
+
+func (h *RolesHandler) joinRequestQuery() *ent.JoinRequestQuery {
+	return h.client.JoinRequest.Query().
+		WithUser().
+		WithRole().
+		Order(ent.Asc(joinrequest.FieldCreateTime))
+}
+
+func (h *RolesHandler) getJoinRequestResponse(ctx context.Context, requestID string) (JoinRequestResponse, error) {
+	jr, err := h.joinRequestQuery().Where(joinrequest.ID(requestID)).Only(ctx)
+	if err != nil {
+		return JoinRequestResponse{}, err
+	}
+	return buildJoinRequestResponse(jr), nil
+}
+
+func buildJoinRequestResponse(jr *ent.JoinRequest) JoinRequestResponse {
+	resp := JoinRequestResponse{
+		ID:        jr.ID,
+		Message:   jr.Message,
+		Status:    string(jr.Status),
+		CreatedAt: jr.CreateTime.Format("2006-01-02T15:04:05Z"),
+	}
+	if jr.RespondedAt != nil {
+		respondedAt := jr.RespondedAt.Format("2006-01-02T15:04:05Z")
+		resp.RespondedAt = &respondedAt
+	}
+	if u := jr.Edges.User; u != nil {
+		resp.User.ID = u.ID
+		resp.User.Username = u.Username
+	}
+	if role := jr.Edges.Role; role != nil {
+		resp.Role = &JoinRequestRole{ID: role.ID, Title: role.Title}
+	}
+	return resp
+}
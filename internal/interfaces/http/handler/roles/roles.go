@@ -0,0 +1,21 @@
+package roles
+
+import (
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+)
+
+type RolesHandler struct {
+	client *ent.Client
+	bus    *eventbus.Bus
+	authz  *authz.Authorizer
+}
+
+func NewRolesHandler(client *ent.Client, bus *eventbus.Bus, authorizer *authz.Authorizer) *RolesHandler {
+	return &RolesHandler{
+		client: client,
+		bus:    bus,
+		authz:  authorizer,
+	}
+}
@@ -0,0 +1,262 @@
+package search
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/projects"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/tags"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/users"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	searchsvc "github.com/jorge-j1m/hackspark_server/internal/service/search"
+)
+
+const (
+	minQueryLength = 2
+	maxQueryLength = 200
+
+	// perTypeLimit is the number of results of every type returned when not searching a single type
+	perTypeLimit = 5
+)
+
+type ProjectHit struct {
+	projects.ProjectResponse
+	Rank    float64 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+type UserHit struct {
+	users.PublicUserResponse
+	Rank    float64 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+type TagHit struct {
+	tags.TagResponse
+	Rank    float64 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+type SearchResponse struct {
+	Query string `json:"query"`
+	// Facets hold the total number of matches of every type
+	Facets   map[searchsvc.Type]int `json:"facets"`
+	Projects []ProjectHit           `json:"projects,omitempty"`
+	Users    []UserHit              `json:"users,omitempty"`
+	Tags     []TagHit               `json:"tags,omitempty"`
+}
+
+// Search looks for projects, users and tags matching q. Without a type, the best few
+// results of every type are returned with the facets; with one, the results of that type are
+// paginated.
+func (h *SearchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q := strings.TrimSpace(r.URL.Query().Get("q"))
+	if len(q) < minQueryLength || len(q) > maxQueryLength {
+		response.Error(w, errors.ErrInvalidSearchQuery)
+		return
+	}
+
+	typeParam := r.URL.Query().Get("type")
+	if typeParam == "" {
+		h.searchAll(w, r, q)
+		return
+	}
+	t, ok := searchsvc.ParseType(typeParam)
+	if !ok {
+		response.Error(w, errors.ErrInvalidSearchType)
+		return
+	}
+
+	// Hits are ranked on the fly, so pages are resumed by offset
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	hits, err := h.searcher.Search(ctx, t, q, params.Fetch(), params.Offset())
+	if err != nil {
+		log.Error(ctx).Err(err).Msgf("Failed to search %s", t)
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	hits, next := pagination.Trim(hits, params, func(searchsvc.Hit) pagination.Cursor {
+		return params.OffsetCursor()
+	})
+
+	var items any
+	switch t {
+	case searchsvc.TypeProject:
+		items, err = h.buildProjectHits(ctx, hits)
+	case searchsvc.TypeUser:
+		items, err = h.buildUserHits(ctx, hits)
+	case searchsvc.TypeTag:
+		items, err = h.buildTagHits(ctx, hits)
+	}
+	if err != nil {
+		log.Error(ctx).Err(err).Msgf("Failed to load %s search results", t)
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	page := response.NewPage(items, next)
+	if params.WithTotal {
+		facets, err := h.searcher.Facets(ctx, q)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count search results")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		total := facets[t]
+		page.Total = &total
+	}
+	response.Paginated(w, r, "Search results retrieved successfully", page)
+}
+
+// searchAll sends the best few results of every type
+func (h *SearchHandler) searchAll(w http.ResponseWriter, r *http.Request, q string) {
+	ctx := r.Context()
+
+	facets, err := h.searcher.Facets(ctx, q)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to count search results")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp := SearchResponse{Query: q, Facets: facets}
+	for _, t := range searchsvc.Types {
+		hits, err := h.searcher.Search(ctx, t, q, perTypeLimit, 0)
+		if err != nil {
+			log.Error(ctx).Err(err).Msgf("Failed to search %s", t)
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+
+		switch t {
+		case searchsvc.TypeProject:
+			resp.Projects, err = h.buildProjectHits(ctx, hits)
+		case searchsvc.TypeUser:
+			resp.Users, err = h.buildUserHits(ctx, hits)
+		case searchsvc.TypeTag:
+			resp.Tags, err = h.buildTagHits(ctx, hits)
+		}
+		if err != nil {
+			log.Error(ctx).Err(err).Msgf("Failed to load %s search results", t)
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+	}
+
+	response.JSON(w, http.StatusOK, "Search results retrieved successfully", resp)
+}
+
+// The hits are already ranked, so entities are loaded in one query and put back in hit order.
+// A hit whose entity disappeared in between is skipped.
+
+func (h *SearchHandler) buildProjectHits(ctx context.Context, hits []searchsvc.Hit) ([]ProjectHit, error) {
+	found, err := h.client.Project.Query().
+		Where(project.IDIn(hitIDs(hits)...)).
+		WithOwner().
+		WithTags().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*ent.Project, len(found))
+	for _, p := range found {
+		byID[p.ID] = p
+	}
+
+	projectHits := make([]ProjectHit, 0, len(hits))
+	for _, hit := range hits {
+		if p, ok := byID[hit.ID]; ok {
+			projectHits = append(projectHits, ProjectHit{
+				ProjectResponse: projects.BuildProjectResponse(p),
+				Rank:            hit.Rank,
+				Snippet:         hit.Snippet,
+			})
+		}
+	}
+
+	resps := make([]*projects.ProjectResponse, len(projectHits))
+	for i := range projectHits {
+		resps[i] = &projectHits[i].ProjectResponse
+	}
+	if err := projects.FillProgress(ctx, h.client, resps...); err != nil {
+		return nil, err
+	}
+	if err := projects.FillMedia(ctx, h.client, resps...); err != nil {
+		return nil, err
+	}
+	return projectHits, nil
+}
+
+func (h *SearchHandler) buildUserHits(ctx context.Context, hits []searchsvc.Hit) ([]UserHit, error) {
+	found, err := h.client.User.Query().
+		Where(user.IDIn(hitIDs(hits)...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*ent.User, len(found))
+	for _, u := range found {
+		byID[u.ID] = u
+	}
+
+	userHits := make([]UserHit, 0, len(hits))
+	for _, hit := range hits {
+		if u, ok := byID[hit.ID]; ok {
+			userHits = append(userHits, UserHit{
+				PublicUserResponse: users.BuildPublicUserResponse(u),
+				Rank:               hit.Rank,
+				Snippet:            hit.Snippet,
+			})
+		}
+	}
+	return userHits, nil
+}
+
+func (h *SearchHandler) buildTagHits(ctx context.Context, hits []searchsvc.Hit) ([]TagHit, error) {
+	found, err := h.client.Tag.Query().
+		Where(tag.IDIn(hitIDs(hits)...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*ent.Tag, len(found))
+	for _, t := range found {
+		byID[t.ID] = t
+	}
+
+	tagHits := make([]TagHit, 0, len(hits))
+	for _, hit := range hits {
+		if t, ok := byID[hit.ID]; ok {
+			tagHits = append(tagHits, TagHit{
+				TagResponse: tags.BuildTagResponse(t),
+				Rank:        hit.Rank,
+				Snippet:     hit.Snippet,
+			})
+		}
+	}
+	return tagHits, nil
+}
+
+func hitIDs(hits []searchsvc.Hit) []string {
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+	return ids
+}
@@ -0,0 +1,18 @@
+package search
+
+import (
+	"github.com/jorge-j1m/hackspark_server/ent"
+	searchsvc "github.com/jorge-j1m/hackspark_server/internal/service/search"
+)
+
+type SearchHandler struct {
+	client   *ent.Client
+	searcher *searchsvc.Searcher
+}
+
+func NewSearchHandler(client *ent.Client) *SearchHandler {
+	return &SearchHandler{
+		client:   client,
+		searcher: searchsvc.New(client),
+	}
+}
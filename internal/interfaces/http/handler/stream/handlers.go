@@ -0,0 +1,273 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent/notification"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/pubsub"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/websocket"
+	"github.com/jorge-j1m/hackspark_server/internal/service/realtime"
+)
+
+const (
+	// heartbeatInterval keeps idle streams open through proxies that close silent connections
+	heartbeatInterval = 25 * time.Second
+
+	// maxWatchedProjects bounds the projects a stream watches
+	maxWatchedProjects = 50
+)
+
+// ControlMessage changes the projects a WebSocket stream watches
+type ControlMessage struct {
+	Action   string   `json:"action"` // subscribe or unsubscribe
+	Projects []string `json:"projects"`
+}
+
+// Stream sends real-time updates to the authenticated user: their new notifications, and the
+// like counts and new comments of the projects listed in the projects query parameter. It
+// streams server-sent events, or WebSocket messages when the client asks for an upgrade. Over
+// WebSocket the client can change the projects it watches with control messages.
+func (h *StreamHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var requested []string
+	for _, id := range strings.Split(r.URL.Query().Get("projects"), ",") {
+		if id = strings.TrimSpace(id); id != "" && !slices.Contains(requested, id) {
+			requested = append(requested, id)
+		}
+	}
+	if len(requested) > maxWatchedProjects {
+		response.Error(w, errors.ErrTooManyWatchedProjects)
+		return
+	}
+
+	watched, err := h.visibleProjects(ctx, requested)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get watched projects")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	topics := []string{realtime.UserTopic(userID)}
+	for _, id := range watched {
+		topics = append(topics, realtime.ProjectTopic(id))
+	}
+	sub := h.ps.Subscribe(topics...)
+	defer sub.Close()
+
+	ready, err := h.subscriptionsUpdate(ctx, userID, watched)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to count unread notifications")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	if websocket.IsUpgrade(r) {
+		h.serveWebSocket(w, r, sub, watched, ready)
+		return
+	}
+	h.serveEvents(w, r, sub, ready)
+}
+
+// serveEvents streams the updates as server-sent events named after their type
+func (h *StreamHandler) serveEvents(w http.ResponseWriter, r *http.Request, sub *pubsub.Subscription, ready []byte) {
+	ctx := r.Context()
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		log.Error(ctx).Msg("Response does not support streaming")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Keeps reverse proxies like nginx from buffering the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	writeEvent := func(payload []byte) {
+		var u struct {
+			Type string `json:"type"`
+		}
+		_ = json.Unmarshal(payload, &u)
+		fmt.Fprintf(w, "event: %s\ndata: %s\n\n", u.Type, payload)
+		flusher.Flush()
+	}
+	writeEvent(ready)
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.shutdown.Done():
+			return
+		case <-ticker.C:
+			fmt.Fprint(w, ": ping\n\n")
+			flusher.Flush()
+		case msg, ok := <-sub.C():
+			if !ok {
+				return
+			}
+			writeEvent(msg.Payload)
+		}
+	}
+}
+
+// serveWebSocket streams the updates as WebSocket text messages, and reads the control
+// messages of the client
+func (h *StreamHandler) serveWebSocket(w http.ResponseWriter, r *http.Request, sub *pubsub.Subscription, watched []string, ready []byte) {
+	conn, err := websocket.Upgrade(w, r)
+	if err != nil {
+		log.Error(r.Context()).Err(err).Msg("Failed to upgrade to WebSocket")
+		return
+	}
+	closeCode := websocket.CloseNormal
+	defer func() { conn.Close(closeCode, "") }()
+
+	// The connection no longer belongs to the HTTP server, so the request context is not
+	// cancelled when the client leaves: reading fails instead
+	ctx, cancel := context.WithCancel(context.WithoutCancel(r.Context()))
+	defer cancel()
+	userID := visibility.Viewer(ctx)
+
+	updates := make(chan []byte, 1)
+	go func() {
+		defer cancel()
+		for {
+			raw, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var msg ControlMessage
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				continue
+			}
+
+			switch msg.Action {
+			case "subscribe":
+				ids, err := h.visibleProjects(ctx, msg.Projects)
+				if err != nil {
+					log.Error(ctx).Err(err).Msg("Failed to get watched projects")
+					continue
+				}
+				for _, id := range ids {
+					if !slices.Contains(watched, id) && len(watched) < maxWatchedProjects {
+						watched = append(watched, id)
+						sub.Add(realtime.ProjectTopic(id))
+					}
+				}
+			case "unsubscribe":
+				for _, id := range msg.Projects {
+					watched = slices.DeleteFunc(watched, func(w string) bool { return w == id })
+					sub.Remove(realtime.ProjectTopic(id))
+				}
+			default:
+				continue
+			}
+
+			update, err := h.subscriptionsUpdate(ctx, userID, watched)
+			if err != nil {
+				log.Error(ctx).Err(err).Msg("Failed to count unread notifications")
+				continue
+			}
+			select {
+			case updates <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	if err := conn.WriteText(ready); err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.shutdown.Done():
+			closeCode = websocket.CloseGoingAway
+			return
+		case <-ticker.C:
+			err = conn.Ping()
+		case update := <-updates:
+			err = conn.WriteText(update)
+		case msg, ok := <-sub.C():
+			if !ok {
+				return
+			}
+			err = conn.WriteText(msg.Payload)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// visibleProjects keeps the projects the viewer can see, in order
+func (h *StreamHandler) visibleProjects(ctx context.Context, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return []string{}, nil
+	}
+	visible, err := h.client.Project.Query().
+		Where(project.IDIn(ids...)).
+		IDs(visibility.WithUnlisted(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	kept := []string{}
+	for _, id := range ids {
+		if slices.Contains(visible, id) && !slices.Contains(kept, id) {
+			kept = append(kept, id)
+		}
+	}
+	return kept, nil
+}
+
+// subscriptionsUpdate encodes the update telling the client what the stream watches, along
+// with its unread notification count to start from
+func (h *StreamHandler) subscriptionsUpdate(ctx context.Context, userID string, watched []string) ([]byte, error) {
+	unread, err := h.client.Notification.Query().
+		Where(notification.RecipientID(userID), notification.ReadAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(realtime.Update{
+		Type: realtime.StreamSubscriptions,
+		Data: map[string]any{
+			"projects":     watched,
+			"unread_count": unread,
+		},
+		SentAt: time.Now(),
+	})
+}
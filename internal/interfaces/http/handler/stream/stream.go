@@ -0,0 +1,24 @@
+package stream
+
+import (
+	"context"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/pubsub"
+)
+
+type StreamHandler struct {
+	client *ent.Client
+	ps     pubsub.PubSub
+
+	// shutdown is cancelled when the server shuts down, it ends the open streams
+	shutdown context.Context
+}
+
+func NewStreamHandler(client *ent.Client, ps pubsub.PubSub, shutdown context.Context) *StreamHandler {
+	return &StreamHandler{
+		client:   client,
+		ps:       ps,
+		shutdown: shutdown,
+	}
+}
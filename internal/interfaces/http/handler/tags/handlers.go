@@ -2,16 +2,20 @@ package tags
 
 import (
 	"net/http"
-	"strconv"
 	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/jorge-j1m/hackspark_server/ent"
-	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/predicate"
 	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/ent/trendingsnapshot"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
 	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
 	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
 	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/projectfilter"
+	"github.com/jorge-j1m/hackspark_server/internal/service/trending"
 )
 
 type TagResponse struct {
@@ -28,30 +32,63 @@ type TagResponse struct {
 func (h *TagsHandler) ListTags(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	limit, offset := h.getPagination(r)
-
-	query := h.client.Tag.Query().
-		Limit(limit).
-		Offset(offset)
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
 
+	var filters []predicate.Tag
 	if search := r.URL.Query().Get("search"); search != "" {
-		query = query.Where(tag.NameContains(search))
+		filters = append(filters, tag.NameContains(search))
 	}
 
 	if category := r.URL.Query().Get("category"); category != "" {
-		query = query.Where(tag.CategoryEQ(tag.Category(category)))
+		filters = append(filters, tag.CategoryEQ(tag.Category(category)))
 	}
 
-	sortBy := r.URL.Query().Get("sort")
-	switch sortBy {
-	case "popular":
-		query = query.Order(ent.Desc(tag.FieldUsageCount))
+	query := h.client.Tag.Query().
+		Where(filters...).
+		Limit(params.Fetch())
+
+	// Every order ends with the ID so that cursors point to a single position
+	var cursorOf func(*ent.Tag) pagination.Cursor
+	c := params.After
+	switch r.URL.Query().Get("sort") {
 	case "alphabetical":
-		query = query.Order(ent.Asc(tag.FieldName))
+		query = query.Order(ent.Asc(tag.FieldName), ent.Asc(tag.FieldID))
+		if c != nil {
+			query = query.Where(tag.Or(
+				tag.NameGT(c.String),
+				tag.And(tag.Name(c.String), tag.IDGT(c.ID)),
+			))
+		}
+		cursorOf = func(t *ent.Tag) pagination.Cursor {
+			return pagination.Cursor{String: t.Name, ID: t.ID}
+		}
 	case "recent":
-		query = query.Order(ent.Desc(tag.FieldCreateTime))
+		query = query.Order(ent.Desc(tag.FieldCreateTime), ent.Desc(tag.FieldID))
+		if c != nil {
+			query = query.Where(tag.Or(
+				tag.CreateTimeLT(c.Time),
+				tag.And(tag.CreateTime(c.Time), tag.IDLT(c.ID)),
+			))
+		}
+		cursorOf = func(t *ent.Tag) pagination.Cursor {
+			return pagination.Cursor{Time: t.CreateTime, ID: t.ID}
+		}
 	default:
-		query = query.Order(ent.Desc(tag.FieldUsageCount))
+		query = query.Order(ent.Desc(tag.FieldUsageCount), ent.Desc(tag.FieldID))
+		if c != nil {
+			query = query.Where(tag.Or(
+				tag.UsageCountLT(int(c.Int)),
+				tag.And(tag.UsageCount(int(c.Int)), tag.IDLT(c.ID)),
+			))
+		}
+		cursorOf = func(t *ent.Tag) pagination.Cursor {
+			return pagination.Cursor{Int: int64(t.UsageCount), ID: t.ID}
+		}
 	}
 
 	tags, err := query.All(ctx)
@@ -60,14 +97,26 @@ func (h *TagsHandler) ListTags(w http.ResponseWriter, r *http.Request) {
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
+	tags, next := pagination.Trim(tags, params, cursorOf)
 
-	var tagResponses []TagResponse
+	tagResponses := make([]TagResponse, 0, len(tags))
 	for _, t := range tags {
-		resp := h.buildTagResponse(t)
+		resp := BuildTagResponse(t)
 		tagResponses = append(tagResponses, resp)
 	}
 
-	response.JSON(w, http.StatusOK, "Tags retrieved successfully", tagResponses)
+	page := response.NewPage(tagResponses, next)
+	if params.WithTotal {
+		total, err := h.client.Tag.Query().Where(filters...).Count(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count tags")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "Tags retrieved successfully", page)
 }
 
 func (h *TagsHandler) GetTag(w http.ResponseWriter, r *http.Request) {
@@ -88,7 +137,7 @@ func (h *TagsHandler) GetTag(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp := h.buildTagResponse(tag)
+	resp := BuildTagResponse(tag)
 	response.JSON(w, http.StatusOK, "Tag retrieved successfully", resp)
 }
 
@@ -96,7 +145,12 @@ func (h *TagsHandler) GetTagProjects(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	slug := chi.URLParam(r, "slug")
 
-	limit, offset := h.getPagination(r)
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
 
 	tag, err := h.client.Tag.Query().
 		Where(tag.Slug(slug)).
@@ -112,18 +166,25 @@ func (h *TagsHandler) GetTagProjects(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	projects, err := tag.QueryProjects().
+	filter, err := projectfilter.Parse(ctx, r.URL.Query())
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid project filter")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+	// The tag of the page is always required, on top of any other tag filter
+	filter.TagsAll = append(filter.TagsAll, tag.Slug)
+
+	projects, err := filter.Paginate(filter.Apply(h.client.Project.Query()), params).
 		WithOwner().
 		WithTags().
-		Limit(limit).
-		Offset(offset).
-		Order(ent.Desc(project.FieldCreateTime)).
 		All(ctx)
 	if err != nil {
 		log.Error(ctx).Err(err).Msg("Failed to get tag projects")
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
+	projects, next := pagination.Trim(projects, params, filter.Cursor(params))
 
 	type ProjectResponse struct {
 		ID          string `json:"id"`
@@ -137,7 +198,7 @@ func (h *TagsHandler) GetTagProjects(w http.ResponseWriter, r *http.Request) {
 		CreatedAt string `json:"created_at"`
 	}
 
-	var projectResponses []ProjectResponse
+	projectResponses := make([]ProjectResponse, 0, len(projects))
 	for _, p := range projects {
 		resp := ProjectResponse{
 			ID:          p.ID,
@@ -158,14 +219,30 @@ func (h *TagsHandler) GetTagProjects(w http.ResponseWriter, r *http.Request) {
 		projectResponses = append(projectResponses, resp)
 	}
 
-	response.JSON(w, http.StatusOK, "Tag projects retrieved successfully", projectResponses)
+	page := response.NewPage(projectResponses, next)
+	if params.WithTotal {
+		total, err := filter.Count(ctx, h.client)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count tag projects")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "Tag projects retrieved successfully", page)
 }
 
 func (h *TagsHandler) GetTagUsers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	slug := chi.URLParam(r, "slug")
 
-	limit, offset := h.getPagination(r)
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
 
 	tag, err := h.client.Tag.Query().
 		Where(tag.Slug(slug)).
@@ -181,15 +258,22 @@ func (h *TagsHandler) GetTagUsers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	users, err := tag.QueryUsers().
-		Limit(limit).
-		Offset(offset).
-		All(ctx)
+	query := tag.QueryUsers().
+		Order(ent.Asc(user.FieldUsername)).
+		Limit(params.Fetch())
+	if params.After != nil {
+		query = query.Where(user.UsernameGT(params.After.String))
+	}
+
+	users, err := query.All(ctx)
 	if err != nil {
 		log.Error(ctx).Err(err).Msg("Failed to get tag users")
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
+	users, next := pagination.Trim(users, params, func(u *ent.User) pagination.Cursor {
+		return pagination.Cursor{String: u.Username}
+	})
 
 	type UserResponse struct {
 		ID       string  `json:"id"`
@@ -197,7 +281,7 @@ func (h *TagsHandler) GetTagUsers(w http.ResponseWriter, r *http.Request) {
 		Bio      *string `json:"bio"`
 	}
 
-	var userResponses []UserResponse
+	userResponses := make([]UserResponse, 0, len(users))
 	for _, u := range users {
 		resp := UserResponse{
 			ID:       u.ID,
@@ -207,20 +291,68 @@ func (h *TagsHandler) GetTagUsers(w http.ResponseWriter, r *http.Request) {
 		userResponses = append(userResponses, resp)
 	}
 
-	response.JSON(w, http.StatusOK, "Tag users retrieved successfully", userResponses)
+	page := response.NewPage(userResponses, next)
+	if params.WithTotal {
+		total, err := tag.QueryUsers().Count(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count tag users")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "Tag users retrieved successfully", page)
 }
 
 func (h *TagsHandler) GetTrendingTags(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	limit, _ := h.getPagination(r)
-	if limit > 50 {
-		limit = 50
+	window, ok := trending.ParseWindow(r.URL.Query().Get("window"))
+	if !ok {
+		log.Error(ctx).Msg("Invalid trending window")
+		response.Error(w, errors.ErrInvalidTrendingWindow)
+		return
+	}
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+	if params.Limit > 50 {
+		params.Limit = 50
+	}
+
+	query := h.client.TrendingSnapshot.Query().
+		Where(
+			trendingsnapshot.SubjectTypeEQ(trendingsnapshot.SubjectTypeTag),
+			trendingsnapshot.PeriodEQ(window.Period),
+		).
+		Order(ent.Asc(trendingsnapshot.FieldRank)).
+		Limit(params.Fetch())
+	if params.After != nil {
+		query = query.Where(trendingsnapshot.RankGT(int(params.After.Int)))
+	}
+
+	snapshots, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get trending snapshot")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	snapshots, next := pagination.Trim(snapshots, params, func(s *ent.TrendingSnapshot) pagination.Cursor {
+		return pagination.Cursor{Int: int64(s.Rank)}
+	})
+
+	tagIDs := make([]string, len(snapshots))
+	for i, s := range snapshots {
+		tagIDs[i] = s.SubjectID
 	}
 
 	tags, err := h.client.Tag.Query().
-		Order(ent.Desc(tag.FieldUsageCount)).
-		Limit(limit).
+		Where(tag.IDIn(tagIDs...)).
 		All(ctx)
 	if err != nil {
 		log.Error(ctx).Err(err).Msg("Failed to get trending tags")
@@ -228,16 +360,36 @@ func (h *TagsHandler) GetTrendingTags(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var tagResponses []TagResponse
+	tagsByID := make(map[string]*ent.Tag, len(tags))
 	for _, t := range tags {
-		resp := h.buildTagResponse(t)
-		tagResponses = append(tagResponses, resp)
+		tagsByID[t.ID] = t
+	}
+
+	type TrendingTagResponse struct {
+		TagResponse
+		Rank  int     `json:"rank"`
+		Score float64 `json:"score"`
 	}
 
-	response.JSON(w, http.StatusOK, "Trending tags retrieved successfully", tagResponses)
+	// Keep the snapshot ranking, skipping tags deleted since the last refresh
+	tagResponses := []TrendingTagResponse{}
+	for _, s := range snapshots {
+		t, ok := tagsByID[s.SubjectID]
+		if !ok {
+			continue
+		}
+		tagResponses = append(tagResponses, TrendingTagResponse{
+			TagResponse: BuildTagResponse(t),
+			Rank:        s.Rank,
+			Score:       s.Score,
+		})
+	}
+
+	response.Paginated(w, r, "Trending tags retrieved successfully", response.NewPage(tagResponses, next))
 }
 
-func (h *TagsHandler) buildTagResponse(t *ent.Tag) TagResponse {
+// BuildTagResponse converts a tag to its API representation
+func BuildTagResponse(t *ent.Tag) TagResponse {
 	return TagResponse{
 		ID:          t.ID,
 		Name:        t.Name,
@@ -250,25 +402,6 @@ func (h *TagsHandler) buildTagResponse(t *ent.Tag) TagResponse {
 	}
 }
 
-func (h *TagsHandler) getPagination(r *http.Request) (limit, offset int) {
-	limit = 20
-	offset = 0
-
-	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
-		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
-			limit = l
-		}
-	}
-
-	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
-		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
-			offset = o
-		}
-	}
-
-	return limit, offset
-}
-
 func (h *TagsHandler) normalizeSlug(input string) string {
 	slug := strings.ToLower(input)
 	slug = strings.ReplaceAll(slug, " ", "-")
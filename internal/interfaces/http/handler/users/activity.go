@@ -0,0 +1,249 @@
+package users
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"slices"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/like"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	user_ent "github.com/jorge-j1m/hackspark_server/ent/user"
+	"github.com/jorge-j1m/hackspark_server/ent/usertechnology"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+)
+
+// ActivityType is the kind of an entry of a user's activity timeline
+type ActivityType string
+
+const (
+	ActivityProjectCreated  ActivityType = "project_created"
+	ActivityProjectLiked    ActivityType = "project_liked"
+	ActivityTechnologyAdded ActivityType = "technology_added"
+)
+
+type ActivityProject struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Owner       struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"owner"`
+}
+
+type ActivityResponse struct {
+	ID         string              `json:"id"`
+	Type       ActivityType        `json:"type"`
+	Project    *ActivityProject    `json:"project,omitempty"`
+	Technology *TechnologyResponse `json:"technology,omitempty"`
+	CreatedAt  string              `json:"created_at"`
+}
+
+// timelineSources are the sources of the timeline, in the order their entries created at the
+// same time are listed. Within a source, entries created at the same time are listed by
+// descending ID as the database orders them, so IDs are never compared across sources.
+var timelineSources = []ActivityType{ActivityProjectCreated, ActivityProjectLiked, ActivityTechnologyAdded}
+
+// activity is an entry of the timeline before it is converted to its response,
+// keeping the values the timeline is ordered by
+type activity struct {
+	id        string
+	createdAt time.Time
+	resp      ActivityResponse
+}
+
+// GetUserActivity returns the public timeline of a user: projects created, projects liked and technologies added,
+// most recent first. Likes are left out when the user hides them.
+func (u *UsersHandler) GetUserActivity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	username := chi.URLParam(r, "username")
+
+	user, err := u.getUserByUsername(ctx, username)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("User not found")
+			response.Error(w, errors.ErrUserNotFound)
+			return
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get user")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	// The cursor names the source of the last entry, which the order depends on
+	if c := params.After; c != nil && !slices.Contains(timelineSources, ActivityType(c.String)) {
+		log.Error(ctx).Msg("Invalid activity cursor")
+		response.Error(w, errors.ErrInvalidCursor)
+		return
+	}
+
+	viewerID, _ := middleware.GetUserIDFromContext(ctx)
+	activities, err := u.getUserActivity(ctx, user, params, canSeeLikes(user, viewerID))
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user activity")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	activities, next := pagination.Trim(activities, params, activityCursor)
+
+	activityResponses := make([]ActivityResponse, len(activities))
+	for i, a := range activities {
+		activityResponses[i] = a.resp
+	}
+
+	response.Paginated(w, r, "User activity retrieved successfully", response.NewPage(activityResponses, next))
+}
+
+// getUserActivity merges the most recent entries of every source of the timeline. Each source is
+// queried for a full page after the cursor, so the merged list holds every entry of the page.
+func (u *UsersHandler) getUserActivity(ctx context.Context, user *ent.User, params pagination.Params, withLikes bool) ([]activity, error) {
+	var activities []activity
+
+	projectQuery := u.client.Project.Query().
+		Where(project.HasOwnerWith(user_ent.ID(user.ID))).
+		WithOwner().
+		Order(ent.Desc(project.FieldCreateTime), ent.Desc(project.FieldID)).
+		Limit(params.Fetch())
+	if c := params.After; c != nil {
+		projectQuery = projectQuery.Where(after(c, ActivityProjectCreated))
+	}
+	created, err := projectQuery.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range created {
+		activities = append(activities, activity{
+			id:        p.ID,
+			createdAt: p.CreateTime,
+			resp: ActivityResponse{
+				ID:        p.ID,
+				Type:      ActivityProjectCreated,
+				Project:   buildActivityProject(p),
+				CreatedAt: p.CreateTime.Format("2006-01-02T15:04:05Z"),
+			},
+		})
+	}
+
+	if withLikes {
+		likeQuery := u.client.Like.Query().
+			Where(like.UserID(user.ID), visibleLikes(ctx)).
+			WithProject(func(q *ent.ProjectQuery) {
+				q.WithOwner()
+			}).
+			Order(ent.Desc(like.FieldCreateTime), ent.Desc(like.FieldID)).
+			Limit(params.Fetch())
+		if c := params.After; c != nil {
+			likeQuery = likeQuery.Where(after(c, ActivityProjectLiked))
+		}
+		likes, err := likeQuery.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range likes {
+			activities = append(activities, activity{
+				id:        l.ID,
+				createdAt: l.CreateTime,
+				resp: ActivityResponse{
+					ID:        l.ID,
+					Type:      ActivityProjectLiked,
+					Project:   buildActivityProject(l.Edges.Project),
+					CreatedAt: l.CreateTime.Format("2006-01-02T15:04:05Z"),
+				},
+			})
+		}
+	}
+
+	techQuery := u.client.UserTechnology.Query().
+		Where(usertechnology.UserID(user.ID)).
+		WithTechnology().
+		Order(ent.Desc(usertechnology.FieldCreateTime), ent.Desc(usertechnology.FieldID)).
+		Limit(params.Fetch())
+	if c := params.After; c != nil {
+		techQuery = techQuery.Where(after(c, ActivityTechnologyAdded))
+	}
+	techs, err := techQuery.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i, t := range convertUserTechnologiesToResponse(techs) {
+		activities = append(activities, activity{
+			id:        techs[i].ID,
+			createdAt: techs[i].CreateTime,
+			resp: ActivityResponse{
+				ID:         techs[i].ID,
+				Type:       ActivityTechnologyAdded,
+				Technology: &t,
+				CreatedAt:  techs[i].CreateTime.Format("2006-01-02T15:04:05Z"),
+			},
+		})
+	}
+
+	// Entries of each source were appended in the order of their query, which the stable sort
+	// keeps for entries of the same source created at the same time
+	sort.SliceStable(activities, func(i, j int) bool {
+		if !activities[i].createdAt.Equal(activities[j].createdAt) {
+			return activities[i].createdAt.After(activities[j].createdAt)
+		}
+		return slices.Index(timelineSources, activities[i].resp.Type) < slices.Index(timelineSources, activities[j].resp.Type)
+	})
+	if len(activities) > params.Fetch() {
+		activities = activities[:params.Fetch()]
+	}
+
+	return activities, nil
+}
+
+// activityCursor is the cursor resuming the timeline after the entry
+func activityCursor(a activity) pagination.Cursor {
+	return pagination.Cursor{Time: a.createdAt, String: string(a.resp.Type), ID: a.id}
+}
+
+// after matches the entries of the source listed after the cursor: those created before it and,
+// at the same time, those of the sources listed after the source of the cursor or, within that
+// source, those with a lower ID. Every source has the create_time and id columns.
+func after(c *pagination.Cursor, source ActivityType) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		createTime, id := s.C(project.FieldCreateTime), s.C(project.FieldID)
+		switch cmp.Compare(slices.Index(timelineSources, source), slices.Index(timelineSources, ActivityType(c.String))) {
+		case -1:
+			s.Where(sql.LT(createTime, c.Time))
+		case 0:
+			s.Where(sql.Or(
+				sql.LT(createTime, c.Time),
+				sql.And(sql.EQ(createTime, c.Time), sql.LT(id, c.ID)),
+			))
+		default:
+			s.Where(sql.LTE(createTime, c.Time))
+		}
+	}
+}
+
+func buildActivityProject(p *ent.Project) *ActivityProject {
+	resp := &ActivityProject{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+	}
+	if p.Edges.Owner != nil {
+		resp.Owner.ID = p.Edges.Owner.ID
+		resp.Owner.Username = p.Edges.Owner.Username
+	}
+	return resp
+}
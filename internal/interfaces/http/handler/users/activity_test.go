@@ -0,0 +1,77 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/testdb"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+)
+
+func TestUserActivityPages(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := visibility.System(context.Background())
+	u := &UsersHandler{client: client}
+
+	user := testdb.User(t, client, "maker")
+	other := testdb.User(t, client, "other")
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Entries of every source share the same times, so pages break ties across sources
+	for i := range 3 {
+		for _, created := range []time.Time{at, at.Add(-time.Hour)} {
+			testdb.Project(t, client, user.ID, fmt.Sprintf("Mine %d %d", i, created.Hour()), func(c *ent.ProjectCreate) {
+				c.SetCreateTime(created)
+			})
+			liked := testdb.Project(t, client, other.ID, fmt.Sprintf("Theirs %d %d", i, created.Hour()), nil)
+			client.Like.Create().SetUserID(user.ID).SetProjectID(liked.ID).SetCreateTime(created).ExecX(ctx)
+			slug := fmt.Sprintf("tech-%d-%d", i, created.Hour())
+			tech := client.Tag.Create().SetName(slug).SetSlug(slug).SaveX(ctx)
+			client.UserTechnology.Create().SetUserID(user.ID).SetTechnologyID(tech.ID).SetCreateTime(created).ExecX(ctx)
+		}
+	}
+
+	all, err := u.getUserActivity(context.Background(), user, pagination.Params{Limit: pagination.MaxLimit}, true)
+	if err != nil {
+		t.Fatalf("getUserActivity() error = %v", err)
+	}
+	if len(all) != 18 {
+		t.Fatalf("%d entries, want 18", len(all))
+	}
+	var want []string
+	for _, a := range all {
+		want = append(want, a.id)
+	}
+
+	for _, limit := range []int{1, 2, 4, 5} {
+		t.Run(fmt.Sprintf("limit %d", limit), func(t *testing.T) {
+			var got []string
+			params := pagination.Params{Limit: limit}
+			for range len(want) + 1 {
+				activities, err := u.getUserActivity(context.Background(), user, params, true)
+				if err != nil {
+					t.Fatalf("getUserActivity() error = %v", err)
+				}
+				activities, next := pagination.Trim(activities, params, activityCursor)
+				for _, a := range activities {
+					got = append(got, a.id)
+				}
+				if next == nil {
+					break
+				}
+				params.After, err = pagination.Decode(*next)
+				if err != nil {
+					t.Fatalf("decoding cursor: %v", err)
+				}
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("pages list %v, want %v", got, want)
+			}
+		})
+	}
+}
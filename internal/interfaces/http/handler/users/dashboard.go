@@ -0,0 +1,194 @@
+package users
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/projects"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/service/dashboard"
+)
+
+// dashboardTTL is how long a dashboard is served from the cache before being computed again
+const dashboardTTL = time.Minute
+
+type DashboardStats struct {
+	ProjectCount  int `json:"project_count"`
+	TotalLikes    int `json:"total_likes"`
+	TotalStars    int `json:"total_stars"`
+	TotalComments int `json:"total_comments"`
+}
+
+type DayCountResponse struct {
+	Date  string `json:"date"`
+	Likes int    `json:"likes"`
+}
+
+type ProjectTrendResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	LikeCount int    `json:"like_count"`
+	// LikesCurrent and LikesPrevious are the likes received during the last trend period and the one before
+	LikesCurrent  int `json:"likes_current"`
+	LikesPrevious int `json:"likes_previous"`
+	LikesChange   int `json:"likes_change"`
+}
+
+type RecentLikeResponse struct {
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"project"`
+	LikedAt string `json:"liked_at"`
+}
+
+type SkillCategoryResponse struct {
+	Category string         `json:"category"`
+	Count    int            `json:"count"`
+	Levels   map[string]int `json:"levels"`
+}
+
+type StreakResponse struct {
+	Current    int     `json:"current"`
+	Longest    int     `json:"longest"`
+	LastActive *string `json:"last_active"`
+}
+
+type DashboardResponse struct {
+	Stats        DashboardStats             `json:"stats"`
+	LikesHistory []DayCountResponse         `json:"likes_history"`
+	TrendDays    int                        `json:"trend_days"`
+	Projects     []ProjectTrendResponse     `json:"projects"`
+	RecentLikes  []RecentLikeResponse       `json:"recent_likes"`
+	Suggestions  []projects.ProjectResponse `json:"suggested_projects"`
+	Skills       []SkillCategoryResponse    `json:"skills"`
+	Streak       StreakResponse             `json:"streak"`
+	GeneratedAt  string                     `json:"generated_at"`
+}
+
+// GetMyDashboard returns the stats, trends and suggestions of the authenticated user.
+// Dashboards are cached per user for dashboardTTL.
+func (u *UsersHandler) GetMyDashboard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user, ok := ctx.Value(log.UserCtxKey).(*ent.User)
+	if !ok || user == nil {
+		log.Debug(ctx).Msg("Failed to get user from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	if resp, ok := u.dashboards.Get(user.ID); ok {
+		response.JSON(w, http.StatusOK, "Dashboard retrieved successfully", resp)
+		return
+	}
+
+	now := time.Now()
+	d, err := dashboard.Build(ctx, u.client, user, now)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to build dashboard")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resp := buildDashboardResponse(d, now)
+	suggestions := make([]*projects.ProjectResponse, len(resp.Suggestions))
+	for i := range resp.Suggestions {
+		suggestions[i] = &resp.Suggestions[i]
+	}
+	if err := projects.FillProgress(ctx, u.client, suggestions...); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to compute project progress")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if err := projects.FillMedia(ctx, u.client, suggestions...); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to load project media")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	u.dashboards.Set(user.ID, resp)
+	response.JSON(w, http.StatusOK, "Dashboard retrieved successfully", resp)
+}
+
+func buildDashboardResponse(d *dashboard.Dashboard, now time.Time) DashboardResponse {
+	resp := DashboardResponse{
+		Stats: DashboardStats{
+			ProjectCount:  d.Stats.ProjectCount,
+			TotalLikes:    d.Stats.TotalLikes,
+			TotalStars:    d.Stats.TotalStars,
+			TotalComments: d.Stats.TotalComments,
+		},
+		LikesHistory: make([]DayCountResponse, len(d.LikesHistory)),
+		TrendDays:    dashboard.TrendDays,
+		Projects:     make([]ProjectTrendResponse, len(d.Projects)),
+		RecentLikes:  make([]RecentLikeResponse, 0, len(d.RecentLikes)),
+		Suggestions:  make([]projects.ProjectResponse, len(d.Suggestions)),
+		Skills:       make([]SkillCategoryResponse, len(d.Skills)),
+		Streak: StreakResponse{
+			Current: d.Streak.Current,
+			Longest: d.Streak.Longest,
+		},
+		GeneratedAt: now.UTC().Format("2006-01-02T15:04:05Z"),
+	}
+
+	for i, c := range d.LikesHistory {
+		resp.LikesHistory[i] = DayCountResponse{
+			Date:  c.Day.Format("2006-01-02"),
+			Likes: c.Count,
+		}
+	}
+
+	for i, t := range d.Projects {
+		resp.Projects[i] = ProjectTrendResponse{
+			ID:            t.Project.ID,
+			Name:          t.Project.Name,
+			LikeCount:     t.Project.LikeCount,
+			LikesCurrent:  t.LikesCurrent,
+			LikesPrevious: t.LikesPrev,
+			LikesChange:   t.LikesCurrent - t.LikesPrev,
+		}
+	}
+
+	for _, l := range d.RecentLikes {
+		if l.Edges.User == nil || l.Edges.Project == nil {
+			continue
+		}
+		var like RecentLikeResponse
+		like.User.ID = l.Edges.User.ID
+		like.User.Username = l.Edges.User.Username
+		like.Project.ID = l.Edges.Project.ID
+		like.Project.Name = l.Edges.Project.Name
+		like.LikedAt = l.CreateTime.Format("2006-01-02T15:04:05Z")
+		resp.RecentLikes = append(resp.RecentLikes, like)
+	}
+
+	for i, p := range d.Suggestions {
+		resp.Suggestions[i] = projects.BuildProjectResponse(p)
+	}
+
+	for i, s := range d.Skills {
+		levels := make(map[string]int, len(s.Levels))
+		for level, count := range s.Levels {
+			levels[string(level)] = count
+		}
+		resp.Skills[i] = SkillCategoryResponse{
+			Category: string(s.Category),
+			Count:    s.Count,
+			Levels:   levels,
+		}
+	}
+
+	if d.Streak.LastActive != nil {
+		lastActive := d.Streak.LastActive.Format("2006-01-02")
+		resp.Streak.LastActive = &lastActive
+	}
+
+	return resp
+}
@@ -4,15 +4,21 @@ import (
 	"encoding/json"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/like"
 	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
 	"github.com/jorge-j1m/hackspark_server/ent/usertechnology"
 	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/projects"
 	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
 	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
 	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/projectfilter"
 )
 
 func (u *UsersHandler) Me(w http.ResponseWriter, r *http.Request) {
@@ -40,12 +46,14 @@ func (u *UsersHandler) Me(w http.ResponseWriter, r *http.Request) {
 
 	type MeResponse struct {
 		UserData
+		Settings     SettingsResponse     `json:"settings"`
 		Technologies []TechnologyResponse `json:"technologies"`
 		Projects     []ProjectResponse    `json:"projects"`
 	}
 
 	response.JSON(w, http.StatusOK, "User fetched successfully", MeResponse{
 		UserData:     convertUserToUserData(user),
+		Settings:     convertUserToSettings(user),
 		Technologies: convertUserTechnologiesToResponse(userTechs),
 		Projects:     convertProjectsToResponse(userProjects),
 	})
@@ -104,6 +112,76 @@ func (u *UsersHandler) GetUserProfile(w http.ResponseWriter, r *http.Request) {
 	response.JSON(w, http.StatusOK, "User profile retrieved successfully", resp)
 }
 
+type UpdateSettingsRequest struct {
+	// Discoverable controls whether the user is suggested as a candidate for open project roles
+	Discoverable *bool `json:"discoverable"`
+	// HideLikes hides the projects liked by the user from everyone else
+	HideLikes *bool `json:"hide_likes"`
+	// DigestFrequency is how often the user gets an email digest: off, daily or weekly
+	DigestFrequency *string `json:"digest_frequency"`
+	// Timezone is the IANA name of the timezone digests are scheduled in, e.g. Europe/Madrid
+	Timezone *string `json:"timezone"`
+}
+
+func (r UpdateSettingsRequest) Validate() error {
+	if r.DigestFrequency != nil && user.DigestFrequencyValidator(user.DigestFrequency(*r.DigestFrequency)) != nil {
+		return errors.ErrInvalidDigestFrequency
+	}
+	if r.Timezone != nil {
+		if _, err := time.LoadLocation(*r.Timezone); err != nil || *r.Timezone == "" || *r.Timezone == "Local" {
+			return errors.ErrInvalidTimezone
+		}
+	}
+	return nil
+}
+
+func (u *UsersHandler) UpdateMySettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req UpdateSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	update := u.client.User.UpdateOneID(userID)
+	if req.Discoverable != nil {
+		update = update.SetDiscoverable(*req.Discoverable)
+	}
+	if req.HideLikes != nil {
+		update = update.SetHideLikes(*req.HideLikes)
+	}
+	if req.DigestFrequency != nil {
+		update = update.SetDigestFrequency(user.DigestFrequency(*req.DigestFrequency))
+	}
+	if req.Timezone != nil {
+		update = update.SetTimezone(*req.Timezone)
+	}
+
+	user, err := update.Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update user settings")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("User settings updated successfully: %s", userID)
+	response.JSON(w, http.StatusOK, "Settings updated successfully", convertUserToSettings(user))
+}
+
 type AddTechnologyRequest struct {
 	TagSlug         string   `json:"tag_slug"`
 	SkillLevel      string   `json:"skill_level"`
@@ -207,37 +285,95 @@ func (u *UsersHandler) GetUserTechnologies(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	userTechs, err := u.getUserTechnologies(ctx, user.ID)
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	query := u.client.UserTechnology.Query().
+		Where(usertechnology.UserID(user.ID)).
+		WithTechnology().
+		Order(ent.Asc(usertechnology.FieldCreateTime), ent.Asc(usertechnology.FieldID)).
+		Limit(params.Fetch())
+	if c := params.After; c != nil {
+		query = query.Where(usertechnology.Or(
+			usertechnology.CreateTimeGT(c.Time),
+			usertechnology.And(usertechnology.CreateTime(c.Time), usertechnology.IDGT(c.ID)),
+		))
+	}
+
+	userTechs, err := query.All(ctx)
 	if err != nil {
 		log.Error(ctx).Err(err).Msg("Failed to get user technologies")
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
+	userTechs, next := pagination.Trim(userTechs, params, func(t *ent.UserTechnology) pagination.Cursor {
+		return pagination.Cursor{Time: t.CreateTime, ID: t.ID}
+	})
 
-	techResponses := convertUserTechnologiesToResponse(userTechs)
-	response.JSON(w, http.StatusOK, "User technologies retrieved successfully", techResponses)
+	page := response.NewPage(convertUserTechnologiesToResponse(userTechs), next)
+	if params.WithTotal {
+		total, err := u.client.UserTechnology.Query().Where(usertechnology.UserID(user.ID)).Count(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count user technologies")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "User technologies retrieved successfully", page)
 }
 
 func (u *UsersHandler) GetUserProjects(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	username := chi.URLParam(r, "username")
 
-	userProjects, err := u.getUserProjects(ctx, username)
+	filter, err := projectfilter.Parse(ctx, r.URL.Query())
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid project filter")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+	filter.Owner = username
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	userProjects, err := filter.Paginate(filter.Apply(u.client.Project.Query()), params).All(ctx)
 	if err != nil {
 		log.Error(ctx).Err(err).Msg("Failed to get user projects")
 		response.Error(w, errors.ErrInternalServerError)
 		return
 	}
+	userProjects, next := pagination.Trim(userProjects, params, filter.Cursor(params))
 
-	projectResponses := convertProjectsToResponse(userProjects)
-	response.JSON(w, http.StatusOK, "User projects retrieved successfully", projectResponses)
+	page := response.NewPage(convertProjectsToResponse(userProjects), next)
+	if params.WithTotal {
+		total, err := filter.Count(ctx, u.client)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count user projects")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "User projects retrieved successfully", page)
 }
 
 func (u *UsersHandler) GetUserLikes(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	username := chi.URLParam(r, "username")
 
-	userLikes, err := u.getUserLikedProjects(ctx, username)
+	user, err := u.getUserByUsername(ctx, username)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			log.Error(ctx).Err(err).Msg("User not found")
@@ -249,8 +385,73 @@ func (u *UsersHandler) GetUserLikes(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	projectResponses := convertProjectsToExtendedResponse(userLikes)
-	response.JSON(w, http.StatusOK, "User likes retrieved successfully", projectResponses)
+	viewerID, _ := middleware.GetUserIDFromContext(ctx)
+	if !canSeeLikes(user, viewerID) {
+		log.Error(ctx).Msg("User likes are hidden")
+		response.Error(w, errors.ErrLikesHidden)
+		return
+	}
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	// Most recently liked first
+	query := u.client.Like.Query().
+		Where(like.UserID(user.ID), visibleLikes(ctx)).
+		WithProject(func(q *ent.ProjectQuery) {
+			q.WithOwner().WithTags()
+		}).
+		Order(ent.Desc(like.FieldCreateTime), ent.Desc(like.FieldID)).
+		Limit(params.Fetch())
+	if c := params.After; c != nil {
+		query = query.Where(like.Or(
+			like.CreateTimeLT(c.Time),
+			like.And(like.CreateTime(c.Time), like.IDLT(c.ID)),
+		))
+	}
+
+	likes, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user likes")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	likes, next := pagination.Trim(likes, params, func(l *ent.Like) pagination.Cursor {
+		return pagination.Cursor{Time: l.CreateTime, ID: l.ID}
+	})
+
+	likedResponses := convertLikesToResponse(likes)
+	resps := make([]*projects.ProjectResponse, len(likedResponses))
+	for i := range likedResponses {
+		resps[i] = &likedResponses[i].ProjectResponse
+	}
+	if err := projects.FillProgress(ctx, u.client, resps...); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to compute project progress")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if err := projects.FillMedia(ctx, u.client, resps...); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to load project media")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	page := response.NewPage(likedResponses, next)
+	if params.WithTotal {
+		total, err := u.client.Like.Query().Where(like.UserID(user.ID), visibleLikes(ctx)).Count(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count user likes")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "User likes retrieved successfully", page)
 }
 
 type UpdateTechnologyRequest struct {
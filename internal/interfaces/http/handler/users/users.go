@@ -4,12 +4,19 @@ import (
 	"context"
 
 	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/like"
+	"github.com/jorge-j1m/hackspark_server/ent/predicate"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
 	user_ent "github.com/jorge-j1m/hackspark_server/ent/user"
 	"github.com/jorge-j1m/hackspark_server/ent/usertechnology"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/projects"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/cache"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
 )
 
 type UsersHandler struct {
-	client *ent.Client
+	client     *ent.Client
+	dashboards *cache.Cache[string, DashboardResponse]
 }
 
 type UserData struct {
@@ -19,6 +26,23 @@ type UserData struct {
 	Email     string `json:"email"`
 }
 
+// PublicUserResponse is what anyone can see about a user
+type PublicUserResponse struct {
+	ID        string  `json:"id"`
+	Username  string  `json:"username"`
+	FirstName string  `json:"firstName"`
+	LastName  string  `json:"lastName"`
+	Bio       *string `json:"bio"`
+	AvatarURL *string `json:"avatar_url"`
+}
+
+type SettingsResponse struct {
+	Discoverable    bool   `json:"discoverable"`
+	HideLikes       bool   `json:"hide_likes"`
+	DigestFrequency string `json:"digest_frequency"`
+	Timezone        string `json:"timezone"`
+}
+
 type CreatedUser struct {
 	UserData
 	Id string `json:"id"`
@@ -40,9 +64,16 @@ type ProjectResponse struct {
 	AddedAt     string `json:"added_at"`
 }
 
+// LikedProjectResponse is a project liked by a user, with its owner and tags
+type LikedProjectResponse struct {
+	projects.ProjectResponse
+	LikedAt string `json:"liked_at"`
+}
+
 func NewUsersHandler(client *ent.Client) *UsersHandler {
 	return &UsersHandler{
-		client: client,
+		client:     client,
+		dashboards: cache.New[string, DashboardResponse](dashboardTTL),
 	}
 }
 
@@ -52,6 +83,11 @@ func (u *UsersHandler) getUserByUsername(ctx context.Context, username string) (
 		First(ctx)
 }
 
+// canSeeLikes reports whether the viewer, who may be anonymous, can see the projects liked by the user
+func canSeeLikes(user *ent.User, viewerID string) bool {
+	return !user.HideLikes || user.ID == viewerID
+}
+
 func (u *UsersHandler) getUserTechnologies(ctx context.Context, userID string) ([]*ent.UserTechnology, error) {
 	return u.client.UserTechnology.
 		Query().
@@ -67,13 +103,6 @@ func (u *UsersHandler) getUserProjects(ctx context.Context, username string) ([]
 		All(ctx)
 }
 
-func (u *UsersHandler) getUserLikedProjects(ctx context.Context, username string) ([]*ent.Project, error) {
-	return u.client.User.Query().
-		Where(user_ent.Username(username)).
-		QueryLikedProjects().
-		All(ctx)
-}
-
 func convertUserTechnologiesToResponse(userTechs []*ent.UserTechnology) []TechnologyResponse {
 	techResponses := make([]TechnologyResponse, len(userTechs))
 	for i, tech := range userTechs {
@@ -102,19 +131,18 @@ func convertProjectsToResponse(projects []*ent.Project) []ProjectResponse {
 	return projectResponses
 }
 
-func convertProjectsToExtendedResponse(projects []*ent.Project) []ProjectResponse {
-	projectResponses := make([]ProjectResponse, len(projects))
-	for i, project := range projects {
-		projectResponses[i] = ProjectResponse{
-			ID:          project.ID,
-			Name:        project.Name,
-			Description: project.Description,
-			LikeCount:   project.LikeCount,
-			StarCount:   project.StarCount,
-			AddedAt:     project.CreateTime.Format("2006-01-02T15:04:05Z"),
+func convertLikesToResponse(likes []*ent.Like) []LikedProjectResponse {
+	likedResponses := make([]LikedProjectResponse, 0, len(likes))
+	for _, l := range likes {
+		if l.Edges.Project == nil {
+			continue
 		}
+		likedResponses = append(likedResponses, LikedProjectResponse{
+			ProjectResponse: projects.BuildProjectResponse(l.Edges.Project),
+			LikedAt:         l.CreateTime.Format("2006-01-02T15:04:05Z"),
+		})
 	}
-	return projectResponses
+	return likedResponses
 }
 
 func convertUserToUserData(user *ent.User) UserData {
@@ -125,3 +153,34 @@ func convertUserToUserData(user *ent.User) UserData {
 		Username:  user.Username,
 	}
 }
+
+func convertUserToSettings(user *ent.User) SettingsResponse {
+	return SettingsResponse{
+		Discoverable:    user.Discoverable,
+		HideLikes:       user.HideLikes,
+		DigestFrequency: string(user.DigestFrequency),
+		Timezone:        user.Timezone,
+	}
+}
+
+// BuildPublicUserResponse converts a user to the representation that is safe to show to anyone
+func BuildPublicUserResponse(user *ent.User) PublicUserResponse {
+	return PublicUserResponse{
+		ID:        user.ID,
+		Username:  user.Username,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Bio:       user.Bio,
+		AvatarURL: user.AvatarURL,
+	}
+}
+
+// visibleLikes matches the likes whose project the viewer of the context can see, so pages and
+// totals leave out the same likes
+func visibleLikes(ctx context.Context) predicate.Like {
+	visible := []predicate.Project{project.DeletedAtIsNil()}
+	if p, ok := visibility.Filter(ctx); ok {
+		visible = append(visible, p)
+	}
+	return like.HasProjectWith(visible...)
+}
@@ -0,0 +1,530 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	entwebhook "github.com/jorge-j1m/hackspark_server/ent/webhook"
+	"github.com/jorge-j1m/hackspark_server/ent/webhookdelivery"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"github.com/jorge-j1m/hackspark_server/internal/service/webhook"
+)
+
+// maxWebhooks bounds the number of webhooks of a user, or of a project
+const maxWebhooks = 10
+
+type CreateWebhookRequest struct {
+	// ProjectID scopes the webhook to a project, without it the webhook gets the activity of the
+	// projects of its owner and of the owner themselves
+	ProjectID   *string  `json:"project_id"`
+	URL         string   `json:"url"`
+	Description *string  `json:"description"`
+	Format      string   `json:"format"`
+	EventTypes  []string `json:"event_types"`
+}
+
+func (r CreateWebhookRequest) Validate() error {
+	if r.ProjectID != nil && *r.ProjectID == "" {
+		return errors.ErrInvalidRequest
+	}
+	if !validWebhookURL(r.URL) {
+		return errors.ErrInvalidWebhookURL
+	}
+	if r.Description != nil && len(*r.Description) > 255 {
+		return errors.ErrInvalidRequest
+	}
+	if r.Format != "" && entwebhook.FormatValidator(entwebhook.Format(r.Format)) != nil {
+		return errors.ErrInvalidWebhookFormat
+	}
+	return validEventTypes(r.EventTypes)
+}
+
+type UpdateWebhookRequest struct {
+	URL *string `json:"url"`
+	// Description is left untouched when omitted and cleared when empty
+	Description *string `json:"description"`
+	Format      *string `json:"format"`
+	// EventTypes is left untouched when omitted, an empty list subscribes to every type
+	EventTypes *[]string `json:"event_types"`
+	Active     *bool     `json:"active"`
+}
+
+func (r UpdateWebhookRequest) Validate() error {
+	if r.URL != nil && !validWebhookURL(*r.URL) {
+		return errors.ErrInvalidWebhookURL
+	}
+	if r.Description != nil && len(*r.Description) > 255 {
+		return errors.ErrInvalidRequest
+	}
+	if r.Format != nil && entwebhook.FormatValidator(entwebhook.Format(*r.Format)) != nil {
+		return errors.ErrInvalidWebhookFormat
+	}
+	if r.EventTypes != nil {
+		return validEventTypes(*r.EventTypes)
+	}
+	return nil
+}
+
+// validWebhookURL reports whether the value is an absolute http or https URL
+func validWebhookURL(value string) bool {
+	if len(value) > 2048 {
+		return false
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func validEventTypes(types []string) error {
+	for _, t := range types {
+		if !slices.Contains(webhook.EventTypes, eventbus.Type(t)) {
+			return errors.ErrInvalidWebhookEventType
+		}
+	}
+	return nil
+}
+
+type WebhookResponse struct {
+	ID          string   `json:"id"`
+	ProjectID   *string  `json:"project_id"`
+	URL         string   `json:"url"`
+	Description *string  `json:"description"`
+	Format      string   `json:"format"`
+	EventTypes  []string `json:"event_types"`
+	Active      bool     `json:"active"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+// WebhookSecretResponse is only returned when the secret is created, it cannot be read afterwards
+type WebhookSecretResponse struct {
+	WebhookResponse
+	Secret string `json:"secret"`
+}
+
+type DeliveryResponse struct {
+	ID             string  `json:"id"`
+	EventType      string  `json:"event_type"`
+	Payload        string  `json:"payload"`
+	Status         string  `json:"status"`
+	Attempts       int     `json:"attempts"`
+	NextAttemptAt  *string `json:"next_attempt_at"`
+	ResponseStatus *int    `json:"response_status"`
+	ResponseBody   *string `json:"response_body"`
+	LastError      *string `json:"last_error"`
+	DurationMs     *int    `json:"duration_ms"`
+	CreatedAt      string  `json:"created_at"`
+	DeliveredAt    *string `json:"delivered_at"`
+}
+
+type EventTypesResponse struct {
+	EventTypes []string `json:"event_types"`
+}
+
+// ListEventTypes returns the types of events webhooks can subscribe to
+func (h *WebhooksHandler) ListEventTypes(w http.ResponseWriter, r *http.Request) {
+	types := make([]string, len(webhook.EventTypes))
+	for i, t := range webhook.EventTypes {
+		types[i] = string(t)
+	}
+
+	response.JSON(w, http.StatusOK, "Webhook event types retrieved successfully", EventTypesResponse{EventTypes: types})
+}
+
+// CreateWebhook creates a webhook for the authenticated user or, with a project_id, for a
+// project they may manage the webhooks of. The signing secret is only returned here.
+func (h *WebhooksHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	var req CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	scope := h.client.Webhook.Query().Where(entwebhook.OwnerID(userID), entwebhook.ProjectIDIsNil())
+	if req.ProjectID != nil {
+		if _, err := h.authz.Authorize(ctx, *req.ProjectID, userID, authz.ActionManageWebhooks); err != nil {
+			log.Error(ctx).Err(err).Msg("User cannot manage the project webhooks")
+			response.Error(w, errors.AsAppError(err))
+			return
+		}
+		scope = h.client.Webhook.Query().Where(entwebhook.ProjectID(*req.ProjectID))
+	}
+
+	count, err := scope.Count(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to count webhooks")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	if count >= maxWebhooks {
+		log.Error(ctx).Msg("Too many webhooks")
+		response.Error(w, errors.ErrTooManyWebhooks)
+		return
+	}
+
+	create := h.client.Webhook.Create().
+		SetOwnerID(userID).
+		SetNillableProjectID(req.ProjectID).
+		SetURL(req.URL).
+		SetNillableDescription(req.Description).
+		SetSecret(webhook.NewSecret()).
+		SetEventTypes(req.EventTypes)
+	if req.Format != "" {
+		create.SetFormat(entwebhook.Format(req.Format))
+	}
+
+	wh, err := create.Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to create webhook")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Webhook created: %s", wh.ID)
+	response.JSON(w, http.StatusOK, "Webhook created successfully", WebhookSecretResponse{
+		WebhookResponse: buildWebhookResponse(wh),
+		Secret:          wh.Secret,
+	})
+}
+
+// ListWebhooks returns the webhooks of the authenticated user or, with a project_id, those of a
+// project they may manage the webhooks of
+func (h *WebhooksHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	query := h.client.Webhook.Query().Where(entwebhook.OwnerID(userID), entwebhook.ProjectIDIsNil())
+	if projectID := r.URL.Query().Get("project_id"); projectID != "" {
+		if _, err := h.authz.Authorize(ctx, projectID, userID, authz.ActionManageWebhooks); err != nil {
+			log.Error(ctx).Err(err).Msg("User cannot manage the project webhooks")
+			response.Error(w, errors.AsAppError(err))
+			return
+		}
+		query = h.client.Webhook.Query().Where(entwebhook.ProjectID(projectID))
+	}
+
+	webhooks, err := query.Order(ent.Asc(entwebhook.FieldCreateTime)).All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list webhooks")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	resps := make([]WebhookResponse, len(webhooks))
+	for i, wh := range webhooks {
+		resps[i] = buildWebhookResponse(wh)
+	}
+
+	response.JSON(w, http.StatusOK, "Webhooks retrieved successfully", resps)
+}
+
+func (h *WebhooksHandler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	wh, ok := h.getWebhook(w, r)
+	if !ok {
+		return
+	}
+
+	log.Info(ctx).Msgf("Webhook retrieved: %s", wh.ID)
+	response.JSON(w, http.StatusOK, "Webhook retrieved successfully", buildWebhookResponse(wh))
+}
+
+func (h *WebhooksHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	wh, ok := h.getWebhook(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to decode request body")
+		response.Error(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid request data")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	update := h.client.Webhook.UpdateOneID(wh.ID)
+	if req.URL != nil {
+		update.SetURL(*req.URL)
+	}
+	if req.Description != nil {
+		if *req.Description == "" {
+			update.ClearDescription()
+		} else {
+			update.SetDescription(*req.Description)
+		}
+	}
+	if req.Format != nil {
+		update.SetFormat(entwebhook.Format(*req.Format))
+	}
+	if req.EventTypes != nil {
+		update.SetEventTypes(*req.EventTypes)
+	}
+	if req.Active != nil {
+		update.SetActive(*req.Active)
+	}
+
+	wh, err := update.Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to update webhook")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Webhook updated: %s", wh.ID)
+	response.JSON(w, http.StatusOK, "Webhook updated successfully", buildWebhookResponse(wh))
+}
+
+// DeleteWebhook deletes a webhook along with its deliveries, sent or not
+func (h *WebhooksHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	wh, ok := h.getWebhook(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.client.Webhook.DeleteOneID(wh.ID).Exec(ctx); err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to delete webhook")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Webhook deleted: %s", wh.ID)
+	response.JSON(w, http.StatusOK, "Webhook deleted successfully", nil)
+}
+
+// RotateSecret replaces the signing secret of a webhook, deliveries not sent yet are signed
+// with the new one
+func (h *WebhooksHandler) RotateSecret(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	wh, ok := h.getWebhook(w, r)
+	if !ok {
+		return
+	}
+
+	wh, err := h.client.Webhook.UpdateOneID(wh.ID).SetSecret(webhook.NewSecret()).Save(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to rotate webhook secret")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Webhook secret rotated: %s", wh.ID)
+	response.JSON(w, http.StatusOK, "Webhook secret rotated successfully", WebhookSecretResponse{
+		WebhookResponse: buildWebhookResponse(wh),
+		Secret:          wh.Secret,
+	})
+}
+
+// SendTestEvent queues a test event for a webhook, even an inactive one. It is sent like any
+// other delivery and shows up in the delivery log.
+func (h *WebhooksHandler) SendTestEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return
+	}
+
+	wh, ok := h.getWebhook(w, r)
+	if !ok {
+		return
+	}
+
+	d, err := h.enqueuer.EnqueueTest(ctx, wh, userID)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to enqueue webhook test event")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+
+	log.Info(ctx).Msgf("Webhook test event queued: %s", d.ID)
+	response.JSON(w, http.StatusOK, "Webhook test event queued successfully", buildDeliveryResponse(d))
+}
+
+// ListDeliveries returns the delivery log of a webhook, the most recent first. The status
+// parameter narrows it to pending, succeeded or failed deliveries.
+func (h *WebhooksHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	wh, ok := h.getWebhook(w, r)
+	if !ok {
+		return
+	}
+
+	params, err := pagination.FromRequest(r)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Invalid pagination parameters")
+		response.Error(w, errors.AsAppError(err))
+		return
+	}
+
+	filter := h.client.WebhookDelivery.Query().Where(webhookdelivery.WebhookID(wh.ID))
+	if status := r.URL.Query().Get("status"); status != "" {
+		if webhookdelivery.StatusValidator(webhookdelivery.Status(status)) != nil {
+			log.Error(ctx).Str("status", status).Msg("Invalid delivery status")
+			response.Error(w, errors.ErrInvalidRequest)
+			return
+		}
+		filter = filter.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(status)))
+	}
+
+	query := filter.Clone().
+		Order(ent.Desc(webhookdelivery.FieldCreateTime), ent.Desc(webhookdelivery.FieldID)).
+		Limit(params.Fetch())
+	if c := params.After; c != nil {
+		query = query.Where(webhookdelivery.Or(
+			webhookdelivery.CreateTimeLT(c.Time),
+			webhookdelivery.And(webhookdelivery.CreateTime(c.Time), webhookdelivery.IDLT(c.ID)),
+		))
+	}
+
+	deliveries, err := query.All(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to list webhook deliveries")
+		response.Error(w, errors.ErrInternalServerError)
+		return
+	}
+	deliveries, next := pagination.Trim(deliveries, params, func(d *ent.WebhookDelivery) pagination.Cursor {
+		return pagination.Cursor{Time: d.CreateTime, ID: d.ID}
+	})
+
+	resps := make([]DeliveryResponse, len(deliveries))
+	for i, d := range deliveries {
+		resps[i] = buildDeliveryResponse(d)
+	}
+
+	page := response.NewPage(resps, next)
+	if params.WithTotal {
+		total, err := filter.Count(ctx)
+		if err != nil {
+			log.Error(ctx).Err(err).Msg("Failed to count webhook deliveries")
+			response.Error(w, errors.ErrInternalServerError)
+			return
+		}
+		page.Total = &total
+	}
+
+	response.Paginated(w, r, "Webhook deliveries retrieved successfully", page)
+}
+
+// getWebhook loads the webhook of the request. Webhooks of a project may be managed by anyone
+// allowed to, the others only by their owner; they do not exist for everybody else.
+func (h *WebhooksHandler) getWebhook(w http.ResponseWriter, r *http.Request) (*ent.Webhook, bool) {
+	ctx := r.Context()
+	webhookID := chi.URLParam(r, "id")
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to get user ID from context")
+		response.Error(w, errors.ErrUserNotFound)
+		return nil, false
+	}
+
+	wh, err := h.client.Webhook.Get(ctx, webhookID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			log.Error(ctx).Err(err).Msg("Webhook not found")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		log.Error(ctx).Err(err).Msg("Failed to get webhook")
+		response.Error(w, errors.ErrInternalServerError)
+		return nil, false
+	}
+
+	if wh.ProjectID == nil {
+		if wh.OwnerID != userID {
+			log.Error(ctx).Msg("Webhook belongs to another user")
+			response.Error(w, errors.ErrNotFound)
+			return nil, false
+		}
+		return wh, true
+	}
+
+	if _, err := h.authz.Authorize(ctx, *wh.ProjectID, userID, authz.ActionManageWebhooks); err != nil {
+		log.Error(ctx).Err(err).Msg("User cannot manage the project webhooks")
+		response.Error(w, errors.ErrNotFound)
+		return nil, false
+	}
+	return wh, true
+}
+
+func buildWebhookResponse(wh *ent.Webhook) WebhookResponse {
+	eventTypes := wh.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	return WebhookResponse{
+		ID:          wh.ID,
+		ProjectID:   wh.ProjectID,
+		URL:         wh.URL,
+		Description: wh.Description,
+		Format:      string(wh.Format),
+		EventTypes:  eventTypes,
+		Active:      wh.Active,
+		CreatedAt:   wh.CreateTime.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:   wh.UpdateTime.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func buildDeliveryResponse(d *ent.WebhookDelivery) DeliveryResponse {
+	resp := DeliveryResponse{
+		ID:             d.ID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		ResponseBody:   d.ResponseBody,
+		LastError:      d.LastError,
+		DurationMs:     d.DurationMs,
+		CreatedAt:      d.CreateTime.Format("2006-01-02T15:04:05Z"),
+	}
+	if d.Status == webhookdelivery.StatusPending {
+		next := d.NextAttemptAt.Format("2006-01-02T15:04:05Z")
+		resp.NextAttemptAt = &next
+	}
+	if d.DeliveredAt != nil {
+		deliveredAt := d.DeliveredAt.Format("2006-01-02T15:04:05Z")
+		resp.DeliveredAt = &deliveredAt
+	}
+	return resp
+}
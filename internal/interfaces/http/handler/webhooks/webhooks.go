@@ -0,0 +1,21 @@
+package webhooks
+
+import (
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/webhook"
+)
+
+type WebhooksHandler struct {
+	client   *ent.Client
+	authz    *authz.Authorizer
+	enqueuer *webhook.Enqueuer
+}
+
+func NewWebhooksHandler(client *ent.Client, authorizer *authz.Authorizer, enqueuer *webhook.Enqueuer) *WebhooksHandler {
+	return &WebhooksHandler{
+		client:   client,
+		authz:    authorizer,
+		enqueuer: enqueuer,
+	}
+}
@@ -11,6 +11,7 @@ import (
 	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
 	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
 	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
 	"go.jetify.com/typeid/v2"
 )
 
@@ -31,6 +32,12 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
+		// The user may already have been resolved by OptionalAuth
+		if user, ok := ctx.Value(log.UserCtxKey).(*ent.User); ok && user != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		user, err := m.GetUserFromRequest(ctx, r)
 		if err != nil {
 			log.Debug(ctx).Err(err).Msg("Failed to get user from request")
@@ -38,9 +45,7 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 			return
 		}
 
-		// Add the user to the context
-		ctx = context.WithValue(ctx, log.UserCtxKey, user)
-		next.ServeHTTP(w, r.WithContext(ctx))
+		next.ServeHTTP(w, r.WithContext(withUser(ctx, user)))
 	})
 }
 
@@ -133,8 +138,29 @@ func (m *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
 			return
 		}
 
-		// Add the user to the context
-		ctx = context.WithValue(ctx, log.UserCtxKey, user)
-		next.ServeHTTP(w, r.WithContext(ctx))
+		next.ServeHTTP(w, r.WithContext(withUser(ctx, user)))
 	})
 }
+
+// RequireAdmin lets only admins through. It must come after Authenticate.
+func (m *AuthMiddleware) RequireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		user, ok := ctx.Value(log.UserCtxKey).(*ent.User)
+		if !ok || user == nil || user.Role != user_ent.RoleAdmin {
+			log.Debug(ctx).Msg("User is not an admin")
+			response.Error(w, errors.ErrForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// withUser adds the authenticated user to the context, both for handlers and for the
+// visibility rules applied to every project query
+func withUser(ctx context.Context, user *ent.User) context.Context {
+	ctx = context.WithValue(ctx, log.UserCtxKey, user)
+	return visibility.WithViewer(ctx, user.ID)
+}
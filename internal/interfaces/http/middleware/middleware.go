@@ -7,6 +7,7 @@ import (
 
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
 	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
 	"github.com/rs/zerolog"
 	"go.jetify.com/typeid/v2"
 )
@@ -67,6 +68,14 @@ func Logger(next http.Handler) http.Handler {
 	})
 }
 
+// Unlisted lets the request see unlisted projects. It is meant for the routes addressing
+// a single project by its ID, since unlisted projects must never show up in lists.
+func Unlisted(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(visibility.WithUnlisted(r.Context())))
+	})
+}
+
 // SecurityHeaders adds security headers to the response
 func SecurityHeaders(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
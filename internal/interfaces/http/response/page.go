@@ -0,0 +1,44 @@
+package response
+
+import (
+	"net/http"
+)
+
+// Page is the envelope of every paginated list
+type Page struct {
+	Items      any     `json:"items"`
+	NextCursor *string `json:"next_cursor"`
+	HasMore    bool    `json:"has_more"`
+	// Total is only set when the client asks for it with total=true
+	Total *int `json:"total,omitempty"`
+}
+
+// NewPage builds a page from its items and the cursor of the next page, if any
+func NewPage(items any, nextCursor *string) Page {
+	return Page{
+		Items:      items,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != nil,
+	}
+}
+
+// Paginated sends a page of a list, advertising the next page in an RFC 8288 Link header
+func Paginated(w http.ResponseWriter, r *http.Request, message string, page Page) {
+	if page.NextCursor != nil {
+		query := r.URL.Query()
+		query.Set("cursor", *page.NextCursor)
+		query.Del("offset")
+		next := url(r.URL.Path, query.Encode())
+		w.Header().Add("Link", "<"+next+`>; rel="next"`)
+	}
+
+	JSON(w, http.StatusOK, message, page)
+}
+
+// url builds a reference relative to the request's host, which clients resolve against the request URL
+func url(path, rawQuery string) string {
+	if rawQuery == "" {
+		return path
+	}
+	return path + "?" + rawQuery
+}
@@ -0,0 +1,81 @@
+package response_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/response"
+)
+
+func TestPaginated(t *testing.T) {
+	next := "eyJvIjoyMH0"
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/projects?sort=popular&tags=go,rust&cursor=old&offset=10&limit=10", nil)
+	w := httptest.NewRecorder()
+
+	response.Paginated(w, r, "Projects retrieved successfully", response.NewPage([]string{"a", "b"}, &next))
+
+	links := w.Result().Header.Values("Link")
+	if len(links) != 1 {
+		t.Fatalf("Link = %v, want a single link", links)
+	}
+	link := links[0]
+	const suffix = `>; rel="next"`
+	if len(link) < len(suffix)+2 || link[0] != '<' || link[len(link)-len(suffix):] != suffix {
+		t.Fatalf("Link = %q, want <reference>; rel=\"next\"", link)
+	}
+	ref, err := url.Parse(link[1 : len(link)-len(suffix)])
+	if err != nil {
+		t.Fatalf("parsing link reference: %v", err)
+	}
+
+	// The next page keeps the filters of the request, with the new cursor and no offset
+	if ref.Host != "" || ref.Path != "/api/v1/projects" {
+		t.Errorf("link reference = %s, want the request path", ref)
+	}
+	want := url.Values{"sort": {"popular"}, "tags": {"go,rust"}, "cursor": {next}, "limit": {"10"}}
+	if got := ref.Query(); got.Encode() != want.Encode() {
+		t.Errorf("link query = %s, want %s", got.Encode(), want.Encode())
+	}
+
+	var body struct {
+		Data struct {
+			Items      []string `json:"items"`
+			NextCursor *string  `json:"next_cursor"`
+			HasMore    bool     `json:"has_more"`
+			Total      *int     `json:"total"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding body: %v", err)
+	}
+	if len(body.Data.Items) != 2 || body.Data.NextCursor == nil || *body.Data.NextCursor != next || !body.Data.HasMore || body.Data.Total != nil {
+		t.Errorf("page = %+v, want the items, the next cursor and no total", body.Data)
+	}
+}
+
+func TestPaginatedLastPage(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/projects?cursor=abc", nil)
+	w := httptest.NewRecorder()
+
+	total := 2
+	page := response.NewPage([]string{"a", "b"}, nil)
+	page.Total = &total
+	response.Paginated(w, r, "Projects retrieved successfully", page)
+
+	if link := w.Result().Header.Get("Link"); link != "" {
+		t.Errorf("Link = %q, want none on the last page", link)
+	}
+
+	var body struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding body: %v", err)
+	}
+	if body.Data["has_more"] != false || body.Data["next_cursor"] != nil || body.Data["total"] != float64(2) {
+		t.Errorf("page = %v, want no more items, a null cursor and the total", body.Data)
+	}
+}
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -10,22 +11,41 @@ import (
 
 	"github.com/jorge-j1m/hackspark_server/ent"
 	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/config"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/pubsub"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/storage"
 	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/admin"
 	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/auth"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/collections"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/comments"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/events"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/media"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/members"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/milestones"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/notifications"
 	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/projects"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/roles"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/search"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/stream"
 	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/tags"
 	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/users"
+	"github.com/jorge-j1m/hackspark_server/internal/interfaces/http/handler/webhooks"
 	cMiddleware "github.com/jorge-j1m/hackspark_server/internal/interfaces/http/middleware"
+	"github.com/jorge-j1m/hackspark_server/internal/service/authz"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"github.com/jorge-j1m/hackspark_server/internal/service/importer"
+	"github.com/jorge-j1m/hackspark_server/internal/service/scheduler"
+	"github.com/jorge-j1m/hackspark_server/internal/service/webhook"
 )
 
-// New creates a new router with all routes and middleware
-func New(cfg *config.Config, client *ent.Client) http.Handler {
+// New creates a new router with all routes and middleware. The streams context is cancelled
+// when the server shuts down, it ends the open real-time streams.
+func New(cfg *config.Config, client *ent.Client, bus *eventbus.Bus, blob storage.Blob, ps pubsub.PubSub, hooks *webhook.Enqueuer, sched *scheduler.Scheduler, streams context.Context) http.Handler {
 	r := chi.NewRouter()
 
 	// Basic middleware
 	r.Use(middleware.RealIP)
 	r.Use(middleware.Recoverer)
-	r.Use(middleware.Timeout(30 * time.Second))
 	r.Use(middleware.Heartbeat("/ping"))
 
 	// Custom middleware
@@ -49,15 +69,45 @@ func New(cfg *config.Config, client *ent.Client) http.Handler {
 	healthHandler := handler.NewHealthHandler(cfg)
 	authHandler := auth.NewAuthHandler(client)
 	usersHandler := users.NewUsersHandler(client)
-	projectsHandler := projects.NewProjectsHandler(client)
+	authorizer := authz.New(client)
+	forge := importer.NewForge(cfg.ForgeHost, cfg.ForgeAPIURL, cfg.ForgeToken)
+	projectsHandler := projects.NewProjectsHandler(client, bus, authorizer, forge, cfg.TrashRetention)
 	tagsHandler := tags.NewTagsHandler(client)
+	commentsHandler := comments.NewCommentsHandler(client, bus, authorizer, cfg.TrashRetention)
+	milestonesHandler := milestones.NewMilestonesHandler(client, authorizer)
+	membersHandler := members.NewMembersHandler(client, bus, authorizer)
+	rolesHandler := roles.NewRolesHandler(client, bus, authorizer)
+	searchHandler := search.NewSearchHandler(client)
+	mediaHandler := media.NewMediaHandler(client, authorizer, blob, cfg.MediaMaxBytes)
+	collectionsHandler := collections.NewCollectionsHandler(client)
+	eventsHandler := events.NewEventsHandler(client, authorizer)
+	notificationsHandler := notifications.NewNotificationsHandler(client)
+	streamHandler := stream.NewStreamHandler(client, ps, streams)
+	webhooksHandler := webhooks.NewWebhooksHandler(client, authorizer, hooks)
+	adminHandler := admin.NewAdminHandler(client, sched)
 
-	r.Get("/health", healthHandler.Handle)
+	// Requests are cancelled when they take too long, all but streams, which stay open for as
+	// long as the client listens
+	timeout := middleware.Timeout(30 * time.Second)
+
+	r.With(timeout).Get("/health", healthHandler.Handle)
+
+	// Files stored on the local disk are served by the API itself
+	if local, ok := blob.(*storage.Local); ok {
+		r.With(timeout).Handle("/media/*", http.StripPrefix("/media", local.Handler()))
+	}
+
+	// Real-time updates, as server-sent events or over WebSocket
+	r.With(authMiddleware.Authenticate).Get("/api/v1/stream", streamHandler.Stream)
 
 	// API routes
-	r.Route("/api", func(r chi.Router) {
+	r.With(timeout).Route("/api", func(r chi.Router) {
 		// v1 API routes
 		r.Route("/v1", func(r chi.Router) {
+			// Resolve the user on every request, so that what they can see does not depend on
+			// the route: private projects and hidden likes stay visible to those allowed to see them
+			r.Use(authMiddleware.OptionalAuth)
+
 			// Auth routes
 			r.Route("/auth", func(r chi.Router) {
 				r.Post("/signup", authHandler.SignUp)
@@ -70,11 +120,15 @@ func New(cfg *config.Config, client *ent.Client) http.Handler {
 				r.Get("/{username}", usersHandler.GetUserProfile)
 				r.Get("/{username}/technologies", usersHandler.GetUserTechnologies)
 				r.Get("/{username}/projects", usersHandler.GetUserProjects)
+				r.Get("/{username}/likes", usersHandler.GetUserLikes)
+				r.Get("/{username}/activity", usersHandler.GetUserActivity)
+				r.Get("/{username}/collections", collectionsHandler.GetUserCollections)
 
 				r.Group(func(r chi.Router) {
 					r.Use(authMiddleware.Authenticate)
 					r.Get("/me", usersHandler.Me)
-					// r.Get("/me/dashboard", usersHandler.GetMyDashboard)
+					r.Put("/me/settings", usersHandler.UpdateMySettings)
+					r.Get("/me/dashboard", usersHandler.GetMyDashboard)
 					r.Post("/technologies", usersHandler.AddUserTechnology)
 					r.Put("/technologies/{slug}", usersHandler.UpdateUserTechnology)
 					r.Delete("/technologies/{slug}", usersHandler.RemoveUserTechnology)
@@ -84,20 +138,187 @@ func New(cfg *config.Config, client *ent.Client) http.Handler {
 			// Project routes
 			r.Route("/projects", func(r chi.Router) {
 				r.Get("/", projectsHandler.ListProjects)
-				r.Get("/{id}", projectsHandler.GetProject)
-				r.Get("/{id}/likes", projectsHandler.GetProjectLikes)
+				r.Get("/trending", projectsHandler.GetTrendingProjects)
+				r.With(authMiddleware.Authenticate).Post("/", projectsHandler.CreateProject)
+				r.With(authMiddleware.Authenticate).Get("/import/preview", projectsHandler.PreviewImport)
+				r.With(authMiddleware.Authenticate).Post("/import", projectsHandler.ImportProject)
+
+				// Routes addressing a single project, which can reach it even when unlisted
+				r.Route("/{id}", func(r chi.Router) {
+					r.Use(cMiddleware.Unlisted)
+
+					r.Get("/", projectsHandler.GetProject)
+					r.Get("/likes", projectsHandler.GetProjectLikes)
+					r.Get("/status/history", projectsHandler.GetProjectStatusHistory)
+					r.Get("/revisions", projectsHandler.ListRevisions)
+					r.Get("/revisions/diff", projectsHandler.DiffRevisions)
+					r.Get("/revisions/{number}", projectsHandler.GetRevision)
+					r.Get("/links", projectsHandler.ListLinks)
+					r.Get("/remixes", projectsHandler.ListRemixes)
+					r.Get("/media", mediaHandler.ListMedia)
+					r.Get("/milestones", milestonesHandler.ListMilestones)
+					r.Get("/members", membersHandler.ListMembers)
+					r.Get("/roles", rolesHandler.ListRoles)
+					r.Get("/comments", commentsHandler.ListComments)
+
+					r.Group(func(r chi.Router) {
+						r.Use(authMiddleware.Authenticate)
+						r.Put("/", projectsHandler.UpdateProject)
+						r.Delete("/", projectsHandler.DeleteProject)
+						r.Post("/restore", projectsHandler.RestoreProject)
+						r.Post("/revisions/{number}/revert", projectsHandler.RevertRevision)
+						r.Post("/links", projectsHandler.AddLink)
+						r.Put("/links/{linkID}", projectsHandler.UpdateLink)
+						r.Delete("/links/{linkID}", projectsHandler.DeleteLink)
+						r.Post("/media", mediaHandler.UploadMedia)
+						r.Put("/media/{mediaID}", mediaHandler.UpdateMedia)
+						r.Delete("/media/{mediaID}", mediaHandler.DeleteMedia)
+						r.Put("/status", projectsHandler.UpdateProjectStatus)
+						r.Post("/like", projectsHandler.LikeProject)
+						r.Delete("/like", projectsHandler.UnlikeProject)
+						r.Get("/liked", projectsHandler.CheckProjectLiked)
+						r.Post("/remix", projectsHandler.RemixProject)
+
+						r.Post("/comments", commentsHandler.CreateComment)
+						r.Put("/comments/{commentID}", commentsHandler.UpdateComment)
+						r.Delete("/comments/{commentID}", commentsHandler.DeleteComment)
+						r.Post("/comments/{commentID}/restore", commentsHandler.RestoreComment)
+						r.Post("/comments/{commentID}/hide", commentsHandler.HideComment)
+						r.Delete("/comments/{commentID}/hide", commentsHandler.UnhideComment)
+
+						r.Post("/milestones", milestonesHandler.CreateMilestone)
+						r.Put("/milestones/order", milestonesHandler.ReorderMilestones)
+						r.Put("/milestones/{milestoneID}", milestonesHandler.UpdateMilestone)
+						r.Delete("/milestones/{milestoneID}", milestonesHandler.DeleteMilestone)
+						r.Post("/milestones/{milestoneID}/tasks", milestonesHandler.CreateTask)
+						r.Put("/milestones/{milestoneID}/tasks/order", milestonesHandler.ReorderTasks)
+						r.Put("/milestones/{milestoneID}/tasks/{taskID}", milestonesHandler.UpdateTask)
+						r.Delete("/milestones/{milestoneID}/tasks/{taskID}", milestonesHandler.DeleteTask)
+
+						r.Get("/invitations", membersHandler.ListProjectInvitations)
+						r.Post("/invitations", membersHandler.CreateInvitation)
+						r.Delete("/invitations/{invitationID}", membersHandler.RevokeInvitation)
+						r.Put("/members/{username}", membersHandler.UpdateMember)
+						r.Delete("/members/{username}", membersHandler.RemoveMember)
+						r.Post("/transfer", membersHandler.TransferOwnership)
+
+						r.Post("/roles", rolesHandler.CreateRole)
+						r.Put("/roles/{roleID}", rolesHandler.UpdateRole)
+						r.Delete("/roles/{roleID}", rolesHandler.DeleteRole)
+						r.Get("/roles/{roleID}/candidates", rolesHandler.GetRoleCandidates)
+						r.Get("/join-requests", rolesHandler.ListJoinRequests)
+						r.Post("/join-requests", rolesHandler.CreateJoinRequest)
+						r.Delete("/join-requests/{requestID}", rolesHandler.WithdrawJoinRequest)
+						r.Post("/join-requests/{requestID}/accept", rolesHandler.AcceptJoinRequest)
+						r.Post("/join-requests/{requestID}/decline", rolesHandler.DeclineJoinRequest)
+					})
+				})
+			})
+
+			// Invitation routes, from the invitee's side
+			r.Route("/invitations", func(r chi.Router) {
+				r.Use(authMiddleware.Authenticate)
+				r.Get("/", membersHandler.ListMyInvitations)
+				r.Post("/{invitationID}/accept", membersHandler.AcceptInvitation)
+				r.Post("/{invitationID}/decline", membersHandler.DeclineInvitation)
+			})
+
+			// Notification routes, the inbox of the authenticated user
+			r.Route("/notifications", func(r chi.Router) {
+				// Linked from the digest emails, the token identifies the user. Only posting
+				// unsubscribes, opening the link shows a confirmation page
+				r.Get("/unsubscribe", notificationsHandler.UnsubscribePage)
+				r.Post("/unsubscribe", notificationsHandler.Unsubscribe)
 
 				r.Group(func(r chi.Router) {
 					r.Use(authMiddleware.Authenticate)
-					r.Post("/", projectsHandler.CreateProject)
-					r.Put("/{id}", projectsHandler.UpdateProject)
-					r.Delete("/{id}", projectsHandler.DeleteProject)
-					r.Post("/{id}/like", projectsHandler.LikeProject)
-					r.Delete("/{id}/like", projectsHandler.UnlikeProject)
-					r.Get("/{id}/liked", projectsHandler.CheckProjectLiked)
+					r.Get("/", notificationsHandler.ListNotifications)
+					r.Get("/unread-count", notificationsHandler.GetUnreadCount)
+					r.Post("/read", notificationsHandler.MarkAllRead)
+					r.Post("/{id}/read", notificationsHandler.MarkRead)
+					r.Get("/preferences", notificationsHandler.GetPreferences)
+					r.Put("/preferences", notificationsHandler.UpdatePreferences)
 				})
 			})
 
+			// Webhook routes, for the authenticated user's own webhooks and those of the projects
+			// they manage
+			r.Route("/webhooks", func(r chi.Router) {
+				r.Use(authMiddleware.Authenticate)
+				r.Get("/", webhooksHandler.ListWebhooks)
+				r.Post("/", webhooksHandler.CreateWebhook)
+				r.Get("/event-types", webhooksHandler.ListEventTypes)
+
+				r.Route("/{id}", func(r chi.Router) {
+					r.Get("/", webhooksHandler.GetWebhook)
+					r.Put("/", webhooksHandler.UpdateWebhook)
+					r.Delete("/", webhooksHandler.DeleteWebhook)
+					r.Post("/secret", webhooksHandler.RotateSecret)
+					r.Post("/test", webhooksHandler.SendTestEvent)
+					r.Get("/deliveries", webhooksHandler.ListDeliveries)
+				})
+			})
+
+			// Admin routes, to run the maintenance tasks by hand and follow their runs
+			r.Route("/admin", func(r chi.Router) {
+				r.Use(authMiddleware.Authenticate)
+				r.Use(authMiddleware.RequireAdmin)
+				r.Get("/tasks", adminHandler.ListTasks)
+				r.Post("/tasks/{name}/run", adminHandler.RunTask)
+				r.Get("/tasks/{name}/runs", adminHandler.ListRuns)
+			})
+
+			r.Route("/collections", func(r chi.Router) {
+				r.With(authMiddleware.Authenticate).Post("/", collectionsHandler.CreateCollection)
+
+				r.Route("/{id}", func(r chi.Router) {
+					r.Get("/", collectionsHandler.GetCollection)
+
+					r.Group(func(r chi.Router) {
+						r.Use(authMiddleware.Authenticate)
+						r.Put("/", collectionsHandler.UpdateCollection)
+						r.Delete("/", collectionsHandler.DeleteCollection)
+						r.Post("/items", collectionsHandler.AddItem)
+						r.Put("/items/order", collectionsHandler.ReorderItems)
+						r.Put("/items/{itemID}", collectionsHandler.UpdateItem)
+						r.Delete("/items/{itemID}", collectionsHandler.RemoveItem)
+					})
+				})
+			})
+
+			// Hackathon event routes, with registration, submissions and judging
+			r.Route("/events", func(r chi.Router) {
+				r.Get("/", eventsHandler.ListEvents)
+				r.With(authMiddleware.Authenticate).Post("/", eventsHandler.CreateEvent)
+
+				r.Route("/{slug}", func(r chi.Router) {
+					r.Get("/", eventsHandler.GetEvent)
+					r.Get("/members", eventsHandler.ListMembers)
+					r.Get("/submissions", eventsHandler.ListSubmissions)
+					r.Get("/criteria", eventsHandler.ListCriteria)
+					r.Get("/results", eventsHandler.GetResults)
+
+					r.Group(func(r chi.Router) {
+						r.Use(authMiddleware.Authenticate)
+						r.Put("/", eventsHandler.UpdateEvent)
+						r.Post("/registration", eventsHandler.Register)
+						r.Delete("/registration", eventsHandler.Unregister)
+						r.Put("/members/{username}", eventsHandler.SetMemberRole)
+						r.Delete("/members/{username}", eventsHandler.RemoveMember)
+						r.Post("/submissions", eventsHandler.SubmitProject)
+						r.Delete("/submissions/{submissionID}", eventsHandler.WithdrawSubmission)
+						r.Put("/submissions/{submissionID}/scores", eventsHandler.ScoreSubmission)
+						r.Post("/criteria", eventsHandler.CreateCriterion)
+						r.Put("/criteria/{criterionID}", eventsHandler.UpdateCriterion)
+						r.Delete("/criteria/{criterionID}", eventsHandler.DeleteCriterion)
+						r.Post("/results/publish", eventsHandler.PublishResults)
+					})
+				})
+			})
+
+			// Search across projects, users and tags
+			r.Get("/search", searchHandler.Search)
+
 			// Tag routes
 			r.Route("/tags", func(r chi.Router) {
 				r.Get("/", tagsHandler.ListTags)
@@ -105,6 +326,7 @@ func New(cfg *config.Config, client *ent.Client) http.Handler {
 				r.Get("/{slug}", tagsHandler.GetTag)
 				r.Get("/{slug}/projects", tagsHandler.GetTagProjects)
 				r.Get("/{slug}/users", tagsHandler.GetTagUsers)
+				r.Get("/{slug}/collections", collectionsHandler.GetTagCollections)
 			})
 		})
 	})
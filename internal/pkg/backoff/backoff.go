@@ -0,0 +1,24 @@
+// Package backoff computes how long to wait before retrying work that failed, shared by every
+// queue that retries so they all back off the same way.
+package backoff
+
+import "time"
+
+// Policy waits Base after the first failed attempt and doubles the wait after every other
+// one, up to Max
+type Policy struct {
+	Base time.Duration
+	Max  time.Duration
+}
+
+// Delay returns how long to wait after the given number of failed attempts
+func (p Policy) Delay(attempts int) time.Duration {
+	delay := p.Base
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= p.Max {
+			return p.Max
+		}
+	}
+	return min(delay, p.Max)
+}
@@ -0,0 +1,13 @@
+package errors
+
+// Collection-related errors
+var (
+	// ErrInvalidCollectionVisibility is returned when the visibility of a collection is not public or private
+	ErrInvalidCollectionVisibility = NewBadRequestError("Invalid collection visibility, expected one of public or private")
+
+	// ErrProjectAlreadyInCollection is returned when adding a project to a collection that already has it
+	ErrProjectAlreadyInCollection = NewConflictError("Project is already in the collection")
+
+	// ErrTooManyCollectionItems is returned when adding to a collection that has reached the maximum number of projects
+	ErrTooManyCollectionItems = NewConflictError("Collection has reached the maximum number of projects")
+)
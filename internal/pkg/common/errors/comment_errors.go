@@ -0,0 +1,16 @@
+package errors
+
+// Comment-related errors
+var (
+	// ErrInvalidComment is returned when the comment body is empty or too long
+	ErrInvalidComment = NewBadRequestError("Comment body must be between 1 and 10000 characters")
+
+	// ErrInvalidParentComment is returned when replying to a reply or to a comment of another project
+	ErrInvalidParentComment = NewBadRequestError("Replies can only be added to top-level comments of the same project")
+
+	// ErrCommentDeleted is returned when acting on a comment that has been deleted
+	ErrCommentDeleted = NewConflictError("Comment has been deleted")
+
+	// ErrCommentNotDeleted is returned when restoring a comment that has not been deleted
+	ErrCommentNotDeleted = NewConflictError("Comment has not been deleted")
+)
@@ -107,6 +107,15 @@ func NewConflictError(message string) *AppError {
 	}
 }
 
+// NewExternalAPIError creates a new error for failures of the external services the API relies on
+func NewExternalAPIError(message string) *AppError {
+	return &AppError{
+		Code:       ErrCodeExternalAPI,
+		HTTPStatus: http.StatusBadGateway,
+		Message:    message,
+	}
+}
+
 // NewInternalError creates a new internal error
 func NewInternalError(message string) *AppError {
 	return &AppError{
@@ -0,0 +1,61 @@
+package errors
+
+// Event-related errors
+var (
+	// ErrInvalidEvent is returned when an event has no name or does not end after it starts
+	ErrInvalidEvent = NewBadRequestError("Invalid event, expected a name and RFC 3339 start and end times, the end after the start")
+
+	// ErrEventSlugTaken is returned when creating an event with the slug of another one
+	ErrEventSlugTaken = NewConflictError("An event with this slug already exists")
+
+	// ErrUnknownEventTags is returned when an event requires tags that do not exist
+	ErrUnknownEventTags = NewBadRequestError("Required tags must be existing tag slugs")
+
+	// ErrEventEnded is returned when changing the schedule of an event whose submissions are frozen
+	ErrEventEnded = NewConflictError("Event has ended")
+
+	// ErrInvalidEventRole is returned when the role is not one of organizer, judge or participant
+	ErrInvalidEventRole = NewBadRequestError("Invalid event role, expected one of organizer, judge or participant")
+
+	// ErrLastOrganizer is returned when removing or demoting the last organizer of an event
+	ErrLastOrganizer = NewConflictError("An event needs at least one organizer")
+
+	// ErrAlreadyRegistered is returned when registering to an event the user already takes part in
+	ErrAlreadyRegistered = NewConflictError("Already registered to the event")
+
+	// ErrNotRegistered is returned when a user who is not a participant of an event acts as one
+	ErrNotRegistered = NewForbiddenError("Register to the event first")
+
+	// ErrRegistrationClosed is returned when registering to or leaving an event that has ended
+	ErrRegistrationClosed = NewConflictError("Registration is closed once the event has ended")
+
+	// ErrEventHasSubmissions is returned when leaving an event with submissions
+	ErrEventHasSubmissions = NewConflictError("Withdraw your submissions before leaving the event")
+
+	// ErrSubmissionsClosed is returned when submitting or withdrawing outside of the event
+	ErrSubmissionsClosed = NewConflictError("Submissions are only open while the event runs")
+
+	// ErrSubmissionNotAllowed is returned when submitting a project that is private or a draft
+	ErrSubmissionNotAllowed = NewForbiddenError("Only published projects that are not private can be submitted")
+
+	// ErrMissingRequiredTags is returned when submitting a project without every tag the event requires
+	ErrMissingRequiredTags = NewBadRequestError("Project is missing tags required by the event")
+
+	// ErrProjectAlreadySubmitted is returned when submitting a project twice to an event
+	ErrProjectAlreadySubmitted = NewConflictError("Project is already submitted to the event")
+
+	// ErrInvalidCriterion is returned when a criterion has no name or an out of range weight or maximum score
+	ErrInvalidCriterion = NewBadRequestError("Invalid criterion, expected a name, a weight from 1 to 10 and a maximum score from 1 to 100")
+
+	// ErrJudgingClosed is returned when scoring before the event ends or after the results are published
+	ErrJudgingClosed = NewConflictError("Judging is only open between the end of the event and the publication of the results")
+
+	// ErrInvalidScore is returned when a score is not between 0 and the maximum score of its criterion
+	ErrInvalidScore = NewBadRequestError("Scores must be between 0 and the maximum score of their criterion")
+
+	// ErrResultsPublished is returned when changing the criteria or publishing the results of an event whose results are published
+	ErrResultsPublished = NewConflictError("Results have already been published")
+
+	// ErrResultsNotPublished is returned when someone who does not run the event asks for unpublished results
+	ErrResultsNotPublished = NewForbiddenError("Results have not been published yet")
+)
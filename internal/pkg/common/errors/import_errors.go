@@ -0,0 +1,13 @@
+package errors
+
+// Repository import errors
+var (
+	// ErrInvalidRepositoryURL is returned when the URL does not point to a repository of the configured forge
+	ErrInvalidRepositoryURL = NewBadRequestError("Invalid repository URL, expected https://<forge>/<owner>/<repository>")
+
+	// ErrRepositoryNotFound is returned when the forge does not know the repository, or it is private
+	ErrRepositoryNotFound = NewNotFoundError("Repository not found")
+
+	// ErrForgeUnavailable is returned when the forge cannot be reached or answers with an error
+	ErrForgeUnavailable = NewExternalAPIError("Repository host is unavailable, try again later")
+)
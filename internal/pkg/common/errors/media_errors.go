@@ -0,0 +1,25 @@
+package errors
+
+import "net/http"
+
+// Media-related errors
+var (
+	// ErrMediaTooLarge is returned when an uploaded file exceeds the size limit
+	ErrMediaTooLarge = &AppError{
+		Code:       ErrCodeValidation,
+		HTTPStatus: http.StatusRequestEntityTooLarge,
+		Message:    "File is too large",
+	}
+
+	// ErrUnsupportedMediaType is returned when an uploaded file is not a JPEG, PNG or GIF image
+	ErrUnsupportedMediaType = NewBadRequestError("Unsupported file type, expected a JPEG, PNG or GIF image")
+
+	// ErrInvalidImageDimensions is returned when an image is too small or too large
+	ErrInvalidImageDimensions = NewBadRequestError("Images must be between 32 and 8192 pixels wide and high, and at most 40 megapixels")
+
+	// ErrTooManyMedia is returned when uploading to a project that has reached the maximum number of media
+	ErrTooManyMedia = NewConflictError("Project has reached the maximum number of media")
+
+	// ErrInvalidMedia is returned when the caption of a media is too long
+	ErrInvalidMedia = NewBadRequestError("Captions must be at most 500 characters")
+)
@@ -0,0 +1,37 @@
+package errors
+
+// Project member and invitation related errors
+var (
+	// ErrInvalidMemberRole is returned when a role is unknown or cannot be granted that way
+	ErrInvalidMemberRole = NewBadRequestError("Invalid role, expected maintainer or contributor")
+
+	// ErrAlreadyMember is returned when inviting a user that is already a member of the project
+	ErrAlreadyMember = NewConflictError("User is already a member of the project")
+
+	// ErrInvitationPending is returned when the user already has a pending invitation to the project
+	ErrInvitationPending = NewConflictError("User already has a pending invitation to the project")
+
+	// ErrInvitationNotPending is returned when acting on an invitation that was already answered or revoked
+	ErrInvitationNotPending = NewConflictError("Invitation is no longer pending")
+
+	// ErrNotAMember is returned when the target user is not a member of the project
+	ErrNotAMember = NewBadRequestError("User is not a member of the project")
+
+	// ErrOwnerRoleChange is returned when changing the role of the owner outside of a transfer
+	ErrOwnerRoleChange = NewBadRequestError("The owner's role can only change through an ownership transfer")
+
+	// ErrOwnerCannotLeave is returned when the owner tries to leave or be removed from their project
+	ErrOwnerCannotLeave = NewBadRequestError("The owner must transfer ownership before leaving the project")
+
+	// ErrInvalidSkillLevel is returned when a skill level is not beginner, intermediate or expert
+	ErrInvalidSkillLevel = NewBadRequestError("Invalid skill level, expected beginner, intermediate or expert")
+
+	// ErrRoleNotOpen is returned when applying for a role that is closed or belongs to another project
+	ErrRoleNotOpen = NewBadRequestError("Role is not open on this project")
+
+	// ErrJoinRequestPending is returned when the user already asked to join the project
+	ErrJoinRequestPending = NewConflictError("You already have a pending request to join the project")
+
+	// ErrJoinRequestNotPending is returned when acting on a join request that was already answered or withdrawn
+	ErrJoinRequestNotPending = NewConflictError("Join request is no longer pending")
+)
@@ -0,0 +1,10 @@
+package errors
+
+// Milestone and task related errors
+var (
+	// ErrInvalidOrder is returned when a bulk reorder does not list every item exactly once
+	ErrInvalidOrder = NewBadRequestError("Order must list every item exactly once")
+
+	// ErrInvalidDueDate is returned when a due date is not formatted as YYYY-MM-DD
+	ErrInvalidDueDate = NewBadRequestError("Invalid due date, expected YYYY-MM-DD")
+)
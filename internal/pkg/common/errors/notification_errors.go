@@ -0,0 +1,16 @@
+package errors
+
+// Notification-related errors
+var (
+	// ErrInvalidNotificationType is returned when a notification preference names an unknown type
+	ErrInvalidNotificationType = NewBadRequestError("Invalid notification type")
+
+	// ErrInvalidDigestFrequency is returned when the digest frequency is not off, daily or weekly
+	ErrInvalidDigestFrequency = NewBadRequestError("Invalid digest frequency, expected one of off, daily or weekly")
+
+	// ErrInvalidTimezone is returned when a timezone is not a known IANA timezone name
+	ErrInvalidTimezone = NewBadRequestError("Invalid timezone, expected an IANA name such as Europe/Madrid")
+
+	// ErrInvalidUnsubscribeToken is returned when unsubscribing with a token that matches no user
+	ErrInvalidUnsubscribeToken = NewNotFoundError("Invalid unsubscribe token")
+)
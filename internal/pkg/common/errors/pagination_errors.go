@@ -0,0 +1,7 @@
+package errors
+
+// Pagination related errors
+var (
+	// ErrInvalidCursor is returned when a pagination cursor cannot be decoded
+	ErrInvalidCursor = NewBadRequestError("Invalid pagination cursor")
+)
@@ -0,0 +1,40 @@
+package errors
+
+// Project-related errors
+var (
+	// ErrInvalidProjectStatus is returned when the status is not one of the known lifecycle statuses
+	ErrInvalidProjectStatus = NewBadRequestError("Invalid project status, expected one of idea, planning, in_progress, shipped or abandoned")
+
+	// ErrInvalidStatusTransition is returned when a project cannot move from its current status to the requested one
+	ErrInvalidStatusTransition = NewConflictError("Project cannot move from its current status to the requested one")
+
+	// ErrInvalidProjectVisibility is returned when the visibility is not one of public, unlisted or private
+	ErrInvalidProjectVisibility = NewBadRequestError("Invalid project visibility, expected one of public, unlisted or private")
+
+	// ErrInvalidProjectDate is returned when a target date is not formatted as YYYY-MM-DD
+	ErrInvalidProjectDate = NewBadRequestError("Invalid date, expected YYYY-MM-DD")
+
+	// ErrInvalidProjectSort is returned when listing projects with an unknown sort order
+	ErrInvalidProjectSort = NewBadRequestError("Invalid sort, expected one of recent, popular, trending or updated")
+
+	// ErrInvalidProjectFilter is returned when a project list filter cannot be parsed
+	ErrInvalidProjectFilter = NewBadRequestError("Invalid project filter, dates must be YYYY-MM-DD or RFC 3339 and min_likes a non-negative number")
+
+	// ErrProjectNotDeleted is returned when restoring a project that has not been deleted
+	ErrProjectNotDeleted = NewConflictError("Project has not been deleted")
+
+	// ErrRestoreWindowExpired is returned when restoring a project or comment deleted longer ago than the retention
+	ErrRestoreWindowExpired = NewConflictError("Restore window has expired")
+
+	// ErrInvalidRevision is returned when a revision number is not a positive number
+	ErrInvalidRevision = NewBadRequestError("Invalid revision, expected a positive revision number")
+
+	// ErrInvalidProjectLink is returned when a link has an unknown type or is not an http or https URL
+	ErrInvalidProjectLink = NewBadRequestError("Invalid link, expected a type of repo, demo, docs or video and an http or https URL")
+
+	// ErrTooManyProjectLinks is returned when adding a link to a project that has reached the maximum number of links
+	ErrTooManyProjectLinks = NewConflictError("Project has reached the maximum number of links")
+
+	// ErrRemixNotAllowed is returned when remixing a project that is private, a draft or deleted
+	ErrRemixNotAllowed = NewForbiddenError("Only published projects that are not private can be remixed")
+)
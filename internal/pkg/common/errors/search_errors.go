@@ -0,0 +1,10 @@
+package errors
+
+// Search related errors
+var (
+	// ErrInvalidSearchQuery is returned when the search query is missing or too long
+	ErrInvalidSearchQuery = NewBadRequestError("Search query must be between 2 and 200 characters")
+
+	// ErrInvalidSearchType is returned when searching for an unknown type
+	ErrInvalidSearchType = NewBadRequestError("Invalid search type, expected projects, users or tags")
+)
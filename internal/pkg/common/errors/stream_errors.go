@@ -0,0 +1,7 @@
+package errors
+
+// Stream-related errors
+var (
+	// ErrTooManyWatchedProjects is returned when a stream watches more projects than allowed
+	ErrTooManyWatchedProjects = NewBadRequestError("Too many projects watched by the stream")
+)
@@ -0,0 +1,7 @@
+package errors
+
+// Scheduled task-related errors
+var (
+	// ErrTaskAlreadyRunning is returned when triggering a task that is running on some replica
+	ErrTaskAlreadyRunning = NewConflictError("Task is already running")
+)
@@ -0,0 +1,7 @@
+package errors
+
+// Trending-related errors
+var (
+	// ErrInvalidTrendingWindow is returned when the requested trending window is not supported
+	ErrInvalidTrendingWindow = NewBadRequestError("Invalid trending window, expected one of 24h, 7d or 30d")
+)
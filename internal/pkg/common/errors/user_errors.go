@@ -20,6 +20,7 @@ var (
 	ErrUserInactive     = NewForbiddenError("user account is not active")
 	ErrAccountInactive  = NewAuthorizationError("User account is inactive")
 	ErrAccountSuspended = NewForbiddenError("Account suspended")
+	ErrLikesHidden      = NewForbiddenError("This user's likes are private")
 
 	// General errors
 	ErrInvalidRequest      = NewBadRequestError("Invalid request data")
@@ -0,0 +1,16 @@
+package errors
+
+// Webhook-related errors
+var (
+	// ErrInvalidWebhookURL is returned when the URL of a webhook is not an absolute http or https URL
+	ErrInvalidWebhookURL = NewBadRequestError("Invalid webhook URL, expected an absolute http or https URL")
+
+	// ErrInvalidWebhookFormat is returned when the format of a webhook is not json, slack or discord
+	ErrInvalidWebhookFormat = NewBadRequestError("Invalid webhook format, expected one of json, slack or discord")
+
+	// ErrInvalidWebhookEventType is returned when a webhook subscribes to an unknown type of event
+	ErrInvalidWebhookEventType = NewBadRequestError("Invalid webhook event type")
+
+	// ErrTooManyWebhooks is returned when creating a webhook for a user or project that has reached the maximum
+	ErrTooManyWebhooks = NewConflictError("Maximum number of webhooks reached")
+)
@@ -0,0 +1,239 @@
+// Package cron parses cron expressions and computes when they are due.
+//
+// Expressions have the five standard fields: minute, hour, day of month, month and day of
+// week. Fields take *, single values, ranges (1-5), steps (*/15, 0-30/10) and lists of those
+// (1,15,30). Months and days of week may be given by name (jan, mon), and Sunday is both 0
+// and 7. As in every cron, a day matches when either of the day fields does if both are
+// restricted; a day field starting with * or covering every value is not restricted. The
+// descriptors @yearly, @monthly, @weekly, @daily and @hourly are accepted too.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	monthNames = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+	dayNames   = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+)
+
+// bounds are the values a field may take, with the names of the values from min on
+type bounds struct {
+	min, max int
+	names    []string
+}
+
+var (
+	minutes = bounds{0, 59, nil}
+	hours   = bounds{0, 23, nil}
+	days    = bounds{1, 31, nil}
+	months  = bounds{1, 12, monthNames}
+	weekday = bounds{0, 7, dayNames}
+)
+
+// Schedule is a parsed cron expression
+type Schedule struct {
+	expr string
+
+	minute, hour, dom, month, dow uint64
+	// Whether the day fields are restricted, which decides how they combine
+	domAny, dowAny bool
+}
+
+// Parse parses a cron expression
+func Parse(expr string) (*Schedule, error) {
+	spec := strings.TrimSpace(expr)
+	if d, ok := descriptors[strings.ToLower(spec)]; ok {
+		spec = d
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q: expected 5 fields, got %d", expr, len(fields))
+	}
+
+	s := &Schedule{expr: expr}
+	var err error
+	if s.minute, err = parseField(fields[0], minutes); err != nil {
+		return nil, fmt.Errorf("cron expression %q: minute: %w", expr, err)
+	}
+	if s.hour, err = parseField(fields[1], hours); err != nil {
+		return nil, fmt.Errorf("cron expression %q: hour: %w", expr, err)
+	}
+	if s.dom, err = parseField(fields[2], days); err != nil {
+		return nil, fmt.Errorf("cron expression %q: day of month: %w", expr, err)
+	}
+	if s.month, err = parseField(fields[3], months); err != nil {
+		return nil, fmt.Errorf("cron expression %q: month: %w", expr, err)
+	}
+	if s.dow, err = parseField(fields[4], weekday); err != nil {
+		return nil, fmt.Errorf("cron expression %q: day of week: %w", expr, err)
+	}
+	// Sunday is both 0 and 7
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domAny = unrestricted(fields[2], s.dom, days)
+	s.dowAny = unrestricted(fields[4], s.dow, bounds{0, 6, nil})
+
+	if s.Next(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return nil, fmt.Errorf("cron expression %q is never due", expr)
+	}
+	return s, nil
+}
+
+// String returns the expression the schedule was parsed from
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+// Next returns the first time after t the schedule is due, in the location of t. It returns
+// the zero time when the schedule is never due, e.g. on February 30th.
+//
+// Around DST changes, wall clock times the clocks skip are never due, and those they repeat are
+// only due the first time, unless the schedule runs every hour.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	// Every valid schedule is due within 8 years, the longest gap between leap days as 2100 is
+	// not a leap year
+	limit := t.AddDate(9, 0, 0)
+	for t.Before(limit) {
+		var next time.Time
+		switch {
+		case !has(s.month, int(t.Month())):
+			next = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.dayMatches(t):
+			next = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case !has(s.hour, t.Hour()):
+			next = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case !has(s.minute, t.Minute()) || (s.repeated(t) && !s.everyHour()):
+			next = t.Add(time.Minute)
+		default:
+			return t
+		}
+
+		// A wall clock time skipped by a DST change resolves to an earlier time, moving on to
+		// the next hour instead keeps the search from going round in circles
+		if !next.After(t) {
+			next = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+		}
+		t = next
+	}
+	return time.Time{}
+}
+
+// repeated reports whether the wall clock time of t already came an hour earlier, when the
+// clocks went back
+func (s *Schedule) repeated(t time.Time) bool {
+	earlier := t.Add(-time.Hour)
+	return earlier.Hour() == t.Hour() && earlier.Day() == t.Day()
+}
+
+// everyHour reports whether the schedule is due in every hour of the day
+func (s *Schedule) everyHour() bool {
+	return covers(s.hour, hours)
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom := has(s.dom, t.Day())
+	dow := has(s.dow, int(t.Weekday()))
+	if s.domAny || s.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// unrestricted reports whether a day field lets every day through: it starts with * or ?, even
+// with a step as in */2, or it covers every value as in 1-31
+func unrestricted(field string, set uint64, b bounds) bool {
+	return strings.HasPrefix(field, "*") || strings.HasPrefix(field, "?") || covers(set, b)
+}
+
+// covers reports whether the set holds every value of the field
+func covers(set uint64, b bounds) bool {
+	for v := b.min; v <= b.max; v++ {
+		if !has(set, v) {
+			return false
+		}
+	}
+	return true
+}
+
+func has(set uint64, v int) bool {
+	return set&(1<<uint(v)) != 0
+}
+
+// parseField parses a comma separated list of ranges into the set of values it covers
+func parseField(field string, b bounds) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+			rng, step = part[:i], n
+		}
+
+		lo, hi := b.min, b.max
+		switch {
+		case rng == "*" || rng == "?":
+		case strings.Contains(rng, "-"):
+			from, to, _ := strings.Cut(rng, "-")
+			var err error
+			if lo, err = parseValue(from, b); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(to, b); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q", rng)
+			}
+		default:
+			v, err := parseValue(rng, b)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			// A single value with a step runs up to the maximum, as in 5/15
+			if step == 1 {
+				hi = v
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+	return set, nil
+}
+
+func parseValue(value string, b bounds) (int, error) {
+	for i, name := range b.names {
+		if strings.EqualFold(value, name) {
+			return b.min + i, nil
+		}
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil || v < b.min || v > b.max {
+		return 0, fmt.Errorf("invalid value %q, expected %d-%d", value, b.min, b.max)
+	}
+	return v, nil
+}
@@ -0,0 +1,149 @@
+package cron
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("timezone %s unavailable: %v", name, err)
+	}
+	return loc
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "", want: "expected 5 fields"},
+		{expr: "* * * *", want: "expected 5 fields"},
+		{expr: "* * * * * *", want: "expected 5 fields"},
+		{expr: "@often", want: "expected 5 fields"},
+		{expr: "60 * * * *", want: "minute"},
+		{expr: "* 24 * * *", want: "hour"},
+		{expr: "* * 0 * *", want: "day of month"},
+		{expr: "* * 32 * *", want: "day of month"},
+		{expr: "* * * 13 *", want: "month"},
+		{expr: "* * * foo *", want: "month"},
+		{expr: "* * * * 8", want: "day of week"},
+		{expr: "*/0 * * * *", want: "invalid step"},
+		{expr: "*/x * * * *", want: "invalid step"},
+		{expr: "30-10 * * * *", want: "invalid range"},
+		{expr: "1,,2 * * * *", want: "minute"},
+		{expr: "0 0 30 2 *", want: "never due"},
+		{expr: "0 0 31 4,6,9,11 *", want: "never due"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := Parse(tt.expr)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Parse(%q) error = %v, want %q", tt.expr, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	date := func(year int, month time.Month, day, hour, min int) time.Time {
+		return time.Date(year, month, day, hour, min, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{name: "every quarter", expr: "*/15 * * * *", from: date(2025, 1, 1, 10, 7), want: date(2025, 1, 1, 10, 15)},
+		{name: "strictly after", expr: "*/15 * * * *", from: date(2025, 1, 1, 10, 15), want: date(2025, 1, 1, 10, 30)},
+		{name: "seconds dropped", expr: "*/15 * * * *", from: date(2025, 1, 1, 10, 14).Add(59 * time.Second), want: date(2025, 1, 1, 10, 15)},
+		{name: "next day", expr: "0 9 * * *", from: date(2025, 1, 1, 10, 0), want: date(2025, 1, 2, 9, 0)},
+		{name: "next year", expr: "@yearly", from: date(2025, 12, 31, 23, 59), want: date(2026, 1, 1, 0, 0)},
+		{name: "month names", expr: "0 12 * jan,jul *", from: date(2025, 2, 1, 0, 0), want: date(2025, 7, 1, 12, 0)},
+		{name: "single value with step", expr: "5/20 * * * *", from: date(2025, 1, 1, 10, 26), want: date(2025, 1, 1, 10, 45)},
+
+		// Month ends
+		{name: "31st skips short months", expr: "0 0 31 * *", from: date(2025, 4, 1, 0, 0), want: date(2025, 5, 31, 0, 0)},
+		{name: "31st skips February", expr: "0 0 31 * *", from: date(2025, 1, 31, 0, 0), want: date(2025, 3, 31, 0, 0)},
+		{name: "30th skips February", expr: "0 0 30 * *", from: date(2025, 1, 30, 12, 0), want: date(2025, 3, 30, 0, 0)},
+		{name: "monthly", expr: "@monthly", from: date(2025, 1, 31, 12, 0), want: date(2025, 2, 1, 0, 0)},
+
+		// Leap days
+		{name: "leap day", expr: "0 0 29 2 *", from: date(2025, 3, 1, 0, 0), want: date(2028, 2, 29, 0, 0)},
+		{name: "leap day this year", expr: "0 0 29 2 *", from: date(2028, 1, 1, 0, 0), want: date(2028, 2, 29, 0, 0)},
+		{name: "leap day skips century", expr: "0 0 29 2 *", from: date(2097, 1, 1, 0, 0), want: date(2104, 2, 29, 0, 0)},
+
+		// Day of month and day of week
+		{name: "weekdays", expr: "0 9 * * mon-fri", from: date(2025, 10, 17, 10, 0), want: date(2025, 10, 20, 9, 0)},
+		{name: "sunday as 7", expr: "0 0 * * 7", from: date(2025, 1, 1, 0, 0), want: date(2025, 1, 5, 0, 0)},
+		{name: "weekly", expr: "@weekly", from: date(2025, 1, 1, 0, 0), want: date(2025, 1, 5, 0, 0)},
+		{name: "both restricted match either", expr: "0 0 13 * fri", from: date(2025, 1, 1, 0, 0), want: date(2025, 1, 3, 0, 0)},
+		{name: "both restricted match either, day of month first", expr: "0 0 2 * fri", from: date(2025, 1, 1, 0, 0), want: date(2025, 1, 2, 0, 0)},
+		{name: "stepped day of month is unrestricted", expr: "0 0 */2 * mon", from: date(2025, 1, 1, 0, 0), want: date(2025, 1, 13, 0, 0)},
+		{name: "every day of month is unrestricted", expr: "0 0 1-31 * mon", from: date(2025, 1, 1, 0, 0), want: date(2025, 1, 6, 0, 0)},
+		{name: "question mark is unrestricted", expr: "0 0 ? * mon", from: date(2025, 1, 1, 0, 0), want: date(2025, 1, 6, 0, 0)},
+		{name: "every day of week is unrestricted", expr: "0 0 1 * 0-6", from: date(2025, 1, 2, 0, 0), want: date(2025, 2, 1, 0, 0)},
+		{name: "every day of week from monday is unrestricted", expr: "0 0 1 * 1-7", from: date(2025, 1, 2, 0, 0), want: date(2025, 2, 1, 0, 0)},
+		{name: "stepped day of week is unrestricted", expr: "0 0 1 * */1", from: date(2025, 1, 2, 0, 0), want: date(2025, 2, 1, 0, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.expr, err)
+			}
+			if got := s.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next(%s) of %q = %s, want %s", tt.from, tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextAcrossDST(t *testing.T) {
+	loc := mustLoad(t, "America/New_York")
+	date := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2025, month, day, hour, min, 0, 0, loc)
+	}
+	// The clocks go from 02:00 EST to 03:00 EDT on 9 March, and from 02:00 EDT back to 01:00
+	// EST on 2 November
+	firstOneThirty := date(11, 2, 1, 30)
+	secondOneThirty := firstOneThirty.Add(time.Hour)
+	if firstOneThirty.Hour() != secondOneThirty.Hour() {
+		t.Fatalf("01:30 not repeated on 2 November in %s", loc)
+	}
+
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{name: "skipped time is not due", expr: "30 2 * * *", from: date(3, 8, 12, 0), want: date(3, 10, 2, 30)},
+		{name: "hourly skips the missing hour", expr: "0 * * * *", from: date(3, 9, 1, 30), want: date(3, 9, 3, 0)},
+		{name: "due after the change", expr: "30 3 * * *", from: date(3, 9, 0, 0), want: date(3, 9, 3, 30)},
+		{name: "repeated time is due the first time", expr: "30 1 * * *", from: date(11, 2, 0, 0), want: firstOneThirty},
+		{name: "repeated time is not due again", expr: "30 1 * * *", from: firstOneThirty, want: date(11, 3, 1, 30)},
+		{name: "hourly runs in the repeated hour", expr: "*/30 * * * *", from: firstOneThirty.Add(15 * time.Minute), want: secondOneThirty.Add(-30 * time.Minute)},
+		{name: "daily after the repeated hour", expr: "0 2 * * *", from: date(11, 2, 0, 0), want: date(11, 2, 2, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.expr, err)
+			}
+			got := s.Next(tt.from)
+			if !got.Equal(tt.want) {
+				t.Errorf("Next(%s) of %q = %s, want %s", tt.from, tt.expr, got, tt.want)
+			}
+			if got.Location() != loc {
+				t.Errorf("Next() in %s, want %s", got.Location(), loc)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// Cursor is the position right after the last item of a page, in the order of the list.
+// Only the fields making up that order are set: a list ordered by like count and then by
+// ID sets Int and ID. Lists whose order cannot be resumed from a row, like rankings
+// computed on the fly, fall back to Offset.
+//
+// Clients only ever see cursors encoded, so their layout can change at any time.
+type Cursor struct {
+	Time   time.Time `json:"t,omitzero"`
+	Int    int64     `json:"n,omitempty"`
+	String string    `json:"s,omitempty"`
+	ID     string    `json:"id,omitempty"`
+	Offset int       `json:"o,omitempty"`
+}
+
+// Encode returns the opaque representation of the cursor
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Decode parses a cursor returned by Encode
+func Decode(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.Offset < 0 {
+		return nil, errors.ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// Params are the pagination parameters of a list request
+type Params struct {
+	Limit int
+	// After is nil when requesting the first page
+	After *Cursor
+	// WithTotal asks for the total number of items, which costs an extra query
+	WithTotal bool
+}
+
+// FromRequest reads the limit, cursor and total query string parameters
+func FromRequest(r *http.Request) (Params, error) {
+	query := r.URL.Query()
+	p := Params{
+		Limit:     DefaultLimit,
+		WithTotal: query.Get("total") == "true",
+	}
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= MaxLimit {
+			p.Limit = l
+		}
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		c, err := Decode(cursor)
+		if err != nil {
+			return Params{}, err
+		}
+		p.After = c
+	}
+
+	return p, nil
+}
+
+// Fetch is the number of items to query: one more than the page size, to know whether there is a next page
+func (p Params) Fetch() int {
+	return p.Limit + 1
+}
+
+// Offset is the offset of the page for lists paginated by offset
+func (p Params) Offset() int {
+	if p.After == nil {
+		return 0
+	}
+	return p.After.Offset
+}
+
+// OffsetCursor returns the cursor of the page following the current one for lists paginated by offset
+func (p Params) OffsetCursor() Cursor {
+	return Cursor{Offset: p.Offset() + p.Limit}
+}
+
+// Trim cuts items, queried with a limit of Fetch, down to the page size.
+// The next cursor is built from the last item kept and is nil on the last page.
+func Trim[T any](items []T, p Params, cursorOf func(T) Cursor) ([]T, *string) {
+	if len(items) <= p.Limit {
+		return items, nil
+	}
+	items = items[:p.Limit]
+	next := cursorOf(items[len(items)-1]).Encode()
+	return items, &next
+}
@@ -0,0 +1,138 @@
+package pagination
+
+import (
+	"encoding/base64"
+	stderrors "errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := map[string]Cursor{
+		"time and id": {Time: time.Date(2026, 3, 1, 12, 30, 0, 123456789, time.UTC), ID: "prj_01h455vb4pex5vsknk084sn02q"},
+		"count":       {Int: 42, Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), ID: "prj_1"},
+		"string":      {String: "ada lovelace/é", ID: "usr_1"},
+		"offset":      {Offset: 40},
+		"time zone":   {Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.FixedZone("CET", 3600)), ID: "prj_1"},
+	}
+	for name, c := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := Decode(c.Encode())
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if !got.Time.Equal(c.Time) || got.Int != c.Int || got.String != c.String || got.ID != c.ID || got.Offset != c.Offset {
+				t.Errorf("Decode(Encode()) = %+v, want %+v", *got, c)
+			}
+		})
+	}
+
+	// Cursors go in query strings as they are
+	encoded := Cursor{String: "???>>>", ID: "a"}.Encode()
+	if _, err := base64.RawURLEncoding.DecodeString(encoded); err != nil {
+		t.Errorf("Encode() = %q, want unpadded URL safe base64", encoded)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	b64 := base64.RawURLEncoding.EncodeToString
+	tests := map[string]string{
+		"empty":           "",
+		"not base64":      "not a cursor!",
+		"padded base64":   base64.URLEncoding.EncodeToString([]byte(`{"o":1}`)),
+		"not json":        b64([]byte("offset=20")),
+		"truncated json":  b64([]byte(`{"t":"2026-03-01T00:00:00Z","id":"prj_1"`)),
+		"json array":      b64([]byte(`[1,2]`)),
+		"wrong type":      b64([]byte(`{"n":"many"}`)),
+		"invalid time":    b64([]byte(`{"t":"yesterday"}`)),
+		"negative offset": b64([]byte(`{"o":-20}`)),
+	}
+	for name, s := range tests {
+		t.Run(name, func(t *testing.T) {
+			if c, err := Decode(s); !stderrors.Is(err, errors.ErrInvalidCursor) {
+				t.Errorf("Decode(%q) = %+v, %v, want %v", s, c, err, errors.ErrInvalidCursor)
+			}
+		})
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	after := Cursor{Offset: 20}
+
+	tests := []struct {
+		query     string
+		limit     int
+		after     *Cursor
+		withTotal bool
+	}{
+		{query: "", limit: DefaultLimit},
+		{query: "limit=5&total=true", limit: 5, withTotal: true},
+		{query: "limit=100", limit: MaxLimit},
+		// Out of range limits fall back to the default
+		{query: "limit=101", limit: DefaultLimit},
+		{query: "limit=0", limit: DefaultLimit},
+		{query: "limit=-1", limit: DefaultLimit},
+		{query: "limit=ten&total=1", limit: DefaultLimit},
+		{query: "cursor=" + after.Encode(), limit: DefaultLimit, after: &after},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			p, err := FromRequest(httptest.NewRequest("GET", "/api/v1/projects?"+tt.query, nil))
+			if err != nil {
+				t.Fatalf("FromRequest() error = %v", err)
+			}
+			if p.Limit != tt.limit || p.WithTotal != tt.withTotal {
+				t.Errorf("FromRequest() = limit %d total %t, want limit %d total %t", p.Limit, p.WithTotal, tt.limit, tt.withTotal)
+			}
+			if (p.After == nil) != (tt.after == nil) || (p.After != nil && *p.After != *tt.after) {
+				t.Errorf("After = %+v, want %+v", p.After, tt.after)
+			}
+		})
+	}
+
+	if _, err := FromRequest(httptest.NewRequest("GET", "/api/v1/projects?cursor=bogus!", nil)); !stderrors.Is(err, errors.ErrInvalidCursor) {
+		t.Errorf("FromRequest() error = %v, want %v", err, errors.ErrInvalidCursor)
+	}
+}
+
+func TestTrim(t *testing.T) {
+	p := Params{Limit: 3}
+	cursorOf := func(n int) Cursor { return Cursor{Int: int64(n)} }
+
+	// Queried with a limit of Fetch, a full page has one extra item
+	items, next := Trim([]int{1, 2, 3, 4}, p, cursorOf)
+	if len(items) != 3 || next == nil {
+		t.Fatalf("Trim() = %v, %v, want 3 items and a next cursor", items, next)
+	}
+	c, err := Decode(*next)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if c.Int != 3 {
+		t.Errorf("next cursor = %+v, want the cursor of the last item kept", *c)
+	}
+
+	for _, last := range [][]int{{1, 2, 3}, {1}, {}} {
+		if items, next := Trim(last, p, cursorOf); len(items) != len(last) || next != nil {
+			t.Errorf("Trim(%v) = %v, %v, want the items and no next cursor", last, items, next)
+		}
+	}
+}
+
+func TestOffset(t *testing.T) {
+	p := Params{Limit: 10}
+	if p.Offset() != 0 || p.OffsetCursor().Offset != 10 {
+		t.Errorf("first page: Offset() = %d, next offset = %d, want 0, 10", p.Offset(), p.OffsetCursor().Offset)
+	}
+	if p.Fetch() != 11 {
+		t.Errorf("Fetch() = %d, want 11", p.Fetch())
+	}
+
+	p.After = &Cursor{Offset: 30}
+	if p.Offset() != 30 || p.OffsetCursor().Offset != 40 {
+		t.Errorf("later page: Offset() = %d, next offset = %d, want 30, 40", p.Offset(), p.OffsetCursor().Offset)
+	}
+}
@@ -0,0 +1,302 @@
+package projectfilter
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/predicate"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/ent/trendingsnapshot"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/trending"
+)
+
+// Sort is the order projects are listed in
+type Sort string
+
+const (
+	SortRecent   Sort = "recent"
+	SortPopular  Sort = "popular"
+	SortTrending Sort = "trending"
+	SortUpdated  Sort = "updated"
+)
+
+// sortAliases keeps older clients working
+var sortAliases = map[string]Sort{
+	"likes": SortPopular,
+}
+
+// Filter describes which projects to list and in which order.
+// The zero value lists every project, most recent first.
+type Filter struct {
+	// TagsAll keeps projects having every one of these tag slugs
+	TagsAll []string
+	// TagsAny keeps projects having at least one of these tag slugs
+	TagsAny []string
+	// Owner and LikedBy are usernames
+	Owner   string
+	LikedBy string
+	// Viewer is the ID of the user listing the projects, empty when anonymous. Users hiding
+	// their likes are only matched by LikedBy when they list the projects themselves.
+	Viewer        string
+	Statuses      []project.Status
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	MinLikes      *int
+	Sort          Sort
+	// Window is the trending window used by SortTrending
+	Window trending.Window
+}
+
+// Parse reads a filter from the query string parameters tags, tags_any, owner, liked_by,
+// status, created_after, created_before, min_likes, sort and window, for the viewer of the
+// context. List values are comma separated.
+func Parse(ctx context.Context, values url.Values) (Filter, error) {
+	f := Filter{
+		TagsAll: splitList(values.Get("tags")),
+		TagsAny: splitList(values.Get("tags_any")),
+		Owner:   values.Get("owner"),
+		LikedBy: values.Get("liked_by"),
+		Viewer:  visibility.Viewer(ctx),
+		Sort:    SortRecent,
+		Window:  trending.DefaultWindow,
+	}
+
+	for _, st := range splitList(values.Get("status")) {
+		if project.StatusValidator(project.Status(st)) != nil {
+			return Filter{}, errors.ErrInvalidProjectStatus
+		}
+		f.Statuses = append(f.Statuses, project.Status(st))
+	}
+
+	var err error
+	if f.CreatedAfter, err = parseTime(values.Get("created_after")); err != nil {
+		return Filter{}, err
+	}
+	if f.CreatedBefore, err = parseTime(values.Get("created_before")); err != nil {
+		return Filter{}, err
+	}
+
+	if minLikes := values.Get("min_likes"); minLikes != "" {
+		n, err := strconv.Atoi(minLikes)
+		if err != nil || n < 0 {
+			return Filter{}, errors.ErrInvalidProjectFilter
+		}
+		f.MinLikes = &n
+	}
+
+	if s := values.Get("sort"); s != "" {
+		sort, ok := parseSort(s)
+		if !ok {
+			return Filter{}, errors.ErrInvalidProjectSort
+		}
+		f.Sort = sort
+	}
+
+	if w := values.Get("window"); w != "" {
+		window, ok := trending.ParseWindow(w)
+		if !ok {
+			return Filter{}, errors.ErrInvalidTrendingWindow
+		}
+		f.Window = window
+	}
+
+	return f, nil
+}
+
+// Predicates returns the conditions of the filter, to be combined with any other
+func (f Filter) Predicates() []predicate.Project {
+	var ps []predicate.Project
+	for _, slug := range f.TagsAll {
+		ps = append(ps, project.HasTagsWith(tag.Slug(slug)))
+	}
+	if len(f.TagsAny) > 0 {
+		ps = append(ps, project.HasTagsWith(tag.SlugIn(f.TagsAny...)))
+	}
+	if f.Owner != "" {
+		ps = append(ps, project.HasOwnerWith(user.Username(f.Owner)))
+	}
+	if f.LikedBy != "" {
+		ps = append(ps, project.HasLikedByWith(user.Username(f.LikedBy), likesVisibleTo(f.Viewer)))
+	}
+	if len(f.Statuses) > 0 {
+		ps = append(ps, project.StatusIn(f.Statuses...))
+	}
+	if f.CreatedAfter != nil {
+		ps = append(ps, project.CreateTimeGTE(*f.CreatedAfter))
+	}
+	if f.CreatedBefore != nil {
+		ps = append(ps, project.CreateTimeLT(*f.CreatedBefore))
+	}
+	if f.MinLikes != nil {
+		ps = append(ps, project.LikeCountGTE(*f.MinLikes))
+	}
+	return ps
+}
+
+// Apply adds the conditions and the order of the filter to the query
+func (f Filter) Apply(q *ent.ProjectQuery) *ent.ProjectQuery {
+	return q.Where(f.Predicates()...).Order(f.Order()...)
+}
+
+// Count returns the number of projects matching the filter
+func (f Filter) Count(ctx context.Context, client *ent.Client) (int, error) {
+	return client.Project.Query().Where(f.Predicates()...).Count(ctx)
+}
+
+// Order returns the ordering of the filter. Every order ends with the ID so that
+// pages never overlap when many projects share the same value.
+func (f Filter) Order() []project.OrderOption {
+	switch f.Sort {
+	case SortPopular:
+		return []project.OrderOption{
+			project.ByLikeCount(sql.OrderDesc()),
+			project.ByCreateTime(sql.OrderDesc()),
+			project.ByID(sql.OrderDesc()),
+		}
+	case SortUpdated:
+		return []project.OrderOption{
+			project.ByUpdateTime(sql.OrderDesc()),
+			project.ByID(sql.OrderDesc()),
+		}
+	case SortTrending:
+		return []project.OrderOption{
+			byTrendingRank(f.Window),
+			project.ByCreateTime(sql.OrderDesc()),
+			project.ByID(sql.OrderDesc()),
+		}
+	default:
+		return []project.OrderOption{
+			project.ByCreateTime(sql.OrderDesc()),
+			project.ByID(sql.OrderDesc()),
+		}
+	}
+}
+
+// Paginate restricts the query, already ordered by Apply, to the page described by the params.
+// Lists are resumed right after the last project of the previous page, except for the
+// trending order whose ranking is computed by a join and is paginated by offset.
+func (f Filter) Paginate(q *ent.ProjectQuery, p pagination.Params) *ent.ProjectQuery {
+	q = q.Limit(p.Fetch())
+	if p.After == nil {
+		return q
+	}
+
+	if f.Sort == SortTrending {
+		return q.Offset(p.Offset())
+	}
+	return q.Where(f.after(*p.After))
+}
+
+// after matches the projects coming after the cursor in the order of the filter. It does not
+// apply to the trending order, which is paginated by offset.
+func (f Filter) after(c pagination.Cursor) predicate.Project {
+	switch f.Sort {
+	case SortPopular:
+		return project.Or(
+			project.LikeCountLT(int(c.Int)),
+			project.And(project.LikeCount(int(c.Int)), project.Or(
+				project.CreateTimeLT(c.Time),
+				project.And(project.CreateTime(c.Time), project.IDLT(c.ID)),
+			)),
+		)
+	case SortUpdated:
+		return project.Or(
+			project.UpdateTimeLT(c.Time),
+			project.And(project.UpdateTime(c.Time), project.IDLT(c.ID)),
+		)
+	default:
+		return project.Or(
+			project.CreateTimeLT(c.Time),
+			project.And(project.CreateTime(c.Time), project.IDLT(c.ID)),
+		)
+	}
+}
+
+// Cursor returns the function building the cursor of the next page from the last project of the current one
+func (f Filter) Cursor(p pagination.Params) func(*ent.Project) pagination.Cursor {
+	return func(last *ent.Project) pagination.Cursor {
+		switch f.Sort {
+		case SortTrending:
+			return p.OffsetCursor()
+		case SortPopular:
+			return pagination.Cursor{Int: int64(last.LikeCount), Time: last.CreateTime, ID: last.ID}
+		case SortUpdated:
+			return pagination.Cursor{Time: last.UpdateTime, ID: last.ID}
+		default:
+			return pagination.Cursor{Time: last.CreateTime, ID: last.ID}
+		}
+	}
+}
+
+// byTrendingRank orders projects by their rank in the latest trending snapshot of the window.
+// Projects that are not trending come last.
+func byTrendingRank(w trending.Window) project.OrderOption {
+	return func(s *sql.Selector) {
+		t := sql.Table(trendingsnapshot.Table).As("trending")
+		s.LeftJoin(t).OnP(sql.And(
+			sql.ColumnsEQ(s.C(project.FieldID), t.C(trendingsnapshot.FieldSubjectID)),
+			sql.EQ(t.C(trendingsnapshot.FieldSubjectType), trendingsnapshot.SubjectTypeProject),
+			sql.EQ(t.C(trendingsnapshot.FieldPeriod), w.Period),
+		))
+		s.OrderExpr(sql.Expr(t.C(trendingsnapshot.FieldRank) + " ASC NULLS LAST"))
+	}
+}
+
+// likesVisibleTo matches the users whose likes the viewer, who may be anonymous, can see:
+// everyone's but those of users hiding them, who only see their own
+func likesVisibleTo(viewerID string) predicate.User {
+	if viewerID == "" {
+		return user.HideLikes(false)
+	}
+	return user.Or(user.HideLikes(false), user.ID(viewerID))
+}
+
+func parseSort(s string) (Sort, bool) {
+	if alias, ok := sortAliases[s]; ok {
+		return alias, true
+	}
+	switch sort := Sort(s); sort {
+	case SortRecent, SortPopular, SortTrending, SortUpdated:
+		return sort, true
+	}
+	return "", false
+}
+
+// parseTime accepts a plain date (start of the day, UTC) or a full RFC 3339 timestamp
+func parseTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.ErrInvalidProjectFilter
+	}
+	return &t, nil
+}
+
+func splitList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,294 @@
+package projectfilter
+
+import (
+	"context"
+	stderrors "errors"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	_ "github.com/jorge-j1m/hackspark_server/ent/runtime"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/pagination"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/trending"
+)
+
+var errRecorded = stderrors.New("query recorded")
+
+// recorder is a database driver that records the queries instead of running them
+type recorder struct {
+	query string
+	args  []any
+}
+
+func (r *recorder) Exec(context.Context, string, any, any) error { return errRecorded }
+
+func (r *recorder) Query(_ context.Context, query string, args, _ any) error {
+	r.query = query
+	r.args, _ = args.([]any)
+	return errRecorded
+}
+
+func (r *recorder) Tx(context.Context) (dialect.Tx, error) { return nil, errRecorded }
+func (r *recorder) Close() error                           { return nil }
+func (r *recorder) Dialect() string                        { return dialect.Postgres }
+
+// listQuery returns the SQL listing the page of projects matching the filter
+func listQuery(t *testing.T, f Filter, p pagination.Params) (string, []any) {
+	t.Helper()
+	rec := &recorder{}
+	client := ent.NewClient(ent.Driver(rec))
+
+	ctx := visibility.IncludeDeleted(visibility.System(context.Background()))
+	if _, err := f.Paginate(f.Apply(client.Project.Query()), p).All(ctx); !stderrors.Is(err, errRecorded) {
+		t.Fatalf("listing projects: %v", err)
+	}
+	return rec.query, rec.args
+}
+
+func TestParse(t *testing.T) {
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2026, 2, 1, 12, 0, 0, 0, time.FixedZone("", 2*3600))
+	minLikes := 3
+
+	tests := []struct {
+		query string
+		want  Filter
+	}{
+		{
+			query: "",
+			want:  Filter{Sort: SortRecent, Window: trending.DefaultWindow},
+		},
+		{
+			query: "tags=go,+rust+,,&tags_any=cli&owner=ada&liked_by=bob&status=idea,shipped",
+			want: Filter{
+				TagsAll:  []string{"go", "rust"},
+				TagsAny:  []string{"cli"},
+				Owner:    "ada",
+				LikedBy:  "bob",
+				Statuses: []project.Status{project.StatusIdea, project.StatusShipped},
+				Sort:     SortRecent,
+				Window:   trending.DefaultWindow,
+			},
+		},
+		{
+			query: "created_after=2026-01-01&created_before=2026-02-01T12:00:00%2B02:00&min_likes=3",
+			want: Filter{
+				CreatedAfter:  &after,
+				CreatedBefore: &before,
+				MinLikes:      &minLikes,
+				Sort:          SortRecent,
+				Window:        trending.DefaultWindow,
+			},
+		},
+		{
+			query: "sort=trending&window=24h",
+			want:  Filter{Sort: SortTrending, Window: trending.Day},
+		},
+		{
+			// Older clients sort by likes
+			query: "sort=likes",
+			want:  Filter{Sort: SortPopular, Window: trending.DefaultWindow},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			values, _ := url.ParseQuery(tt.query)
+			got, err := Parse(context.Background(), values)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !equalFilters(got, tt.want) {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := map[string]error{
+		"status=done":                errors.ErrInvalidProjectStatus,
+		"status=idea,done":           errors.ErrInvalidProjectStatus,
+		"created_after=yesterday":    errors.ErrInvalidProjectFilter,
+		"created_before=2026-13-01":  errors.ErrInvalidProjectFilter,
+		"min_likes=-1":               errors.ErrInvalidProjectFilter,
+		"min_likes=many":             errors.ErrInvalidProjectFilter,
+		"sort=oldest":                errors.ErrInvalidProjectSort,
+		"sort=trending&window=1year": errors.ErrInvalidTrendingWindow,
+	}
+	for query, want := range tests {
+		t.Run(query, func(t *testing.T) {
+			values, _ := url.ParseQuery(query)
+			if _, err := Parse(context.Background(), values); !stderrors.Is(err, want) {
+				t.Errorf("Parse() error = %v, want %v", err, want)
+			}
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		sort  Sort
+		last  ent.Project
+		where string
+		args  []any
+		order string
+	}{
+		{
+			sort:  SortRecent,
+			last:  ent.Project{ID: "prj_2", CreateTime: at},
+			where: `"projects"."create_time" < $1 OR ("projects"."create_time" = $2 AND "projects"."id" < $3)`,
+			args:  []any{at, at, "prj_2"},
+			order: `ORDER BY "projects"."create_time" DESC, "projects"."id" DESC`,
+		},
+		{
+			sort:  SortPopular,
+			last:  ent.Project{ID: "prj_2", CreateTime: at, LikeCount: 7},
+			where: `"projects"."like_count" < $1 OR ("projects"."like_count" = $2 AND ("projects"."create_time" < $3 OR ("projects"."create_time" = $4 AND "projects"."id" < $5)))`,
+			args:  []any{7, 7, at, at, "prj_2"},
+			order: `ORDER BY "projects"."like_count" DESC, "projects"."create_time" DESC, "projects"."id" DESC`,
+		},
+		{
+			sort:  SortUpdated,
+			last:  ent.Project{ID: "prj_2", CreateTime: at.Add(-time.Hour), UpdateTime: at},
+			where: `"projects"."update_time" < $1 OR ("projects"."update_time" = $2 AND "projects"."id" < $3)`,
+			args:  []any{at, at, "prj_2"},
+			order: `ORDER BY "projects"."update_time" DESC, "projects"."id" DESC`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			f := Filter{Sort: tt.sort, Window: trending.DefaultWindow}
+
+			first, _ := listQuery(t, f, pagination.Params{Limit: 2})
+			if strings.Contains(first, "WHERE") || !strings.Contains(first, tt.order+" LIMIT 3") {
+				t.Errorf("first page query = %s, want no condition, %s and the limit", first, tt.order)
+			}
+
+			// The next page resumes after the cursor built from the last project of the previous one
+			p := pagination.Params{Limit: 2}
+			c, err := pagination.Decode(f.Cursor(p)(&tt.last).Encode())
+			if err != nil {
+				t.Fatalf("decoding cursor: %v", err)
+			}
+			p.After = c
+
+			query, args := listQuery(t, f, p)
+			if !strings.Contains(query, "WHERE "+tt.where+" "+tt.order+" LIMIT 3") {
+				t.Errorf("next page query = %s, want WHERE %s %s", query, tt.where, tt.order)
+			}
+			if strings.Contains(query, "OFFSET") {
+				t.Errorf("next page query = %s, want no offset", query)
+			}
+			if len(args) != len(tt.args) {
+				t.Fatalf("args = %v, want %v", args, tt.args)
+			}
+			for i := range args {
+				if t1, ok := tt.args[i].(time.Time); ok {
+					if t2, ok := args[i].(time.Time); !ok || !t1.Equal(t2) {
+						t.Errorf("arg %d = %v, want %v", i, args[i], tt.args[i])
+					}
+				} else if args[i] != tt.args[i] {
+					t.Errorf("arg %d = %v, want %v", i, args[i], tt.args[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPaginateTrending(t *testing.T) {
+	// Trending ranks come from a join, so the list falls back to offsets
+	f := Filter{Sort: SortTrending, Window: trending.DefaultWindow}
+	p := pagination.Params{Limit: 10, After: &pagination.Cursor{Offset: 20}}
+
+	query, args := listQuery(t, f, p)
+	for _, want := range []string{
+		`LEFT JOIN "trending_snapshots" AS "trending"`,
+		`ORDER BY "trending"."rank" ASC NULLS LAST, "projects"."create_time" DESC, "projects"."id" DESC`,
+		"LIMIT 11 OFFSET 20",
+	} {
+		if !strings.Contains(query, want) {
+			t.Errorf("query = %s, want %s", query, want)
+		}
+	}
+	if strings.Contains(query, "WHERE") {
+		t.Errorf("query = %s, want no keyset condition", query)
+	}
+	if len(args) != 2 || args[1] != trending.DefaultWindow.Period {
+		t.Errorf("args = %v, want the subject type and the period of the window", args)
+	}
+
+	next := f.Cursor(p)(&ent.Project{ID: "prj_1", LikeCount: 3})
+	if next != (pagination.Cursor{Offset: 30}) {
+		t.Errorf("next cursor = %+v, want the offset of the next page only", next)
+	}
+}
+
+func equalFilters(a, b Filter) bool {
+	equalTimes := func(x, y *time.Time) bool {
+		return (x == nil) == (y == nil) && (x == nil || x.Equal(*y))
+	}
+	equalInts := func(x, y *int) bool {
+		return (x == nil) == (y == nil) && (x == nil || *x == *y)
+	}
+	return slices.Equal(a.TagsAll, b.TagsAll) &&
+		slices.Equal(a.TagsAny, b.TagsAny) &&
+		a.Owner == b.Owner &&
+		a.LikedBy == b.LikedBy &&
+		slices.Equal(a.Statuses, b.Statuses) &&
+		equalTimes(a.CreatedAfter, b.CreatedAfter) &&
+		equalTimes(a.CreatedBefore, b.CreatedBefore) &&
+		equalInts(a.MinLikes, b.MinLikes) &&
+		a.Sort == b.Sort &&
+		a.Window == b.Window
+}
+
+func TestLikedByHiddenLikes(t *testing.T) {
+	values := url.Values{"liked_by": {"ada"}}
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		where string
+		args  []any
+	}{
+		{
+			name:  "anonymous",
+			ctx:   context.Background(),
+			where: `WHERE "t1"."username" = $1 AND NOT "t1"."hide_likes")`,
+			args:  []any{"ada"},
+		},
+		{
+			// The likes of users hiding them are only listed for themselves
+			name:  "signed in",
+			ctx:   visibility.WithViewer(context.Background(), "usr_1"),
+			where: `WHERE "t1"."username" = $1 AND (NOT "t1"."hide_likes" OR "t1"."id" = $2))`,
+			args:  []any{"ada", "usr_1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Parse(tt.ctx, values)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			query, args := listQuery(t, f, pagination.Params{Limit: 1})
+			if !strings.Contains(query, `"projects"."id" IN (SELECT "likes"."project_id" FROM "likes" JOIN "users" AS "t1" ON "likes"."user_id" = "t1"."id" `+tt.where) {
+				t.Errorf("query = %s, want the likes of ada %s", query, tt.where)
+			}
+			if !slices.Equal(args, tt.args) {
+				t.Errorf("args = %v, want %v", args, tt.args)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Package testdb gives tests an empty, migrated PostgreSQL database.
+//
+// Every test gets a schema of its own on the server of TEST_DATABASE_STRING, dropped when the
+// test ends, so tests of different packages can run at the same time. Tests are skipped when the
+// variable is unset, e.g.
+//
+//	TEST_DATABASE_STRING="host=localhost port=5432 user=postgres password=postgres dbname=hackspark_test sslmode=disable" go test ./...
+package testdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	_ "github.com/jorge-j1m/hackspark_server/ent/runtime"
+	_ "github.com/lib/pq"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+)
+
+// schemas numbers the schemas created by the test binary
+var schemas atomic.Int64
+
+// DSN returns the connection string of the test database, skipping the test when it is unset
+func DSN(t testing.TB) string {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_STRING")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_STRING is not set")
+	}
+	return dsn
+}
+
+// Open returns a client of a new schema holding every table of the application
+func Open(t testing.TB) *ent.Client {
+	t.Helper()
+	client, _ := OpenDSN(t)
+	return client
+}
+
+// OpenDSN is like Open, and also returns the connection string of the schema, for the code that
+// opens connections of its own
+func OpenDSN(t testing.TB) (*ent.Client, string) {
+	t.Helper()
+	dsn := DSN(t)
+	ctx := context.Background()
+
+	admin, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("opening test database: %v", err)
+	}
+	t.Cleanup(func() { admin.Close() })
+
+	// Extensions belong to the whole database, so they are created once in public, where every
+	// schema finds them. The lock keeps test binaries running at the same time from racing.
+	tx, err := admin.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("starting transaction: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(7301)"); err != nil {
+		tx.Rollback()
+		t.Fatalf("locking extensions: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, "CREATE EXTENSION IF NOT EXISTS pg_trgm SCHEMA public"); err != nil {
+		tx.Rollback()
+		t.Fatalf("creating extensions: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("creating extensions: %v", err)
+	}
+
+	schema := fmt.Sprintf("test_%d_%d", os.Getpid(), schemas.Add(1))
+	if _, err := admin.ExecContext(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatalf("creating schema: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.ExecContext(context.Background(), "DROP SCHEMA "+schema+" CASCADE"); err != nil {
+			t.Errorf("dropping schema %s: %v", schema, err)
+		}
+	})
+
+	schemaDSN := withSearchPath(dsn, schema+",public")
+	client, err := ent.Open("postgres", schemaDSN)
+	if err != nil {
+		t.Fatalf("opening test schema: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	if err := database.Migrate(ctx, client); err != nil {
+		t.Fatalf("migrating test schema: %v", err)
+	}
+	return client, schemaDSN
+}
+
+// withSearchPath sets the search path of the connections of the connection string, given as a
+// URL or as key=value pairs
+func withSearchPath(dsn, path string) string {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		u, err := url.Parse(dsn)
+		if err == nil {
+			q := u.Query()
+			q.Set("search_path", path)
+			u.RawQuery = q.Encode()
+			return u.String()
+		}
+	}
+	return dsn + " search_path=" + path
+}
+
+// User creates an active user named after the username
+func User(t testing.TB, client *ent.Client, username string) *ent.User {
+	t.Helper()
+	u, err := client.User.Create().
+		SetUsername(username).
+		SetEmail(username + "@example.com").
+		SetPassword("password123").
+		SetFirstName(username).
+		SetLastName("Test").
+		SetAccountStatus("active").
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("creating user %s: %v", username, err)
+	}
+	return u
+}
+
+// Project creates a public project of the owner. Change what the test needs through update,
+// which may be nil.
+func Project(t testing.TB, client *ent.Client, ownerID, name string, update func(*ent.ProjectCreate)) *ent.Project {
+	t.Helper()
+	create := client.Project.Create().
+		SetOwnerID(ownerID).
+		SetName(name).
+		SetDescription("Description of " + name)
+	if update != nil {
+		update(create)
+	}
+	p, err := create.Save(visibility.System(context.Background()))
+	if err != nil {
+		t.Fatalf("creating project %s: %v", name, err)
+	}
+	return p
+}
@@ -0,0 +1,113 @@
+// Package visibility decides which projects a request can see.
+//
+// Every project query goes through an interceptor registered by the Project schema, which
+// restricts it to the projects visible to the viewer of the context:
+//   - public projects that are not drafts are visible to everyone
+//   - unlisted projects that are not drafts are only visible when the context allows them,
+//     i.e. when the project is reached through its ID rather than found in a list
+//   - private projects and drafts are only visible to their owner, members and pending invitees
+//
+// Contexts without a viewer are anonymous. Background jobs that must see every project opt
+// out with System.
+//
+// Soft-deleted rows are left out of every query as well, whatever the viewer, unless the
+// context includes them with IncludeDeleted.
+package visibility
+
+import (
+	"context"
+
+	"github.com/jorge-j1m/hackspark_server/ent/predicate"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectinvitation"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+)
+
+type ctxKey int
+
+const (
+	viewerKey ctxKey = iota
+	systemKey
+	unlistedKey
+	deletedKey
+)
+
+// WithViewer returns a context whose queries see the projects visible to the user
+func WithViewer(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, viewerKey, userID)
+}
+
+// Viewer returns the ID of the user the context acts for, empty when anonymous
+func Viewer(ctx context.Context) string {
+	userID, _ := ctx.Value(viewerKey).(string)
+	return userID
+}
+
+// System returns a context whose queries see every project, for jobs that do not act for a user
+func System(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemKey, true)
+}
+
+// WithUnlisted returns a context whose queries also see unlisted projects. It is meant for
+// requests addressing a single project by its ID, never for lists.
+func WithUnlisted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unlistedKey, true)
+}
+
+// WithoutUnlisted returns a context whose queries no longer see unlisted projects, for the
+// lists served by routes that address a single project
+func WithoutUnlisted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unlistedKey, false)
+}
+
+// IncludeDeleted returns a context whose queries also return soft-deleted rows and whose
+// deletes remove rows for good instead of marking them as deleted
+func IncludeDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, deletedKey, true)
+}
+
+// DeletedIncluded reports whether the context includes soft-deleted rows
+func DeletedIncluded(ctx context.Context) bool {
+	included, _ := ctx.Value(deletedKey).(bool)
+	return included
+}
+
+// Listed matches the projects anyone can find in lists, search and trending
+func Listed() predicate.Project {
+	return project.And(
+		project.VisibilityEQ(project.VisibilityPublic),
+		project.Draft(false),
+		project.DeletedAtIsNil(),
+	)
+}
+
+// Filter returns the predicate restricting project queries made with the context.
+// It returns false when the context sees every project.
+func Filter(ctx context.Context) (predicate.Project, bool) {
+	if system, _ := ctx.Value(systemKey).(bool); system {
+		return nil, false
+	}
+
+	visible := Listed()
+	if unlisted, _ := ctx.Value(unlistedKey).(bool); unlisted {
+		visible = project.And(
+			project.VisibilityIn(project.VisibilityPublic, project.VisibilityUnlisted),
+			project.Draft(false),
+		)
+	}
+
+	userID := Viewer(ctx)
+	if userID == "" {
+		return visible, true
+	}
+	return project.Or(
+		visible,
+		project.HasOwnerWith(user.ID(userID)),
+		project.HasMembershipsWith(projectmember.UserID(userID)),
+		project.HasInvitationsWith(
+			projectinvitation.InviteeID(userID),
+			projectinvitation.StatusEQ(projectinvitation.StatusPending),
+		),
+	), true
+}
@@ -0,0 +1,212 @@
+// The tests run against the Project schema, which imports this package, so they are external
+package visibility_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectinvitation"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/testdb"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/dashboard"
+	"github.com/jorge-j1m/hackspark_server/internal/service/search"
+)
+
+// fixture is a project of every visibility and draft state, and a deleted one, all owned by the
+// same user, with a member and a pending invitee on every project
+type fixture struct {
+	client *ent.Client
+
+	owner, member, invitee, declined, stranger *ent.User
+	// projects by name: the visibility, with a -draft suffix for drafts, or deleted
+	projects map[string]*ent.Project
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	client := testdb.Open(t)
+	ctx := visibility.System(context.Background())
+
+	f := &fixture{
+		client:   client,
+		owner:    testdb.User(t, client, "owner"),
+		member:   testdb.User(t, client, "member"),
+		invitee:  testdb.User(t, client, "invitee"),
+		declined: testdb.User(t, client, "declined"),
+		stranger: testdb.User(t, client, "stranger"),
+		projects: make(map[string]*ent.Project),
+	}
+
+	add := func(name string, v project.Visibility, draft bool) *ent.Project {
+		// Every project matches the same search, so search results can be compared with lists
+		p := testdb.Project(t, client, f.owner.ID, "Rocket "+name, func(c *ent.ProjectCreate) {
+			c.SetVisibility(v).SetDraft(draft)
+		})
+		client.ProjectMember.Create().SetProjectID(p.ID).SetUserID(f.owner.ID).SetRole(projectmember.RoleOwner).ExecX(ctx)
+		client.ProjectMember.Create().SetProjectID(p.ID).SetUserID(f.member.ID).SetRole(projectmember.RoleContributor).ExecX(ctx)
+		client.ProjectInvitation.Create().SetProjectID(p.ID).SetInviteeID(f.invitee.ID).SetInviterID(f.owner.ID).ExecX(ctx)
+		client.ProjectInvitation.Create().SetProjectID(p.ID).SetInviteeID(f.declined.ID).SetInviterID(f.owner.ID).
+			SetStatus(projectinvitation.StatusDeclined).ExecX(ctx)
+		f.projects[name] = p
+		return p
+	}
+	for _, v := range []project.Visibility{project.VisibilityPublic, project.VisibilityUnlisted, project.VisibilityPrivate} {
+		add(string(v), v, false)
+		add(string(v)+"-draft", v, true)
+	}
+	deleted := add("deleted", project.VisibilityPublic, false)
+	client.Project.DeleteOne(deleted).ExecX(ctx)
+	return f
+}
+
+// names returns the sorted names of the fixture projects among the projects
+func (f *fixture) names(projects []*ent.Project) []string {
+	var names []string
+	for name, p := range f.projects {
+		for _, found := range projects {
+			if found.ID == p.ID {
+				names = append(names, name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func TestFilter(t *testing.T) {
+	f := newFixture(t)
+	bg := context.Background()
+
+	listed := []string{"public"}
+	everything := []string{"private", "private-draft", "public", "public-draft", "unlisted", "unlisted-draft"}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{name: "anonymous", ctx: bg, want: listed},
+		{name: "anonymous by ID", ctx: visibility.WithUnlisted(bg), want: []string{"public", "unlisted"}},
+		{name: "anonymous in a list by ID", ctx: visibility.WithoutUnlisted(visibility.WithUnlisted(bg)), want: listed},
+		{name: "stranger", ctx: visibility.WithViewer(bg, f.stranger.ID), want: listed},
+		{name: "stranger by ID", ctx: visibility.WithUnlisted(visibility.WithViewer(bg, f.stranger.ID)), want: []string{"public", "unlisted"}},
+		{name: "declined invitee", ctx: visibility.WithViewer(bg, f.declined.ID), want: listed},
+		{name: "owner", ctx: visibility.WithViewer(bg, f.owner.ID), want: everything},
+		{name: "member", ctx: visibility.WithViewer(bg, f.member.ID), want: everything},
+		{name: "invitee", ctx: visibility.WithViewer(bg, f.invitee.ID), want: everything},
+		{name: "system", ctx: visibility.System(bg), want: everything},
+		{name: "owner with deleted", ctx: visibility.IncludeDeleted(visibility.WithViewer(bg, f.owner.ID)), want: append([]string{"deleted"}, everything...)},
+		{name: "anonymous with deleted", ctx: visibility.IncludeDeleted(bg), want: listed},
+		{name: "system with deleted", ctx: visibility.IncludeDeleted(visibility.System(bg)), want: append([]string{"deleted"}, everything...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projects, err := f.client.Project.Query().All(tt.ctx)
+			if err != nil {
+				t.Fatalf("querying projects: %v", err)
+			}
+			if got := f.names(projects); !slices.Equal(got, tt.want) {
+				t.Errorf("projects = %v, want %v", got, tt.want)
+			}
+
+			// Traversals and eager loading go through the same filter
+			owned, err := f.client.User.QueryOwnedProjects(f.owner).All(tt.ctx)
+			if err != nil {
+				t.Fatalf("traversing projects: %v", err)
+			}
+			if got := f.names(owned); !slices.Equal(got, tt.want) {
+				t.Errorf("traversed projects = %v, want %v", got, tt.want)
+			}
+			owner, err := f.client.User.Query().Where(user.ID(f.owner.ID)).WithOwnedProjects().Only(tt.ctx)
+			if err != nil {
+				t.Fatalf("loading projects: %v", err)
+			}
+			if got := f.names(owner.Edges.OwnedProjects); !slices.Equal(got, tt.want) {
+				t.Errorf("loaded projects = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Raw queries skip the interceptor, the lists they make must still agree with it
+func TestRawQueriesAgreeWithFilter(t *testing.T) {
+	f := newFixture(t)
+	bg := context.Background()
+
+	anonymous, err := f.client.Project.Query().All(bg)
+	if err != nil {
+		t.Fatalf("querying projects: %v", err)
+	}
+	want := f.names(anonymous)
+
+	listed, err := f.client.Project.Query().Where(visibility.Listed()).All(visibility.System(bg))
+	if err != nil {
+		t.Fatalf("querying listed projects: %v", err)
+	}
+	if got := f.names(listed); !slices.Equal(got, want) {
+		t.Errorf("Listed() = %v, the interceptor lets anonymous viewers see %v", got, want)
+	}
+
+	t.Run("search", func(t *testing.T) {
+		hits, err := search.New(f.client).Search(bg, search.TypeProject, "rocket", 50, 0)
+		if err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+		var found []*ent.Project
+		for _, hit := range hits {
+			found = append(found, &ent.Project{ID: hit.ID})
+		}
+		if got := f.names(found); !slices.Equal(got, want) {
+			t.Errorf("search found %v, want %v", got, want)
+		}
+
+		facets, err := search.New(f.client).Facets(bg, "rocket")
+		if err != nil {
+			t.Fatalf("Facets() error = %v", err)
+		}
+		if facets[search.TypeProject] != len(want) {
+			t.Errorf("facet = %d, want %d", facets[search.TypeProject], len(want))
+		}
+	})
+
+	t.Run("dashboard", func(t *testing.T) {
+		// The owner sees every project of theirs but the deleted one, and so do the likes counted
+		// by the dashboard
+		ctx := visibility.WithViewer(bg, f.owner.ID)
+		owned, err := f.client.Project.Query().All(ctx)
+		if err != nil {
+			t.Fatalf("querying projects: %v", err)
+		}
+		for _, p := range f.projects {
+			f.client.Like.Create().SetUserID(f.stranger.ID).SetProjectID(p.ID).ExecX(visibility.IncludeDeleted(visibility.System(bg)))
+		}
+
+		d, err := dashboard.Build(ctx, f.client, f.owner, time.Now())
+		if err != nil {
+			t.Fatalf("Build() error = %v", err)
+		}
+		var projects []*ent.Project
+		for _, trend := range d.Projects {
+			projects = append(projects, trend.Project)
+			if trend.LikesCurrent != 1 {
+				t.Errorf("project %s has %d likes, want 1", trend.Project.Name, trend.LikesCurrent)
+			}
+		}
+		if got, want := f.names(projects), f.names(owned); !slices.Equal(got, want) {
+			t.Errorf("dashboard projects = %v, want %v", got, want)
+		}
+		likes := 0
+		for _, day := range d.LikesHistory {
+			likes += day.Count
+		}
+		if likes != len(owned) {
+			t.Errorf("likes history counts %d likes, want %d", likes, len(owned))
+		}
+	})
+}
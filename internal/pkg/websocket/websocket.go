@@ -0,0 +1,300 @@
+// Package websocket implements the server side of the WebSocket protocol (RFC 6455), as much
+// of it as streaming updates needs: text messages out, small control messages in.
+package websocket
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// acceptGUID is appended to the client key to compute the handshake response
+	acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+	// MaxMessageSize bounds the messages read from clients
+	MaxMessageSize = 4096
+
+	// writeTimeout bounds the time spent writing a frame to a client that stopped reading
+	writeTimeout = 10 * time.Second
+
+	// maxControlPayload bounds the payload of control frames, which cannot be fragmented
+	maxControlPayload = 125
+)
+
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xA
+)
+
+// Close codes
+const (
+	CloseNormal          = 1000
+	CloseGoingAway       = 1001
+	CloseProtocolError   = 1002
+	CloseMessageTooLarge = 1009
+)
+
+var (
+	// ErrClosed is returned when reading from a connection the client closed
+	ErrClosed = errors.New("websocket: connection closed")
+
+	errProtocol = errors.New("websocket: protocol error")
+	errTooLarge = errors.New("websocket: message too large")
+)
+
+// IsUpgrade reports whether the request asks to switch to the WebSocket protocol
+func IsUpgrade(r *http.Request) bool {
+	return headerHasToken(r.Header, "Connection", "upgrade") && headerHasToken(r.Header, "Upgrade", "websocket")
+}
+
+// Conn is a WebSocket connection. Writes may happen from any goroutine, reads from a single one.
+type Conn struct {
+	conn net.Conn
+	rw   *bufio.ReadWriter
+
+	wmu    sync.Mutex
+	closed bool
+}
+
+// Upgrade completes the WebSocket handshake, taking the connection over from the HTTP server.
+// On failure the error response has already been written.
+func Upgrade(w http.ResponseWriter, r *http.Request) (*Conn, error) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if r.Method != http.MethodGet || !IsUpgrade(r) || key == "" {
+		http.Error(w, "Bad WebSocket handshake", http.StatusBadRequest)
+		return nil, fmt.Errorf("%w: bad handshake", errProtocol)
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "Unsupported WebSocket version", http.StatusUpgradeRequired)
+		return nil, fmt.Errorf("%w: unsupported version", errProtocol)
+	}
+
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "WebSocket not supported", http.StatusInternalServerError)
+		return nil, errors.New("websocket: response does not support hijacking")
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return nil, fmt.Errorf("websocket: hijacking connection: %w", err)
+	}
+	// The HTTP server may have set deadlines meant for regular requests
+	_ = conn.SetDeadline(time.Time{})
+
+	sum := sha1.Sum([]byte(key + acceptGUID))
+	fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n",
+		base64.StdEncoding.EncodeToString(sum[:]))
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("websocket: writing handshake: %w", err)
+	}
+
+	return &Conn{conn: conn, rw: rw}, nil
+}
+
+// WriteText sends a text message
+func (c *Conn) WriteText(data []byte) error {
+	return c.writeFrame(opText, data)
+}
+
+// Ping sends a ping, which clients answer with a pong
+func (c *Conn) Ping() error {
+	return c.writeFrame(opPing, nil)
+}
+
+// Close sends a close frame with the code and closes the connection
+func (c *Conn) Close(code int, reason string) error {
+	payload := make([]byte, 2, 2+len(reason))
+	binary.BigEndian.PutUint16(payload, uint16(code))
+	payload = append(payload, reason...)
+	_ = c.writeFrame(opClose, payload)
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	c.closed = true
+	return c.conn.Close()
+}
+
+// ReadMessage returns the next text or binary message from the client, answering pings along
+// the way. It returns ErrClosed once the client closes the connection. Protocol violations and
+// messages over MaxMessageSize close the connection with the matching code.
+func (c *Conn) ReadMessage() ([]byte, error) {
+	message, err := c.readMessage()
+	if errors.Is(err, errTooLarge) {
+		_ = c.Close(CloseMessageTooLarge, "")
+	} else if errors.Is(err, errProtocol) {
+		_ = c.Close(CloseProtocolError, "")
+	}
+	return message, err
+}
+
+func (c *Conn) readMessage() ([]byte, error) {
+	var message []byte
+	for {
+		fin, op, payload, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+
+		switch op {
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			// The close is answered with the code of the client, if it gave one
+			code := CloseNormal
+			switch {
+			case len(payload) == 1:
+				return nil, fmt.Errorf("%w: truncated close code", errProtocol)
+			case len(payload) >= 2:
+				code = int(binary.BigEndian.Uint16(payload))
+				if !validCloseCode(code) {
+					return nil, fmt.Errorf("%w: invalid close code %d", errProtocol, code)
+				}
+			}
+			_ = c.Close(code, "")
+			return nil, ErrClosed
+		case opText, opBinary:
+			if message != nil {
+				return nil, fmt.Errorf("%w: unfinished fragmented message", errProtocol)
+			}
+			message = payload
+		case opContinuation:
+			if message == nil {
+				return nil, fmt.Errorf("%w: unexpected continuation", errProtocol)
+			}
+			if len(message)+len(payload) > MaxMessageSize {
+				return nil, errTooLarge
+			}
+			message = append(message, payload...)
+		default:
+			return nil, fmt.Errorf("%w: unknown opcode %d", errProtocol, op)
+		}
+
+		if fin {
+			return message, nil
+		}
+	}
+}
+
+func (c *Conn) readFrame() (fin bool, op byte, payload []byte, err error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.rw, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+	fin = header[0]&0x80 != 0
+	op = header[0] & 0x0F
+	masked := header[1]&0x80 != 0
+	length := uint64(header[1] & 0x7F)
+
+	// Clients must mask every frame
+	if !masked || header[0]&0x70 != 0 {
+		return false, 0, nil, fmt.Errorf("%w: unmasked frame or reserved bits set", errProtocol)
+	}
+	// Control frames may come between the fragments of a message, so they must fit in one frame
+	if op&0x8 != 0 && (!fin || length > maxControlPayload) {
+		return false, 0, nil, fmt.Errorf("%w: fragmented or oversized control frame", errProtocol)
+	}
+
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.rw, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.rw, ext[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if length > MaxMessageSize {
+		return false, 0, nil, errTooLarge
+	}
+
+	var mask [4]byte
+	if _, err := io.ReadFull(c.rw, mask[:]); err != nil {
+		return false, 0, nil, err
+	}
+	payload = make([]byte, length)
+	if _, err := io.ReadFull(c.rw, payload); err != nil {
+		return false, 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return fin, op, payload, nil
+}
+
+func (c *Conn) writeFrame(op byte, payload []byte) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	if c.closed {
+		return ErrClosed
+	}
+	_ = c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+
+	header := []byte{0x80 | op}
+	switch n := len(payload); {
+	case n < 126:
+		header = append(header, byte(n))
+	case n <= 0xFFFF:
+		header = append(header, 126)
+		header = binary.BigEndian.AppendUint16(header, uint16(n))
+	default:
+		header = append(header, 127)
+		header = binary.BigEndian.AppendUint64(header, uint64(n))
+	}
+
+	if _, err := c.rw.Write(header); err != nil {
+		return err
+	}
+	if _, err := c.rw.Write(payload); err != nil {
+		return err
+	}
+	return c.rw.Flush()
+}
+
+// validCloseCode reports whether a peer may send the close code: a defined one or one left to
+// libraries and applications, never those reserved for reporting a connection state
+func validCloseCode(code int) bool {
+	switch {
+	case code >= 1000 && code <= 1003, code >= 1007 && code <= 1014:
+		return true
+	default:
+		return code >= 3000 && code <= 4999
+	}
+}
+
+func headerHasToken(h http.Header, name, token string) bool {
+	for _, v := range h.Values(name) {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
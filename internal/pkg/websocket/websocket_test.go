@@ -0,0 +1,354 @@
+package websocket
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// frame is a frame as seen on the wire
+type frame struct {
+	fin     bool
+	op      byte
+	payload []byte
+}
+
+// pipe returns a connection over an in-memory pipe, and the client end of the pipe
+func pipe(t *testing.T) (*Conn, net.Conn) {
+	t.Helper()
+	server, client := net.Pipe()
+	t.Cleanup(func() {
+		server.Close()
+		client.Close()
+	})
+	_ = client.SetDeadline(time.Now().Add(5 * time.Second))
+	return &Conn{conn: server, rw: bufio.NewReadWriter(bufio.NewReader(server), bufio.NewWriter(server))}, client
+}
+
+// encodeClientFrame encodes a frame the way clients send them, masked
+func encodeClientFrame(f frame) []byte {
+	b0 := f.op
+	if f.fin {
+		b0 |= 0x80
+	}
+	buf := []byte{b0}
+	switch n := len(f.payload); {
+	case n < 126:
+		buf = append(buf, 0x80|byte(n))
+	case n <= 0xFFFF:
+		buf = append(buf, 0x80|126)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(n))
+	default:
+		buf = append(buf, 0x80|127)
+		buf = binary.BigEndian.AppendUint64(buf, uint64(n))
+	}
+	mask := [4]byte{0x12, 0x34, 0x56, 0x78}
+	buf = append(buf, mask[:]...)
+	for i, b := range f.payload {
+		buf = append(buf, b^mask[i%4])
+	}
+	return buf
+}
+
+// readServerFrame reads a frame sent by the server, which must not be masked
+func readServerFrame(t *testing.T, r io.Reader) frame {
+	t.Helper()
+	var header [2]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		t.Fatalf("reading frame header: %v", err)
+	}
+	if header[1]&0x80 != 0 {
+		t.Fatal("server frame is masked")
+	}
+	length := uint64(header[1] & 0x7F)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			t.Fatalf("reading frame length: %v", err)
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			t.Fatalf("reading frame length: %v", err)
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		t.Fatalf("reading frame payload: %v", err)
+	}
+	return frame{fin: header[0]&0x80 != 0, op: header[0] & 0x0F, payload: payload}
+}
+
+// send writes the frames from the client in the background, since pipe writes block until read
+func send(client net.Conn, frames ...frame) {
+	var buf []byte
+	for _, f := range frames {
+		buf = append(buf, encodeClientFrame(f)...)
+	}
+	go func() { _, _ = client.Write(buf) }()
+}
+
+func closeCode(t *testing.T, f frame) int {
+	t.Helper()
+	if f.op != opClose || len(f.payload) < 2 {
+		t.Fatalf("frame = op %d with %d bytes, want a close frame with a code", f.op, len(f.payload))
+	}
+	return int(binary.BigEndian.Uint16(f.payload))
+}
+
+func TestUpgrade(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := Upgrade(w, r)
+		if err != nil {
+			return
+		}
+		defer conn.Close(CloseNormal, "")
+		msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		_ = conn.WriteText(append([]byte("echo: "), msg...))
+	}))
+	defer srv.Close()
+
+	t.Run("rejects plain requests", func(t *testing.T) {
+		res, err := http.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("GET error = %v", err)
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusBadRequest {
+			t.Errorf("status = %d, want %d", res.StatusCode, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("rejects other versions", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Upgrade", "websocket")
+		req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+		req.Header.Set("Sec-WebSocket-Version", "8")
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("GET error = %v", err)
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusUpgradeRequired || res.Header.Get("Sec-WebSocket-Version") != "13" {
+			t.Errorf("status = %d with version %q, want %d with 13", res.StatusCode, res.Header.Get("Sec-WebSocket-Version"), http.StatusUpgradeRequired)
+		}
+	})
+
+	t.Run("switches protocols", func(t *testing.T) {
+		conn, err := net.Dial("tcp", strings.TrimPrefix(srv.URL, "http://"))
+		if err != nil {
+			t.Fatalf("dial error = %v", err)
+		}
+		defer conn.Close()
+		_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+		// The key and accept value of the example of RFC 6455
+		_, err = io.WriteString(conn, "GET / HTTP/1.1\r\nHost: example.com\r\nConnection: keep-alive, Upgrade\r\n"+
+			"Upgrade: websocket\r\nSec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\nSec-WebSocket-Version: 13\r\n\r\n")
+		if err != nil {
+			t.Fatalf("writing handshake: %v", err)
+		}
+		r := bufio.NewReader(conn)
+		res, err := http.ReadResponse(r, nil)
+		if err != nil {
+			t.Fatalf("reading handshake: %v", err)
+		}
+		if res.StatusCode != http.StatusSwitchingProtocols {
+			t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusSwitchingProtocols)
+		}
+		if got := res.Header.Get("Sec-WebSocket-Accept"); got != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+			t.Errorf("Sec-WebSocket-Accept = %q, want s3pPLMBiTxaQ9kYGzzhZRbK+xOo=", got)
+		}
+
+		if _, err := conn.Write(encodeClientFrame(frame{fin: true, op: opText, payload: []byte("hello")})); err != nil {
+			t.Fatalf("writing frame: %v", err)
+		}
+		f := readServerFrame(t, r)
+		if !f.fin || f.op != opText || string(f.payload) != "echo: hello" {
+			t.Errorf("frame = %+v, want the echoed text", f)
+		}
+		if code := closeCode(t, readServerFrame(t, r)); code != CloseNormal {
+			t.Errorf("close code = %d, want %d", code, CloseNormal)
+		}
+	})
+}
+
+func TestWriteText(t *testing.T) {
+	// Lengths on both sides of the 7-bit, 16-bit and 64-bit length encodings
+	for _, n := range []int{0, 125, 126, 0xFFFF, 0x10000} {
+		conn, client := pipe(t)
+		payload := bytes.Repeat([]byte("a"), n)
+
+		errc := make(chan error, 1)
+		go func() { errc <- conn.WriteText(payload) }()
+
+		f := readServerFrame(t, client)
+		if err := <-errc; err != nil {
+			t.Fatalf("WriteText(%d bytes) error = %v", n, err)
+		}
+		if !f.fin || f.op != opText || !bytes.Equal(f.payload, payload) {
+			t.Errorf("WriteText(%d bytes) sent fin=%t op=%d with %d bytes", n, f.fin, f.op, len(f.payload))
+		}
+	}
+}
+
+func TestReadMessage(t *testing.T) {
+	text := func(fin bool, s string) frame { return frame{fin: fin, op: opText, payload: []byte(s)} }
+	cont := func(fin bool, s string) frame { return frame{fin: fin, op: opContinuation, payload: []byte(s)} }
+
+	tests := []struct {
+		name   string
+		frames []frame
+		want   string
+	}{
+		{name: "single frame", frames: []frame{text(true, "hello")}, want: "hello"},
+		{name: "empty", frames: []frame{text(true, "")}, want: ""},
+		{name: "16-bit length", frames: []frame{text(true, strings.Repeat("b", 300))}, want: strings.Repeat("b", 300)},
+		{name: "fragments", frames: []frame{text(false, "hel"), cont(false, "l"), cont(true, "o")}, want: "hello"},
+		{name: "pong between fragments", frames: []frame{text(false, "hel"), {fin: true, op: opPong}, cont(true, "lo")}, want: "hello"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, client := pipe(t)
+			send(client, tt.frames...)
+			got, err := conn.ReadMessage()
+			if err != nil {
+				t.Fatalf("ReadMessage() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("ReadMessage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadMessageAnswersPings(t *testing.T) {
+	conn, client := pipe(t)
+
+	// The ping comes between the fragments of a message, its pong is sent before the message is read
+	go func() {
+		_, _ = client.Write(encodeClientFrame(frame{fin: false, op: opText, payload: []byte("hel")}))
+		_, _ = client.Write(encodeClientFrame(frame{fin: true, op: opPing, payload: []byte("are you there")}))
+	}()
+	type result struct {
+		msg []byte
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		msg, err := conn.ReadMessage()
+		done <- result{msg, err}
+	}()
+
+	f := readServerFrame(t, client)
+	if f.op != opPong || string(f.payload) != "are you there" {
+		t.Errorf("frame = op %d %q, want a pong with the payload of the ping", f.op, f.payload)
+	}
+	_, _ = client.Write(encodeClientFrame(frame{fin: true, op: opContinuation, payload: []byte("lo")}))
+
+	r := <-done
+	if r.err != nil || string(r.msg) != "hello" {
+		t.Errorf("ReadMessage() = %q, %v, want hello", r.msg, r.err)
+	}
+}
+
+func TestReadMessageEchoesClose(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload []byte
+		want    int
+	}{
+		{name: "without code", payload: nil, want: CloseNormal},
+		{name: "with code", payload: binary.BigEndian.AppendUint16(nil, CloseGoingAway), want: CloseGoingAway},
+		{name: "with reason", payload: append(binary.BigEndian.AppendUint16(nil, 4000), "bye"...), want: 4000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, client := pipe(t)
+			send(client, frame{fin: true, op: opClose, payload: tt.payload})
+
+			errc := make(chan error, 1)
+			go func() {
+				_, err := conn.ReadMessage()
+				errc <- err
+			}()
+			if code := closeCode(t, readServerFrame(t, client)); code != tt.want {
+				t.Errorf("close code = %d, want %d", code, tt.want)
+			}
+			if err := <-errc; !errors.Is(err, ErrClosed) {
+				t.Errorf("ReadMessage() error = %v, want ErrClosed", err)
+			}
+			if err := conn.WriteText([]byte("late")); !errors.Is(err, ErrClosed) {
+				t.Errorf("WriteText() after close error = %v, want ErrClosed", err)
+			}
+		})
+	}
+}
+
+func TestReadMessageRejects(t *testing.T) {
+	unmasked := []byte{0x80 | opText, 2, 'h', 'i'}
+
+	tests := []struct {
+		name     string
+		raw      []byte
+		wantCode int
+		wantErr  error
+	}{
+		{name: "unmasked frame", raw: unmasked, wantCode: CloseProtocolError, wantErr: errProtocol},
+		{name: "reserved bits", raw: append([]byte{0xC0 | opText}, encodeClientFrame(frame{fin: true, op: opText})[1:]...), wantCode: CloseProtocolError, wantErr: errProtocol},
+		{name: "unknown opcode", raw: encodeClientFrame(frame{fin: true, op: 0x3}), wantCode: CloseProtocolError, wantErr: errProtocol},
+		{name: "fragmented control frame", raw: encodeClientFrame(frame{fin: false, op: opPing}), wantCode: CloseProtocolError, wantErr: errProtocol},
+		{name: "oversized control frame", raw: encodeClientFrame(frame{fin: true, op: opPing, payload: make([]byte, 126)}), wantCode: CloseProtocolError, wantErr: errProtocol},
+		{name: "truncated close code", raw: encodeClientFrame(frame{fin: true, op: opClose, payload: []byte{0x03}}), wantCode: CloseProtocolError, wantErr: errProtocol},
+		{name: "reserved close code", raw: encodeClientFrame(frame{fin: true, op: opClose, payload: binary.BigEndian.AppendUint16(nil, 1005)}), wantCode: CloseProtocolError, wantErr: errProtocol},
+		{name: "unexpected continuation", raw: encodeClientFrame(frame{fin: true, op: opContinuation, payload: []byte("x")}), wantCode: CloseProtocolError, wantErr: errProtocol},
+		{
+			name: "message inside a fragmented message",
+			raw: append(encodeClientFrame(frame{fin: false, op: opText, payload: []byte("a")}),
+				encodeClientFrame(frame{fin: true, op: opText, payload: []byte("b")})...),
+			wantCode: CloseProtocolError,
+			wantErr:  errProtocol,
+		},
+		{name: "frame too large", raw: encodeClientFrame(frame{fin: true, op: opText, payload: make([]byte, MaxMessageSize+1)})[:14], wantCode: CloseMessageTooLarge, wantErr: errTooLarge},
+		{
+			name: "fragments too large",
+			raw: append(encodeClientFrame(frame{fin: false, op: opText, payload: make([]byte, MaxMessageSize)}),
+				encodeClientFrame(frame{fin: true, op: opContinuation, payload: []byte("x")})...),
+			wantCode: CloseMessageTooLarge,
+			wantErr:  errTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, client := pipe(t)
+			go func() { _, _ = client.Write(tt.raw) }()
+
+			errc := make(chan error, 1)
+			go func() {
+				_, err := conn.ReadMessage()
+				errc <- err
+			}()
+			if code := closeCode(t, readServerFrame(t, client)); code != tt.wantCode {
+				t.Errorf("close code = %d, want %d", code, tt.wantCode)
+			}
+			if err := <-errc; !errors.Is(err, tt.wantErr) {
+				t.Errorf("ReadMessage() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
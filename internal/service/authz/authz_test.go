@@ -0,0 +1,152 @@
+package authz
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmember"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/testdb"
+)
+
+func TestAllows(t *testing.T) {
+	actions := []Action{
+		ActionEditProject, ActionChangeStatus, ActionChangeVisibility, ActionDeleteProject, ActionEditPlan,
+		ActionModerateComments, ActionInviteMembers, ActionManageRoles, ActionManageMembers,
+		ActionTransferOwnership, ActionManageWebhooks,
+	}
+	// What maintainers and contributors may do, owners may do everything and strangers nothing
+	maintainer := map[Action]bool{
+		ActionEditProject: true, ActionChangeStatus: true, ActionEditPlan: true, ActionModerateComments: true,
+		ActionInviteMembers: true, ActionManageRoles: true,
+	}
+	contributor := map[Action]bool{ActionEditPlan: true}
+
+	for _, action := range actions {
+		tests := []struct {
+			role projectmember.Role
+			want bool
+		}{
+			{role: projectmember.RoleOwner, want: true},
+			{role: projectmember.RoleMaintainer, want: maintainer[action]},
+			{role: projectmember.RoleContributor, want: contributor[action]},
+			{role: "", want: false},
+			{role: "admin", want: false},
+		}
+		for _, tt := range tests {
+			if got := Allows(tt.role, action); got != tt.want {
+				t.Errorf("Allows(%q, %s) = %v, want %v", tt.role, action, got, tt.want)
+			}
+		}
+	}
+
+	// Every action is granted to someone, so none is left out of the table above
+	for role, granted := range rolePermissions {
+		for _, action := range granted {
+			found := false
+			for _, a := range actions {
+				found = found || a == action
+			}
+			if !found {
+				t.Errorf("role %s grants %s, missing from the test", role, action)
+			}
+		}
+	}
+}
+
+func TestRoleOf(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+	a := New(client)
+
+	owner := testdb.User(t, client, "owner")
+	formerOwner := testdb.User(t, client, "former")
+	maintainer := testdb.User(t, client, "maintainer")
+	contributor := testdb.User(t, client, "contributor")
+	stranger := testdb.User(t, client, "stranger")
+
+	p := testdb.Project(t, client, owner.ID, "Rocket", nil)
+	member := func(userID string, role projectmember.Role) {
+		client.ProjectMember.Create().SetProjectID(p.ID).SetUserID(userID).SetRole(role).ExecX(ctx)
+	}
+	// The owner membership is out of date: the project was transferred, the owner edge says who
+	// owns it now
+	member(owner.ID, projectmember.RoleContributor)
+	member(formerOwner.ID, projectmember.RoleOwner)
+	member(maintainer.ID, projectmember.RoleMaintainer)
+	member(contributor.ID, projectmember.RoleContributor)
+
+	tests := []struct {
+		name   string
+		userID string
+		want   projectmember.Role
+	}{
+		{name: "owner edge wins over membership", userID: owner.ID, want: projectmember.RoleOwner},
+		{name: "stale owner membership", userID: formerOwner.ID, want: projectmember.RoleMaintainer},
+		{name: "maintainer", userID: maintainer.ID, want: projectmember.RoleMaintainer},
+		{name: "contributor", userID: contributor.ID, want: projectmember.RoleContributor},
+		{name: "not a member", userID: stranger.ID, want: ""},
+		{name: "anonymous", userID: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// With and without the owner loaded
+			withOwner := client.Project.Query().Where(project.ID(p.ID)).WithOwner().OnlyX(ctx)
+			for _, loaded := range []*ent.Project{p, withOwner} {
+				got, err := a.RoleOf(ctx, loaded, tt.userID)
+				if err != nil {
+					t.Fatalf("RoleOf() error = %v", err)
+				}
+				if got != tt.want {
+					t.Errorf("RoleOf() = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+	a := New(client)
+
+	owner := testdb.User(t, client, "owner")
+	maintainer := testdb.User(t, client, "maintainer")
+	stranger := testdb.User(t, client, "stranger")
+	p := testdb.Project(t, client, owner.ID, "Rocket", nil)
+	client.ProjectMember.Create().SetProjectID(p.ID).SetUserID(owner.ID).SetRole(projectmember.RoleOwner).ExecX(ctx)
+	client.ProjectMember.Create().SetProjectID(p.ID).SetUserID(maintainer.ID).SetRole(projectmember.RoleMaintainer).ExecX(ctx)
+
+	tests := []struct {
+		name      string
+		projectID string
+		userID    string
+		action    Action
+		want      error
+	}{
+		{name: "owner", projectID: p.ID, userID: owner.ID, action: ActionTransferOwnership},
+		{name: "maintainer allowed", projectID: p.ID, userID: maintainer.ID, action: ActionEditProject},
+		{name: "maintainer not allowed", projectID: p.ID, userID: maintainer.ID, action: ActionDeleteProject, want: errors.ErrForbidden},
+		{name: "stranger", projectID: p.ID, userID: stranger.ID, action: ActionEditProject, want: errors.ErrForbidden},
+		{name: "anonymous", projectID: p.ID, userID: "", action: ActionEditProject, want: errors.ErrForbidden},
+		{name: "unknown project", projectID: "proj_unknown", userID: owner.ID, action: ActionEditProject, want: errors.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.Authorize(ctx, tt.projectID, tt.userID, tt.action)
+			if !stderrors.Is(err, tt.want) {
+				t.Fatalf("Authorize() error = %v, want %v", err, tt.want)
+			}
+			if tt.want == nil && (got == nil || got.ID != p.ID || got.Edges.Owner == nil) {
+				t.Errorf("Authorize() = %+v, want the project with its owner", got)
+			}
+			// Handlers respond with the error as it is
+			if tt.want != nil && errors.AsAppError(err) != tt.want {
+				t.Errorf("AsAppError() = %v, want %v", errors.AsAppError(err), tt.want)
+			}
+		})
+	}
+}
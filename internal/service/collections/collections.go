@@ -0,0 +1,195 @@
+// Package collections keeps the items of collections in order.
+//
+// The items of a collection are numbered from 0 without gaps. Every change to them locks the row
+// of the collection first, so that concurrent changes apply one after the other and keep the
+// numbering dense, whether they come from the owner or from projects being purged.
+package collections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/collection"
+	"github.com/jorge-j1m/hackspark_server/ent/collectionitem"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+)
+
+// MaxItems bounds the number of projects in a collection, so collections are returned whole
+const MaxItems = 100
+
+// Lock locks the collection until the end of the transaction
+func Lock(ctx context.Context, tx *ent.Tx, collectionID string) error {
+	_, err := tx.Collection.Query().
+		Where(collection.ID(collectionID)).
+		ForUpdate().
+		OnlyID(ctx)
+	return err
+}
+
+// Add inserts the project in the collection at the position, moving the items from there down
+// by one. The project goes last when position is nil or past the end.
+func Add(ctx context.Context, client *ent.Client, collectionID, projectID string, note *string, position *int) (*ent.CollectionItem, error) {
+	var item *ent.CollectionItem
+	err := database.WithTx(ctx, client, func(tx *ent.Tx) error {
+		if err := Lock(ctx, tx, collectionID); err != nil {
+			return err
+		}
+		items, err := tx.CollectionItem.Query().
+			Where(collectionitem.CollectionID(collectionID)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		if len(items) >= MaxItems {
+			return errors.ErrTooManyCollectionItems
+		}
+		for _, it := range items {
+			if it.ProjectID == projectID {
+				return errors.ErrProjectAlreadyInCollection
+			}
+		}
+
+		at := len(items)
+		if position != nil && *position < at {
+			at = max(*position, 0)
+			if err := tx.CollectionItem.Update().
+				Where(collectionitem.CollectionID(collectionID), collectionitem.PositionGTE(at)).
+				AddPosition(1).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		item, err = tx.CollectionItem.Create().
+			SetCollectionID(collectionID).
+			SetProjectID(projectID).
+			SetNillableNote(note).
+			SetPosition(at).
+			Save(ctx)
+		return err
+	})
+	if ent.IsConstraintError(err) {
+		return nil, errors.ErrProjectAlreadyInCollection
+	}
+	return item, err
+}
+
+// Remove removes the item from the collection and closes the gap it leaves. Removing an item
+// that is already gone does nothing.
+func Remove(ctx context.Context, client *ent.Client, collectionID, itemID string) error {
+	return database.WithTx(ctx, client, func(tx *ent.Tx) error {
+		if err := Lock(ctx, tx, collectionID); err != nil {
+			return err
+		}
+		// The position may have moved since the item was read
+		it, err := tx.CollectionItem.Query().
+			Where(collectionitem.ID(itemID), collectionitem.CollectionID(collectionID)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if err := tx.CollectionItem.DeleteOneID(it.ID).Exec(ctx); err != nil {
+			return err
+		}
+		return tx.CollectionItem.Update().
+			Where(collectionitem.CollectionID(collectionID), collectionitem.PositionGT(it.Position)).
+			AddPosition(-1).
+			Exec(ctx)
+	})
+}
+
+// Reorder puts the items of the collection in the order of ids, which must list every item of
+// the collection exactly once
+func Reorder(ctx context.Context, client *ent.Client, collectionID string, ids []string) error {
+	return database.WithTx(ctx, client, func(tx *ent.Tx) error {
+		if err := Lock(ctx, tx, collectionID); err != nil {
+			return err
+		}
+		existing, err := tx.CollectionItem.Query().
+			Where(collectionitem.CollectionID(collectionID)).
+			IDs(ctx)
+		if err != nil {
+			return err
+		}
+		if !samePermutation(existing, ids) {
+			return errors.ErrInvalidOrder
+		}
+
+		for i, id := range ids {
+			if err := tx.CollectionItem.UpdateOneID(id).SetPosition(i).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveProjects removes the projects from the collections holding them within the transaction,
+// and numbers the remaining items again without gaps
+func RemoveProjects(ctx context.Context, tx *ent.Tx, projectIDs []string) error {
+	collectionIDs, err := tx.CollectionItem.Query().
+		Where(collectionitem.ProjectIDIn(projectIDs...)).
+		Unique(true).
+		Select(collectionitem.FieldCollectionID).
+		Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("loading collections: %w", err)
+	}
+	if len(collectionIDs) == 0 {
+		return nil
+	}
+
+	// In ID order, so that concurrent removals cannot deadlock
+	if _, err := tx.Collection.Query().
+		Where(collection.IDIn(collectionIDs...)).
+		Order(ent.Asc(collection.FieldID)).
+		ForUpdate().
+		IDs(ctx); err != nil {
+		return fmt.Errorf("locking collections: %w", err)
+	}
+	if _, err := tx.CollectionItem.Delete().Where(collectionitem.ProjectIDIn(projectIDs...)).Exec(ctx); err != nil {
+		return fmt.Errorf("deleting collection items: %w", err)
+	}
+
+	for _, id := range collectionIDs {
+		items, err := tx.CollectionItem.Query().
+			Where(collectionitem.CollectionID(id)).
+			Order(ent.Asc(collectionitem.FieldPosition), ent.Asc(collectionitem.FieldCreateTime)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("loading items of collection %s: %w", id, err)
+		}
+		for i, it := range items {
+			if it.Position == i {
+				continue
+			}
+			if err := tx.CollectionItem.UpdateOneID(it.ID).SetPosition(i).Exec(ctx); err != nil {
+				return fmt.Errorf("moving item %s of collection %s: %w", it.ID, id, err)
+			}
+		}
+	}
+	return nil
+}
+
+// samePermutation reports whether ordered lists every ID of existing exactly once
+func samePermutation(existing, ordered []string) bool {
+	if len(existing) != len(ordered) {
+		return false
+	}
+	remaining := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		remaining[id] = true
+	}
+	for _, id := range ordered {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+	return true
+}
@@ -0,0 +1,337 @@
+package collections
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/collectionitem"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/testdb"
+)
+
+// fixture is a collection and projects to put in it, by name
+type fixture struct {
+	client     *ent.Client
+	collection *ent.Collection
+	projects   map[string]*ent.Project
+}
+
+func newFixture(t *testing.T, names ...string) *fixture {
+	t.Helper()
+	client := testdb.Open(t)
+	owner := testdb.User(t, client, "owner")
+
+	f := &fixture{
+		client:     client,
+		collection: newCollection(t, client, owner.ID),
+		projects:   make(map[string]*ent.Project),
+	}
+	for _, name := range names {
+		f.projects[name] = testdb.Project(t, client, owner.ID, name, nil)
+	}
+	return f
+}
+
+func newCollection(t *testing.T, client *ent.Client, ownerID string) *ent.Collection {
+	t.Helper()
+	c, err := client.Collection.Create().SetOwnerID(ownerID).SetName("Weekend ideas").Save(context.Background())
+	if err != nil {
+		t.Fatalf("creating collection: %v", err)
+	}
+	return c
+}
+
+// add adds the projects to the end of the collection
+func (f *fixture) add(t *testing.T, collectionID string, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		if _, err := Add(context.Background(), f.client, collectionID, f.projects[name].ID, nil, nil); err != nil {
+			t.Fatalf("Add(%s) error = %v", name, err)
+		}
+	}
+}
+
+// items returns the items of the collection in order, failing if they are not numbered from 0
+// without gaps
+func (f *fixture) items(t *testing.T, collectionID string) []*ent.CollectionItem {
+	t.Helper()
+	items, err := f.client.CollectionItem.Query().
+		Where(collectionitem.CollectionID(collectionID)).
+		Order(ent.Asc(collectionitem.FieldPosition)).
+		All(context.Background())
+	if err != nil {
+		t.Fatalf("loading items: %v", err)
+	}
+	for i, it := range items {
+		if it.Position != i {
+			t.Fatalf("item %d is at position %d", i, it.Position)
+		}
+	}
+	return items
+}
+
+// order returns the names of the projects of the collection in order
+func (f *fixture) order(t *testing.T, collectionID string) []string {
+	t.Helper()
+	var names []string
+	for _, it := range f.items(t, collectionID) {
+		for name, p := range f.projects {
+			if p.ID == it.ProjectID {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+func ids(items []*ent.CollectionItem) []string {
+	var ids []string
+	for _, it := range items {
+		ids = append(ids, it.ID)
+	}
+	return ids
+}
+
+func TestAdd(t *testing.T) {
+	f := newFixture(t, "A", "B", "C", "D", "E", "F")
+	ctx := context.Background()
+	c := f.collection.ID
+	f.add(t, c, "A", "B", "C")
+
+	tests := []struct {
+		name     string
+		position int
+		want     []string
+	}{
+		{name: "D", position: 1, want: []string{"A", "D", "B", "C"}},
+		{name: "E", position: 0, want: []string{"E", "A", "D", "B", "C"}},
+		{name: "F", position: 42, want: []string{"E", "A", "D", "B", "C", "F"}},
+	}
+	for _, tt := range tests {
+		item, err := Add(ctx, f.client, c, f.projects[tt.name].ID, nil, &tt.position)
+		if err != nil {
+			t.Fatalf("Add(%s, %d) error = %v", tt.name, tt.position, err)
+		}
+		if got := f.order(t, c); !slices.Equal(got, tt.want) {
+			t.Errorf("after adding %s at %d, order = %v, want %v", tt.name, tt.position, got, tt.want)
+		}
+		if want := min(tt.position, len(tt.want)-1); item.Position != want {
+			t.Errorf("%s added at %d, want %d", tt.name, item.Position, want)
+		}
+	}
+
+	// A project is only collected once, and a failed insertion moves nothing
+	zero := 0
+	if _, err := Add(ctx, f.client, c, f.projects["B"].ID, nil, &zero); !stderrors.Is(err, errors.ErrProjectAlreadyInCollection) {
+		t.Errorf("Add() of a collected project error = %v, want %v", err, errors.ErrProjectAlreadyInCollection)
+	}
+	if got, want := f.order(t, c), tests[len(tests)-1].want; !slices.Equal(got, want) {
+		t.Errorf("order after a failed insertion = %v, want %v", got, want)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	f := newFixture(t, "A", "B", "C", "D")
+	ctx := context.Background()
+	c := f.collection.ID
+	f.add(t, c, "A", "B", "C", "D")
+	items := f.items(t, c)
+
+	if err := Remove(ctx, f.client, c, items[1].ID); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if got, want := f.order(t, c), []string{"A", "C", "D"}; !slices.Equal(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+
+	// Removing again, or through another collection, changes nothing
+	other := newCollection(t, f.client, f.collection.OwnerID)
+	for _, collectionID := range []string{c, other.ID} {
+		if err := Remove(ctx, f.client, collectionID, items[1].ID); err != nil {
+			t.Errorf("Remove() of a removed item error = %v", err)
+		}
+	}
+	if err := Remove(ctx, f.client, other.ID, items[2].ID); err != nil {
+		t.Errorf("Remove() through another collection error = %v", err)
+	}
+	if got, want := f.order(t, c), []string{"A", "C", "D"}; !slices.Equal(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+
+	if err := Remove(ctx, f.client, c, items[3].ID); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if err := Remove(ctx, f.client, c, items[0].ID); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if got, want := f.order(t, c), []string{"C"}; !slices.Equal(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+}
+
+func TestReorder(t *testing.T) {
+	f := newFixture(t, "A", "B", "C", "X")
+	ctx := context.Background()
+	c := f.collection.ID
+	f.add(t, c, "A", "B", "C")
+	items := f.items(t, c)
+	a, b, cc := items[0].ID, items[1].ID, items[2].ID
+
+	// Moving the last item first
+	if err := Reorder(ctx, f.client, c, []string{cc, a, b}); err != nil {
+		t.Fatalf("Reorder() error = %v", err)
+	}
+	if got, want := f.order(t, c), []string{"C", "A", "B"}; !slices.Equal(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+
+	other := newCollection(t, f.client, f.collection.OwnerID)
+	f.add(t, other.ID, "X")
+	x := f.items(t, other.ID)[0].ID
+
+	invalid := map[string][]string{
+		"missing":   {a, b},
+		"repeated":  {a, b, b},
+		"unknown":   {a, b, "citem_unknown"},
+		"foreign":   {a, b, x},
+		"too many":  {a, b, cc, x},
+		"empty":     {},
+		"duplicate": {a, a, b, cc},
+	}
+	for name, order := range invalid {
+		if err := Reorder(ctx, f.client, c, order); !stderrors.Is(err, errors.ErrInvalidOrder) {
+			t.Errorf("Reorder() %s error = %v, want %v", name, err, errors.ErrInvalidOrder)
+		}
+	}
+	if got, want := f.order(t, c), []string{"C", "A", "B"}; !slices.Equal(got, want) {
+		t.Errorf("order after invalid reorders = %v, want %v", got, want)
+	}
+	if got := f.order(t, other.ID); !slices.Equal(got, []string{"X"}) {
+		t.Errorf("other collection = %v, want [X]", got)
+	}
+}
+
+// Deleted and purged projects leave the collections holding them
+func TestRemoveProjects(t *testing.T) {
+	f := newFixture(t, "A", "B", "C", "D")
+	ctx := context.Background()
+	c := f.collection.ID
+	other := newCollection(t, f.client, f.collection.OwnerID)
+	f.add(t, c, "A", "B", "C", "D")
+	f.add(t, other.ID, "D", "C", "A")
+
+	err := database.WithTx(ctx, f.client, func(tx *ent.Tx) error {
+		return RemoveProjects(ctx, tx, []string{f.projects["A"].ID, f.projects["C"].ID})
+	})
+	if err != nil {
+		t.Fatalf("RemoveProjects() error = %v", err)
+	}
+	if got, want := f.order(t, c), []string{"B", "D"}; !slices.Equal(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+	if got, want := f.order(t, other.ID), []string{"D"}; !slices.Equal(got, want) {
+		t.Errorf("other order = %v, want %v", got, want)
+	}
+
+	// Projects in no collection
+	err = database.WithTx(ctx, f.client, func(tx *ent.Tx) error {
+		return RemoveProjects(ctx, tx, []string{f.projects["A"].ID})
+	})
+	if err != nil {
+		t.Errorf("RemoveProjects() of projects in no collection error = %v", err)
+	}
+}
+
+// Changes made at the same time wait for each other on the lock of the collection, so none is
+// lost and positions stay dense
+func TestConcurrentChanges(t *testing.T) {
+	var names []string
+	for i := range 12 {
+		names = append(names, fmt.Sprintf("P%02d", i))
+	}
+	f := newFixture(t, names...)
+	ctx := context.Background()
+	c := f.collection.ID
+	f.add(t, c, names[:6]...)
+	items := f.items(t, c)
+
+	forward := ids(items)
+	backward := slices.Clone(forward)
+	slices.Reverse(backward)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 16)
+	run := func(fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- fn()
+		}()
+	}
+
+	t.Run("reorder", func(t *testing.T) {
+		for i := range 8 {
+			order := forward
+			if i%2 == 1 {
+				order = backward
+			}
+			run(func() error { return Reorder(ctx, f.client, c, order) })
+		}
+		wg.Wait()
+		for range 8 {
+			if err := <-errs; err != nil {
+				t.Fatalf("Reorder() error = %v", err)
+			}
+		}
+		// One of the orders wins whole, never a mix of both
+		got := ids(f.items(t, c))
+		if !slices.Equal(got, forward) && !slices.Equal(got, backward) {
+			t.Errorf("order = %v, want %v or %v", got, forward, backward)
+		}
+	})
+
+	t.Run("mixed", func(t *testing.T) {
+		zero := 0
+		for _, name := range names[6:] {
+			run(func() error {
+				_, err := Add(ctx, f.client, c, f.projects[name].ID, nil, &zero)
+				return err
+			})
+		}
+		run(func() error { return Remove(ctx, f.client, c, items[2].ID) })
+		run(func() error {
+			return database.WithTx(ctx, f.client, func(tx *ent.Tx) error {
+				return RemoveProjects(ctx, tx, []string{f.projects[names[4]].ID})
+			})
+		})
+		// The items changing under it, a reorder either applies whole or fails
+		run(func() error {
+			if err := Reorder(ctx, f.client, c, backward); !stderrors.Is(err, errors.ErrInvalidOrder) {
+				return err
+			}
+			return nil
+		})
+		wg.Wait()
+		for range 9 {
+			if err := <-errs; err != nil {
+				t.Fatalf("concurrent change error = %v", err)
+			}
+		}
+
+		got := f.order(t, c)
+		if len(got) != 10 {
+			t.Errorf("order = %v, want 10 projects", got)
+		}
+		for _, gone := range []string{names[2], names[4]} {
+			if slices.Contains(got, gone) {
+				t.Errorf("removed project %s still in %v", gone, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,236 @@
+// Package counters keeps the denormalized counters of projects and tags in line with the rows
+// they count. Counters are recounted rather than incremented, so recounting twice is harmless
+// and a job that runs again after a crash cannot skew them.
+package counters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/comment"
+	"github.com/jorge-j1m/hackspark_server/ent/like"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projecttag"
+	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/jobs"
+)
+
+const (
+	// RecountLikesJob recounts the likes of a project
+	RecountLikesJob jobs.Kind = "counters.recount_likes"
+
+	// RecountTagsJob recounts the projects using tags
+	RecountTagsJob jobs.Kind = "counters.recount_tags"
+)
+
+type likesPayload struct {
+	ProjectID string `json:"project_id"`
+}
+
+type tagsPayload struct {
+	TagIDs []string `json:"tag_ids"`
+}
+
+// Register sets the handlers of the counter jobs
+func Register(r *jobs.Runner, client *ent.Client) {
+	r.Register(RecountLikesJob, func(ctx context.Context, payload json.RawMessage) error {
+		p, err := jobs.Decode[likesPayload](payload)
+		if err != nil {
+			return err
+		}
+		return RecountLikes(ctx, client, p.ProjectID)
+	})
+	r.Register(RecountTagsJob, func(ctx context.Context, payload json.RawMessage) error {
+		p, err := jobs.Decode[tagsPayload](payload)
+		if err != nil {
+			return err
+		}
+		return RecountTags(ctx, client, p.TagIDs)
+	})
+}
+
+// EnqueueRecountLikes queues the recount of the likes of the project
+func EnqueueRecountLikes(ctx context.Context, tx *ent.Tx, projectID string) error {
+	return jobs.Enqueue(ctx, tx.Job, RecountLikesJob, likesPayload{ProjectID: projectID})
+}
+
+// EnqueueRecountTags queues the recount of the usage of the tags, if any
+func EnqueueRecountTags(ctx context.Context, tx *ent.Tx, tagIDs ...string) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	slices.Sort(tagIDs)
+	return jobs.Enqueue(ctx, tx.Job, RecountTagsJob, tagsPayload{TagIDs: slices.Compact(tagIDs)})
+}
+
+// EnqueueRecountProjectTags queues the recount of the usage of the tags of the project, e.g.
+// when it is deleted or restored
+func EnqueueRecountProjectTags(ctx context.Context, tx *ent.Tx, projectID string) error {
+	tagIDs, err := tx.ProjectTag.Query().
+		Where(projecttag.ProjectID(projectID)).
+		Select(projecttag.FieldTagID).
+		Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("loading tags of project %s: %w", projectID, err)
+	}
+	return EnqueueRecountTags(ctx, tx, tagIDs...)
+}
+
+// RecountLikes sets the like counter of the project to its number of likes
+func RecountLikes(ctx context.Context, client *ent.Client, projectID string) error {
+	ctx = visibility.IncludeDeleted(visibility.System(ctx))
+
+	count, err := client.Like.Query().
+		Where(like.ProjectID(projectID)).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("counting likes of project %s: %w", projectID, err)
+	}
+	if err := client.Project.Update().
+		Where(project.ID(projectID)).
+		SetLikeCount(count).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("updating like count of project %s: %w", projectID, err)
+	}
+	return nil
+}
+
+// RecountTags sets the usage counter of the tags to the number of projects using them
+func RecountTags(ctx context.Context, client *ent.Client, tagIDs []string) error {
+	ctx = visibility.IncludeDeleted(visibility.System(ctx))
+
+	for _, id := range tagIDs {
+		count, err := client.ProjectTag.Query().
+			Where(
+				projecttag.TagID(id),
+				projecttag.HasProjectWith(project.DeletedAtIsNil()),
+			).
+			Count(ctx)
+		if err != nil {
+			return fmt.Errorf("counting usage of tag %s: %w", id, err)
+		}
+		if err := client.Tag.Update().
+			Where(tag.ID(id)).
+			SetUsageCount(count).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("updating usage of tag %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// Rows of the counts grouped by the counted entity
+type (
+	projectCount struct {
+		ProjectID string `json:"project_id"`
+		Count     int    `json:"count"`
+	}
+	remixCount struct {
+		RemixedFromID string `json:"remixed_from_id"`
+		Count         int    `json:"count"`
+	}
+	tagCount struct {
+		TagID string `json:"tag_id"`
+		Count int    `json:"count"`
+	}
+)
+
+// Reconcile recounts every counter and fixes those that drifted, returning how many rows it
+// fixed. Counters drift when a change bypasses the jobs, e.g. when edited by hand.
+func Reconcile(ctx context.Context, client *ent.Client) (int, error) {
+	ctx = visibility.IncludeDeleted(visibility.System(ctx))
+
+	var likes, comments []projectCount
+	var remixes []remixCount
+	var usages []tagCount
+	if err := client.Like.Query().
+		GroupBy(like.FieldProjectID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &likes); err != nil {
+		return 0, fmt.Errorf("counting likes: %w", err)
+	}
+	if err := client.Comment.Query().
+		Where(comment.DeletedAtIsNil(), comment.Hidden(false)).
+		GroupBy(comment.FieldProjectID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &comments); err != nil {
+		return 0, fmt.Errorf("counting comments: %w", err)
+	}
+	if err := client.Project.Query().
+		Where(project.RemixedFromIDNotNil()).
+		GroupBy(project.FieldRemixedFromID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &remixes); err != nil {
+		return 0, fmt.Errorf("counting remixes: %w", err)
+	}
+	if err := client.ProjectTag.Query().
+		Where(projecttag.HasProjectWith(project.DeletedAtIsNil())).
+		GroupBy(projecttag.FieldTagID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &usages); err != nil {
+		return 0, fmt.Errorf("counting tag usage: %w", err)
+	}
+
+	likeCounts := make(map[string]int, len(likes))
+	for _, c := range likes {
+		likeCounts[c.ProjectID] = c.Count
+	}
+	commentCounts := make(map[string]int, len(comments))
+	for _, c := range comments {
+		commentCounts[c.ProjectID] = c.Count
+	}
+	remixCounts := make(map[string]int, len(remixes))
+	for _, c := range remixes {
+		remixCounts[c.RemixedFromID] = c.Count
+	}
+	usageCounts := make(map[string]int, len(usages))
+	for _, c := range usages {
+		usageCounts[c.TagID] = c.Count
+	}
+
+	projects, err := client.Project.Query().
+		Select(project.FieldID, project.FieldLikeCount, project.FieldCommentCount, project.FieldRemixCount).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("loading project counters: %w", err)
+	}
+
+	fixed := 0
+	for _, p := range projects {
+		l, c, r := likeCounts[p.ID], commentCounts[p.ID], remixCounts[p.ID]
+		if p.LikeCount == l && p.CommentCount == c && p.RemixCount == r {
+			continue
+		}
+		if err := client.Project.Update().
+			Where(project.ID(p.ID)).
+			SetLikeCount(l).
+			SetCommentCount(c).
+			SetRemixCount(r).
+			Exec(ctx); err != nil {
+			return fixed, fmt.Errorf("fixing counters of project %s: %w", p.ID, err)
+		}
+		fixed++
+	}
+
+	tags, err := client.Tag.Query().
+		Select(tag.FieldID, tag.FieldUsageCount).
+		All(ctx)
+	if err != nil {
+		return fixed, fmt.Errorf("loading tag counters: %w", err)
+	}
+	for _, t := range tags {
+		if t.UsageCount == usageCounts[t.ID] {
+			continue
+		}
+		if err := client.Tag.UpdateOneID(t.ID).SetUsageCount(usageCounts[t.ID]).Exec(ctx); err != nil {
+			return fixed, fmt.Errorf("fixing usage of tag %s: %w", t.ID, err)
+		}
+		fixed++
+	}
+
+	return fixed, nil
+}
@@ -0,0 +1,333 @@
+package dashboard
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/comment"
+	"github.com/jorge-j1m/hackspark_server/ent/like"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+	"github.com/jorge-j1m/hackspark_server/ent/usertechnology"
+)
+
+const (
+	// HistoryDays is the number of days, today included, covered by the likes history
+	HistoryDays = 30
+	// TrendDays is the length of the two periods compared to compute the trend of a project
+	TrendDays = 7
+	// StreakDays bounds how far back activity is looked up to compute streaks
+	StreakDays = 365
+
+	recentLikesLimit = 10
+	suggestionsLimit = 5
+)
+
+// Stats are the totals of the projects owned by the user
+type Stats struct {
+	ProjectCount  int
+	TotalLikes    int
+	TotalStars    int
+	TotalComments int
+}
+
+// DayCount is the number of likes received during a day
+type DayCount struct {
+	Day   time.Time
+	Count int
+}
+
+// ProjectTrend compares the likes a project received during the last TrendDays with the period before
+type ProjectTrend struct {
+	Project      *ent.Project
+	LikesCurrent int
+	LikesPrev    int
+}
+
+// SkillCategory sums up the technologies of the user in a tag category
+type SkillCategory struct {
+	Category tag.Category
+	Count    int
+	Levels   map[usertechnology.SkillLevel]int
+}
+
+// Streak counts consecutive days of activity: creating a project, liking one, adding a technology or commenting
+type Streak struct {
+	// Current is zero unless the user was active today or yesterday
+	Current    int
+	Longest    int
+	LastActive *time.Time
+}
+
+// Dashboard is everything shown to a user about their own activity
+type Dashboard struct {
+	Stats        Stats
+	LikesHistory []DayCount
+	Projects     []ProjectTrend
+	// RecentLikes are the latest likes on the user's projects, with their user and project loaded
+	RecentLikes []*ent.Like
+	// Suggestions are projects using the user's technologies, with their owner and tags loaded
+	Suggestions []*ent.Project
+	Skills      []SkillCategory
+	Streak      Streak
+}
+
+// Build computes the dashboard of the user at the given time.
+// It runs a fixed number of queries, whatever the number of projects or likes of the user.
+func Build(ctx context.Context, client *ent.Client, u *ent.User, now time.Time) (*Dashboard, error) {
+	today := day(now)
+	d := &Dashboard{}
+
+	projects, err := client.Project.Query().
+		Where(project.HasOwnerWith(user.ID(u.ID))).
+		Order(ent.Desc(project.FieldLikeCount), ent.Desc(project.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying projects: %w", err)
+	}
+	d.Stats.ProjectCount = len(projects)
+	for _, p := range projects {
+		d.Stats.TotalLikes += p.LikeCount
+		d.Stats.TotalStars += p.StarCount
+		d.Stats.TotalComments += p.CommentCount
+	}
+
+	if err := d.fillLikes(ctx, client, u, projects, today); err != nil {
+		return nil, err
+	}
+
+	d.RecentLikes, err = client.Like.Query().
+		Where(
+			like.HasProjectWith(project.HasOwnerWith(user.ID(u.ID))),
+			like.UserIDNEQ(u.ID),
+		).
+		WithUser().
+		WithProject().
+		Order(ent.Desc(like.FieldCreateTime), ent.Desc(like.FieldID)).
+		Limit(recentLikesLimit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying recent likes: %w", err)
+	}
+
+	techs, err := client.UserTechnology.Query().
+		Where(usertechnology.UserID(u.ID)).
+		WithTechnology().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying technologies: %w", err)
+	}
+	d.Skills = skills(techs)
+
+	if d.Suggestions, err = suggestions(ctx, client, u, techs); err != nil {
+		return nil, err
+	}
+
+	if d.Streak, err = streak(ctx, client, u, today); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// fillLikes builds the likes history and the trend of every project from a single aggregate
+// of the likes received per project and day
+func (d *Dashboard) fillLikes(ctx context.Context, client *ent.Client, u *ent.User, projects []*ent.Project, today time.Time) error {
+	first := today.AddDate(0, 0, -(HistoryDays - 1))
+	currentStart := today.AddDate(0, 0, -(TrendDays - 1))
+	prevStart := currentStart.AddDate(0, 0, -TrendDays)
+	since := first
+	if prevStart.Before(since) {
+		since = prevStart
+	}
+
+	query := fmt.Sprintf(`SELECT l.%[1]s, date_trunc('day', l.%[2]s AT TIME ZONE 'UTC') AS day, count(*)
+FROM %[3]s l
+JOIN %[4]s p ON p.%[5]s = l.%[1]s
+WHERE p.%[6]s = $1 AND p.%[7]s IS NULL AND l.%[2]s >= $2
+GROUP BY 1, 2`,
+		like.FieldProjectID, like.FieldCreateTime, like.Table,
+		project.Table, project.FieldID, project.OwnerColumn, project.FieldDeletedAt)
+
+	rows, err := client.QueryContext(ctx, query, u.ID, since)
+	if err != nil {
+		return fmt.Errorf("aggregating likes: %w", err)
+	}
+	defer rows.Close()
+
+	perDay := make(map[time.Time]int, HistoryDays)
+	current := make(map[string]int)
+	prev := make(map[string]int)
+	for rows.Next() {
+		var (
+			projectID string
+			at        time.Time
+			count     int
+		)
+		if err := rows.Scan(&projectID, &at, &count); err != nil {
+			return fmt.Errorf("scanning likes: %w", err)
+		}
+		at = day(at)
+		if !at.Before(first) {
+			perDay[at] += count
+		}
+		switch {
+		case !at.Before(currentStart):
+			current[projectID] += count
+		case !at.Before(prevStart):
+			prev[projectID] += count
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("scanning likes: %w", err)
+	}
+
+	d.LikesHistory = make([]DayCount, HistoryDays)
+	for i := range d.LikesHistory {
+		at := first.AddDate(0, 0, i)
+		d.LikesHistory[i] = DayCount{Day: at, Count: perDay[at]}
+	}
+
+	d.Projects = make([]ProjectTrend, len(projects))
+	for i, p := range projects {
+		d.Projects[i] = ProjectTrend{
+			Project:      p,
+			LikesCurrent: current[p.ID],
+			LikesPrev:    prev[p.ID],
+		}
+	}
+	return nil
+}
+
+func skills(techs []*ent.UserTechnology) []SkillCategory {
+	byCategory := make(map[tag.Category]*SkillCategory)
+	for _, t := range techs {
+		if t.Edges.Technology == nil {
+			continue
+		}
+		category := t.Edges.Technology.Category
+		s, ok := byCategory[category]
+		if !ok {
+			s = &SkillCategory{Category: category, Levels: make(map[usertechnology.SkillLevel]int)}
+			byCategory[category] = s
+		}
+		s.Count++
+		s.Levels[t.SkillLevel]++
+	}
+
+	categories := make([]SkillCategory, 0, len(byCategory))
+	for _, s := range byCategory {
+		categories = append(categories, *s)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		if categories[i].Count != categories[j].Count {
+			return categories[i].Count > categories[j].Count
+		}
+		return categories[i].Category < categories[j].Category
+	})
+	return categories
+}
+
+// suggestions returns the most liked projects using the technologies of the user that they
+// neither own nor liked yet. Users without technologies get the most liked projects overall.
+func suggestions(ctx context.Context, client *ent.Client, u *ent.User, techs []*ent.UserTechnology) ([]*ent.Project, error) {
+	query := client.Project.Query().
+		Where(
+			project.Not(project.HasOwnerWith(user.ID(u.ID))),
+			project.Not(project.HasLikesWith(like.UserID(u.ID))),
+		)
+
+	if len(techs) > 0 {
+		tagIDs := make([]string, len(techs))
+		for i, t := range techs {
+			tagIDs[i] = t.TechnologyID
+		}
+		query = query.Where(project.HasTagsWith(tag.IDIn(tagIDs...)))
+	}
+
+	projects, err := query.
+		WithOwner().
+		WithTags().
+		Order(ent.Desc(project.FieldLikeCount), ent.Desc(project.FieldCreateTime)).
+		Limit(suggestionsLimit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying suggestions: %w", err)
+	}
+	return projects, nil
+}
+
+// streak computes the streaks of the user from the distinct days they were active on
+func streak(ctx context.Context, client *ent.Client, u *ent.User, today time.Time) (Streak, error) {
+	query := fmt.Sprintf(`SELECT DISTINCT date_trunc('day', t AT TIME ZONE 'UTC') AS day FROM (
+	SELECT %[1]s AS t FROM %[2]s WHERE %[3]s = $1
+	UNION ALL SELECT %[1]s FROM %[4]s WHERE %[5]s = $1
+	UNION ALL SELECT %[1]s FROM %[6]s WHERE %[7]s = $1
+	UNION ALL SELECT %[1]s FROM %[8]s WHERE %[9]s = $1
+) activity
+WHERE t >= $2
+ORDER BY day DESC`,
+		project.FieldCreateTime,
+		project.Table, project.OwnerColumn,
+		like.Table, like.FieldUserID,
+		usertechnology.Table, usertechnology.FieldUserID,
+		comment.Table, comment.FieldAuthorID)
+
+	rows, err := client.QueryContext(ctx, query, u.ID, today.AddDate(0, 0, -(StreakDays-1)))
+	if err != nil {
+		return Streak{}, fmt.Errorf("querying activity days: %w", err)
+	}
+	days, err := scanDays(rows)
+	if err != nil {
+		return Streak{}, fmt.Errorf("scanning activity days: %w", err)
+	}
+
+	var s Streak
+	if len(days) == 0 {
+		return s, nil
+	}
+	s.LastActive = &days[0]
+
+	// Days are distinct and sorted from the most recent, so a run goes on
+	// as long as every day is the one before the previous
+	run := 1
+	for i := 1; i <= len(days); i++ {
+		if i < len(days) && days[i].Equal(days[i-1].AddDate(0, 0, -1)) {
+			run++
+			continue
+		}
+		if run > s.Longest {
+			s.Longest = run
+		}
+		// The first run is the current streak when it reaches today or yesterday
+		if s.Current == 0 && i == run && !days[0].Before(today.AddDate(0, 0, -1)) {
+			s.Current = run
+		}
+		run = 1
+	}
+	return s, nil
+}
+
+func scanDays(rows *sql.Rows) ([]time.Time, error) {
+	defer rows.Close()
+	var days []time.Time
+	for rows.Next() {
+		var at time.Time
+		if err := rows.Scan(&at); err != nil {
+			return nil, err
+		}
+		days = append(days, day(at))
+	}
+	return days, rows.Err()
+}
+
+// day truncates t to the start of its day in UTC
+func day(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,279 @@
+// Package digest emails users a summary of their unread notifications, daily or weekly.
+//
+// Digests go out in the morning of each user's own day: users are batched by timezone, and a
+// batch is due once its local delivery hour has passed since the last digest of its users.
+package digest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	htmltemplate "html/template"
+	"net/url"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/notification"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/mailer"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/notify"
+)
+
+const (
+	// maxItems is the number of notifications listed in a digest, the others are only counted
+	maxItems = 20
+
+	// shownActors is the number of actors named in each notification of a digest
+	shownActors = 2
+)
+
+var (
+	//go:embed templates
+	templates embed.FS
+
+	textTemplate = texttemplate.Must(texttemplate.ParseFS(templates, "templates/digest.txt.tmpl"))
+	htmlTemplate = htmltemplate.Must(htmltemplate.ParseFS(templates, "templates/digest.html.tmpl"))
+)
+
+// periods are how far back the first digest of a user looks, per frequency
+var periods = map[user.DigestFrequency]time.Duration{
+	user.DigestFrequencyDaily:  24 * time.Hour,
+	user.DigestFrequencyWeekly: 7 * 24 * time.Hour,
+}
+
+// Config holds the settings of the scheduler
+type Config struct {
+	// Hour is the local hour of the day digests are sent at, weekly ones on Mondays
+	Hour int
+	// PublicURL is the base URL of the API, which unsubscribe links point to
+	PublicURL string
+	// AppURL is the base URL of the web app, which links to the notifications point to
+	AppURL string
+}
+
+// Scheduler sends the digests that are due, as a scheduled task run often enough to reach
+// every timezone at the digest hour
+type Scheduler struct {
+	client *ent.Client
+	sender mailer.Sender
+	cfg    Config
+}
+
+// NewScheduler creates a new scheduler
+func NewScheduler(client *ent.Client, sender mailer.Sender, cfg Config) *Scheduler {
+	cfg.PublicURL = strings.TrimSuffix(cfg.PublicURL, "/")
+	cfg.AppURL = strings.TrimSuffix(cfg.AppURL, "/")
+	return &Scheduler{
+		client: client,
+		sender: sender,
+		cfg:    cfg,
+	}
+}
+
+// SendDue sends the digests due at now, one timezone at a time. A digest that fails to send is
+// retried on the next run.
+func (s *Scheduler) SendDue(ctx context.Context, now time.Time) error {
+	timezones, err := s.client.User.Query().
+		Where(
+			user.DigestFrequencyNEQ(user.DigestFrequencyOff),
+			user.EmailVerified(true),
+			user.AccountStatusEQ(user.AccountStatusActive),
+		).
+		GroupBy(user.FieldTimezone).
+		Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("loading timezones: %w", err)
+	}
+
+	sent := 0
+	for _, tz := range timezones {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			log.Warn(ctx).Err(err).Msgf("Unknown timezone %q, sending its digests in UTC", tz)
+			loc = time.UTC
+		}
+
+		for freq := range periods {
+			due := lastDelivery(freq, now.In(loc), s.cfg.Hour)
+			users, err := s.client.User.Query().
+				Where(
+					user.Timezone(tz),
+					user.DigestFrequencyEQ(freq),
+					user.EmailVerified(true),
+					user.AccountStatusEQ(user.AccountStatusActive),
+					user.Or(user.LastDigestAtIsNil(), user.LastDigestAtLT(due)),
+				).
+				All(ctx)
+			if err != nil {
+				return fmt.Errorf("loading %s digest users of %s: %w", freq, tz, err)
+			}
+
+			for _, u := range users {
+				ok, err := s.send(ctx, u, now)
+				if err != nil {
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+					log.Error(ctx).Err(err).Msgf("Failed to send digest to user %s", u.ID)
+					continue
+				}
+				if ok {
+					sent++
+				}
+			}
+		}
+	}
+
+	if sent > 0 {
+		log.Info(ctx).Msgf("Sent %d digests", sent)
+	}
+	return nil
+}
+
+// lastDelivery returns the most recent delivery time of the frequency at or before local
+func lastDelivery(freq user.DigestFrequency, local time.Time, hour int) time.Time {
+	t := time.Date(local.Year(), local.Month(), local.Day(), hour, 0, 0, 0, local.Location())
+	if t.After(local) {
+		t = t.AddDate(0, 0, -1)
+	}
+	if freq == user.DigestFrequencyWeekly {
+		for t.Weekday() != time.Monday {
+			t = t.AddDate(0, 0, -1)
+		}
+	}
+	return t
+}
+
+type item struct {
+	Message string
+	When    string
+}
+
+type data struct {
+	Subject        string
+	Username       string
+	Frequency      string
+	Notifications  []item
+	More           int
+	InboxURL       string
+	UnsubscribeURL string
+}
+
+// send emails the user their unread notifications since their last digest. Users without any
+// get no email. It reports whether an email was sent.
+func (s *Scheduler) send(ctx context.Context, u *ent.User, now time.Time) (bool, error) {
+	since := now.Add(-periods[u.DigestFrequency])
+	if u.LastDigestAt != nil && u.LastDigestAt.After(since) {
+		since = *u.LastDigestAt
+	}
+
+	// Projects are seen as the user sees them, so a project made private is not named
+	viewerCtx := visibility.WithViewer(ctx, u.ID)
+	unread := s.client.Notification.Query().
+		Where(
+			notification.RecipientID(u.ID),
+			notification.ReadAtIsNil(),
+			notification.UpdateTimeGT(since),
+		)
+	total, err := unread.Clone().Count(ctx)
+	if err != nil {
+		return false, fmt.Errorf("counting notifications: %w", err)
+	}
+	if total == 0 {
+		return false, s.client.User.UpdateOneID(u.ID).SetLastDigestAt(now).Exec(ctx)
+	}
+
+	notifications, err := unread.
+		WithProject().
+		Order(ent.Desc(notification.FieldUpdateTime), ent.Desc(notification.FieldID)).
+		Limit(maxItems).
+		All(viewerCtx)
+	if err != nil {
+		return false, fmt.Errorf("loading notifications: %w", err)
+	}
+	actors, err := notify.Actors(ctx, s.client, notifications, shownActors)
+	if err != nil {
+		return false, fmt.Errorf("loading actors: %w", err)
+	}
+
+	token := ""
+	if u.UnsubscribeToken != nil {
+		token = *u.UnsubscribeToken
+	} else {
+		token = newToken()
+		if err := s.client.User.UpdateOneID(u.ID).SetUnsubscribeToken(token).Exec(ctx); err != nil {
+			return false, fmt.Errorf("saving unsubscribe token: %w", err)
+		}
+	}
+	unsubscribeURL := s.cfg.PublicURL + "/api/v1/notifications/unsubscribe?token=" + url.QueryEscape(token)
+
+	d := data{
+		Subject:        subject(u.DigestFrequency, total),
+		Username:       u.Username,
+		Frequency:      string(u.DigestFrequency),
+		More:           total - len(notifications),
+		InboxURL:       s.cfg.AppURL + "/notifications",
+		UnsubscribeURL: unsubscribeURL,
+	}
+	for _, n := range notifications {
+		var names []string
+		for _, id := range notify.RecentActors(n, shownActors) {
+			if a, ok := actors[id]; ok {
+				names = append(names, a.Username)
+			}
+		}
+		projectName := ""
+		if n.Edges.Project != nil {
+			projectName = n.Edges.Project.Name
+		}
+		d.Notifications = append(d.Notifications, item{
+			Message: notify.Message(n.Type, names, len(n.ActorIds), projectName),
+			When:    n.UpdateTime.UTC().Format("Jan 2, 15:04 UTC"),
+		})
+	}
+
+	var text, html bytes.Buffer
+	if err := textTemplate.Execute(&text, d); err != nil {
+		return false, fmt.Errorf("rendering text digest: %w", err)
+	}
+	if err := htmlTemplate.Execute(&html, d); err != nil {
+		return false, fmt.Errorf("rendering HTML digest: %w", err)
+	}
+
+	err = s.sender.Send(ctx, mailer.Message{
+		To:      u.Email,
+		Subject: d.Subject,
+		Text:    text.String(),
+		HTML:    html.String(),
+		Headers: map[string]string{
+			"List-Unsubscribe":      "<" + unsubscribeURL + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, s.client.User.UpdateOneID(u.ID).SetLastDigestAt(now).Exec(ctx)
+}
+
+func subject(freq user.DigestFrequency, total int) string {
+	if total == 1 {
+		return fmt.Sprintf("Your %s HackSpark digest: 1 new notification", freq)
+	}
+	return fmt.Sprintf("Your %s HackSpark digest: %d new notifications", freq, total)
+}
+
+// newToken returns a random unsubscribe token
+func newToken() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
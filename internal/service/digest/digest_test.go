@@ -0,0 +1,194 @@
+package digest
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+)
+
+const testHour = 8
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("timezone %s unavailable: %v", name, err)
+	}
+	return loc
+}
+
+// isDue mirrors the condition SendDue puts on the users of a batch
+func isDue(lastDigestAt *time.Time, due time.Time) bool {
+	return lastDigestAt == nil || lastDigestAt.Before(due)
+}
+
+func TestLastDeliveryPerTimezone(t *testing.T) {
+	// Monday 2 March 2026, 08:30 UTC
+	now := time.Date(2026, 3, 2, 8, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		tz   string
+		want time.Time
+	}{
+		// Past the hour in UTC, due since this morning
+		{tz: "UTC", want: time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)},
+		// 17:30 in Tokyo, due since this morning there, last night in UTC
+		{tz: "Asia/Tokyo", want: time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC)},
+		// 03:30 in New York, still due since yesterday morning there
+		{tz: "America/New_York", want: time.Date(2026, 3, 1, 13, 0, 0, 0, time.UTC)},
+		// 21:30 in Auckland, due since this morning there
+		{tz: "Pacific/Auckland", want: time.Date(2026, 3, 1, 19, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tz, func(t *testing.T) {
+			loc := mustLoad(t, tt.tz)
+			got := lastDelivery(user.DigestFrequencyDaily, now.In(loc), testHour)
+			if !got.Equal(tt.want) {
+				t.Errorf("lastDelivery() = %s, want %s", got.UTC(), tt.want)
+			}
+			if got.In(loc).Hour() != testHour {
+				t.Errorf("lastDelivery() is at %d:00 local time, want %d:00", got.In(loc).Hour(), testHour)
+			}
+		})
+	}
+}
+
+func TestLastDeliveryBatches(t *testing.T) {
+	// Users of a timezone get their digest once the hour has passed there, not before
+	tokyo := mustLoad(t, "Asia/Tokyo")
+	newYork := mustLoad(t, "America/New_York")
+	yesterday := time.Date(2026, 3, 1, 14, 0, 0, 0, time.UTC)
+
+	run := time.Date(2026, 3, 2, 0, 5, 0, 0, time.UTC) // 09:05 in Tokyo, 19:05 the day before in New York
+	if !isDue(&yesterday, lastDelivery(user.DigestFrequencyDaily, run.In(tokyo), testHour)) {
+		t.Error("Tokyo users are not due past their delivery hour")
+	}
+	if isDue(&yesterday, lastDelivery(user.DigestFrequencyDaily, run.In(newYork), testHour)) {
+		t.Error("New York users are due before their delivery hour")
+	}
+}
+
+func TestLastDeliveryDeduplicates(t *testing.T) {
+	loc := mustLoad(t, "Europe/Paris")
+
+	for _, freq := range []user.DigestFrequency{user.DigestFrequencyDaily, user.DigestFrequencyWeekly} {
+		t.Run(string(freq), func(t *testing.T) {
+			// Runs every 15 minutes over three weeks, spanning the switch to summer time, for a user
+			// who got their previous digest just before
+			start := time.Date(2026, 3, 16, 0, 0, 0, 0, loc)
+			previous := start.Add(-time.Minute)
+			last := &previous
+			sent := map[string]int{}
+			for run := start; run.Before(start.AddDate(0, 0, 21)); run = run.Add(15 * time.Minute) {
+				if !isDue(last, lastDelivery(freq, run, testHour)) {
+					continue
+				}
+				at := run
+				last = &at
+				sent[run.Format(time.DateOnly)]++
+
+				if run.Hour() != testHour || run.Minute() != 0 {
+					t.Errorf("digest sent at %s, want it at %d:00", run, testHour)
+				}
+				if freq == user.DigestFrequencyWeekly && run.Weekday() != time.Monday {
+					t.Errorf("weekly digest sent on %s", run.Weekday())
+				}
+			}
+
+			for day, n := range sent {
+				if n > 1 {
+					t.Errorf("%d digests sent on %s, want at most one", n, day)
+				}
+			}
+			want := 21
+			if freq == user.DigestFrequencyWeekly {
+				want = 3
+			}
+			if got := len(sent); got != want {
+				t.Errorf("digests sent on %d days, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestLastDeliveryWeekly(t *testing.T) {
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{
+			name: "monday past the hour",
+			now:  time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "monday before the hour",
+			now:  time.Date(2026, 3, 2, 7, 59, 0, 0, time.UTC),
+			want: time.Date(2026, 2, 23, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "sunday",
+			now:  time.Date(2026, 3, 8, 23, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "exactly at the hour",
+			now:  time.Date(2026, 3, 9, 8, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 9, 8, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lastDelivery(user.DigestFrequencyWeekly, tt.now, testHour); !got.Equal(tt.want) {
+				t.Errorf("lastDelivery() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubject(t *testing.T) {
+	if got, want := subject(user.DigestFrequencyDaily, 1), "Your daily HackSpark digest: 1 new notification"; got != want {
+		t.Errorf("subject() = %q, want %q", got, want)
+	}
+	if got, want := subject(user.DigestFrequencyWeekly, 3), "Your weekly HackSpark digest: 3 new notifications"; got != want {
+		t.Errorf("subject() = %q, want %q", got, want)
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	d := data{
+		Subject:        subject(user.DigestFrequencyDaily, 22),
+		Username:       "ada",
+		Frequency:      string(user.DigestFrequencyDaily),
+		Notifications:  []item{{Message: "bob and <script>eve</script> liked your project", When: "Mar 2, 08:00 UTC"}},
+		More:           21,
+		InboxURL:       "https://app.hackspark.dev/notifications",
+		UnsubscribeURL: "https://api.hackspark.dev/api/v1/notifications/unsubscribe?token=abc&x=1",
+	}
+
+	var text, html bytes.Buffer
+	if err := textTemplate.Execute(&text, d); err != nil {
+		t.Fatalf("rendering text digest: %v", err)
+	}
+	if err := htmlTemplate.Execute(&html, d); err != nil {
+		t.Fatalf("rendering HTML digest: %v", err)
+	}
+
+	for _, want := range []string{"Hi ada,", "- bob and <script>eve</script> liked your project (Mar 2, 08:00 UTC)", "- and 21 more", d.InboxURL, d.UnsubscribeURL} {
+		if !strings.Contains(text.String(), want) {
+			t.Errorf("text digest does not contain %q:\n%s", want, text.String())
+		}
+	}
+	for _, want := range []string{"&lt;script&gt;", "and 21 more", `href="https://api.hackspark.dev/api/v1/notifications/unsubscribe?token=abc&amp;x=1"`} {
+		if !strings.Contains(html.String(), want) {
+			t.Errorf("HTML digest does not contain %q:\n%s", want, html.String())
+		}
+	}
+	if strings.Contains(html.String(), "<script>") {
+		t.Error("HTML digest does not escape the notifications")
+	}
+}
@@ -0,0 +1,114 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+)
+
+// Type identifies a kind of domain event
+type Type string
+
+const (
+	// ProjectStatusChanged is published when a project moves to another lifecycle status
+	ProjectStatusChanged Type = "project.status_changed"
+
+	// ProjectMemberInvited is published when a user is invited to collaborate on a project
+	ProjectMemberInvited Type = "project.member_invited"
+
+	// ProjectJoinRequested is published when a user asks to join a project
+	ProjectJoinRequested Type = "project.join_requested"
+
+	// ProjectMemberJoined is published when a user joins a project, through an invitation or a join request
+	ProjectMemberJoined Type = "project.member_joined"
+
+	// ProjectOwnershipTransferred is published when a project changes owner
+	ProjectOwnershipTransferred Type = "project.ownership_transferred"
+
+	// ProjectRemixed is published when a user creates a project from another one
+	ProjectRemixed Type = "project.remixed"
+
+	// ProjectLiked is published when a user likes a project
+	ProjectLiked Type = "project.liked"
+
+	// ProjectUnliked is published when a user takes their like of a project back
+	ProjectUnliked Type = "project.unliked"
+
+	// CommentCreated is published when a user comments on a project or replies to a comment
+	CommentCreated Type = "comment.created"
+)
+
+// Event is something that happened in the domain that other parts of the system may react to
+type Event struct {
+	Type       Type           `json:"type"`
+	ActorID    string         `json:"actor_id"`
+	ProjectID  string         `json:"project_id,omitempty"`
+	Payload    map[string]any `json:"payload,omitempty"`
+	OccurredAt time.Time      `json:"occurred_at"`
+}
+
+// Handler reacts to a published event
+type Handler func(ctx context.Context, e Event)
+
+// Bus is an in-process publish/subscribe dispatcher for domain events.
+// Handlers run synchronously in the publisher's goroutine, so they must be quick
+// and hand off any slow work.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[Type][]Handler
+	all      []Handler
+}
+
+// New creates a new event bus
+func New() *Bus {
+	return &Bus{
+		handlers: make(map[Type][]Handler),
+	}
+}
+
+// Subscribe registers a handler for the given event types
+func (b *Bus) Subscribe(h Handler, types ...Type) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, t := range types {
+		b.handlers[t] = append(b.handlers[t], h)
+	}
+}
+
+// SubscribeAll registers a handler for every event type
+func (b *Bus) SubscribeAll(h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.all = append(b.all, h)
+}
+
+// Publish dispatches the event to its subscribers. A failing handler never
+// affects the publisher nor the other handlers.
+func (b *Bus) Publish(ctx context.Context, e Event) {
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = time.Now()
+	}
+
+	b.mu.RLock()
+	handlers := make([]Handler, 0, len(b.handlers[e.Type])+len(b.all))
+	handlers = append(handlers, b.handlers[e.Type]...)
+	handlers = append(handlers, b.all...)
+	b.mu.RUnlock()
+
+	for _, h := range handlers {
+		b.dispatch(ctx, h, e)
+	}
+}
+
+func (b *Bus) dispatch(ctx context.Context, h Handler, e Event) {
+	defer func() {
+		if v := recover(); v != nil {
+			log.Error(ctx).Interface("panic", v).Str("event_type", string(e.Type)).Msg("Event handler panicked")
+		}
+	}()
+	h(ctx, e)
+}
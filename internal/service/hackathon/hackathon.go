@@ -0,0 +1,287 @@
+// Package hackathon runs the lifecycle of events: who takes part in them, freezing their
+// submissions at the deadline and ranking them once judged.
+//
+// Submissions are frozen when the event ends. A frozen submission points to the revision the
+// project had at the deadline, so later edits of the project do not change what is judged.
+// Projects without a revision at the deadline get a copy of their content instead.
+package hackathon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/event"
+	"github.com/jorge-j1m/hackspark_server/ent/eventmember"
+	"github.com/jorge-j1m/hackspark_server/ent/eventsubmission"
+	"github.com/jorge-j1m/hackspark_server/ent/predicate"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrevision"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/revision"
+)
+
+// Status is the stage of an event
+type Status string
+
+const (
+	StatusUpcoming Status = "upcoming"
+	StatusOngoing  Status = "ongoing"
+	StatusJudging  Status = "judging"
+	StatusFinished Status = "finished"
+)
+
+// StatusOf returns the stage of the event at now
+func StatusOf(e *ent.Event, now time.Time) Status {
+	switch {
+	case now.Before(e.StartsAt):
+		return StatusUpcoming
+	case now.Before(e.EndsAt):
+		return StatusOngoing
+	case e.ResultsPublishedAt == nil:
+		return StatusJudging
+	default:
+		return StatusFinished
+	}
+}
+
+// RoleOf returns the role of the user in the event, or an empty role if they do not take part in it
+func RoleOf(ctx context.Context, client *ent.Client, eventID, userID string) (eventmember.Role, error) {
+	if userID == "" {
+		return "", nil
+	}
+
+	m, err := client.EventMember.Query().
+		Where(eventmember.EventID(eventID), eventmember.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return m.Role, nil
+}
+
+// CanJudge reports whether the role may score submissions
+func CanJudge(role eventmember.Role) bool {
+	return role == eventmember.RoleJudge || role == eventmember.RoleOrganizer
+}
+
+// LockOngoing locks the event until the end of the transaction, failing with
+// errors.ErrSubmissionsClosed when its submissions are closed. Freeze claims the same row, so a
+// submission changed under the lock is either frozen with the others or not changed at all.
+func LockOngoing(ctx context.Context, tx *ent.Tx, eventID string) error {
+	e, err := tx.Event.Query().
+		Where(event.ID(eventID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	if e.FrozenAt != nil || StatusOf(e, time.Now()) != StatusOngoing {
+		return errors.ErrSubmissionsClosed
+	}
+	return nil
+}
+
+// Freeze freezes the submissions of an event that has ended. It does nothing when the event
+// is still running or already frozen, so it is safe to call whenever frozen submissions are needed.
+func Freeze(ctx context.Context, client *ent.Client, e *ent.Event) error {
+	now := time.Now()
+	if e.FrozenAt != nil || now.Before(e.EndsAt) {
+		return nil
+	}
+
+	// Submissions are frozen whatever became of their project since
+	ctx = visibility.IncludeDeleted(visibility.System(ctx))
+
+	return database.WithTx(ctx, client, func(tx *ent.Tx) error {
+		// Claiming the event locks its row, so concurrent freezes do it only once
+		n, err := tx.Event.Update().
+			Where(event.ID(e.ID), event.FrozenAtIsNil()).
+			SetFrozenAt(now).
+			Save(ctx)
+		if err != nil || n == 0 {
+			return err
+		}
+
+		submissions, err := tx.EventSubmission.Query().
+			Where(eventsubmission.EventID(e.ID)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("loading submissions: %w", err)
+		}
+
+		for _, s := range submissions {
+			if err := freezeSubmission(ctx, tx, s, e.EndsAt, now); err != nil {
+				return fmt.Errorf("freezing submission %s: %w", s.ID, err)
+			}
+		}
+
+		e.FrozenAt = &now
+		return nil
+	})
+}
+
+// freezeSubmission records the content the project of the submission had at the deadline.
+// Projects whose history starts after the deadline, such as backfilled ones, are frozen at their
+// oldest revision, the closest to the deadline, and projects without history at their content.
+func freezeSubmission(ctx context.Context, tx *ent.Tx, s *ent.EventSubmission, deadline, now time.Time) error {
+	update := tx.EventSubmission.UpdateOneID(s.ID).SetFrozenAt(now)
+
+	rev, err := tx.ProjectRevision.Query().
+		Where(
+			projectrevision.ProjectID(s.ProjectID),
+			projectrevision.CreateTimeLTE(deadline),
+		).
+		Order(ent.Desc(projectrevision.FieldNumber)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		rev, err = tx.ProjectRevision.Query().
+			Where(projectrevision.ProjectID(s.ProjectID)).
+			Order(ent.Asc(projectrevision.FieldNumber)).
+			First(ctx)
+	}
+	switch {
+	case err == nil:
+		update.SetRevisionNumber(rev.Number)
+	case ent.IsNotFound(err):
+		p, err := tx.Project.Get(ctx, s.ProjectID)
+		if err != nil {
+			return err
+		}
+		tags, err := revision.Tags(ctx, tx.Client(), s.ProjectID)
+		if err != nil {
+			return err
+		}
+		update.SetFrozenName(p.Name).
+			SetFrozenDescription(p.Description).
+			SetFrozenTags(tags)
+	default:
+		return err
+	}
+
+	return update.Exec(ctx)
+}
+
+// Snapshot is the content of a project as submitted to an event
+type Snapshot struct {
+	Name        string
+	Description string
+	Tags        []string
+	// Revision is the number of the revision the snapshot comes from, nil for copied content
+	Revision *int
+}
+
+// Snapshots returns the frozen content of the submissions, by submission ID.
+// Submissions that are not frozen yet are left out.
+func Snapshots(ctx context.Context, client *ent.Client, submissions []*ent.EventSubmission) (map[string]Snapshot, error) {
+	snapshots := make(map[string]Snapshot, len(submissions))
+
+	var revisions []predicate.ProjectRevision
+	for _, s := range submissions {
+		switch {
+		case s.RevisionNumber != nil:
+			revisions = append(revisions, projectrevision.And(
+				projectrevision.ProjectID(s.ProjectID),
+				projectrevision.Number(*s.RevisionNumber),
+			))
+		case s.FrozenAt != nil:
+			snapshots[s.ID] = Snapshot{
+				Name:        deref(s.FrozenName),
+				Description: deref(s.FrozenDescription),
+				Tags:        s.FrozenTags,
+			}
+		}
+	}
+	if len(revisions) == 0 {
+		return snapshots, nil
+	}
+
+	revs, err := client.ProjectRevision.Query().
+		Where(projectrevision.Or(revisions...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading revisions: %w", err)
+	}
+	byProject := make(map[string]*ent.ProjectRevision, len(revs))
+	for _, rev := range revs {
+		byProject[rev.ProjectID] = rev
+	}
+
+	for _, s := range submissions {
+		if s.RevisionNumber == nil {
+			continue
+		}
+		if rev, ok := byProject[s.ProjectID]; ok {
+			snapshots[s.ID] = Snapshot{
+				Name:        rev.Name,
+				Description: rev.Description,
+				Tags:        rev.Tags,
+				Revision:    &rev.Number,
+			}
+		}
+	}
+	return snapshots, nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// Freezer periodically freezes the submissions of the events that have ended
+type Freezer struct {
+	client   *ent.Client
+	interval time.Duration
+}
+
+// NewFreezer creates a new freezer
+func NewFreezer(client *ent.Client, interval time.Duration) *Freezer {
+	return &Freezer{
+		client:   client,
+		interval: interval,
+	}
+}
+
+// Run freezes right away and then on every interval until ctx is cancelled
+func (f *Freezer) Run(ctx context.Context) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := f.FreezeEnded(ctx); err != nil && ctx.Err() == nil {
+			log.Error(ctx).Err(err).Msg("Failed to freeze event submissions")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// FreezeEnded freezes the submissions of every event that has ended and is not frozen yet
+func (f *Freezer) FreezeEnded(ctx context.Context) error {
+	events, err := f.client.Event.Query().
+		Where(event.EndsAtLTE(time.Now()), event.FrozenAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("loading ended events: %w", err)
+	}
+
+	for _, e := range events {
+		if err := Freeze(ctx, f.client, e); err != nil {
+			return fmt.Errorf("freezing event %s: %w", e.ID, err)
+		}
+		log.Info(ctx).Msgf("Froze the submissions of event %s", e.ID)
+	}
+	return nil
+}
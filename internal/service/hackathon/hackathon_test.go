@@ -0,0 +1,165 @@
+package hackathon
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/testdb"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+)
+
+// newEvent creates an event running between the times
+func newEvent(t *testing.T, client *ent.Client, startsAt, endsAt time.Time) *ent.Event {
+	t.Helper()
+	slug := fmt.Sprintf("event-%d", time.Now().UnixNano())
+	e, err := client.Event.Create().
+		SetName(slug).
+		SetSlug(slug).
+		SetStartsAt(startsAt).
+		SetEndsAt(endsAt).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("creating event: %v", err)
+	}
+	return e
+}
+
+func TestLockOngoing(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	frozen := newEvent(t, client, now.Add(-time.Hour), now.Add(time.Hour))
+	client.Event.UpdateOne(frozen).SetFrozenAt(now).ExecX(ctx)
+
+	tests := []struct {
+		name    string
+		eventID string
+		want    error
+	}{
+		{name: "ongoing", eventID: newEvent(t, client, now.Add(-time.Hour), now.Add(time.Hour)).ID},
+		{name: "upcoming", eventID: newEvent(t, client, now.Add(time.Hour), now.Add(2*time.Hour)).ID, want: errors.ErrSubmissionsClosed},
+		{name: "ended", eventID: newEvent(t, client, now.Add(-2*time.Hour), now.Add(-time.Hour)).ID, want: errors.ErrSubmissionsClosed},
+		{name: "frozen", eventID: frozen.ID, want: errors.ErrSubmissionsClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := database.WithTx(ctx, client, func(tx *ent.Tx) error {
+				return LockOngoing(ctx, tx, tt.eventID)
+			})
+			if !stderrors.Is(err, tt.want) {
+				t.Errorf("LockOngoing() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	err := database.WithTx(ctx, client, func(tx *ent.Tx) error {
+		return LockOngoing(ctx, tx, "evt_unknown")
+	})
+	if !ent.IsNotFound(err) {
+		t.Errorf("LockOngoing() of an unknown event error = %v, want not found", err)
+	}
+}
+
+func TestFreezeWaitsForLockedSubmissions(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+
+	owner := testdb.User(t, client, "owner")
+	p := testdb.Project(t, client, owner.ID, "Rocket", nil)
+	e := newEvent(t, client, time.Now().Add(-time.Hour), time.Now().Add(time.Second))
+
+	// A participant submits right before the deadline
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatalf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+	if err := LockOngoing(ctx, tx, e.ID); err != nil {
+		t.Fatalf("LockOngoing() error = %v", err)
+	}
+	s := tx.EventSubmission.Create().SetEventID(e.ID).SetProjectID(p.ID).SetSubmitterID(owner.ID).SaveX(ctx)
+
+	// The event ends before the submission is committed
+	time.Sleep(time.Until(e.EndsAt))
+	done := make(chan error, 1)
+	go func() {
+		done <- Freeze(ctx, client, e)
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("Freeze() = %v while a submission held the event", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("committing submission: %v", err)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("Freeze() error = %v", err)
+	}
+
+	got := client.EventSubmission.GetX(ctx, s.ID)
+	if got.FrozenAt == nil {
+		t.Error("submission committed before the freeze was not frozen")
+	}
+}
+
+func TestSubmitAfterFreeze(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+
+	owner := testdb.User(t, client, "owner")
+	// Created after the deadline, so its history starts after it
+	p := testdb.Project(t, client, owner.ID, "Rocket", nil)
+	late := testdb.Project(t, client, owner.ID, "Late", nil)
+	e := newEvent(t, client, time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+	s := client.EventSubmission.Create().SetEventID(e.ID).SetProjectID(p.ID).SetSubmitterID(owner.ID).SaveX(ctx)
+
+	if err := Freeze(ctx, client, e); err != nil {
+		t.Fatalf("Freeze() error = %v", err)
+	}
+	if e.FrozenAt == nil {
+		t.Fatal("Freeze() did not mark the event frozen")
+	}
+	// Freezing again changes nothing
+	frozenAt := *e.FrozenAt
+	if err := Freeze(ctx, client, client.Event.GetX(ctx, e.ID)); err != nil {
+		t.Fatalf("Freeze() again error = %v", err)
+	}
+	// Stored to the microsecond
+	if got := client.Event.GetX(ctx, e.ID); got.FrozenAt.Sub(frozenAt).Abs() > time.Microsecond {
+		t.Errorf("frozen at %s, then at %s", frozenAt, got.FrozenAt)
+	}
+
+	// Submissions and withdrawals are closed, even if the handler checked the event before
+	err := database.WithTx(ctx, client, func(tx *ent.Tx) error {
+		if err := LockOngoing(ctx, tx, e.ID); err != nil {
+			return err
+		}
+		return tx.EventSubmission.Create().SetEventID(e.ID).SetProjectID(late.ID).SetSubmitterID(owner.ID).Exec(ctx)
+	})
+	if !stderrors.Is(err, errors.ErrSubmissionsClosed) {
+		t.Fatalf("submitting after the freeze error = %v, want %v", err, errors.ErrSubmissionsClosed)
+	}
+	if n := client.EventSubmission.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d submissions, want 1", n)
+	}
+
+	// Later edits of the project do not change what is judged
+	client.Project.UpdateOneID(p.ID).SetName("Renamed").ExecX(visibility.System(ctx))
+	snapshots, err := Snapshots(ctx, client, client.EventSubmission.Query().AllX(ctx))
+	if err != nil {
+		t.Fatalf("Snapshots() error = %v", err)
+	}
+	snap, ok := snapshots[s.ID]
+	if !ok || snap.Name != "Rocket" || snap.Revision == nil || *snap.Revision != 1 {
+		t.Errorf("snapshot = %+v, want the first revision of Rocket", snap)
+	}
+}
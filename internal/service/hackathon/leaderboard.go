@@ -0,0 +1,130 @@
+package hackathon
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/eventcriterion"
+	"github.com/jorge-j1m/hackspark_server/ent/eventscore"
+	"github.com/jorge-j1m/hackspark_server/ent/eventsubmission"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+)
+
+// CriterionResult is how a submission fared on a criterion
+type CriterionResult struct {
+	Criterion *ent.EventCriterion
+	// Average is the average score of the judges, on the scale of the criterion
+	Average float64
+	Scores  int
+}
+
+// Entry is the result of a submission
+type Entry struct {
+	// Rank is shared by submissions with the same score, the next rank skipping as many places
+	Rank       int
+	Submission *ent.EventSubmission
+	// Score is the weighted average of the criteria, each brought to a 0-100 scale.
+	// Criteria no judge scored count as 0.
+	Score    float64
+	Criteria []CriterionResult
+	Judges   int
+}
+
+// Leaderboard ranks the submissions of the event from their scores, with three queries whatever
+// the number of submissions, criteria and judges
+func Leaderboard(ctx context.Context, client *ent.Client, eventID string) ([]Entry, error) {
+	criteria, err := client.EventCriterion.Query().
+		Where(eventcriterion.EventID(eventID)).
+		Order(ent.Asc(eventcriterion.FieldPosition), ent.Asc(eventcriterion.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading criteria: %w", err)
+	}
+
+	// Deleted projects drop out of the results, until they are restored
+	submissions, err := client.EventSubmission.Query().
+		Where(
+			eventsubmission.EventID(eventID),
+			eventsubmission.HasProjectWith(project.DeletedAtIsNil()),
+		).
+		Order(ent.Asc(eventsubmission.FieldCreateTime), ent.Asc(eventsubmission.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading submissions: %w", err)
+	}
+
+	scores, err := client.EventScore.Query().
+		Where(eventscore.HasSubmissionWith(eventsubmission.EventID(eventID))).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading scores: %w", err)
+	}
+
+	return rank(criteria, submissions, scores), nil
+}
+
+// rank scores the submissions, given in submission order, and ranks them
+func rank(criteria []*ent.EventCriterion, submissions []*ent.EventSubmission, scores []*ent.EventScore) []Entry {
+	type key struct{ submission, criterion string }
+	sums := make(map[key]int)
+	counts := make(map[key]int)
+	judges := make(map[string]map[string]bool)
+	for _, s := range scores {
+		k := key{s.SubmissionID, s.CriterionID}
+		sums[k] += s.Score
+		counts[k]++
+		if judges[s.SubmissionID] == nil {
+			judges[s.SubmissionID] = make(map[string]bool)
+		}
+		judges[s.SubmissionID][s.JudgeID] = true
+	}
+
+	totalWeight := 0
+	for _, c := range criteria {
+		totalWeight += c.Weight
+	}
+
+	entries := make([]Entry, len(submissions))
+	for i, s := range submissions {
+		entry := Entry{
+			Submission: s,
+			Criteria:   make([]CriterionResult, len(criteria)),
+			Judges:     len(judges[s.ID]),
+		}
+		weighted := 0.0
+		for j, c := range criteria {
+			k := key{s.ID, c.ID}
+			result := CriterionResult{Criterion: c, Scores: counts[k]}
+			if counts[k] > 0 {
+				result.Average = float64(sums[k]) / float64(counts[k])
+				weighted += float64(c.Weight) * result.Average / float64(c.MaxScore)
+			}
+			entry.Criteria[j] = result
+		}
+		if totalWeight > 0 {
+			entry.Score = round(100 * weighted / float64(totalWeight))
+		}
+		entries[i] = entry
+	}
+
+	// Submissions keep their submission order on ties
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Score > entries[j].Score
+	})
+	for i := range entries {
+		if i > 0 && entries[i].Score == entries[i-1].Score {
+			entries[i].Rank = entries[i-1].Rank
+		} else {
+			entries[i].Rank = i + 1
+		}
+	}
+	return entries
+}
+
+// round rounds to two decimals, so scores that only differ by float noise tie
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,93 @@
+package hackathon
+
+import (
+	"testing"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+)
+
+func TestRank(t *testing.T) {
+	criteria := []*ent.EventCriterion{
+		{ID: "crit_idea", Weight: 2, MaxScore: 10},
+		{ID: "crit_demo", Weight: 1, MaxScore: 5},
+	}
+	var submissions []*ent.EventSubmission
+	for _, id := range []string{"sub_1", "sub_2", "sub_3", "sub_4", "sub_5"} {
+		submissions = append(submissions, &ent.EventSubmission{ID: id})
+	}
+	score := func(submission, judge, criterion string, value int) *ent.EventScore {
+		return &ent.EventScore{SubmissionID: submission, JudgeID: judge, CriterionID: criterion, Score: value}
+	}
+	scores := []*ent.EventScore{
+		// Averages of 8/10 and 5/5
+		score("sub_1", "usr_a", "crit_idea", 10),
+		score("sub_1", "usr_a", "crit_demo", 5),
+		score("sub_1", "usr_b", "crit_idea", 6),
+		score("sub_1", "usr_b", "crit_demo", 5),
+		// The same averages from a single judge
+		score("sub_2", "usr_a", "crit_idea", 8),
+		score("sub_2", "usr_a", "crit_demo", 5),
+		// The demo is not scored and counts as 0
+		score("sub_3", "usr_b", "crit_idea", 10),
+		// Beaten by everyone
+		score("sub_5", "usr_a", "crit_idea", 0),
+	}
+
+	entries := rank(criteria, submissions, scores)
+
+	want := []struct {
+		id     string
+		rank   int
+		score  float64
+		judges int
+	}{
+		// Ties share a rank and keep their submission order, the next rank skipping places
+		{id: "sub_1", rank: 1, score: 86.67, judges: 2},
+		{id: "sub_2", rank: 1, score: 86.67, judges: 1},
+		{id: "sub_3", rank: 3, score: 66.67, judges: 1},
+		{id: "sub_4", rank: 4, score: 0, judges: 0},
+		{id: "sub_5", rank: 4, score: 0, judges: 1},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("rank() returned %d entries, want %d", len(entries), len(want))
+	}
+	for i, w := range want {
+		e := entries[i]
+		if e.Submission.ID != w.id || e.Rank != w.rank || e.Score != w.score || e.Judges != w.judges {
+			t.Errorf("entry %d = %s rank %d score %v judges %d, want %s rank %d score %v judges %d",
+				i, e.Submission.ID, e.Rank, e.Score, e.Judges, w.id, w.rank, w.score, w.judges)
+		}
+	}
+
+	sub1 := entries[0].Criteria
+	if len(sub1) != 2 || sub1[0].Criterion.ID != "crit_idea" || sub1[0].Average != 8 || sub1[0].Scores != 2 {
+		t.Errorf("criteria of sub_1 = %+v, want the average of both judges on the idea first", sub1)
+	}
+	if demo := entries[2].Criteria[1]; demo.Average != 0 || demo.Scores != 0 {
+		t.Errorf("unscored demo of sub_3 = %+v, want no score", demo)
+	}
+}
+
+func TestRankWithoutCriteria(t *testing.T) {
+	submissions := []*ent.EventSubmission{{ID: "sub_1"}, {ID: "sub_2"}}
+
+	entries := rank(nil, submissions, nil)
+	for i, e := range entries {
+		if e.Submission.ID != submissions[i].ID || e.Rank != 1 || e.Score != 0 {
+			t.Errorf("entry %d = %s rank %d score %v, want %s tied first with 0", i, e.Submission.ID, e.Rank, e.Score, submissions[i].ID)
+		}
+	}
+	if entries := rank(nil, nil, nil); len(entries) != 0 {
+		t.Errorf("rank() without submissions = %v, want none", entries)
+	}
+}
+
+func TestRound(t *testing.T) {
+	// Scores differing by float noise round to the same value and tie
+	if a, b := round(100*(0.1+0.2)/3), round(100*0.3/3); a != b || a != 10 {
+		t.Errorf("round() = %v and %v, want 10 for both", a, b)
+	}
+	if got := round(200.0 / 3); got != 66.67 {
+		t.Errorf("round(200/3) = %v, want 66.67", got)
+	}
+}
@@ -0,0 +1,146 @@
+package importer
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+)
+
+// Forge imports repositories from a forge exposing the GitHub REST API, such as GitHub itself,
+// GitHub Enterprise or Gitea
+type Forge struct {
+	// host is the host repository URLs must point to, e.g. github.com
+	host string
+	// apiURL is the base URL of the REST API, e.g. https://api.github.com
+	apiURL string
+	token  string
+	client *http.Client
+}
+
+// NewForge creates an importer for the repositories hosted on host, whose API is served from apiURL.
+// The token is optional and only raises the rate limit of the forge.
+func NewForge(host, apiURL, token string) *Forge {
+	return &Forge{
+		host:   host,
+		apiURL: strings.TrimSuffix(apiURL, "/"),
+		token:  token,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type forgeRepository struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	HTMLURL     string   `json:"html_url"`
+	Homepage    *string  `json:"homepage"`
+	Topics      []string `json:"topics"`
+}
+
+// Fetch returns the metadata of the repository at repoURL
+func (f *Forge) Fetch(ctx context.Context, repoURL string) (*Repository, error) {
+	owner, name, err := f.parse(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	path := "/repos/" + url.PathEscape(owner) + "/" + url.PathEscape(name)
+
+	var repo forgeRepository
+	if err := f.get(ctx, path, &repo); err != nil {
+		return nil, err
+	}
+
+	// Languages are reported as the number of bytes of code written in each of them
+	var languages map[string]int
+	if err := f.get(ctx, path+"/languages", &languages); err != nil {
+		return nil, err
+	}
+
+	r := &Repository{
+		URL:       repo.HTMLURL,
+		Name:      repo.Name,
+		Languages: primaryLanguages(languages),
+		Topics:    repo.Topics,
+	}
+	if r.URL == "" {
+		r.URL = "https://" + f.host + "/" + owner + "/" + name
+	}
+	if repo.Description != nil {
+		r.Description = *repo.Description
+	}
+	if repo.Homepage != nil {
+		r.Homepage = *repo.Homepage
+	}
+	if len(r.Topics) > MaxTopics {
+		r.Topics = r.Topics[:MaxTopics]
+	}
+	return r, nil
+}
+
+// parse extracts the owner and name of the repository from its web URL
+func (f *Forge) parse(repoURL string) (owner, name string, err error) {
+	u, err := url.Parse(strings.TrimSpace(repoURL))
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || !strings.EqualFold(u.Host, f.host) {
+		return "", "", errors.ErrInvalidRepositoryURL
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.ErrInvalidRepositoryURL
+	}
+	// Links to a file or a branch of the repository are accepted as well
+	return parts[0], strings.TrimSuffix(parts[1], ".git"), nil
+}
+
+func (f *Forge) get(ctx context.Context, path string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.apiURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("building forge request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if f.token != "" {
+		req.Header.Set("Authorization", "Bearer "+f.token)
+	}
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errors.ErrForgeUnavailable, err)
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return errors.ErrRepositoryNotFound
+	case res.StatusCode != http.StatusOK:
+		return fmt.Errorf("%w: %s answered %s", errors.ErrForgeUnavailable, path, res.Status)
+	}
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("%w: decoding %s: %w", errors.ErrForgeUnavailable, path, err)
+	}
+	return nil
+}
+
+// primaryLanguages returns the languages with the most code, by decreasing share
+func primaryLanguages(bytes map[string]int) []string {
+	languages := make([]string, 0, len(bytes))
+	for l := range bytes {
+		languages = append(languages, l)
+	}
+	slices.SortFunc(languages, func(a, b string) int {
+		if c := cmp.Compare(bytes[b], bytes[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+	if len(languages) > MaxLanguages {
+		languages = languages[:MaxLanguages]
+	}
+	return languages
+}
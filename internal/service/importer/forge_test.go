@@ -0,0 +1,186 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+)
+
+// newTestForge serves the repositories by "owner/name" from an in-process forge. The host
+// repository URLs must point to is example.com.
+func newTestForge(t *testing.T, repos map[string]forgeRepository, languages map[string]map[string]int) *Forge {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/{owner}/{name}", func(w http.ResponseWriter, r *http.Request) {
+		repo, ok := repos[r.PathValue("owner")+"/"+r.PathValue("name")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(repo)
+	})
+	mux.HandleFunc("GET /repos/{owner}/{name}/languages", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(languages[r.PathValue("owner")+"/"+r.PathValue("name")])
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return NewForge("example.com", srv.URL+"/", "")
+}
+
+func TestForgeParse(t *testing.T) {
+	f := NewForge("example.com", "https://api.example.com", "")
+
+	tests := []struct {
+		url   string
+		owner string
+		name  string
+		err   error
+	}{
+		{url: "https://example.com/octo/hello", owner: "octo", name: "hello"},
+		{url: "  https://example.com/octo/hello/  ", owner: "octo", name: "hello"},
+		{url: "http://EXAMPLE.com/octo/hello.git", owner: "octo", name: "hello"},
+		{url: "https://example.com/octo/hello/tree/main/src", owner: "octo", name: "hello"},
+		{url: "https://example.com/octo", err: errors.ErrInvalidRepositoryURL},
+		{url: "https://example.com//hello", err: errors.ErrInvalidRepositoryURL},
+		{url: "https://other.com/octo/hello", err: errors.ErrInvalidRepositoryURL},
+		{url: "ftp://example.com/octo/hello", err: errors.ErrInvalidRepositoryURL},
+		{url: "example.com/octo/hello", err: errors.ErrInvalidRepositoryURL},
+		{url: "://", err: errors.ErrInvalidRepositoryURL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			owner, name, err := f.parse(tt.url)
+			if !stderrors.Is(err, tt.err) {
+				t.Fatalf("parse(%q) error = %v, want %v", tt.url, err, tt.err)
+			}
+			if owner != tt.owner || name != tt.name {
+				t.Errorf("parse(%q) = %q, %q, want %q, %q", tt.url, owner, name, tt.owner, tt.name)
+			}
+		})
+	}
+}
+
+func TestForgeFetch(t *testing.T) {
+	description := "Says hello"
+	homepage := "https://hello.example.com"
+	topics := make([]string, MaxTopics+2)
+	for i := range topics {
+		topics[i] = fmt.Sprintf("topic-%d", i)
+	}
+
+	f := newTestForge(t,
+		map[string]forgeRepository{
+			"octo/hello": {
+				Name:        "hello",
+				Description: &description,
+				HTMLURL:     "https://example.com/octo/hello",
+				Homepage:    &homepage,
+				Topics:      topics,
+			},
+			"octo/bare": {Name: "bare"},
+		},
+		map[string]map[string]int{
+			"octo/hello": {"Shell": 10, "Go": 5000, "Makefile": 10, "TypeScript": 1200, "CSS": 300},
+		},
+	)
+
+	t.Run("metadata", func(t *testing.T) {
+		repo, err := f.Fetch(context.Background(), "https://example.com/octo/hello")
+		if err != nil {
+			t.Fatalf("Fetch() error = %v", err)
+		}
+		if repo.Name != "hello" || repo.Description != description || repo.Homepage != homepage {
+			t.Errorf("Fetch() = %+v, want the name, description and homepage of the repository", repo)
+		}
+		if repo.URL != "https://example.com/octo/hello" {
+			t.Errorf("URL = %q, want the web URL of the repository", repo.URL)
+		}
+	})
+
+	t.Run("languages are ranked by share of the code", func(t *testing.T) {
+		repo, err := f.Fetch(context.Background(), "https://example.com/octo/hello")
+		if err != nil {
+			t.Fatalf("Fetch() error = %v", err)
+		}
+		want := []string{"Go", "TypeScript", "CSS"}
+		if !slices.Equal(repo.Languages, want) {
+			t.Errorf("Languages = %v, want %v", repo.Languages, want)
+		}
+	})
+
+	t.Run("topics are truncated", func(t *testing.T) {
+		repo, err := f.Fetch(context.Background(), "https://example.com/octo/hello")
+		if err != nil {
+			t.Fatalf("Fetch() error = %v", err)
+		}
+		if !slices.Equal(repo.Topics, topics[:MaxTopics]) {
+			t.Errorf("Topics = %v, want the first %d of %v", repo.Topics, MaxTopics, topics)
+		}
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		repo, err := f.Fetch(context.Background(), "https://example.com/octo/bare")
+		if err != nil {
+			t.Fatalf("Fetch() error = %v", err)
+		}
+		if repo.URL != "https://example.com/octo/bare" {
+			t.Errorf("URL = %q, want it built from the host", repo.URL)
+		}
+		if repo.Description != "" || repo.Homepage != "" || len(repo.Languages) != 0 || len(repo.Topics) != 0 {
+			t.Errorf("Fetch() = %+v, want no description, homepage, languages nor topics", repo)
+		}
+	})
+
+	t.Run("unknown repository", func(t *testing.T) {
+		_, err := f.Fetch(context.Background(), "https://example.com/octo/missing")
+		if !stderrors.Is(err, errors.ErrRepositoryNotFound) {
+			t.Errorf("Fetch() error = %v, want %v", err, errors.ErrRepositoryNotFound)
+		}
+	})
+
+	t.Run("invalid URL", func(t *testing.T) {
+		_, err := f.Fetch(context.Background(), "https://other.com/octo/hello")
+		if !stderrors.Is(err, errors.ErrInvalidRepositoryURL) {
+			t.Errorf("Fetch() error = %v, want %v", err, errors.ErrInvalidRepositoryURL)
+		}
+	})
+}
+
+func TestForgeFetchUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "rate limited", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	f := NewForge("example.com", srv.URL, "")
+	_, err := f.Fetch(context.Background(), "https://example.com/octo/hello")
+	if !stderrors.Is(err, errors.ErrForgeUnavailable) {
+		t.Errorf("Fetch() error = %v, want %v", err, errors.ErrForgeUnavailable)
+	}
+}
+
+func TestForgeToken(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer srv.Close()
+
+	f := NewForge("example.com", srv.URL, "secret")
+	if _, err := f.Fetch(context.Background(), "https://example.com/octo/hello"); err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if got != "Bearer secret" {
+		t.Errorf("Authorization = %q, want %q", got, "Bearer secret")
+	}
+}
@@ -0,0 +1,52 @@
+// Package importer fetches the metadata of code repositories to prefill projects with.
+package importer
+
+import (
+	"context"
+)
+
+const (
+	// MaxLanguages is the number of languages, by decreasing share of the code, kept from a repository
+	MaxLanguages = 3
+	// MaxTopics is the number of topics kept from a repository
+	MaxTopics = 10
+)
+
+// Repository is the metadata of a repository
+type Repository struct {
+	// URL is the canonical web address of the repository
+	URL         string
+	Name        string
+	Description string
+	// Homepage is the website of the repository, usually a demo or its documentation
+	Homepage string
+	// Languages are the primary languages, by decreasing share of the code
+	Languages []string
+	Topics    []string
+}
+
+// Tag is a tag a repository maps to
+type Tag struct {
+	Name string
+	// Language is true for the languages of the repository, false for its topics
+	Language bool
+}
+
+// Tags returns the tags the repository maps to: its primary languages, then its topics
+func (r *Repository) Tags() []Tag {
+	tags := make([]Tag, 0, len(r.Languages)+len(r.Topics))
+	for _, l := range r.Languages {
+		tags = append(tags, Tag{Name: l, Language: true})
+	}
+	for _, t := range r.Topics {
+		tags = append(tags, Tag{Name: t})
+	}
+	return tags
+}
+
+// Importer fetches the metadata of repositories.
+// It returns errors.ErrInvalidRepositoryURL for URLs it does not handle, errors.ErrRepositoryNotFound
+// for unknown repositories and errors.ErrForgeUnavailable when the forge fails.
+type Importer interface {
+	Fetch(ctx context.Context, repoURL string) (*Repository, error)
+}
@@ -0,0 +1,266 @@
+// Package jobs runs the side effects of domain changes in the background.
+//
+// A job is written with Enqueue through the ent.Tx of the change it follows, so it exists if
+// and only if the change was committed: nothing is lost when the server stops right after,
+// and nothing runs for a change that was rolled back. The workers of a Runner then claim jobs
+// with SELECT ... FOR UPDATE SKIP LOCKED, so that each job is run by a single worker across
+// every server instance.
+//
+// Failed jobs are retried with exponential backoff. Those failing on every attempt end up
+// dead and are kept for inspection. A job may run more than once, when its worker stops
+// before recording the outcome, so handlers must be idempotent.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/job"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/backoff"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+)
+
+const (
+	// lease is how long a worker may run a job before it is considered abandoned
+	lease = 5 * time.Minute
+)
+
+// retries is how long jobs wait before running again after failing
+var retries = backoff.Policy{Base: 10 * time.Second, Max: time.Hour}
+
+// ErrInvalidPayload is returned for payloads that cannot be decoded. Retrying does not help,
+// so the job is dead right away.
+var ErrInvalidPayload = errors.New("invalid job payload")
+
+// Kind identifies what a job does
+type Kind string
+
+// Handler runs a job of a kind with its payload
+type Handler func(ctx context.Context, payload json.RawMessage) error
+
+// Enqueue writes a job of the kind, to be run as soon as a worker is free. Pass the job client
+// of the transaction of the domain change, e.g. tx.Job.
+func Enqueue(ctx context.Context, jobs *ent.JobClient, kind Kind, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("encoding payload of %s job: %w", kind, err)
+	}
+	return jobs.Create().
+		SetKind(string(kind)).
+		SetPayload(body).
+		SetRunAt(time.Now()).
+		Exec(ctx)
+}
+
+// Decode decodes the payload of a job
+func Decode[T any](payload json.RawMessage) (T, error) {
+	var v T
+	if err := json.Unmarshal(payload, &v); err != nil {
+		return v, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+	return v, nil
+}
+
+// Runner runs the jobs on a pool of workers
+type Runner struct {
+	client   *ent.Client
+	workers  int
+	interval time.Duration
+	handlers map[Kind]Handler
+
+	// stop tells the workers not to claim jobs anymore, cancel interrupts the running ones
+	stop   chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRunner creates a runner with the number of workers, which look for jobs on every interval
+// when there are none left
+func NewRunner(client *ent.Client, workers int, interval time.Duration) *Runner {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Runner{
+		client:   client,
+		workers:  workers,
+		interval: interval,
+		handlers: make(map[Kind]Handler),
+		stop:     make(chan struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Register sets the handler of a kind of jobs. It must be called before Start.
+func (r *Runner) Register(kind Kind, h Handler) {
+	r.handlers[kind] = h
+}
+
+// Start starts the workers
+func (r *Runner) Start() {
+	for range r.workers {
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			r.work()
+		}()
+	}
+}
+
+// Shutdown stops claiming jobs and waits for the running ones to finish. Those still running
+// when ctx is done are interrupted, and taken over once their lease expires.
+func (r *Runner) Shutdown(ctx context.Context) error {
+	close(r.stop)
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		r.cancel()
+		return nil
+	case <-ctx.Done():
+		r.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// work runs jobs until the runner is stopped, waiting for an interval whenever none is due
+func (r *Runner) work() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		default:
+		}
+
+		ran, err := r.RunNext(r.ctx)
+		if err != nil && r.ctx.Err() == nil {
+			log.Error(r.ctx).Err(err).Msg("Failed to run job")
+		}
+		if ran {
+			continue
+		}
+
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunNext claims the next due job and runs it, reporting whether there was one
+func (r *Runner) RunNext(ctx context.Context) (bool, error) {
+	j, err := r.claim(ctx)
+	if err != nil || j == nil {
+		return false, err
+	}
+
+	err = r.run(ctx, j)
+	if ctx.Err() != nil {
+		// Interrupted by the shutdown, the job is taken over once its lease expires
+		return true, nil
+	}
+
+	// The attempt number guards against recording the outcome of a job taken over meanwhile
+	update := r.client.Job.Update().
+		Where(job.ID(j.ID), job.StatusEQ(job.StatusRunning), job.Attempts(j.Attempts)).
+		ClearLockedUntil()
+	switch {
+	case err == nil:
+		update.SetStatus(job.StatusSucceeded).
+			SetFinishedAt(time.Now()).
+			ClearLastError()
+	case j.Attempts >= j.MaxAttempts || errors.Is(err, ErrInvalidPayload):
+		log.Error(ctx).Err(err).Str("job_id", j.ID).Str("kind", j.Kind).Msg("Job failed on its last attempt")
+		update.SetStatus(job.StatusDead).
+			SetFinishedAt(time.Now()).
+			SetLastError(err.Error())
+	default:
+		log.Warn(ctx).Err(err).Str("job_id", j.ID).Str("kind", j.Kind).Msgf("Job failed on attempt %d, retrying", j.Attempts)
+		update.SetStatus(job.StatusPending).
+			SetRunAt(time.Now().Add(retries.Delay(j.Attempts))).
+			SetLastError(err.Error())
+	}
+	if err := update.Exec(ctx); err != nil {
+		return true, fmt.Errorf("recording outcome of job %s: %w", j.ID, err)
+	}
+	return true, nil
+}
+
+// claim locks the next due job, or the next abandoned one, and marks it as running.
+// Jobs locked by other workers are skipped rather than waited for.
+func (r *Runner) claim(ctx context.Context) (*ent.Job, error) {
+	var claimed *ent.Job
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		now := time.Now()
+		j, err := tx.Job.Query().
+			Where(job.Or(
+				job.And(job.StatusEQ(job.StatusPending), job.RunAtLTE(now)),
+				job.And(job.StatusEQ(job.StatusRunning), job.LockedUntilLT(now)),
+			)).
+			Order(ent.Asc(job.FieldRunAt)).
+			ForUpdate(entsql.WithLockAction(entsql.SkipLocked)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// An abandoned job whose worker stopped on its last attempt is not tried again
+		if j.Status == job.StatusRunning && j.Attempts >= j.MaxAttempts {
+			log.Error(ctx).Str("job_id", j.ID).Str("kind", j.Kind).Msg("Job abandoned on its last attempt")
+			return tx.Job.UpdateOne(j).
+				SetStatus(job.StatusDead).
+				SetFinishedAt(now).
+				SetLastError("abandoned by its worker").
+				ClearLockedUntil().
+				Exec(ctx)
+		}
+
+		claimed, err = tx.Job.UpdateOne(j).
+			SetStatus(job.StatusRunning).
+			AddAttempts(1).
+			SetLockedUntil(now.Add(lease)).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("claiming job: %w", err)
+	}
+	return claimed, nil
+}
+
+// run runs the job with its handler, turning panics into errors
+func (r *Runner) run(ctx context.Context, j *ent.Job) (err error) {
+	h, ok := r.handlers[Kind(j.Kind)]
+	if !ok {
+		return fmt.Errorf("no handler for %s jobs", j.Kind)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("job panicked: %v", v)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, lease)
+	defer cancel()
+	return h(ctx, j.Payload)
+}
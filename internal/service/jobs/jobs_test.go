@@ -0,0 +1,386 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/job"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/testdb"
+)
+
+const testKind Kind = "test"
+
+type testPayload struct {
+	N int `json:"n"`
+}
+
+// enqueue writes a job of the kind, due n seconds after the jobs enqueued with lower ns so that
+// they are claimed in order
+func enqueue(t *testing.T, client *ent.Client, kind Kind, n int) *ent.Job {
+	t.Helper()
+	ctx := context.Background()
+	if err := Enqueue(ctx, client.Job, kind, testPayload{N: n}); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	// Jobs enqueued before were moved back in time, the newest one is due last
+	j, err := client.Job.Query().Order(ent.Desc(job.FieldRunAt)).First(ctx)
+	if err != nil {
+		t.Fatalf("loading job: %v", err)
+	}
+	return client.Job.UpdateOne(j).SetRunAt(time.Now().Add(time.Duration(n-100) * time.Second)).SaveX(ctx)
+}
+
+func reload(t *testing.T, client *ent.Client, j *ent.Job) *ent.Job {
+	t.Helper()
+	got, err := client.Job.Get(context.Background(), j.ID)
+	if err != nil {
+		t.Fatalf("loading job: %v", err)
+	}
+	return got
+}
+
+func TestRunNext(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+
+	var got []int
+	r := NewRunner(client, 1, time.Minute)
+	r.Register(testKind, func(_ context.Context, payload json.RawMessage) error {
+		p, err := Decode[testPayload](payload)
+		got = append(got, p.N)
+		return err
+	})
+
+	first := enqueue(t, client, testKind, 1)
+	second := enqueue(t, client, testKind, 2)
+	for range 2 {
+		if ran, err := r.RunNext(ctx); !ran || err != nil {
+			t.Fatalf("RunNext() = %v, %v, want a job run", ran, err)
+		}
+	}
+	if ran, err := r.RunNext(ctx); ran || err != nil {
+		t.Fatalf("RunNext() without due jobs = %v, %v", ran, err)
+	}
+
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("ran payloads %v, want [1 2]", got)
+	}
+	for _, j := range []*ent.Job{first, second} {
+		j = reload(t, client, j)
+		if j.Status != job.StatusSucceeded || j.Attempts != 1 || j.LockedUntil != nil || j.FinishedAt == nil {
+			t.Errorf("job = %s after %d attempts, locked until %v, want succeeded after 1", j.Status, j.Attempts, j.LockedUntil)
+		}
+	}
+}
+
+func TestClaimSkipsLockedJobs(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+
+	r := NewRunner(client, 1, time.Minute)
+	var got []int
+	r.Register(testKind, func(_ context.Context, payload json.RawMessage) error {
+		p, _ := Decode[testPayload](payload)
+		got = append(got, p.N)
+		return nil
+	})
+	locked := enqueue(t, client, testKind, 1)
+	free := enqueue(t, client, testKind, 2)
+
+	// Another worker is claiming the first job
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatalf("starting transaction: %v", err)
+	}
+	defer tx.Rollback()
+	if _, err := tx.Job.Query().Where(job.ID(locked.ID)).ForUpdate().Only(ctx); err != nil {
+		t.Fatalf("locking job: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := r.RunNext(ctx)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("RunNext() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunNext() waited for the locked job")
+	}
+
+	if len(got) != 1 || got[0] != 2 {
+		t.Errorf("ran payloads %v, want [2]", got)
+	}
+	if j := reload(t, client, free); j.Status != job.StatusSucceeded {
+		t.Errorf("free job = %s, want succeeded", j.Status)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("rolling back: %v", err)
+	}
+	if j := reload(t, client, locked); j.Status != job.StatusPending || j.Attempts != 0 {
+		t.Errorf("locked job = %s after %d attempts, want pending", j.Status, j.Attempts)
+	}
+
+	// The job is claimed once released
+	if ran, err := r.RunNext(ctx); !ran || err != nil {
+		t.Fatalf("RunNext() = %v, %v, want the released job run", ran, err)
+	}
+}
+
+func TestRunNextRetries(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+
+	r := NewRunner(client, 1, time.Minute)
+	r.Register(testKind, func(context.Context, json.RawMessage) error {
+		return errors.New("boom")
+	})
+	j := enqueue(t, client, testKind, 1)
+	// On its third attempt
+	j = client.Job.UpdateOne(j).SetAttempts(2).SaveX(ctx)
+
+	before := time.Now()
+	if ran, err := r.RunNext(ctx); !ran || err != nil {
+		t.Fatalf("RunNext() = %v, %v, want a job run", ran, err)
+	}
+	after := time.Now()
+
+	j = reload(t, client, j)
+	if j.Status != job.StatusPending || j.Attempts != 3 || j.LockedUntil != nil {
+		t.Errorf("job = %s after %d attempts, locked until %v, want pending after 3", j.Status, j.Attempts, j.LockedUntil)
+	}
+	if j.LastError == nil || *j.LastError != "boom" {
+		t.Errorf("last error = %v, want boom", j.LastError)
+	}
+	delay := retries.Delay(3)
+	if j.RunAt.Before(before.Add(delay).Truncate(time.Microsecond)) || j.RunAt.After(after.Add(delay)) {
+		t.Errorf("run at %s, want %s after the attempt", j.RunAt, delay)
+	}
+
+	// Not due again before the backoff
+	if ran, err := r.RunNext(ctx); ran || err != nil {
+		t.Errorf("RunNext() during the backoff = %v, %v, want nothing run", ran, err)
+	}
+}
+
+func TestRunNextKillsJobs(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+
+	r := NewRunner(client, 1, time.Minute)
+	r.Register(testKind, func(_ context.Context, payload json.RawMessage) error {
+		p, err := Decode[testPayload](payload)
+		if err != nil {
+			return err
+		}
+		if p.N == 1 {
+			panic("boom")
+		}
+		return errors.New("boom")
+	})
+
+	tests := []struct {
+		name    string
+		create  func() *ent.Job
+		wantErr string
+	}{
+		{
+			name: "last attempt",
+			create: func() *ent.Job {
+				j := enqueue(t, client, testKind, 2)
+				return client.Job.UpdateOne(j).SetAttempts(4).SaveX(ctx)
+			},
+			wantErr: "boom",
+		},
+		{
+			name: "panic on last attempt",
+			create: func() *ent.Job {
+				j := enqueue(t, client, testKind, 1)
+				return client.Job.UpdateOne(j).SetMaxAttempts(1).SaveX(ctx)
+			},
+			wantErr: "job panicked: boom",
+		},
+		{
+			name: "invalid payload",
+			create: func() *ent.Job {
+				return client.Job.Create().
+					SetKind(string(testKind)).
+					SetPayload(json.RawMessage(`"not an object"`)).
+					SetRunAt(time.Now()).
+					SaveX(ctx)
+			},
+			wantErr: ErrInvalidPayload.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := tt.create()
+			if ran, err := r.RunNext(ctx); !ran || err != nil {
+				t.Fatalf("RunNext() = %v, %v, want a job run", ran, err)
+			}
+			j = reload(t, client, j)
+			if j.Status != job.StatusDead || j.FinishedAt == nil || j.LockedUntil != nil {
+				t.Errorf("job = %s, want dead and finished", j.Status)
+			}
+			if j.LastError == nil || !strings.Contains(*j.LastError, tt.wantErr) {
+				t.Errorf("last error = %v, want %q", j.LastError, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRunNextUnknownKind(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+
+	// A job written by a newer version of the server waits for a replica that knows it
+	r := NewRunner(client, 1, time.Minute)
+	j := enqueue(t, client, "unknown", 1)
+	if ran, err := r.RunNext(ctx); !ran || err != nil {
+		t.Fatalf("RunNext() = %v, %v, want a job run", ran, err)
+	}
+
+	j = reload(t, client, j)
+	if j.Status != job.StatusPending || j.Attempts != 1 {
+		t.Errorf("job = %s after %d attempts, want pending after 1", j.Status, j.Attempts)
+	}
+	if j.LastError == nil || !strings.Contains(*j.LastError, "no handler for unknown jobs") {
+		t.Errorf("last error = %v, want no handler", j.LastError)
+	}
+}
+
+func TestClaimTakesOverAbandonedJobs(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+
+	runs := 0
+	r := NewRunner(client, 1, time.Minute)
+	r.Register(testKind, func(context.Context, json.RawMessage) error {
+		runs++
+		return nil
+	})
+
+	// The worker of each job stopped without recording how it went
+	abandon := func(j *ent.Job, attempts int, lockedUntil time.Time) *ent.Job {
+		return client.Job.UpdateOne(j).
+			SetStatus(job.StatusRunning).
+			SetAttempts(attempts).
+			SetLockedUntil(lockedUntil).
+			SaveX(ctx)
+	}
+	expired := abandon(enqueue(t, client, testKind, 1), 1, time.Now().Add(-time.Second))
+	last := abandon(enqueue(t, client, testKind, 2), 5, time.Now().Add(-time.Second))
+	leased := abandon(enqueue(t, client, testKind, 3), 1, time.Now().Add(time.Hour))
+
+	if ran, err := r.RunNext(ctx); !ran || err != nil {
+		t.Fatalf("RunNext() = %v, %v, want the expired job run", ran, err)
+	}
+	if j := reload(t, client, expired); j.Status != job.StatusSucceeded || j.Attempts != 2 {
+		t.Errorf("expired job = %s after %d attempts, want succeeded after 2", j.Status, j.Attempts)
+	}
+
+	// Abandoned on its last attempt, the job is dead without running
+	if ran, err := r.RunNext(ctx); ran || err != nil {
+		t.Fatalf("RunNext() = %v, %v, want nothing run", ran, err)
+	}
+	j := reload(t, client, last)
+	if j.Status != job.StatusDead || j.LastError == nil || *j.LastError != "abandoned by its worker" {
+		t.Errorf("job abandoned on its last attempt = %s with error %v, want dead", j.Status, j.LastError)
+	}
+
+	// Jobs whose lease runs on still belong to their worker
+	if ran, err := r.RunNext(ctx); ran || err != nil {
+		t.Fatalf("RunNext() = %v, %v, want nothing run", ran, err)
+	}
+	if j := reload(t, client, leased); j.Status != job.StatusRunning || j.Attempts != 1 {
+		t.Errorf("leased job = %s after %d attempts, want left running", j.Status, j.Attempts)
+	}
+	if runs != 1 {
+		t.Errorf("handler ran %d times, want 1", runs)
+	}
+}
+
+func TestShutdownDrains(t *testing.T) {
+	client := testdb.Open(t)
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	r := NewRunner(client, 1, 10*time.Millisecond)
+	r.Register(testKind, func(context.Context, json.RawMessage) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	})
+	running := enqueue(t, client, testKind, 1)
+	queued := enqueue(t, client, testKind, 2)
+
+	r.Start()
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("job not started")
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- r.Shutdown(context.Background())
+	}()
+	// Claiming stops first, then the running job is waited for
+	<-r.stop
+	select {
+	case err := <-done:
+		t.Fatalf("Shutdown() = %v before the running job finished", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	if j := reload(t, client, running); j.Status != job.StatusSucceeded {
+		t.Errorf("running job = %s, want succeeded", j.Status)
+	}
+	if j := reload(t, client, queued); j.Status != job.StatusPending || j.Attempts != 0 {
+		t.Errorf("queued job = %s after %d attempts, want left pending", j.Status, j.Attempts)
+	}
+}
+
+func TestShutdownInterrupts(t *testing.T) {
+	client := testdb.Open(t)
+
+	started := make(chan struct{})
+	r := NewRunner(client, 1, 10*time.Millisecond)
+	r.Register(testKind, func(ctx context.Context, _ json.RawMessage) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	j := enqueue(t, client, testKind, 1)
+
+	r.Start()
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("job not started")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := r.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Shutdown() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// Left running, the job is taken over once its lease expires
+	j = reload(t, client, j)
+	if j.Status != job.StatusRunning || j.Attempts != 1 || j.LockedUntil == nil {
+		t.Errorf("interrupted job = %s after %d attempts, want left running", j.Status, j.Attempts)
+	}
+}
@@ -0,0 +1,127 @@
+package matching
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+	"github.com/jorge-j1m/hackspark_server/ent/usertechnology"
+)
+
+// skillRanks orders the skill levels so they can be compared
+var skillRanks = map[string]int{
+	string(usertechnology.SkillLevelBeginner):     1,
+	string(usertechnology.SkillLevelIntermediate): 2,
+	string(usertechnology.SkillLevelExpert):       3,
+}
+
+const (
+	// Every required tag a candidate knows well enough is worth 1, plus a bonus for
+	// each level above the minimum and for experience, capped at maxYearsCounted.
+	levelBonus      = 0.5
+	experienceBonus = 0.5
+	maxYearsCounted = 10.0
+)
+
+// Match is a required tag of the role a candidate knows at the required level or above
+type Match struct {
+	Tag             *ent.Tag
+	SkillLevel      usertechnology.SkillLevel
+	YearsExperience *float64
+}
+
+// Candidate is a user suggested for an open role
+type Candidate struct {
+	User    *ent.User
+	Matches []Match
+	Score   float64
+	// Coverage is the share of the required tags the candidate matches, from 0 to 1
+	Coverage float64
+}
+
+// Rank returns up to limit discoverable users that match at least one of the required tags of the role,
+// best first. The role must have its required tags loaded. Users in exclude, such as current members,
+// are never suggested.
+func Rank(ctx context.Context, client *ent.Client, role *ent.ProjectRole, exclude []string, limit int) ([]Candidate, error) {
+	required := role.Edges.RequiredTags
+	if len(required) == 0 {
+		return []Candidate{}, nil
+	}
+
+	tagIDs := make([]string, len(required))
+	for i, t := range required {
+		tagIDs[i] = t.ID
+	}
+
+	minRank := skillRanks[string(role.MinSkillLevel)]
+	var levels []usertechnology.SkillLevel
+	for level, rank := range skillRanks {
+		if rank >= minRank {
+			levels = append(levels, usertechnology.SkillLevel(level))
+		}
+	}
+
+	techs, err := client.UserTechnology.Query().
+		Where(
+			usertechnology.TechnologyIDIn(tagIDs...),
+			usertechnology.SkillLevelIn(levels...),
+			usertechnology.UserIDNotIn(exclude...),
+			usertechnology.HasUserWith(
+				user.Discoverable(true),
+				user.AccountStatusNEQ(user.AccountStatusSuspended),
+			),
+		).
+		WithUser().
+		WithTechnology().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byUser := map[string]*Candidate{}
+	for _, ut := range techs {
+		c, ok := byUser[ut.UserID]
+		if !ok {
+			c = &Candidate{User: ut.Edges.User}
+			byUser[ut.UserID] = c
+		}
+		c.Matches = append(c.Matches, Match{
+			Tag:             ut.Edges.Technology,
+			SkillLevel:      ut.SkillLevel,
+			YearsExperience: ut.YearsExperience,
+		})
+		c.Score += score(ut, minRank)
+	}
+
+	candidates := make([]Candidate, 0, len(byUser))
+	for _, c := range byUser {
+		c.Coverage = float64(len(c.Matches)) / float64(len(required))
+		candidates = append(candidates, *c)
+	}
+
+	// Covering more of the role beats being very good at a part of it
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if len(a.Matches) != len(b.Matches) {
+			return len(a.Matches) > len(b.Matches)
+		}
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.User.Username < b.User.Username
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates, nil
+}
+
+func score(ut *ent.UserTechnology, minRank int) float64 {
+	s := 1 + levelBonus*float64(skillRanks[string(ut.SkillLevel)]-minRank)
+	if ut.YearsExperience != nil {
+		s += experienceBonus * math.Min(*ut.YearsExperience, maxYearsCounted) / maxYearsCounted
+	}
+	return s
+}
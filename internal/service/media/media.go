@@ -0,0 +1,135 @@
+// Package media validates uploaded images and generates their thumbnails.
+//
+// Only the formats the standard library decodes are accepted: JPEG, PNG and GIF. The type of a
+// file is sniffed from its content, whatever its name or declared type.
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"net/http"
+
+	// Registered decoders, along with image/jpeg
+	_ "image/gif"
+	_ "image/png"
+
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+)
+
+const (
+	// MinDimension and MaxDimension bound the width and height of images
+	MinDimension = 32
+	MaxDimension = 8192
+	// MaxPixels bounds the decoded size of images, as compressed files can decode to huge bitmaps
+	MaxPixels = 40_000_000
+	// ThumbnailSize is the longest side of thumbnails
+	ThumbnailSize = 480
+
+	// samples is the number of samples per side averaged into a thumbnail pixel
+	samples = 4
+)
+
+// extensions maps the accepted content types to the extension of their files
+var extensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+// Image is a validated image along with its thumbnail
+type Image struct {
+	ContentType string
+	Extension   string
+	Width       int
+	Height      int
+	// Thumbnail is always a JPEG, transparent images are drawn over white
+	Thumbnail []byte
+}
+
+// Process validates the image and generates its thumbnail
+func Process(data []byte) (*Image, error) {
+	contentType := http.DetectContentType(data)
+	ext, ok := extensions[contentType]
+	if !ok {
+		return nil, errors.ErrUnsupportedMediaType
+	}
+
+	// The dimensions are checked before decoding the whole image
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.ErrUnsupportedMediaType
+	}
+	if cfg.Width < MinDimension || cfg.Height < MinDimension ||
+		cfg.Width > MaxDimension || cfg.Height > MaxDimension ||
+		cfg.Width*cfg.Height > MaxPixels {
+		return nil, errors.ErrInvalidImageDimensions
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.ErrUnsupportedMediaType
+	}
+
+	var thumb bytes.Buffer
+	if err := jpeg.Encode(&thumb, thumbnail(src), &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+
+	return &Image{
+		ContentType: contentType,
+		Extension:   ext,
+		Width:       cfg.Width,
+		Height:      cfg.Height,
+		Thumbnail:   thumb.Bytes(),
+	}, nil
+}
+
+// thumbnail scales the image down to fit in ThumbnailSize, averaging a grid of samples of the
+// source for every pixel so it never allocates a full size copy of the image
+func thumbnail(src image.Image) *image.RGBA {
+	b := src.Bounds()
+	w, h := fit(b.Dx(), b.Dy(), ThumbnailSize)
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+
+	n := samples
+	if w == b.Dx() {
+		n = 1
+	}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var r, g, bl uint32
+			for sy := 0; sy < n; sy++ {
+				py := b.Min.Y + ((y*n+sy)*2+1)*b.Dy()/(2*h*n)
+				for sx := 0; sx < n; sx++ {
+					px := b.Min.X + ((x*n+sx)*2+1)*b.Dx()/(2*w*n)
+					cr, cg, cb, ca := src.At(px, py).RGBA()
+					// Colors are premultiplied, adding the missing coverage draws them over white
+					r += cr + 0xffff - ca
+					g += cg + 0xffff - ca
+					bl += cb + 0xffff - ca
+				}
+			}
+			count := uint32(n * n)
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / count >> 8),
+				G: uint8(g / count >> 8),
+				B: uint8(bl / count >> 8),
+				A: 0xff,
+			})
+		}
+	}
+	return dst
+}
+
+// fit returns the dimensions of a w x h image scaled down so that its longest side is at most size
+func fit(w, h, size int) (int, int) {
+	if w <= size && h <= size {
+		return w, h
+	}
+	if w >= h {
+		return size, max(1, h*size/w)
+	}
+	return max(1, w*size/h), size
+}
@@ -0,0 +1,203 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	stderrors "errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/common/errors"
+)
+
+// filled returns a w x h image of a single color
+func filled(w, h int, c color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("encoding PNG: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// pngHeader returns a PNG whose header claims the dimensions, without the pixels to match. It is
+// enough for the dimensions to be checked, which happens before decoding.
+func pngHeader(t *testing.T, w, h int) []byte {
+	t.Helper()
+	data := encodePNG(t, filled(MinDimension, MinDimension, color.White))
+
+	// The IHDR chunk follows the 8 bytes signature: length, type, width, height, ..., CRC
+	ihdr := data[8+4 : 8+4+4+13]
+	binary.BigEndian.PutUint32(ihdr[4:], uint32(w))
+	binary.BigEndian.PutUint32(ihdr[8:], uint32(h))
+	binary.BigEndian.PutUint32(data[8+4+4+13:], crc32.ChecksumIEEE(ihdr))
+	return data
+}
+
+func TestProcessSniffing(t *testing.T) {
+	img := filled(64, 48, color.RGBA{R: 200, A: 0xff})
+
+	var jpg, gifData bytes.Buffer
+	if err := jpeg.Encode(&jpg, img, nil); err != nil {
+		t.Fatalf("encoding JPEG: %v", err)
+	}
+	if err := gif.Encode(&gifData, img, nil); err != nil {
+		t.Fatalf("encoding GIF: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		data        []byte
+		contentType string
+		extension   string
+	}{
+		{name: "png", data: encodePNG(t, img), contentType: "image/png", extension: "png"},
+		{name: "jpeg", data: jpg.Bytes(), contentType: "image/jpeg", extension: "jpg"},
+		{name: "gif", data: gifData.Bytes(), contentType: "image/gif", extension: "gif"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Process(tt.data)
+			if err != nil {
+				t.Fatalf("Process() error = %v", err)
+			}
+			if got.ContentType != tt.contentType || got.Extension != tt.extension {
+				t.Errorf("Process() = %s %s, want %s %s", got.ContentType, got.Extension, tt.contentType, tt.extension)
+			}
+			if got.Width != 64 || got.Height != 48 {
+				t.Errorf("dimensions = %dx%d, want 64x48", got.Width, got.Height)
+			}
+		})
+	}
+}
+
+func TestProcessUnsupported(t *testing.T) {
+	valid := encodePNG(t, filled(64, 64, color.White))
+
+	tests := map[string][]byte{
+		"empty":     {},
+		"text":      []byte("<html><body>not an image</body></html>"),
+		"bmp":       append([]byte("BM"), make([]byte, 64)...),
+		"webp":      append([]byte("RIFF\x00\x00\x00\x00WEBPVP8 "), make([]byte, 64)...),
+		"truncated": valid[:len(valid)/2],
+		"corrupted": append(append([]byte{}, valid[:40]...), bytes.Repeat([]byte{0xff}, 64)...),
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Process(data); !stderrors.Is(err, errors.ErrUnsupportedMediaType) {
+				t.Errorf("Process() error = %v, want %v", err, errors.ErrUnsupportedMediaType)
+			}
+		})
+	}
+}
+
+func TestProcessDimensions(t *testing.T) {
+	tests := []struct {
+		name string
+		w, h int
+	}{
+		{name: "too narrow", w: MinDimension - 1, h: 100},
+		{name: "too short", w: 100, h: MinDimension - 1},
+		{name: "too wide", w: MaxDimension + 1, h: 100},
+		{name: "too tall", w: 100, h: MaxDimension + 1},
+		// Within the bounds on each side, but too many pixels once decoded
+		{name: "too many pixels", w: MaxDimension, h: MaxPixels/MaxDimension + 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Process(pngHeader(t, tt.w, tt.h)); !stderrors.Is(err, errors.ErrInvalidImageDimensions) {
+				t.Errorf("Process() error = %v, want %v", err, errors.ErrInvalidImageDimensions)
+			}
+		})
+	}
+
+	if _, err := Process(encodePNG(t, filled(MinDimension, MinDimension, color.White))); err != nil {
+		t.Errorf("Process() of the smallest image error = %v", err)
+	}
+}
+
+func TestProcessThumbnail(t *testing.T) {
+	tests := []struct {
+		name   string
+		w, h   int
+		tw, th int
+	}{
+		{name: "landscape", w: 1200, h: 600, tw: ThumbnailSize, th: ThumbnailSize / 2},
+		{name: "portrait", w: 300, h: 900, tw: ThumbnailSize / 3, th: ThumbnailSize},
+		{name: "small", w: 100, h: 40, tw: 100, th: 40},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Process(encodePNG(t, filled(tt.w, tt.h, color.RGBA{G: 0xff, A: 0xff})))
+			if err != nil {
+				t.Fatalf("Process() error = %v", err)
+			}
+			thumb, format, err := image.Decode(bytes.NewReader(got.Thumbnail))
+			if err != nil {
+				t.Fatalf("decoding thumbnail: %v", err)
+			}
+			if format != "jpeg" {
+				t.Errorf("thumbnail format = %s, want jpeg", format)
+			}
+			if b := thumb.Bounds(); b.Dx() != tt.tw || b.Dy() != tt.th {
+				t.Errorf("thumbnail = %dx%d, want %dx%d", b.Dx(), b.Dy(), tt.tw, tt.th)
+			}
+		})
+	}
+}
+
+func TestThumbnailTransparency(t *testing.T) {
+	// Transparent pixels are drawn over white
+	got := thumbnail(filled(64, 64, color.Transparent))
+	if c := got.RGBAAt(10, 10); c != (color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}) {
+		t.Errorf("transparent pixel = %v, want white", c)
+	}
+
+	// Samples are averaged: a black and white striped image scales down to grey
+	striped := image.NewRGBA(image.Rect(0, 0, 2*ThumbnailSize*2, 2*ThumbnailSize*2))
+	for y := 0; y < striped.Bounds().Dy(); y++ {
+		for x := 0; x < striped.Bounds().Dx(); x++ {
+			if x%2 == 0 {
+				striped.Set(x, y, color.White)
+			} else {
+				striped.Set(x, y, color.Black)
+			}
+		}
+	}
+	c := thumbnail(striped).RGBAAt(ThumbnailSize/2, ThumbnailSize/2)
+	if c.R < 0x60 || c.R > 0xa0 || c.R != c.G || c.G != c.B {
+		t.Errorf("striped pixel = %v, want a mid grey", c)
+	}
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		w, h, size int
+		fw, fh     int
+	}{
+		{w: 100, h: 50, size: 480, fw: 100, fh: 50},
+		{w: 960, h: 480, size: 480, fw: 480, fh: 240},
+		{w: 480, h: 960, size: 480, fw: 240, fh: 480},
+		{w: 10000, h: 1, size: 480, fw: 480, fh: 1},
+	}
+	for _, tt := range tests {
+		if fw, fh := fit(tt.w, tt.h, tt.size); fw != tt.fw || fh != tt.fh {
+			t.Errorf("fit(%d, %d, %d) = %d, %d, want %d, %d", tt.w, tt.h, tt.size, fw, fh, tt.fw, tt.fh)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/notification"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+)
+
+// actions describe what the actors of each type of notification did, %s being the project name
+var actions = map[notification.Type]string{
+	notification.TypeProjectLiked:         "liked your project %s",
+	notification.TypeProjectCommented:     "commented on your project %s",
+	notification.TypeCommentReplied:       "replied to your comment on %s",
+	notification.TypeMemberInvited:        "invited you to join %s",
+	notification.TypeJoinRequested:        "asked to join %s",
+	notification.TypeMemberJoined:         "joined %s",
+	notification.TypeOwnershipTransferred: "transferred %s to you",
+	notification.TypeProjectRemixed:       "remixed your project %s",
+}
+
+// Message renders a notification for people, like "alice and 4 others liked your project X".
+// usernames are those of the most recent actors, at least the first one when known, and total
+// is the number of actors. An empty project name stands for a project the recipient cannot see.
+func Message(typ notification.Type, usernames []string, total int, projectName string) string {
+	if projectName == "" {
+		projectName = "a project"
+	}
+	action := fmt.Sprintf(actions[typ], projectName)
+
+	var who string
+	switch {
+	case len(usernames) == 0:
+		who = "Someone"
+	case total <= 1:
+		who = usernames[0]
+	case total == 2 && len(usernames) >= 2:
+		who = usernames[0] + " and " + usernames[1]
+	case total == 2:
+		who = usernames[0] + " and 1 other"
+	default:
+		who = fmt.Sprintf("%s and %d others", usernames[0], total-1)
+	}
+
+	return who + " " + action
+}
+
+// RecentActors returns the IDs of the most recent actors of the notification, up to limit
+func RecentActors(n *ent.Notification, limit int) []string {
+	return n.ActorIds[:min(len(n.ActorIds), limit)]
+}
+
+// Actors loads the most recent actors of the notifications, up to limit per notification, in a
+// single query. The users are keyed by ID.
+func Actors(ctx context.Context, client *ent.Client, notifications []*ent.Notification, limit int) (map[string]*ent.User, error) {
+	var ids []string
+	for _, n := range notifications {
+		ids = append(ids, RecentActors(n, limit)...)
+	}
+	users, err := client.User.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	actors := make(map[string]*ent.User, len(users))
+	for _, u := range users {
+		actors[u.ID] = u
+	}
+	return actors, nil
+}
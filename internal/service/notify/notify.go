@@ -0,0 +1,363 @@
+// Package notify turns domain events into in-app notifications.
+//
+// Notifications about the same thing are aggregated while unread: the likes a project gets in a
+// burst end up as a single "5 people liked your project" notification instead of five. A user
+// acting more than once, like liking, unliking and liking again, is only counted once.
+//
+// Events are turned into notifications by jobs, enqueued with Enqueue in the transaction of the
+// change they follow, so that no notification is lost on a restart or a burst of activity.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/comment"
+	"github.com/jorge-j1m/hackspark_server/ent/notification"
+	"github.com/jorge-j1m/hackspark_server/ent/notificationpreference"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/pubsub"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"github.com/jorge-j1m/hackspark_server/internal/service/jobs"
+	"github.com/jorge-j1m/hackspark_server/internal/service/realtime"
+)
+
+const (
+	// aggregationWindow is how long an unread notification keeps collecting new actors. Later
+	// activity starts a new notification, so that old ones do not jump back to the top forever.
+	aggregationWindow = 24 * time.Hour
+
+	// DispatchJob stores the notifications caused by an event
+	DispatchJob jobs.Kind = "notify.dispatch"
+)
+
+// events are the types of the events that notify someone
+var events = []eventbus.Type{
+	eventbus.ProjectLiked,
+	eventbus.CommentCreated,
+	eventbus.ProjectMemberInvited,
+	eventbus.ProjectJoinRequested,
+	eventbus.ProjectMemberJoined,
+	eventbus.ProjectOwnershipTransferred,
+	eventbus.ProjectRemixed,
+}
+
+// Types are all the notification types, in the order they are listed in preferences
+var Types = []notification.Type{
+	notification.TypeProjectLiked,
+	notification.TypeProjectCommented,
+	notification.TypeCommentReplied,
+	notification.TypeMemberInvited,
+	notification.TypeJoinRequested,
+	notification.TypeMemberJoined,
+	notification.TypeOwnershipTransferred,
+	notification.TypeProjectRemixed,
+}
+
+// delivery is a notification to give to a user
+type delivery struct {
+	recipientID string
+	typ         notification.Type
+	subjectID   *string
+	// groupKey identifies what the notification is about, deliveries with the same key are aggregated
+	groupKey string
+}
+
+// Dispatcher stores the notifications caused by domain events. Every delivery holds an advisory
+// lock on its recipient and group while it aggregates, so that workers of every replica can
+// dispatch events at the same time without losing actors or splitting a notification in two.
+type Dispatcher struct {
+	client *ent.Client
+	ps     pubsub.PubSub
+}
+
+// NewDispatcher creates a new dispatcher, telling connected recipients about their
+// notifications through ps
+func NewDispatcher(client *ent.Client, ps pubsub.PubSub) *Dispatcher {
+	return &Dispatcher{
+		client: client,
+		ps:     ps,
+	}
+}
+
+// Register sets the handler of the notification jobs
+func Register(r *jobs.Runner, d *Dispatcher) {
+	r.Register(DispatchJob, func(ctx context.Context, payload json.RawMessage) error {
+		e, err := jobs.Decode[eventbus.Event](payload)
+		if err != nil {
+			return err
+		}
+		return d.Dispatch(ctx, e)
+	})
+}
+
+// Enqueue queues the notifications caused by the event, if it notifies anyone. Pass the
+// transaction of the change the event follows.
+func Enqueue(ctx context.Context, tx *ent.Tx, e eventbus.Event) error {
+	if !slices.Contains(events, e.Type) {
+		return nil
+	}
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = time.Now()
+	}
+	return jobs.Enqueue(ctx, tx.Job, DispatchJob, e)
+}
+
+// Dispatch stores the notifications caused by the event. A job running again after a crash
+// notifies nobody twice, since actors are only counted once.
+func (d *Dispatcher) Dispatch(ctx context.Context, e eventbus.Event) error {
+	ctx = visibility.IncludeDeleted(visibility.System(ctx))
+
+	deliveries, err := d.deliveries(ctx, e)
+	if err != nil {
+		return fmt.Errorf("resolving recipients: %w", err)
+	}
+
+	for _, dl := range deliveries {
+		// Nobody is notified of what they did themselves
+		if dl.recipientID == "" || dl.recipientID == e.ActorID {
+			continue
+		}
+		enabled, err := Enabled(ctx, d.client, dl.recipientID, dl.typ)
+		if err != nil {
+			return fmt.Errorf("loading preferences of %s: %w", dl.recipientID, err)
+		}
+		if !enabled {
+			continue
+		}
+		n, err := d.deliver(ctx, e, dl)
+		if err != nil {
+			return fmt.Errorf("notifying %s: %w", dl.recipientID, err)
+		}
+		if n != nil {
+			d.publish(ctx, n)
+		}
+	}
+	return nil
+}
+
+// publish tells the recipient about the notification, along with their new unread count
+func (d *Dispatcher) publish(ctx context.Context, n *ent.Notification) {
+	unread, err := d.client.Notification.Query().
+		Where(notification.RecipientID(n.RecipientID), notification.ReadAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to count unread notifications")
+		return
+	}
+
+	realtime.Publish(ctx, d.ps, realtime.UserTopic(n.RecipientID), realtime.Update{
+		Type:      realtime.NotificationReceived,
+		ProjectID: derefString(n.ProjectID),
+		Data: map[string]any{
+			"notification_id": n.ID,
+			"type":            n.Type,
+			"actor_count":     len(n.ActorIds),
+			"unread_count":    unread,
+		},
+	})
+}
+
+// deliveries resolves who the event notifies and about what
+func (d *Dispatcher) deliveries(ctx context.Context, e eventbus.Event) ([]delivery, error) {
+	perProject := func(recipientID string, typ notification.Type, subjectID *string) delivery {
+		return delivery{
+			recipientID: recipientID,
+			typ:         typ,
+			subjectID:   subjectID,
+			groupKey:    fmt.Sprintf("%s:%s", typ, e.ProjectID),
+		}
+	}
+
+	switch e.Type {
+	case eventbus.ProjectLiked:
+		ownerID, err := d.projectOwner(ctx, e.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		return []delivery{perProject(ownerID, notification.TypeProjectLiked, nil)}, nil
+
+	case eventbus.CommentCreated:
+		ownerID, err := d.projectOwner(ctx, e.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		commentID := payloadString(e, "comment_id")
+		parentID := payloadString(e, "parent_id")
+		if parentID == nil {
+			return []delivery{perProject(ownerID, notification.TypeProjectCommented, commentID)}, nil
+		}
+
+		parent, err := d.client.Comment.Query().Where(comment.ID(*parentID)).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		deliveries := []delivery{{
+			recipientID: parent.AuthorID,
+			typ:         notification.TypeCommentReplied,
+			subjectID:   parentID,
+			groupKey:    fmt.Sprintf("%s:%s", notification.TypeCommentReplied, parent.ID),
+		}}
+		// The owner hears about replies too, unless they are the one being replied to
+		if ownerID != parent.AuthorID {
+			deliveries = append(deliveries, perProject(ownerID, notification.TypeProjectCommented, commentID))
+		}
+		return deliveries, nil
+
+	case eventbus.ProjectMemberInvited:
+		// Every invitation can be accepted or declined on its own, so they are never aggregated
+		invitationID := payloadString(e, "invitation_id")
+		inviteeID := payloadString(e, "invitee_id")
+		if invitationID == nil || inviteeID == nil {
+			return nil, nil
+		}
+		return []delivery{{
+			recipientID: *inviteeID,
+			typ:         notification.TypeMemberInvited,
+			subjectID:   invitationID,
+			groupKey:    fmt.Sprintf("%s:%s", notification.TypeMemberInvited, *invitationID),
+		}}, nil
+
+	case eventbus.ProjectJoinRequested:
+		ownerID, err := d.projectOwner(ctx, e.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		return []delivery{perProject(ownerID, notification.TypeJoinRequested, payloadString(e, "join_request_id"))}, nil
+
+	case eventbus.ProjectMemberJoined:
+		ownerID, err := d.projectOwner(ctx, e.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		return []delivery{perProject(ownerID, notification.TypeMemberJoined, nil)}, nil
+
+	case eventbus.ProjectOwnershipTransferred:
+		toOwnerID := payloadString(e, "to_owner_id")
+		if toOwnerID == nil {
+			return nil, nil
+		}
+		return []delivery{perProject(*toOwnerID, notification.TypeOwnershipTransferred, nil)}, nil
+
+	case eventbus.ProjectRemixed:
+		ownerID, err := d.projectOwner(ctx, e.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		return []delivery{perProject(ownerID, notification.TypeProjectRemixed, payloadString(e, "remix_id"))}, nil
+	}
+
+	return nil, nil
+}
+
+// deliver adds the actor to the unread notification of the same group, or creates a new one.
+// It returns nil when the actor was already counted in the notification.
+func (d *Dispatcher) deliver(ctx context.Context, e eventbus.Event, dl delivery) (*ent.Notification, error) {
+	var n *ent.Notification
+	err := database.WithTx(ctx, d.client, func(tx *ent.Tx) error {
+		if err := lockGroup(ctx, tx, dl); err != nil {
+			return err
+		}
+		var err error
+		n, err = aggregate(ctx, tx, e, dl)
+		return err
+	})
+	return n, err
+}
+
+// aggregate adds the actor to the notification of the group within the transaction holding the
+// lock of the group
+func aggregate(ctx context.Context, tx *ent.Tx, e eventbus.Event, dl delivery) (*ent.Notification, error) {
+	existing, err := tx.Notification.Query().
+		Where(
+			notification.RecipientID(dl.recipientID),
+			notification.GroupKey(dl.groupKey),
+			notification.ReadAtIsNil(),
+			notification.UpdateTimeGTE(time.Now().Add(-aggregationWindow)),
+		).
+		Order(ent.Desc(notification.FieldUpdateTime)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if existing != nil {
+		if slices.Contains(existing.ActorIds, e.ActorID) {
+			return nil, nil
+		}
+		return tx.Notification.UpdateOneID(existing.ID).
+			SetActorIds(append([]string{e.ActorID}, existing.ActorIds...)).
+			Save(ctx)
+	}
+
+	create := tx.Notification.Create().
+		SetRecipientID(dl.recipientID).
+		SetType(dl.typ).
+		SetNillableSubjectID(dl.subjectID).
+		SetGroupKey(dl.groupKey).
+		SetActorIds([]string{e.ActorID})
+	if e.ProjectID != "" {
+		create.SetProjectID(e.ProjectID)
+	}
+	return create.Save(ctx)
+}
+
+// lockGroup takes the advisory lock of the recipient and group of the delivery for the
+// transaction, waiting for the workers aggregating into the same notification
+func lockGroup(ctx context.Context, tx *ent.Tx, dl delivery) error {
+	h := fnv.New64a()
+	h.Write([]byte("hackspark.notify." + dl.recipientID + ":" + dl.groupKey))
+	key := int64(h.Sum64())
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", key); err != nil {
+		return fmt.Errorf("locking notification group %s: %w", dl.groupKey, err)
+	}
+	return nil
+}
+
+func (d *Dispatcher) projectOwner(ctx context.Context, projectID string) (string, error) {
+	return d.client.Project.Query().
+		Where(project.ID(projectID)).
+		QueryOwner().
+		OnlyID(ctx)
+}
+
+// Enabled reports whether the user gets notifications of the type. Every type is enabled until
+// the user turns it off.
+func Enabled(ctx context.Context, client *ent.Client, userID string, typ notification.Type) (bool, error) {
+	pref, err := client.NotificationPreference.Query().
+		Where(
+			notificationpreference.UserID(userID),
+			notificationpreference.TypeEQ(notificationpreference.Type(typ)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return pref.Enabled, nil
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func payloadString(e eventbus.Event, key string) *string {
+	if s, ok := e.Payload[key].(string); ok && s != "" {
+		return &s
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/notification"
+	"github.com/jorge-j1m/hackspark_server/ent/notificationpreference"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/pubsub"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/testdb"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"github.com/jorge-j1m/hackspark_server/internal/service/realtime"
+)
+
+// recorder keeps the updates published to users, along with the notification as the rest of
+// the app could read it at the time
+type recorder struct {
+	*pubsub.Memory
+	client *ent.Client
+
+	mu      sync.Mutex
+	updates []realtime.Update
+	stored  []*ent.Notification
+}
+
+func (r *recorder) Publish(ctx context.Context, topic string, payload []byte) error {
+	var u realtime.Update
+	if err := json.Unmarshal(payload, &u); err != nil {
+		return err
+	}
+	// Outside the transaction of the delivery, so only committed changes are seen
+	n, _ := r.client.Notification.Get(context.Background(), fmt.Sprint(u.Data["notification_id"]))
+
+	r.mu.Lock()
+	r.updates = append(r.updates, u)
+	r.stored = append(r.stored, n)
+	r.mu.Unlock()
+	return r.Memory.Publish(ctx, topic, payload)
+}
+
+func like(t *testing.T, d *Dispatcher, projectID, actorID string) {
+	t.Helper()
+	e := eventbus.Event{Type: eventbus.ProjectLiked, ActorID: actorID, ProjectID: projectID}
+	if err := d.Dispatch(context.Background(), e); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+}
+
+// notifications returns the notifications of the user, most recent first
+func notifications(t *testing.T, client *ent.Client, userID string) []*ent.Notification {
+	t.Helper()
+	ns, err := client.Notification.Query().
+		Where(notification.RecipientID(userID)).
+		Order(ent.Desc(notification.FieldCreateTime)).
+		All(context.Background())
+	if err != nil {
+		t.Fatalf("loading notifications: %v", err)
+	}
+	return ns
+}
+
+func TestDispatchAggregates(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+	d := NewDispatcher(client, pubsub.NewMemory())
+
+	owner := testdb.User(t, client, "owner")
+	alice := testdb.User(t, client, "alice")
+	bob := testdb.User(t, client, "bob")
+	carol := testdb.User(t, client, "carol")
+	p := testdb.Project(t, client, owner.ID, "Rocket", nil)
+
+	// Alice likes, unlikes and likes again, which counts once
+	for _, u := range []*ent.User{alice, bob, alice, carol} {
+		like(t, d, p.ID, u.ID)
+	}
+	// Nobody is notified of their own likes
+	like(t, d, p.ID, owner.ID)
+
+	ns := notifications(t, client, owner.ID)
+	if len(ns) != 1 {
+		t.Fatalf("%d notifications, want 1", len(ns))
+	}
+	if want := []string{carol.ID, bob.ID, alice.ID}; !slices.Equal(ns[0].ActorIds, want) {
+		t.Errorf("actors = %v, want %v", ns[0].ActorIds, want)
+	}
+	if ns[0].Type != notification.TypeProjectLiked || ns[0].ProjectID == nil || *ns[0].ProjectID != p.ID {
+		t.Errorf("notification = %+v, want a like of the project", ns[0])
+	}
+
+	// Once read, the next like starts a new notification
+	client.Notification.UpdateOne(ns[0]).SetReadAt(ns[0].CreateTime).ExecX(ctx)
+	like(t, d, p.ID, bob.ID)
+	ns = notifications(t, client, owner.ID)
+	if len(ns) != 2 {
+		t.Fatalf("%d notifications after reading, want 2", len(ns))
+	}
+	if ns[0].ReadAt != nil || !slices.Equal(ns[0].ActorIds, []string{bob.ID}) {
+		t.Errorf("new notification = %+v, want an unread one from bob", ns[0])
+	}
+
+	// Likes of another project are another notification
+	other := testdb.Project(t, client, owner.ID, "Other", nil)
+	like(t, d, other.ID, bob.ID)
+	unread := client.Notification.Query().
+		Where(notification.RecipientID(owner.ID), notification.ReadAtIsNil()).
+		CountX(ctx)
+	if unread != 2 {
+		t.Errorf("%d unread notifications, want 2", unread)
+	}
+}
+
+func TestDispatchRespectsPreferences(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+	d := NewDispatcher(client, pubsub.NewMemory())
+
+	owner := testdb.User(t, client, "owner")
+	fan := testdb.User(t, client, "fan")
+	p := testdb.Project(t, client, owner.ID, "Rocket", nil)
+	client.NotificationPreference.Create().
+		SetUserID(owner.ID).
+		SetType(notificationpreference.TypeProjectLiked).
+		SetEnabled(false).
+		ExecX(ctx)
+
+	like(t, d, p.ID, fan.ID)
+	if ns := notifications(t, client, owner.ID); len(ns) != 0 {
+		t.Errorf("%d notifications of a disabled type, want 0", len(ns))
+	}
+}
+
+func TestDispatchConcurrentActors(t *testing.T) {
+	client := testdb.Open(t)
+	d := NewDispatcher(client, pubsub.NewMemory())
+
+	owner := testdb.User(t, client, "owner")
+	p := testdb.Project(t, client, owner.ID, "Rocket", nil)
+	var fans []string
+	for i := range 8 {
+		fans = append(fans, testdb.User(t, client, fmt.Sprintf("fan%d", i)).ID)
+	}
+
+	// Workers of every replica aggregate into the same notification without losing anyone
+	var wg sync.WaitGroup
+	errs := make(chan error, len(fans))
+	for _, fan := range fans {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- d.Dispatch(context.Background(), eventbus.Event{Type: eventbus.ProjectLiked, ActorID: fan, ProjectID: p.ID})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Dispatch() error = %v", err)
+		}
+	}
+
+	ns := notifications(t, client, owner.ID)
+	if len(ns) != 1 {
+		t.Fatalf("%d notifications, want 1", len(ns))
+	}
+	got := slices.Clone(ns[0].ActorIds)
+	slices.Sort(got)
+	slices.Sort(fans)
+	if !slices.Equal(got, fans) {
+		t.Errorf("actors = %v, want %v", got, fans)
+	}
+}
+
+func TestDispatchPublishesAfterCommit(t *testing.T) {
+	client := testdb.Open(t)
+	ps := &recorder{Memory: pubsub.NewMemory(), client: client}
+	d := NewDispatcher(client, ps)
+
+	owner := testdb.User(t, client, "owner")
+	alice := testdb.User(t, client, "alice")
+	bob := testdb.User(t, client, "bob")
+	p := testdb.Project(t, client, owner.ID, "Rocket", nil)
+
+	sub := ps.Subscribe(realtime.UserTopic(owner.ID))
+	defer sub.Close()
+
+	like(t, d, p.ID, alice.ID)
+	like(t, d, p.ID, bob.ID)
+	// Already counted, so there is nothing new to tell
+	like(t, d, p.ID, alice.ID)
+
+	if len(ps.updates) != 2 {
+		t.Fatalf("%d updates published, want 2", len(ps.updates))
+	}
+	for i, u := range ps.updates {
+		if u.Type != realtime.NotificationReceived || u.ProjectID != p.ID {
+			t.Errorf("update %d = %+v, want a notification about the project", i, u)
+		}
+		// Numbers are decoded from JSON
+		if got := u.Data["actor_count"]; got != float64(i+1) {
+			t.Errorf("update %d counts %v actors, want %d", i, got, i+1)
+		}
+		if got := u.Data["unread_count"]; got != float64(1) {
+			t.Errorf("update %d counts %v unread notifications, want 1", i, got)
+		}
+		n := ps.stored[i]
+		if n == nil || len(n.ActorIds) != i+1 {
+			t.Errorf("update %d was published before the notification was committed: %+v", i, n)
+		}
+	}
+
+	select {
+	case msg := <-sub.C():
+		if msg.Topic != realtime.UserTopic(owner.ID) {
+			t.Errorf("update published to %s", msg.Topic)
+		}
+	default:
+		t.Error("the recipient did not receive the update")
+	}
+}
@@ -0,0 +1,86 @@
+package progress
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/milestone"
+	"github.com/jorge-j1m/hackspark_server/ent/task"
+)
+
+// counts is the scan target of the grouped progress queries
+type counts struct {
+	ProjectID string `json:"project_id"`
+	Total     int    `json:"total"`
+	Completed int    `json:"completed"`
+}
+
+// completedCount counts the rows with a completion timestamp, COUNT skips NULLs
+func completedCount(s *sql.Selector) string {
+	return sql.As(sql.Count(s.C("completed_at")), "completed")
+}
+
+// ForProjects computes the completion percentage (0-100) of each project.
+// Progress is measured over tasks. Projects that only have milestones, without any
+// tasks, are measured over their milestones instead. Projects with no plan at all
+// report 0. It always runs two queries, regardless of the number of projects.
+func ForProjects(ctx context.Context, client *ent.Client, projectIDs []string) (map[string]int, error) {
+	result := make(map[string]int, len(projectIDs))
+	if len(projectIDs) == 0 {
+		return result, nil
+	}
+
+	var taskCounts []counts
+	if err := client.Task.Query().
+		Where(task.ProjectIDIn(projectIDs...)).
+		GroupBy(task.FieldProjectID).
+		Aggregate(ent.As(ent.Count(), "total"), completedCount).
+		Scan(ctx, &taskCounts); err != nil {
+		return nil, fmt.Errorf("counting tasks: %w", err)
+	}
+
+	var milestoneCounts []counts
+	if err := client.Milestone.Query().
+		Where(milestone.ProjectIDIn(projectIDs...)).
+		GroupBy(milestone.FieldProjectID).
+		Aggregate(ent.As(ent.Count(), "total"), completedCount).
+		Scan(ctx, &milestoneCounts); err != nil {
+		return nil, fmt.Errorf("counting milestones: %w", err)
+	}
+
+	for _, c := range milestoneCounts {
+		result[c.ProjectID] = percentage(c.Completed, c.Total)
+	}
+	// Tasks are finer grained, so they take precedence over milestones
+	for _, c := range taskCounts {
+		result[c.ProjectID] = percentage(c.Completed, c.Total)
+	}
+	return result, nil
+}
+
+// ForMilestone computes the completion percentage of a single milestone out of its tasks
+func ForMilestone(m *ent.Milestone, tasks []*ent.Task) int {
+	if len(tasks) == 0 {
+		if m.CompletedAt != nil {
+			return 100
+		}
+		return 0
+	}
+	completed := 0
+	for _, t := range tasks {
+		if t.CompletedAt != nil {
+			completed++
+		}
+	}
+	return percentage(completed, len(tasks))
+}
+
+func percentage(completed, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return completed * 100 / total
+}
@@ -0,0 +1,181 @@
+package purge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/comment"
+	"github.com/jorge-j1m/hackspark_server/ent/job"
+	"github.com/jorge-j1m/hackspark_server/ent/like"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projectmedia"
+	"github.com/jorge-j1m/hackspark_server/ent/projecttag"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/storage"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/collections"
+	"github.com/jorge-j1m/hackspark_server/internal/service/counters"
+)
+
+// batchSize bounds the number of projects removed in a single transaction
+const batchSize = 100
+
+// Restorable reports whether a row deleted at deletedAt can still be restored at now
+func Restorable(deletedAt time.Time, retention time.Duration, now time.Time) bool {
+	return now.Before(deletedAt.Add(retention))
+}
+
+// Purger removes for good the projects and comments deleted for longer than the retention,
+// as a scheduled task
+type Purger struct {
+	client    *ent.Client
+	blob      storage.Blob
+	retention time.Duration
+}
+
+// NewPurger creates a new purger
+func NewPurger(client *ent.Client, blob storage.Blob, retention time.Duration) *Purger {
+	return &Purger{
+		client:    client,
+		blob:      blob,
+		retention: retention,
+	}
+}
+
+// Purge removes the projects and comments whose retention has expired, along with the jobs
+// that succeeded more than a day ago
+func (p *Purger) Purge(ctx context.Context) error {
+	// The purge sees every project, deleted or not, and its deletes are for good
+	ctx = visibility.IncludeDeleted(visibility.System(ctx))
+	cutoff := time.Now().Add(-p.retention)
+
+	projects := 0
+	for {
+		n, err := p.purgeProjects(ctx, cutoff)
+		if err != nil {
+			return err
+		}
+		projects += n
+		if n < batchSize {
+			break
+		}
+	}
+
+	comments, err := p.purgeComments(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+
+	jobs, err := p.client.Job.Delete().
+		Where(job.StatusEQ(job.StatusSucceeded), job.FinishedAtLT(time.Now().Add(-24*time.Hour))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("deleting succeeded jobs: %w", err)
+	}
+
+	if projects > 0 || comments > 0 || jobs > 0 {
+		log.Info(ctx).Msgf("Purged %d projects, %d comments and %d jobs", projects, comments, jobs)
+	}
+	return nil
+}
+
+// purgeProjects removes a batch of expired projects. Likes and tags are not removed by the
+// database along with the project, so they are removed first and the usage counters of the
+// tags are recomputed, as are the remix counters of the projects remixed. The projects leave
+// the collections holding them, whose items are numbered again without gaps. The files of the
+// media go once the projects are gone for good.
+func (p *Purger) purgeProjects(ctx context.Context, cutoff time.Time) (int, error) {
+	ids, err := p.client.Project.Query().
+		Where(project.DeletedAtLT(cutoff)).
+		Limit(batchSize).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("loading expired projects: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	remixes, err := p.client.Project.Query().
+		Where(
+			project.IDIn(ids...),
+			project.RemixedFromIDNotNil(),
+			project.RemixedFromIDNotIn(ids...),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("loading remixes: %w", err)
+	}
+	remixCounts := make(map[string]int)
+	for _, r := range remixes {
+		remixCounts[*r.RemixedFromID]++
+	}
+
+	media, err := p.client.ProjectMedia.Query().
+		Where(projectmedia.ProjectIDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("loading media: %w", err)
+	}
+
+	err = database.WithTx(ctx, p.client, func(tx *ent.Tx) error {
+		tagIDs, err := tx.ProjectTag.Query().
+			Where(projecttag.ProjectIDIn(ids...)).
+			Unique(true).
+			Select(projecttag.FieldTagID).
+			Strings(ctx)
+		if err != nil {
+			return fmt.Errorf("loading tags: %w", err)
+		}
+
+		if err := collections.RemoveProjects(ctx, tx, ids); err != nil {
+			return err
+		}
+		if _, err := tx.Like.Delete().Where(like.ProjectIDIn(ids...)).Exec(ctx); err != nil {
+			return fmt.Errorf("deleting likes: %w", err)
+		}
+		if _, err := tx.ProjectTag.Delete().Where(projecttag.ProjectIDIn(ids...)).Exec(ctx); err != nil {
+			return fmt.Errorf("deleting project tags: %w", err)
+		}
+		if _, err := tx.Project.Delete().Where(project.IDIn(ids...)).Exec(ctx); err != nil {
+			return fmt.Errorf("deleting projects: %w", err)
+		}
+		for id, n := range remixCounts {
+			if err := tx.Project.UpdateOneID(id).AddRemixCount(-n).Exec(ctx); err != nil {
+				return fmt.Errorf("updating remix count of project %s: %w", id, err)
+			}
+		}
+
+		return counters.RecountTags(ctx, tx.Client(), tagIDs)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, m := range media {
+		for _, key := range []string{m.Key, m.ThumbnailKey} {
+			if err := p.blob.Delete(ctx, key); err != nil {
+				log.Error(ctx).Err(err).Msgf("Failed to delete stored file %s", key)
+			}
+		}
+	}
+	return len(ids), nil
+}
+
+// purgeComments removes the expired comments. Deleted comments that still have replies
+// anchor their thread, so they are kept until every reply is gone.
+func (p *Purger) purgeComments(ctx context.Context, cutoff time.Time) (int, error) {
+	n, err := p.client.Comment.Delete().
+		Where(
+			comment.DeletedAtLT(cutoff),
+			comment.Not(comment.HasReplies()),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("deleting comments: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,122 @@
+// Package realtime publishes the updates streamed to clients: like counts and new comments of
+// the projects they watch, and their own new notifications.
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/like"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/infrastructure/pubsub"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+)
+
+// Type identifies a kind of update
+type Type string
+
+const (
+	// ProjectLikeCount is sent when the like count of a watched project changes
+	ProjectLikeCount Type = "project.like_count"
+
+	// CommentCreated is sent when a watched project gets a comment or a reply
+	CommentCreated Type = "comment.created"
+
+	// NotificationReceived is sent to a user when they get a notification, or a notification of
+	// theirs gets a new actor
+	NotificationReceived Type = "notification.received"
+
+	// StreamSubscriptions is sent when a stream opens and whenever its watched projects change
+	StreamSubscriptions Type = "stream.subscriptions"
+)
+
+// Update is what clients receive
+type Update struct {
+	Type      Type           `json:"type"`
+	ProjectID string         `json:"project_id,omitempty"`
+	Data      map[string]any `json:"data"`
+	SentAt    time.Time      `json:"sent_at"`
+}
+
+// ProjectTopic is the topic of the updates of a project
+func ProjectTopic(projectID string) string {
+	return "project:" + projectID
+}
+
+// UserTopic is the topic of the updates addressed to a user
+func UserTopic(userID string) string {
+	return "user:" + userID
+}
+
+// Publish sends the update on the topic. Updates are best effort, so failures are only logged.
+func Publish(ctx context.Context, ps pubsub.PubSub, topic string, u Update) {
+	if u.SentAt.IsZero() {
+		u.SentAt = time.Now()
+	}
+	payload, err := json.Marshal(u)
+	if err == nil {
+		err = ps.Publish(ctx, topic, payload)
+	}
+	if err != nil {
+		log.Error(ctx).Err(err).Str("update_type", string(u.Type)).Msg("Failed to publish real-time update")
+	}
+}
+
+// Relay publishes the updates caused by domain events
+type Relay struct {
+	client *ent.Client
+	ps     pubsub.PubSub
+}
+
+// NewRelay creates a new relay
+func NewRelay(client *ent.Client, ps pubsub.PubSub) *Relay {
+	return &Relay{
+		client: client,
+		ps:     ps,
+	}
+}
+
+// Subscribe registers the relay for the events clients are updated about
+func (r *Relay) Subscribe(bus *eventbus.Bus) {
+	bus.Subscribe(r.likeCountChanged, eventbus.ProjectLiked, eventbus.ProjectUnliked)
+	bus.Subscribe(r.commentCreated, eventbus.CommentCreated)
+}
+
+// likeCountChanged counts the likes rather than reading the counter of the project, which is
+// only recounted later by a job and would still hold the count from before the event
+func (r *Relay) likeCountChanged(ctx context.Context, e eventbus.Event) {
+	count, err := r.client.Like.Query().
+		Where(like.ProjectID(e.ProjectID)).
+		Count(visibility.IncludeDeleted(visibility.System(ctx)))
+	if err != nil {
+		log.Error(ctx).Err(err).Msg("Failed to count likes")
+		return
+	}
+
+	Publish(ctx, r.ps, ProjectTopic(e.ProjectID), Update{
+		Type:      ProjectLikeCount,
+		ProjectID: e.ProjectID,
+		Data: map[string]any{
+			"like_count": count,
+		},
+	})
+}
+
+func (r *Relay) commentCreated(ctx context.Context, e eventbus.Event) {
+	data := map[string]any{
+		"comment_id": e.Payload["comment_id"],
+		"author_id":  e.ActorID,
+	}
+	if parentID, ok := e.Payload["parent_id"]; ok {
+		data["parent_id"] = parentID
+	}
+
+	Publish(ctx, r.ps, ProjectTopic(e.ProjectID), Update{
+		Type:      CommentCreated,
+		ProjectID: e.ProjectID,
+		Data:      data,
+	})
+}
@@ -0,0 +1,118 @@
+package revision
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+)
+
+// maxDiffCells bounds the size of the table used to diff descriptions line by line.
+// Larger descriptions are reported as entirely replaced.
+const maxDiffCells = 1_000_000
+
+// LineOp tells what happened to a line of the description between two revisions
+type LineOp string
+
+const (
+	LineEqual  LineOp = "equal"
+	LineInsert LineOp = "insert"
+	LineDelete LineOp = "delete"
+)
+
+// Line is a line of a description diff
+type Line struct {
+	Op   LineOp
+	Text string
+}
+
+// Diff lists what changed in the content of a project between two revisions
+type Diff struct {
+	// NameChanged is true when Name holds the names of both revisions
+	NameChanged bool
+	Name        [2]string
+	// Description is empty when the description did not change
+	Description []Line
+	TagsAdded   []string
+	TagsRemoved []string
+}
+
+// Compare returns the changes needed to go from one revision to another
+func Compare(from, to *ent.ProjectRevision) Diff {
+	d := Diff{
+		TagsAdded:   difference(to.Tags, from.Tags),
+		TagsRemoved: difference(from.Tags, to.Tags),
+	}
+	if from.Name != to.Name {
+		d.NameChanged = true
+		d.Name = [2]string{from.Name, to.Name}
+	}
+	if from.Description != to.Description {
+		d.Description = diffLines(strings.Split(from.Description, "\n"), strings.Split(to.Description, "\n"))
+	}
+	return d
+}
+
+// difference returns the values of a missing from b
+func difference(a, b []string) []string {
+	out := []string{}
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// diffLines diffs two texts line by line using their longest common subsequence
+func diffLines(a, b []string) []Line {
+	if len(a)*len(b) > maxDiffCells {
+		lines := make([]Line, 0, len(a)+len(b))
+		for _, l := range a {
+			lines = append(lines, Line{Op: LineDelete, Text: l})
+		}
+		for _, l := range b {
+			lines = append(lines, Line{Op: LineInsert, Text: l})
+		}
+		return lines
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]Line, 0, max(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, Line{Op: LineEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, Line{Op: LineDelete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, Line{Op: LineInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, Line{Op: LineDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, Line{Op: LineInsert, Text: b[j]})
+	}
+	return lines
+}
@@ -0,0 +1,134 @@
+package revision
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+)
+
+// sides rebuilds the texts a diff goes from and to
+func sides(lines []Line) (from, to []string) {
+	for _, l := range lines {
+		if l.Op != LineInsert {
+			from = append(from, l.Text)
+		}
+		if l.Op != LineDelete {
+			to = append(to, l.Text)
+		}
+	}
+	return from, to
+}
+
+func count(lines []Line, op LineOp) int {
+	n := 0
+	for _, l := range lines {
+		if l.Op == op {
+			n++
+		}
+	}
+	return n
+}
+
+func numbered(prefix string, n int) []string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("%s %d", prefix, i)
+	}
+	return lines
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  string
+		want  string
+		equal int
+	}{
+		{name: "identical", a: "a\nb\nc", b: "a\nb\nc", want: " a  b  c", equal: 3},
+		{name: "insert", a: "a\nc", b: "a\nb\nc", want: " a +b  c", equal: 2},
+		{name: "delete", a: "a\nb\nc", b: "a\nc", want: " a -b  c", equal: 2},
+		{name: "replace", a: "a\nb\nc", b: "a\nx\nc", want: " a -b +x  c", equal: 2},
+		{name: "append", a: "a", b: "a\nb\nc", want: " a +b +c", equal: 1},
+		{name: "from empty", a: "", b: "a", want: "- +a", equal: 0},
+		{name: "nothing in common", a: "a\nb", b: "c\nd", want: "-a -b +c +d", equal: 0},
+		{name: "moved line", a: "a\nb\nc\nd", b: "b\nc\nd\na", want: "-a  b  c  d +a", equal: 3},
+		{name: "repeated lines", a: "x\na\nx\nb\nx", b: "x\nb\nx\na\nx", want: " x -a -x  b  x +a +x", equal: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := strings.Split(tt.a, "\n"), strings.Split(tt.b, "\n")
+			lines := diffLines(a, b)
+
+			var got []string
+			for _, l := range lines {
+				op := map[LineOp]string{LineEqual: " ", LineInsert: "+", LineDelete: "-"}[l.Op]
+				got = append(got, op+l.Text)
+			}
+			if strings.Join(got, " ") != tt.want {
+				t.Errorf("diffLines() = %q, want %q", strings.Join(got, " "), tt.want)
+			}
+
+			// Keeping every line of the longest common subsequence
+			if n := count(lines, LineEqual); n != tt.equal {
+				t.Errorf("diffLines() keeps %d lines, want %d", n, tt.equal)
+			}
+			from, to := sides(lines)
+			if !slices.Equal(from, a) || !slices.Equal(to, b) {
+				t.Errorf("diffLines() goes from %q to %q, want %q to %q", from, to, a, b)
+			}
+		})
+	}
+}
+
+func TestDiffLinesCap(t *testing.T) {
+	shared := numbered("shared", 10)
+
+	// At the cap, the description is diffed line by line
+	a := append(numbered("old", 990), shared...)
+	b := append(numbered("new", 990), shared...)
+	if len(a)*len(b) != maxDiffCells {
+		t.Fatalf("texts of %d and %d lines, want %d cells", len(a), len(b), maxDiffCells)
+	}
+	lines := diffLines(a, b)
+	if n := count(lines, LineEqual); n != len(shared) {
+		t.Errorf("diffLines() at the cap keeps %d lines, want %d", n, len(shared))
+	}
+
+	// Past the cap, it is reported as entirely replaced
+	a = append(a, "one more")
+	lines = diffLines(a, b)
+	if count(lines, LineEqual) != 0 || count(lines, LineDelete) != len(a) || count(lines, LineInsert) != len(b) {
+		t.Errorf("diffLines() past the cap = %d equal, %d deleted, %d inserted, want every line replaced",
+			count(lines, LineEqual), count(lines, LineDelete), count(lines, LineInsert))
+	}
+	if from, to := sides(lines); !slices.Equal(from, a) || !slices.Equal(to, b) {
+		t.Error("diffLines() past the cap does not go from one text to the other")
+	}
+	// Old lines come first
+	if lines[0].Op != LineDelete || lines[len(lines)-1].Op != LineInsert {
+		t.Errorf("diffLines() past the cap starts with %s and ends with %s", lines[0].Op, lines[len(lines)-1].Op)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	from := &ent.ProjectRevision{Name: "Spark", Description: "A tool\nfor hackers", Tags: []string{"go", "cli"}}
+
+	d := Compare(from, &ent.ProjectRevision{Name: "Spark", Description: "A tool\nfor hackers", Tags: []string{"cli", "go"}})
+	if d.NameChanged || d.Description != nil || len(d.TagsAdded) != 0 || len(d.TagsRemoved) != 0 {
+		t.Errorf("Compare() of the same content = %+v, want no change", d)
+	}
+
+	d = Compare(from, &ent.ProjectRevision{Name: "HackSpark", Description: "A tool\nfor makers", Tags: []string{"go", "web"}})
+	if !d.NameChanged || d.Name != [2]string{"Spark", "HackSpark"} {
+		t.Errorf("Name = %t %q, want the names of both revisions", d.NameChanged, d.Name)
+	}
+	if want := []Line{{LineEqual, "A tool"}, {LineDelete, "for hackers"}, {LineInsert, "for makers"}}; !slices.Equal(d.Description, want) {
+		t.Errorf("Description = %v, want %v", d.Description, want)
+	}
+	if !slices.Equal(d.TagsAdded, []string{"web"}) || !slices.Equal(d.TagsRemoved, []string{"cli"}) {
+		t.Errorf("tags added %q and removed %q, want web and cli", d.TagsAdded, d.TagsRemoved)
+	}
+}
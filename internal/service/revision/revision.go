@@ -0,0 +1,141 @@
+// Package revision records the history of the content of projects: their name, description and tags.
+//
+// A hook registered by the Project schema records a revision every time a project is created or its
+// content is updated, so every way of editing a project, reverts included, is covered. Projects
+// edited for the first time since revisions exist get their previous content recorded first.
+package revision
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"entgo.io/ent"
+
+	gen "github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/hook"
+	"github.com/jorge-j1m/hackspark_server/ent/projectrevision"
+	"github.com/jorge-j1m/hackspark_server/ent/projecttag"
+	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+)
+
+// Hook records a revision of the projects whose content is created or updated.
+// The tags of the project are read back once the mutation is done, so they must be
+// changed through the mutation itself for the revision to include them.
+func Hook() ent.Hook {
+	return hook.On(
+		hook.If(func(next ent.Mutator) ent.Mutator {
+			return hook.ProjectFunc(func(ctx context.Context, m *gen.ProjectMutation) (ent.Value, error) {
+				client := m.Client()
+				// Whoever edits the project, revisions are about the project as a whole
+				sysCtx := visibility.System(ctx)
+
+				if id, ok := m.ID(); ok && m.Op().Is(ent.OpUpdateOne) {
+					if err := backfill(sysCtx, client, id); err != nil {
+						return nil, fmt.Errorf("recording previous revision: %w", err)
+					}
+				}
+
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+				p, ok := v.(*gen.Project)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation result %T", v)
+				}
+
+				var authorID *string
+				if viewer := visibility.Viewer(ctx); viewer != "" {
+					authorID = &viewer
+				}
+				if err := record(sysCtx, client, p.ID, p.Name, p.Description, authorID); err != nil {
+					return nil, fmt.Errorf("recording revision: %w", err)
+				}
+				return v, nil
+			})
+		}, changesContent),
+		ent.OpCreate|ent.OpUpdateOne,
+	)
+}
+
+// changesContent reports whether the mutation creates a project or changes its content
+func changesContent(_ context.Context, m ent.Mutation) bool {
+	pm, ok := m.(*gen.ProjectMutation)
+	if !ok {
+		return false
+	}
+	if pm.Op().Is(ent.OpCreate) {
+		return true
+	}
+	_, name := pm.Name()
+	_, description := pm.Description()
+	return name || description || pm.TagsCleared() || len(pm.TagsIDs()) > 0 || len(pm.RemovedTagsIDs()) > 0
+}
+
+// backfill records the current content of a project that has no revision yet
+func backfill(ctx context.Context, client *gen.Client, projectID string) error {
+	exists, err := client.ProjectRevision.Query().
+		Where(projectrevision.ProjectID(projectID)).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	p, err := client.Project.Get(ctx, projectID)
+	if err != nil {
+		if gen.IsNotFound(err) {
+			// Let the mutation report the missing project
+			return nil
+		}
+		return err
+	}
+	return record(ctx, client, p.ID, p.Name, p.Description, nil)
+}
+
+// record stores the content of the project as its next revision, unless it did not change
+func record(ctx context.Context, client *gen.Client, projectID, name, description string, authorID *string) error {
+	tags, err := Tags(ctx, client, projectID)
+	if err != nil {
+		return err
+	}
+
+	number := 1
+	last, err := client.ProjectRevision.Query().
+		Where(projectrevision.ProjectID(projectID)).
+		Order(gen.Desc(projectrevision.FieldNumber)).
+		First(ctx)
+	switch {
+	case err == nil:
+		if last.Name == name && last.Description == description && slices.Equal(last.Tags, tags) {
+			return nil
+		}
+		number = last.Number + 1
+	case !gen.IsNotFound(err):
+		return err
+	}
+
+	return client.ProjectRevision.Create().
+		SetProjectID(projectID).
+		SetNillableAuthorID(authorID).
+		SetNumber(number).
+		SetName(name).
+		SetDescription(description).
+		SetTags(tags).
+		Exec(ctx)
+}
+
+// Tags returns the sorted slugs of the tags of the project
+func Tags(ctx context.Context, client *gen.Client, projectID string) ([]string, error) {
+	slugs, err := client.ProjectTag.Query().
+		Where(projecttag.ProjectID(projectID)).
+		QueryTag().
+		Select(tag.FieldSlug).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(slugs)
+	return slugs, nil
+}
@@ -0,0 +1,300 @@
+// Package scheduler runs the recurring maintenance tasks of the server on cron schedules.
+//
+// Every replica runs a scheduler, and they elect which one runs each task: a run holds a
+// PostgreSQL advisory lock on its task for as long as it lasts, and the replicas that fail to
+// get the lock skip the task. Runs are recorded as ScheduledRuns, at most one for each time a
+// task is due, so a replica whose clock is a little late does not run the task again once the
+// lock is released.
+//
+// Schedules are in UTC.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/scheduledrun"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/cron"
+)
+
+// Off is the schedule of the tasks that only run when triggered by hand
+const Off = "off"
+
+var (
+	// ErrUnknownTask is returned when triggering a task that does not exist
+	ErrUnknownTask = errors.New("unknown task")
+
+	// ErrTaskRunning is returned when triggering a task that is already running, on any replica
+	ErrTaskRunning = errors.New("task is already running")
+)
+
+// Func runs a task
+type Func func(ctx context.Context) error
+
+// Task is a recurring piece of work
+type Task struct {
+	Name string
+	// Schedule is nil for the tasks that only run when triggered by hand
+	Schedule *cron.Schedule
+	run      Func
+}
+
+// Scheduler runs the tasks when they are due
+type Scheduler struct {
+	client   *ent.Client
+	instance string
+	tasks    []*Task
+
+	mu  sync.Mutex
+	ctx context.Context // The context of Run, which manual runs are tied to as well
+	wg  sync.WaitGroup
+}
+
+// New creates a new scheduler
+func New(client *ent.Client) *Scheduler {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return &Scheduler{
+		client:   client,
+		instance: host + ":" + strconv.Itoa(os.Getpid()),
+		ctx:      context.Background(),
+	}
+}
+
+// Add adds a task run on the cron expression, or only when triggered when the expression is Off.
+// Tasks must be added before Run.
+func (s *Scheduler) Add(name, expr string, run Func) error {
+	t := &Task{Name: name, run: run}
+	if expr != Off {
+		schedule, err := cron.Parse(expr)
+		if err != nil {
+			return fmt.Errorf("scheduling %s: %w", name, err)
+		}
+		t.Schedule = schedule
+	}
+	s.tasks = append(s.tasks, t)
+	return nil
+}
+
+// Tasks returns the tasks, in the order they were added
+func (s *Scheduler) Tasks() []*Task {
+	return s.tasks
+}
+
+// Task returns the task with the name
+func (s *Scheduler) Task(name string) (*Task, bool) {
+	for _, t := range s.tasks {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// Run runs the tasks whenever they are due until ctx is cancelled, then waits for the running
+// ones to return
+func (s *Scheduler) Run(ctx context.Context) {
+	s.mu.Lock()
+	s.ctx = ctx
+	s.mu.Unlock()
+
+	next := make(map[*Task]time.Time)
+	now := time.Now().UTC()
+	for _, t := range s.tasks {
+		if t.Schedule != nil {
+			next[t] = t.Schedule.Next(now)
+		}
+	}
+
+	for {
+		var earliest time.Time
+		for _, at := range next {
+			if earliest.IsZero() || at.Before(earliest) {
+				earliest = at
+			}
+		}
+		if earliest.IsZero() {
+			// Nothing is scheduled, tasks may still be triggered by hand
+			<-ctx.Done()
+			s.wg.Wait()
+			return
+		}
+
+		timer := time.NewTimer(time.Until(earliest))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.wg.Wait()
+			return
+		case <-timer.C:
+		}
+
+		now := time.Now().UTC()
+		for t, at := range next {
+			if at.After(now) {
+				continue
+			}
+			next[t] = t.Schedule.Next(now)
+
+			_, err := s.start(ctx, t, scheduledrun.TriggerSchedule, &at, nil)
+			if err != nil && !errors.Is(err, ErrTaskRunning) && ctx.Err() == nil {
+				log.Error(ctx).Err(err).Str("task", t.Name).Msg("Failed to start scheduled task")
+			}
+		}
+	}
+}
+
+// Wait waits for the running tasks to return
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// Trigger runs a task right away on behalf of the user, unless it is already running. The run
+// goes on in the background, the returned record tells how it went once it has ended.
+func (s *Scheduler) Trigger(ctx context.Context, name, userID string) (*ent.ScheduledRun, error) {
+	t, ok := s.Task(name)
+	if !ok {
+		return nil, ErrUnknownTask
+	}
+
+	s.mu.Lock()
+	runCtx := s.ctx
+	s.mu.Unlock()
+
+	// The run outlives the request that triggered it
+	return s.start(runCtx, t, scheduledrun.TriggerManual, nil, &userID)
+}
+
+// start takes the lock of the task and runs it in the background, holding the lock until it
+// returns. It returns a nil run when the time the task was due at has already been run.
+func (s *Scheduler) start(ctx context.Context, t *Task, trigger scheduledrun.Trigger, scheduledFor *time.Time, userID *string) (*ent.ScheduledRun, error) {
+	// The lock is tied to the transaction, so it is released even if the replica dies
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	release := func() {
+		_ = tx.Rollback()
+	}
+
+	locked, err := tryLock(ctx, tx, t.Name)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	if !locked {
+		release()
+		return nil, ErrTaskRunning
+	}
+
+	// The replicas of runs left running stopped without recording how they ended, as they
+	// would still hold the lock otherwise
+	if err := s.client.ScheduledRun.Update().
+		Where(scheduledrun.Task(t.Name), scheduledrun.StatusEQ(scheduledrun.StatusRunning)).
+		SetStatus(scheduledrun.StatusFailed).
+		SetFinishedAt(time.Now()).
+		SetError("interrupted").
+		Exec(ctx); err != nil {
+		release()
+		return nil, fmt.Errorf("closing interrupted runs: %w", err)
+	}
+
+	if scheduledFor != nil {
+		done, err := s.client.ScheduledRun.Query().
+			Where(scheduledrun.Task(t.Name), scheduledrun.ScheduledFor(*scheduledFor)).
+			Exist(ctx)
+		if err != nil {
+			release()
+			return nil, fmt.Errorf("checking previous runs: %w", err)
+		}
+		if done {
+			release()
+			return nil, nil
+		}
+	}
+
+	run, err := s.client.ScheduledRun.Create().
+		SetTask(t.Name).
+		SetTrigger(trigger).
+		SetNillableScheduledFor(scheduledFor).
+		SetNillableTriggeredByID(userID).
+		SetInstance(s.instance).
+		SetStartedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("recording run: %w", err)
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer release()
+		s.execute(ctx, t, run)
+	}()
+	return run, nil
+}
+
+// execute runs the task and records how it went
+func (s *Scheduler) execute(ctx context.Context, t *Task, run *ent.ScheduledRun) {
+	log.Info(ctx).Str("task", t.Name).Str("trigger", string(run.Trigger)).Msg("Running task")
+
+	err := safeRun(ctx, t.run)
+	finished := time.Now()
+	update := s.client.ScheduledRun.UpdateOne(run).
+		SetFinishedAt(finished).
+		SetDurationMs(int(finished.Sub(run.StartedAt).Milliseconds()))
+	if err != nil {
+		log.Error(ctx).Err(err).Str("task", t.Name).Msg("Task failed")
+		update.SetStatus(scheduledrun.StatusFailed).SetError(err.Error())
+	} else {
+		log.Info(ctx).Str("task", t.Name).Msgf("Task succeeded in %s", finished.Sub(run.StartedAt).Round(time.Millisecond))
+		update.SetStatus(scheduledrun.StatusSucceeded)
+	}
+
+	// Recorded even when the run was interrupted by the shutdown
+	if err := update.Exec(context.WithoutCancel(ctx)); err != nil {
+		log.Error(ctx).Err(err).Str("task", t.Name).Msg("Failed to record task run")
+	}
+}
+
+// safeRun runs the task, turning panics into errors
+func safeRun(ctx context.Context, run Func) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("task panicked: %v", v)
+		}
+	}()
+	return run(ctx)
+}
+
+// tryLock takes the advisory lock of the task for the transaction, unless another one holds it
+func tryLock(ctx context.Context, tx *ent.Tx, task string) (bool, error) {
+	h := fnv.New64a()
+	h.Write([]byte("hackspark.scheduler." + task))
+	key := int64(h.Sum64())
+
+	rows, err := tx.QueryContext(ctx, "SELECT pg_try_advisory_xact_lock($1)", key)
+	if err != nil {
+		return false, fmt.Errorf("locking task %s: %w", task, err)
+	}
+	defer rows.Close()
+
+	var locked bool
+	if rows.Next() {
+		if err := rows.Scan(&locked); err != nil {
+			return false, fmt.Errorf("locking task %s: %w", task, err)
+		}
+	}
+	return locked, rows.Err()
+}
@@ -0,0 +1,183 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/scheduledrun"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/testdb"
+)
+
+// replica returns a scheduler of the task, as each replica of the server has one
+func replica(t *testing.T, client *ent.Client, name string, run Func) (*Scheduler, *Task) {
+	t.Helper()
+	s := New(client)
+	if err := s.Add(name, "* * * * *", run); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	task, _ := s.Task(name)
+	return s, task
+}
+
+// taskName returns a task name of the test alone, as advisory locks are shared by every schema
+// of the database
+func taskName(t *testing.T) string {
+	return fmt.Sprintf("%s-%d", t.Name(), time.Now().UnixNano())
+}
+
+func TestStartElectsOneReplica(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+	name := taskName(t)
+	user := testdb.User(t, client, "admin")
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	leader, task := replica(t, client, name, func(context.Context) error {
+		close(started)
+		<-release
+		return nil
+	})
+	follower, followerTask := replica(t, client, name, func(context.Context) error {
+		t.Error("task ran on a second replica")
+		return nil
+	})
+
+	at := time.Now().UTC().Truncate(time.Minute)
+	run, err := leader.start(ctx, task, scheduledrun.TriggerSchedule, &at, nil)
+	if err != nil || run == nil {
+		t.Fatalf("start() = %v, %v, want a run", run, err)
+	}
+	<-started
+
+	// The other replicas skip the task while the leader holds the lock, be it due or triggered
+	if _, err := follower.start(ctx, followerTask, scheduledrun.TriggerSchedule, &at, nil); !errors.Is(err, ErrTaskRunning) {
+		t.Errorf("start() on another replica error = %v, want %v", err, ErrTaskRunning)
+	}
+	if _, err := follower.Trigger(ctx, name, user.ID); !errors.Is(err, ErrTaskRunning) {
+		t.Errorf("Trigger() on another replica error = %v, want %v", err, ErrTaskRunning)
+	}
+
+	close(release)
+	leader.Wait()
+
+	got, err := client.ScheduledRun.Get(ctx, run.ID)
+	if err != nil {
+		t.Fatalf("loading run: %v", err)
+	}
+	if got.Status != scheduledrun.StatusSucceeded || got.FinishedAt == nil || got.DurationMs == nil {
+		t.Errorf("run = %+v, want succeeded and finished", got)
+	}
+	if n := client.ScheduledRun.Query().Where(scheduledrun.Task(name)).CountX(ctx); n != 1 {
+		t.Errorf("%d runs recorded, want 1", n)
+	}
+}
+
+func TestStartRunsEachDueTimeOnce(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+	name := taskName(t)
+
+	runs := 0
+	count := func(context.Context) error {
+		runs++
+		return nil
+	}
+	a, taskA := replica(t, client, name, count)
+	b, taskB := replica(t, client, name, count)
+
+	at := time.Now().UTC().Truncate(time.Minute)
+	if run, err := a.start(ctx, taskA, scheduledrun.TriggerSchedule, &at, nil); err != nil || run == nil {
+		t.Fatalf("start() = %v, %v, want a run", run, err)
+	}
+	a.Wait()
+
+	// A replica whose clock is late gets the lock once it is released, but the time was run
+	run, err := b.start(ctx, taskB, scheduledrun.TriggerSchedule, &at, nil)
+	if err != nil || run != nil {
+		t.Fatalf("start() of a time already run = %v, %v, want no run", run, err)
+	}
+	b.Wait()
+
+	next := at.Add(time.Minute)
+	if run, err := b.start(ctx, taskB, scheduledrun.TriggerSchedule, &next, nil); err != nil || run == nil {
+		t.Fatalf("start() of the next time = %v, %v, want a run", run, err)
+	}
+	b.Wait()
+
+	if runs != 2 {
+		t.Errorf("task ran %d times, want 2", runs)
+	}
+	if n := client.ScheduledRun.Query().Where(scheduledrun.Task(name)).CountX(ctx); n != 2 {
+		t.Errorf("%d runs recorded, want 2", n)
+	}
+}
+
+func TestStartRecordsFailures(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		run  Func
+		want string
+	}{
+		{name: "error", run: func(context.Context) error { return errors.New("boom") }, want: "boom"},
+		{name: "panic", run: func(context.Context) error { panic("boom") }, want: "task panicked: boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, task := replica(t, client, taskName(t), tt.run)
+			at := time.Now().UTC().Truncate(time.Minute)
+			run, err := s.start(ctx, task, scheduledrun.TriggerSchedule, &at, nil)
+			if err != nil {
+				t.Fatalf("start() error = %v", err)
+			}
+			s.Wait()
+
+			got, err := client.ScheduledRun.Get(ctx, run.ID)
+			if err != nil {
+				t.Fatalf("loading run: %v", err)
+			}
+			if got.Status != scheduledrun.StatusFailed || got.Error == nil || *got.Error != tt.want {
+				t.Errorf("run = %s with error %v, want failed with %q", got.Status, got.Error, tt.want)
+			}
+		})
+	}
+}
+
+func TestStartClosesInterruptedRuns(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+	name := taskName(t)
+
+	// A replica died while running the task, releasing the lock without recording the end
+	stale, err := client.ScheduledRun.Create().
+		SetTask(name).
+		SetTrigger(scheduledrun.TriggerSchedule).
+		SetInstance("gone:1").
+		SetStartedAt(time.Now().Add(-time.Hour)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("creating run: %v", err)
+	}
+
+	s, task := replica(t, client, name, func(context.Context) error { return nil })
+	at := time.Now().UTC().Truncate(time.Minute)
+	if _, err := s.start(ctx, task, scheduledrun.TriggerSchedule, &at, nil); err != nil {
+		t.Fatalf("start() error = %v", err)
+	}
+	s.Wait()
+
+	got, err := client.ScheduledRun.Get(ctx, stale.ID)
+	if err != nil {
+		t.Fatalf("loading run: %v", err)
+	}
+	if got.Status != scheduledrun.StatusFailed || got.Error == nil || *got.Error != "interrupted" {
+		t.Errorf("interrupted run = %s with error %v, want failed as interrupted", got.Status, got.Error)
+	}
+}
@@ -0,0 +1,183 @@
+package search
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"html"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/tag"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+)
+
+// Type is a kind of searchable entity
+type Type string
+
+const (
+	TypeProject Type = "projects"
+	TypeUser    Type = "users"
+	TypeTag     Type = "tags"
+)
+
+// Types are all the searchable types, in the order results are presented
+var Types = []Type{TypeProject, TypeUser, TypeTag}
+
+// ParseType maps the public type name to a Type
+func ParseType(name string) (Type, bool) {
+	for _, t := range Types {
+		if string(t) == name {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// source describes how a type is searched. The search_vector columns and the
+// trigram indexes are created by the database migration.
+type source struct {
+	table string
+	// fuzzy is the column matched by trigram similarity when words do not match exactly
+	fuzzy string
+	// headline is the text snippets are cut from
+	headline string
+	// filter restricts the rows that can be found at all, nil when every row can be
+	filter func(*sql.Selector)
+}
+
+var sources = map[Type]source{
+	// Raw queries skip the visibility interceptor, so the projects are restricted to the listed
+	// ones with the very predicate lists use
+	TypeProject: {table: project.Table, fuzzy: "name", headline: "t.description", filter: visibility.Listed()},
+	TypeUser:    {table: user.Table, fuzzy: "username", headline: "coalesce(t.bio, t.first_name || ' ' || t.last_name)", filter: user.AccountStatusEQ(user.AccountStatusActive)},
+	TypeTag:     {table: tag.Table, fuzzy: "name", headline: "coalesce(t.description, t.name)"},
+}
+
+// where renders the filter of the source as a condition on its table aliased t, with its
+// arguments numbered after the first n ones of the query
+func (src source) where(n int) (string, []any) {
+	if src.filter == nil {
+		return "TRUE", nil
+	}
+	s := sql.Dialect(dialect.Postgres).Select().From(sql.Table(src.table).As("t"))
+	src.filter(s)
+	p := s.P()
+	p.SetDialect(dialect.Postgres)
+	p.SetTotal(n)
+	return p.Query()
+}
+
+// The query is parsed both with and without stemming so that names, which are
+// indexed without it, match as well as prose.
+const queryCTE = `WITH q AS (
+	SELECT websearch_to_tsquery('english', $1) || websearch_to_tsquery('simple', $1) AS query
+)`
+
+// match selects rows whose text matches the query or, for typos, whose fuzzy column
+// contains a word similar enough to it (pg_trgm word similarity)
+const match = `(t.search_vector @@ q.query OR $1 <%% t.%[1]s)`
+
+// The matched words of snippets are delimited by control characters, which are taken out of
+// the text first, and only turned into marks once the text is escaped
+const (
+	startSel = "\x01"
+	stopSel  = "\x02"
+
+	headlineOptions = "StartSel=" + startSel + ", StopSel=" + stopSel + ", MaxWords=30, MinWords=10, MaxFragments=2"
+)
+
+// marks turns the delimiters of the matched words into mark elements
+var marks = strings.NewReplacer(startSel, "<mark>", stopSel, "</mark>")
+
+// Hit is a single search result
+type Hit struct {
+	ID   string
+	Rank float64
+	// Snippet is HTML: the escaped text around the matches, with the matched words in <mark>
+	Snippet string
+}
+
+// Searcher runs full-text searches with typo tolerance
+type Searcher struct {
+	client *ent.Client
+}
+
+// New creates a new searcher
+func New(client *ent.Client) *Searcher {
+	return &Searcher{
+		client: client,
+	}
+}
+
+// Search returns the best matches of the given type, highest rank first
+func (s *Searcher) Search(ctx context.Context, t Type, q string, limit, offset int) ([]Hit, error) {
+	src, ok := sources[t]
+	if !ok {
+		return nil, fmt.Errorf("unknown search type %q", t)
+	}
+
+	filter, args := src.where(3)
+	query := fmt.Sprintf(`%s
+SELECT t.id,
+	ts_rank_cd(t.search_vector, q.query) + word_similarity($1, t.%[2]s) AS rank,
+	ts_headline('english', translate(coalesce(%[3]s, ''), chr(1) || chr(2), ''), q.query, '%[4]s') AS snippet
+FROM %[5]s t, q
+WHERE %[6]s AND %[7]s
+ORDER BY rank DESC, t.id
+LIMIT $2 OFFSET $3`, queryCTE, src.fuzzy, src.headline, headlineOptions, src.table, fmt.Sprintf(match, src.fuzzy), filter)
+
+	rows, err := s.client.QueryContext(ctx, query, append([]any{q, limit, offset}, args...)...)
+	if err != nil {
+		return nil, fmt.Errorf("searching %s: %w", t, err)
+	}
+	defer rows.Close()
+
+	hits := []Hit{}
+	for rows.Next() {
+		var h Hit
+		if err := rows.Scan(&h.ID, &h.Rank, &h.Snippet); err != nil {
+			return nil, fmt.Errorf("scanning %s hit: %w", t, err)
+		}
+		h.Snippet = marks.Replace(html.EscapeString(h.Snippet))
+		hits = append(hits, h)
+	}
+	return hits, rows.Err()
+}
+
+// Facets returns the total number of matches of every type
+func (s *Searcher) Facets(ctx context.Context, q string) (map[Type]int, error) {
+	facets := make(map[Type]int, len(Types))
+	for _, t := range Types {
+		src := sources[t]
+		filter, args := src.where(1)
+		query := fmt.Sprintf(`%s
+SELECT count(*) FROM %s t, q WHERE %s AND %s`, queryCTE, src.table, fmt.Sprintf(match, src.fuzzy), filter)
+
+		rows, err := s.client.QueryContext(ctx, query, append([]any{q}, args...)...)
+		if err != nil {
+			return nil, fmt.Errorf("counting %s: %w", t, err)
+		}
+		count, err := scanCount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("counting %s: %w", t, err)
+		}
+		facets[t] = count
+	}
+	return facets, nil
+}
+
+func scanCount(rows *stdsql.Rows) (int, error) {
+	defer rows.Close()
+	var count int
+	for rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return 0, err
+		}
+	}
+	return count, rows.Err()
+}
@@ -0,0 +1,41 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/testdb"
+)
+
+func TestSearchEscapesSnippets(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+
+	owner := testdb.User(t, client, "owner")
+	p := testdb.Project(t, client, owner.ID, `<script>alert(1)</script> Rocket`, func(c *ent.ProjectCreate) {
+		c.SetDescription("Builds <b>rockets</b> & <img src=x onerror=alert(1)> launchers, \x01 not a mark \x02 <mark>nor this</mark>")
+	})
+
+	hits, err := New(client).Search(ctx, TypeProject, "rockets", 10, 0)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(hits) != 1 || hits[0].ID != p.ID {
+		t.Fatalf("Search() = %+v, want the project", hits)
+	}
+
+	snippet := hits[0].Snippet
+	if !strings.Contains(snippet, "<mark>rockets</mark>") {
+		t.Errorf("snippet %q does not mark the match", snippet)
+	}
+	if !strings.Contains(snippet, "&lt;img src=x onerror=alert(1)&gt;") || !strings.Contains(snippet, "&amp;") {
+		t.Errorf("snippet %q does not escape the text", snippet)
+	}
+	// Apart from the marks of the matches, nothing is markup
+	text := strings.NewReplacer("<mark>rockets</mark>", "", "<mark>Rocket</mark>", "").Replace(snippet)
+	if strings.ContainsAny(text, "<>\x01\x02") {
+		t.Errorf("snippet %q holds markup besides the marks of the matches", snippet)
+	}
+}
@@ -0,0 +1,23 @@
+// Package sessions maintains the login sessions
+package sessions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/session"
+)
+
+// DeleteExpired deletes the sessions that expired, which can no longer authenticate anyone,
+// returning how many it deleted
+func DeleteExpired(ctx context.Context, client *ent.Client) (int, error) {
+	n, err := client.Session.Delete().
+		Where(session.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("deleting expired sessions: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,248 @@
+package trending
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/like"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/projecttag"
+	"github.com/jorge-j1m/hackspark_server/ent/trendingsnapshot"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/database"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+)
+
+// Window is a sliding time window trending scores are computed over
+type Window struct {
+	Name     string
+	Duration time.Duration
+	Period   trendingsnapshot.Period
+}
+
+var (
+	Day   = Window{Name: "24h", Duration: 24 * time.Hour, Period: trendingsnapshot.PeriodDay}
+	Week  = Window{Name: "7d", Duration: 7 * 24 * time.Hour, Period: trendingsnapshot.PeriodWeek}
+	Month = Window{Name: "30d", Duration: 30 * 24 * time.Hour, Period: trendingsnapshot.PeriodMonth}
+
+	// Windows are all the windows that get a snapshot on every refresh
+	Windows = []Window{Day, Week, Month}
+
+	// DefaultWindow is used when the client does not ask for a specific one
+	DefaultWindow = Week
+)
+
+const (
+	// maxSnapshotSize is the number of ranked subjects kept per type and window
+	maxSnapshotSize = 100
+
+	// Weights of each signal. A tag being attached to a new project is a stronger
+	// signal of interest in that tag than a like on a project that happens to use it.
+	tagUsageWeight    = 1.0
+	tagLikeWeight     = 0.5
+	projectLikeWeight = 1.0
+	newProjectWeight  = 2.0
+)
+
+// ParseWindow maps the public window name (24h, 7d, 30d) to a Window.
+// An empty name resolves to DefaultWindow.
+func ParseWindow(name string) (Window, bool) {
+	if name == "" {
+		return DefaultWindow, true
+	}
+	for _, w := range Windows {
+		if w.Name == name {
+			return w, true
+		}
+	}
+	return Window{}, false
+}
+
+// decay returns the weight of an event that happened at t, halving every quarter of the window
+// so that activity from the last few hours dominates the 24h ranking and so on.
+func (w Window) decay(now, t time.Time) float64 {
+	halfLife := w.Duration / 4
+	age := now.Sub(t)
+	if age < 0 {
+		age = 0
+	}
+	return math.Exp(-math.Ln2 * float64(age) / float64(halfLife))
+}
+
+type score struct {
+	subjectID string
+	value     float64
+	count     int
+}
+
+type scores map[string]*score
+
+func (s scores) add(subjectID string, value float64) {
+	sc, ok := s[subjectID]
+	if !ok {
+		sc = &score{subjectID: subjectID}
+		s[subjectID] = sc
+	}
+	sc.value += value
+	sc.count++
+}
+
+// ranked returns the top n scores, highest first. Ties are broken by ID so that
+// consecutive refreshes over the same data produce the same ranking.
+func (s scores) ranked(n int) []*score {
+	list := make([]*score, 0, len(s))
+	for _, sc := range s {
+		list = append(list, sc)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].value != list[j].value {
+			return list[i].value > list[j].value
+		}
+		return list[i].subjectID < list[j].subjectID
+	})
+	if len(list) > n {
+		list = list[:n]
+	}
+	return list
+}
+
+// Refresher recomputes the trending snapshots, as a scheduled task
+type Refresher struct {
+	client *ent.Client
+}
+
+// NewRefresher creates a new trending refresher
+func NewRefresher(client *ent.Client) *Refresher {
+	return &Refresher{
+		client: client,
+	}
+}
+
+// Refresh recomputes the snapshots of every window
+func (r *Refresher) Refresh(ctx context.Context) error {
+	now := time.Now()
+	for _, w := range Windows {
+		if err := r.refreshWindow(ctx, w, now); err != nil {
+			return fmt.Errorf("refreshing %s window: %w", w.Name, err)
+		}
+	}
+	log.Debug(ctx).Msg("Trending snapshots refreshed")
+	return nil
+}
+
+func (r *Refresher) refreshWindow(ctx context.Context, w Window, now time.Time) error {
+	since := now.Add(-w.Duration)
+
+	// Only listed projects can trend, and activity on the others must not leak through tags
+	likes, err := r.client.Like.Query().
+		Where(
+			like.CreateTimeGTE(since),
+			like.HasProjectWith(visibility.Listed()),
+		).
+		Select(like.FieldProjectID, like.FieldCreateTime).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("loading likes: %w", err)
+	}
+
+	projectScores := scores{}
+	likesByProject := map[string][]time.Time{}
+	for _, l := range likes {
+		projectScores.add(l.ProjectID, projectLikeWeight*w.decay(now, l.CreateTime))
+		likesByProject[l.ProjectID] = append(likesByProject[l.ProjectID], l.CreateTime)
+	}
+
+	// Tags attached within the window count as usage. Projects created within the
+	// window get a boost of their own so that brand new projects can surface.
+	recentTags, err := r.client.ProjectTag.Query().
+		Where(
+			projecttag.CreateTimeGTE(since),
+			projecttag.HasProjectWith(visibility.Listed()),
+		).
+		Select(projecttag.FieldTagID, projecttag.FieldCreateTime).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("loading recent project tags: %w", err)
+	}
+
+	tagScores := scores{}
+	for _, pt := range recentTags {
+		tagScores.add(pt.TagID, tagUsageWeight*w.decay(now, pt.CreateTime))
+	}
+
+	newProjects, err := r.client.Project.Query().
+		Where(
+			project.CreateTimeGTE(since),
+			visibility.Listed(),
+		).
+		Select(project.FieldID, project.FieldCreateTime).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("loading new projects: %w", err)
+	}
+	for _, p := range newProjects {
+		projectScores.add(p.ID, newProjectWeight*w.decay(now, p.CreateTime))
+	}
+
+	// Likes within the window also count towards every tag of the liked project
+	if len(likesByProject) > 0 {
+		projectIDs := make([]string, 0, len(likesByProject))
+		for id := range likesByProject {
+			projectIDs = append(projectIDs, id)
+		}
+		likedTags, err := r.client.ProjectTag.Query().
+			Where(projecttag.ProjectIDIn(projectIDs...)).
+			Select(projecttag.FieldProjectID, projecttag.FieldTagID).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("loading tags of liked projects: %w", err)
+		}
+		for _, pt := range likedTags {
+			for _, t := range likesByProject[pt.ProjectID] {
+				tagScores.add(pt.TagID, tagLikeWeight*w.decay(now, t))
+			}
+		}
+	}
+
+	return r.replaceSnapshot(ctx, w, projectScores, tagScores)
+}
+
+// replaceSnapshot swaps the snapshot rows of a window in a single transaction,
+// so readers never observe a half-written ranking.
+func (r *Refresher) replaceSnapshot(ctx context.Context, w Window, projectScores, tagScores scores) error {
+	return database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if _, err := tx.TrendingSnapshot.Delete().
+			Where(trendingsnapshot.PeriodEQ(w.Period)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("deleting old snapshot: %w", err)
+		}
+
+		var builders []*ent.TrendingSnapshotCreate
+		for subjectType, s := range map[trendingsnapshot.SubjectType]scores{
+			trendingsnapshot.SubjectTypeProject: projectScores,
+			trendingsnapshot.SubjectTypeTag:     tagScores,
+		} {
+			for i, sc := range s.ranked(maxSnapshotSize) {
+				builders = append(builders, tx.TrendingSnapshot.Create().
+					SetSubjectType(subjectType).
+					SetSubjectID(sc.subjectID).
+					SetPeriod(w.Period).
+					SetScore(sc.value).
+					SetRank(i+1).
+					SetActivityCount(sc.count))
+			}
+		}
+
+		if len(builders) == 0 {
+			return nil
+		}
+		if _, err := tx.TrendingSnapshot.CreateBulk(builders...).Save(ctx); err != nil {
+			return fmt.Errorf("saving snapshot: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,155 @@
+package trending
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/trendingsnapshot"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/testdb"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+)
+
+// snapshot returns the subjects of the snapshot of the window, by rank
+func snapshot(t *testing.T, client *ent.Client, subjectType trendingsnapshot.SubjectType, w Window) []*ent.TrendingSnapshot {
+	t.Helper()
+	rows, err := client.TrendingSnapshot.Query().
+		Where(trendingsnapshot.SubjectTypeEQ(subjectType), trendingsnapshot.PeriodEQ(w.Period)).
+		Order(ent.Asc(trendingsnapshot.FieldRank)).
+		All(context.Background())
+	if err != nil {
+		t.Fatalf("loading snapshot: %v", err)
+	}
+	return rows
+}
+
+func TestRefreshWindowRanks(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := visibility.System(context.Background())
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	old := now.AddDate(0, -2, 0)
+
+	owner := testdb.User(t, client, "owner")
+	var fans []*ent.User
+	for i := range 10 {
+		fans = append(fans, testdb.User(t, client, fmt.Sprintf("fan%d", i)))
+	}
+
+	names := map[string]string{}
+	newProject := func(name string, createdAt time.Time, v project.Visibility) *ent.Project {
+		p := testdb.Project(t, client, owner.ID, name, func(c *ent.ProjectCreate) {
+			c.SetCreateTime(createdAt).SetVisibility(v)
+		})
+		names[p.ID] = name
+		return p
+	}
+	likes := func(p *ent.Project, n int, at time.Time) {
+		for _, fan := range fans[:n] {
+			client.Like.Create().SetUserID(fan.ID).SetProjectID(p.ID).SetCreateTime(at).ExecX(ctx)
+		}
+	}
+
+	// A is liked once in the last hours, B a few times this week, C a lot this month, and E is
+	// brand new. D is liked as much as A, but is not listed.
+	a := newProject("A", old, project.VisibilityPublic)
+	likes(a, 1, now.Add(-time.Hour))
+	b := newProject("B", old, project.VisibilityPublic)
+	likes(b, 4, now.Add(-5*24*time.Hour))
+	c := newProject("C", old, project.VisibilityPublic)
+	likes(c, 10, now.Add(-20*24*time.Hour))
+	d := newProject("D", old, project.VisibilityUnlisted)
+	likes(d, 1, now.Add(-time.Hour))
+	newProject("E", now.Add(-2*time.Hour), project.VisibilityPublic)
+	// Too old to count anywhere
+	f := newProject("F", old, project.VisibilityPublic)
+	likes(f, 10, now.AddDate(0, 0, -40))
+
+	// B's likes count towards its tag, D's must not leak through its own
+	goTag := client.Tag.Create().SetName("Go").SetSlug("go").SaveX(ctx)
+	rust := client.Tag.Create().SetName("Rust").SetSlug("rust").SaveX(ctx)
+	client.ProjectTag.Create().SetProjectID(b.ID).SetTagID(goTag.ID).SetCreateTime(old).ExecX(ctx)
+	client.ProjectTag.Create().SetProjectID(d.ID).SetTagID(rust.ID).SetCreateTime(now.Add(-time.Hour)).ExecX(ctx)
+
+	r := NewRefresher(client)
+	tests := []struct {
+		window Window
+		want   []string
+	}{
+		{window: Day, want: []string{"E", "A"}},
+		{window: Week, want: []string{"E", "A", "B"}},
+		{window: Month, want: []string{"B", "E", "C", "A"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.window.Name, func(t *testing.T) {
+			if err := r.refreshWindow(ctx, tt.window, now); err != nil {
+				t.Fatalf("refreshWindow() error = %v", err)
+			}
+
+			var got []string
+			for i, s := range snapshot(t, client, trendingsnapshot.SubjectTypeProject, tt.window) {
+				if s.Rank != i+1 {
+					t.Errorf("%s ranked %d, want %d", names[s.SubjectID], s.Rank, i+1)
+				}
+				got = append(got, names[s.SubjectID])
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ranking = %v, want %v", got, tt.want)
+			}
+
+			tags := snapshot(t, client, trendingsnapshot.SubjectTypeTag, tt.window)
+			wantTags := 0
+			if tt.window != Day {
+				wantTags = 1
+			}
+			if len(tags) != wantTags {
+				t.Fatalf("%d tags trending, want %d", len(tags), wantTags)
+			}
+			if wantTags == 1 && (tags[0].SubjectID != goTag.ID || tags[0].ActivityCount != 4) {
+				t.Errorf("trending tag = %s with %d events, want go with 4 likes", tags[0].SubjectID, tags[0].ActivityCount)
+			}
+		})
+	}
+}
+
+func TestRefreshReplacesSnapshots(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := visibility.System(context.Background())
+
+	owner := testdb.User(t, client, "owner")
+	p := testdb.Project(t, client, owner.ID, "Rocket", nil)
+
+	// Left by a previous refresh, about a project gone since
+	for _, w := range Windows {
+		client.TrendingSnapshot.Create().
+			SetSubjectType(trendingsnapshot.SubjectTypeProject).
+			SetSubjectID("proj_gone").
+			SetPeriod(w.Period).
+			SetRank(1).
+			ExecX(ctx)
+	}
+
+	r := NewRefresher(client)
+	if err := r.Refresh(ctx); err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	for _, w := range Windows {
+		rows := snapshot(t, client, trendingsnapshot.SubjectTypeProject, w)
+		if len(rows) != 1 || rows[0].SubjectID != p.ID || rows[0].Rank != 1 {
+			t.Errorf("%s snapshot = %+v, want the new project alone", w.Name, rows)
+		}
+	}
+
+	// A snapshot failing to save leaves the previous one in place rather than none at all
+	err := r.replaceSnapshot(ctx, Week, scores{"": {subjectID: "", value: 1, count: 1}}, scores{})
+	if err == nil {
+		t.Fatal("replaceSnapshot() of an invalid subject succeeded")
+	}
+	rows := snapshot(t, client, trendingsnapshot.SubjectTypeProject, Week)
+	if len(rows) != 1 || rows[0].SubjectID != p.ID {
+		t.Errorf("snapshot after a failed refresh = %+v, want the previous one", rows)
+	}
+}
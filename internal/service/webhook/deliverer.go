@@ -0,0 +1,238 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/webhook"
+	"github.com/jorge-j1m/hackspark_server/ent/webhookdelivery"
+	log "github.com/jorge-j1m/hackspark_server/internal/infrastructure/logger"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/backoff"
+)
+
+const (
+	// MaxAttempts is the number of times a delivery is sent before it is marked as failed
+	MaxAttempts = 8
+
+	// batchSize is the number of deliveries claimed on every poll
+	batchSize = 50
+
+	// lease is how long a claimed delivery is left alone by other workers
+	lease = time.Minute
+
+	// requestTimeout bounds every attempt
+	requestTimeout = 10 * time.Second
+
+	// maxResponseBody is the part of the response body kept in the delivery log
+	maxResponseBody = 1024
+)
+
+// retries is how long failed deliveries wait before the next attempt
+var retries = backoff.Policy{Base: 30 * time.Second, Max: 6 * time.Hour}
+
+// errPrivateNetwork is returned when a webhook URL resolves to a private address
+var errPrivateNetwork = errors.New("webhook URLs must not point to private networks")
+
+// Deliverer sends the pending deliveries on every interval until ctx is cancelled
+type Deliverer struct {
+	client   *ent.Client
+	http     *http.Client
+	interval time.Duration
+}
+
+// NewDeliverer creates a new deliverer. Unless allowPrivate is set, webhooks cannot reach the
+// loopback, private or link-local addresses of the server's network.
+func NewDeliverer(client *ent.Client, interval time.Duration, allowPrivate bool) *Deliverer {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	if !allowPrivate {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+				ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+				return errPrivateNetwork
+			}
+			return nil
+		}
+	}
+
+	return &Deliverer{
+		client: client,
+		http: &http.Client{
+			Timeout: requestTimeout,
+			Transport: &http.Transport{
+				Proxy:               nil,
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: 5 * time.Second,
+				MaxIdleConnsPerHost: 2,
+			},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		interval: interval,
+	}
+}
+
+// Run sends the due deliveries right away and then on every interval until ctx is cancelled
+func (d *Deliverer) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.DeliverDue(ctx); err != nil && ctx.Err() == nil {
+			log.Error(ctx).Err(err).Msg("Failed to deliver webhooks")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverDue claims the pending deliveries whose next attempt is due and sends them. Disabled
+// webhooks keep their deliveries untouched for when they are enabled again, all but the test
+// events, which are sent on request whatever the state of the webhook.
+func (d *Deliverer) DeliverDue(ctx context.Context) error {
+	now := time.Now()
+	due, err := d.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+			webhookdelivery.Or(
+				webhookdelivery.LockedUntilIsNil(),
+				webhookdelivery.LockedUntilLT(now),
+			),
+			webhookdelivery.Or(
+				webhookdelivery.HasWebhookWith(webhook.Active(true)),
+				webhookdelivery.EventType(string(TestEvent)),
+			),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(batchSize).
+		WithWebhook().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("loading due deliveries: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range due {
+		// The conditional update only succeeds for one worker, whichever instance it runs on
+		claimed, err := d.client.WebhookDelivery.Update().
+			Where(
+				webhookdelivery.ID(delivery.ID),
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+				webhookdelivery.Or(
+					webhookdelivery.LockedUntilIsNil(),
+					webhookdelivery.LockedUntilLT(now),
+				),
+			).
+			SetLockedUntil(now.Add(lease)).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("claiming delivery %s: %w", delivery.ID, err)
+		}
+		if claimed == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := d.deliver(ctx, delivery); err != nil {
+				log.Error(ctx).Err(err).Str("delivery_id", delivery.ID).Msg("Failed to record webhook delivery")
+			}
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// deliver sends the delivery once and records the outcome
+func (d *Deliverer) deliver(ctx context.Context, delivery *ent.WebhookDelivery) error {
+	w := delivery.Edges.Webhook
+	update := d.client.WebhookDelivery.UpdateOneID(delivery.ID).
+		AddAttempts(1).
+		ClearLockedUntil()
+
+	started := time.Now()
+	status, body, err := d.send(ctx, w, delivery)
+	update.SetDurationMs(int(time.Since(started).Milliseconds()))
+	if ctx.Err() != nil {
+		// Shutting down: the attempt does not count and is retried on the next start
+		return d.client.WebhookDelivery.UpdateOneID(delivery.ID).ClearLockedUntil().Exec(context.WithoutCancel(ctx))
+	}
+
+	if status != 0 {
+		update.SetResponseStatus(status).SetResponseBody(body)
+	} else {
+		update.ClearResponseStatus().ClearResponseBody()
+	}
+
+	attempts := delivery.Attempts + 1
+	switch {
+	case err == nil && status >= 200 && status < 300:
+		update.SetStatus(webhookdelivery.StatusSucceeded).
+			SetDeliveredAt(time.Now()).
+			ClearLastError()
+	default:
+		if err == nil {
+			err = fmt.Errorf("unexpected response status %d", status)
+		}
+		update.SetLastError(err.Error())
+		if attempts >= MaxAttempts {
+			update.SetStatus(webhookdelivery.StatusFailed)
+		} else {
+			update.SetNextAttemptAt(time.Now().Add(retries.Delay(attempts)))
+		}
+	}
+
+	return update.Exec(ctx)
+}
+
+// send posts the payload of the delivery to the webhook, returning the response status and the
+// beginning of the response body
+func (d *Deliverer) send(ctx context.Context, w *ent.Webhook, delivery *ent.WebhookDelivery) (int, string, error) {
+	body := []byte(delivery.Payload)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "HackSpark-Webhooks/1.0")
+	req.Header.Set("X-HackSpark-Event", delivery.EventType)
+	req.Header.Set("X-HackSpark-Delivery", delivery.ID)
+	req.Header.Set("X-HackSpark-Timestamp", timestamp)
+	req.Header.Set("X-HackSpark-Signature", Sign(w.Secret, timestamp, body))
+
+	res, err := d.http.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer res.Body.Close()
+
+	b, _ := io.ReadAll(io.LimitReader(res.Body, maxResponseBody))
+	// Drain a bit more so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64*1024))
+	return res.StatusCode, strings.ToValidUTF8(string(b), "\uFFFD"), nil
+}
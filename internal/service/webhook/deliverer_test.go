@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/testdb"
+)
+
+func TestDeliverDueSkipsDisabledWebhooks(t *testing.T) {
+	client := testdb.Open(t)
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	received := map[string]bool{}
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		received[r.Header.Get("X-HackSpark-Delivery")] = true
+		mu.Unlock()
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	owner := testdb.User(t, client, "owner")
+	newWebhook := func(active bool) *ent.Webhook {
+		w, err := client.Webhook.Create().
+			SetOwnerID(owner.ID).
+			SetURL(srv.URL).
+			SetSecret(NewSecret()).
+			SetActive(active).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("creating webhook: %v", err)
+		}
+		return w
+	}
+	newDelivery := func(w *ent.Webhook, eventType string) *ent.WebhookDelivery {
+		delivery, err := client.WebhookDelivery.Create().
+			SetWebhookID(w.ID).
+			SetEventType(eventType).
+			SetPayload(`{}`).
+			SetNextAttemptAt(time.Now().Add(-time.Minute)).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("creating delivery: %v", err)
+		}
+		// Read back, so that the times compare with those read after delivering
+		delivery, err = client.WebhookDelivery.Get(ctx, delivery.ID)
+		if err != nil {
+			t.Fatalf("loading delivery: %v", err)
+		}
+		return delivery
+	}
+
+	active := newWebhook(true)
+	disabled := newWebhook(false)
+	sent := newDelivery(active, "project.liked")
+	// More than a batch, so that they would take the place of the others if they were claimed
+	var held []*ent.WebhookDelivery
+	for range batchSize + 1 {
+		held = append(held, newDelivery(disabled, "project.liked"))
+	}
+	test := newDelivery(disabled, string(TestEvent))
+
+	d := NewDeliverer(client, time.Minute, true)
+	if err := d.DeliverDue(ctx); err != nil {
+		t.Fatalf("DeliverDue() error = %v", err)
+	}
+
+	for _, delivery := range []*ent.WebhookDelivery{sent, test} {
+		if !received[delivery.ID] {
+			t.Errorf("delivery %s of event %s not sent", delivery.ID, delivery.EventType)
+		}
+		got, err := client.WebhookDelivery.Get(ctx, delivery.ID)
+		if err != nil {
+			t.Fatalf("loading delivery: %v", err)
+		}
+		if got.Status != "succeeded" || got.Attempts != 1 {
+			t.Errorf("delivery of event %s = %s after %d attempts, want succeeded after 1", delivery.EventType, got.Status, got.Attempts)
+		}
+	}
+
+	for _, delivery := range held {
+		if received[delivery.ID] {
+			t.Errorf("delivery %s of a disabled webhook sent", delivery.ID)
+		}
+		got, err := client.WebhookDelivery.Get(ctx, delivery.ID)
+		if err != nil {
+			t.Fatalf("loading delivery: %v", err)
+		}
+		if got.Status != delivery.Status || got.Attempts != 0 || got.LockedUntil != nil ||
+			!got.NextAttemptAt.Equal(delivery.NextAttemptAt) || !got.UpdateTime.Equal(delivery.UpdateTime) {
+			t.Errorf("delivery %s of a disabled webhook was rewritten: %+v, want %+v", delivery.ID, got, delivery)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Package webhook sends the activity of projects and users to other services.
+//
+// Every event matching a webhook is stored as a delivery first, in the transaction of the change
+// that caused it, and then sent by the Deliverer. Deliveries are retried with exponential backoff until they
+// succeed or run out of attempts, across restarts.
+//
+// Payloads are signed with the secret of their webhook: the X-HackSpark-Signature header is
+// "sha256=" followed by the hex encoded HMAC-SHA256 of the X-HackSpark-Timestamp header, a dot
+// and the body.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/ent/project"
+	"github.com/jorge-j1m/hackspark_server/ent/user"
+	entwebhook "github.com/jorge-j1m/hackspark_server/ent/webhook"
+	"github.com/jorge-j1m/hackspark_server/internal/pkg/visibility"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+	"go.jetify.com/typeid/v2"
+)
+
+// TestEvent is the type of the events sent on demand to check a webhook
+const TestEvent eventbus.Type = "webhook.test"
+
+// EventTypes are the types of events webhooks can subscribe to
+var EventTypes = []eventbus.Type{
+	eventbus.ProjectStatusChanged,
+	eventbus.ProjectMemberInvited,
+	eventbus.ProjectJoinRequested,
+	eventbus.ProjectMemberJoined,
+	eventbus.ProjectOwnershipTransferred,
+	eventbus.ProjectRemixed,
+	eventbus.ProjectLiked,
+	eventbus.ProjectUnliked,
+	eventbus.CommentCreated,
+}
+
+// summaries describe the events in the messages sent to chat services, %[1]s being the actor
+// and %[2]s the project
+var summaries = map[eventbus.Type]string{
+	eventbus.ProjectStatusChanged:        "%[1]s changed the status of %[2]s",
+	eventbus.ProjectMemberInvited:        "%[1]s invited someone to join %[2]s",
+	eventbus.ProjectJoinRequested:        "%[1]s asked to join %[2]s",
+	eventbus.ProjectMemberJoined:         "A new member joined %[2]s",
+	eventbus.ProjectOwnershipTransferred: "%[1]s transferred %[2]s to a new owner",
+	eventbus.ProjectRemixed:              "%[1]s remixed %[2]s",
+	eventbus.ProjectLiked:                "%[1]s liked %[2]s",
+	eventbus.ProjectUnliked:              "%[1]s no longer likes %[2]s",
+	eventbus.CommentCreated:              "%[1]s commented on %[2]s",
+	TestEvent:                            "This is a test event from HackSpark, sent by %[1]s",
+}
+
+// Payload is the body of the deliveries of JSON webhooks
+type Payload struct {
+	DeliveryID string         `json:"delivery_id"`
+	Type       eventbus.Type  `json:"type"`
+	OccurredAt time.Time      `json:"occurred_at"`
+	Actor      *PayloadUser   `json:"actor"`
+	Project    *PayloadProj   `json:"project"`
+	Data       map[string]any `json:"data"`
+}
+
+type PayloadUser struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+type PayloadProj struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// NewSecret returns a random signing secret
+func NewSecret() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return "whsec_" + hex.EncodeToString(b)
+}
+
+// Sign returns the signature of the body sent at timestamp
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Subscribes reports whether the webhook wants events of the type
+func Subscribes(w *ent.Webhook, t eventbus.Type) bool {
+	return t == TestEvent || len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, string(t))
+}
+
+// Enqueuer stores the deliveries of test events, sent on demand
+type Enqueuer struct {
+	client *ent.Client
+}
+
+// NewEnqueuer creates a new enqueuer
+func NewEnqueuer(client *ent.Client) *Enqueuer {
+	return &Enqueuer{
+		client: client,
+	}
+}
+
+// Enqueue stores a delivery of the event for every active webhook it concerns: those of its
+// project, and those of the owner of the project and of the actor. Pass the transaction of the
+// change the event follows, so that deliveries exist if and only if the change was committed.
+func Enqueue(ctx context.Context, tx *ent.Tx, e eventbus.Event) error {
+	ctx = visibility.IncludeDeleted(visibility.System(ctx))
+	client := tx.Client()
+
+	scopes := []*ent.WebhookQuery{}
+	owners := []string{}
+	if e.ActorID != "" {
+		owners = append(owners, e.ActorID)
+	}
+	if e.ProjectID != "" {
+		ownerID, err := client.Project.Query().
+			Where(project.ID(e.ProjectID)).
+			QueryOwner().
+			OnlyID(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("loading project owner: %w", err)
+		}
+		if ownerID != "" && ownerID != e.ActorID {
+			owners = append(owners, ownerID)
+		}
+		scopes = append(scopes, client.Webhook.Query().Where(entwebhook.ProjectID(e.ProjectID)))
+	}
+	if len(owners) > 0 {
+		scopes = append(scopes, client.Webhook.Query().Where(
+			entwebhook.ProjectIDIsNil(),
+			entwebhook.OwnerIDIn(owners...),
+		))
+	}
+
+	var webhooks []*ent.Webhook
+	for _, scope := range scopes {
+		found, err := scope.Where(entwebhook.Active(true)).All(ctx)
+		if err != nil {
+			return fmt.Errorf("loading webhooks: %w", err)
+		}
+		for _, w := range found {
+			if Subscribes(w, e.Type) {
+				webhooks = append(webhooks, w)
+			}
+		}
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	_, err := enqueue(ctx, client, e, webhooks...)
+	return err
+}
+
+// EnqueueTest stores a test delivery for the webhook, sent on behalf of the user
+func (q *Enqueuer) EnqueueTest(ctx context.Context, w *ent.Webhook, userID string) (*ent.WebhookDelivery, error) {
+	e := eventbus.Event{
+		Type:       TestEvent,
+		ActorID:    userID,
+		OccurredAt: time.Now(),
+		Payload:    map[string]any{"webhook_id": w.ID},
+	}
+	if w.ProjectID != nil {
+		e.ProjectID = *w.ProjectID
+	}
+
+	deliveries, err := enqueue(visibility.IncludeDeleted(visibility.System(ctx)), q.client, e, w)
+	if err != nil {
+		return nil, err
+	}
+	return deliveries[0], nil
+}
+
+func enqueue(ctx context.Context, client *ent.Client, e eventbus.Event, webhooks ...*ent.Webhook) ([]*ent.WebhookDelivery, error) {
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = time.Now()
+	}
+	base := Payload{
+		Type:       e.Type,
+		OccurredAt: e.OccurredAt.UTC(),
+		Data:       e.Payload,
+	}
+	if base.Data == nil {
+		base.Data = map[string]any{}
+	}
+	if e.ActorID != "" {
+		u, err := client.User.Query().Where(user.ID(e.ActorID)).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("loading actor: %w", err)
+		}
+		if u != nil {
+			base.Actor = &PayloadUser{ID: u.ID, Username: u.Username}
+		}
+	}
+	if e.ProjectID != "" {
+		p, err := client.Project.Query().Where(project.ID(e.ProjectID)).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("loading project: %w", err)
+		}
+		if p != nil {
+			base.Project = &PayloadProj{ID: p.ID, Name: p.Name}
+		}
+	}
+
+	creates := make([]*ent.WebhookDeliveryCreate, len(webhooks))
+	for i, w := range webhooks {
+		id := typeid.MustGenerate("whdel").String()
+		payload := base
+		payload.DeliveryID = id
+
+		body, err := render(w.Format, payload)
+		if err != nil {
+			return nil, fmt.Errorf("rendering payload: %w", err)
+		}
+		creates[i] = client.WebhookDelivery.Create().
+			SetID(id).
+			SetWebhookID(w.ID).
+			SetEventType(string(e.Type)).
+			SetPayload(string(body)).
+			SetNextAttemptAt(time.Now())
+	}
+	return client.WebhookDelivery.CreateBulk(creates...).Save(ctx)
+}
+
+// render encodes the payload in the format of the webhook
+func render(format entwebhook.Format, p Payload) ([]byte, error) {
+	switch format {
+	case entwebhook.FormatSlack:
+		return json.Marshal(map[string]string{"text": summary(p)})
+	case entwebhook.FormatDiscord:
+		return json.Marshal(map[string]string{"content": summary(p)})
+	default:
+		return json.Marshal(p)
+	}
+}
+
+func summary(p Payload) string {
+	actor, projectName := "Someone", "a project"
+	if p.Actor != nil {
+		actor = p.Actor.Username
+	}
+	if p.Project != nil {
+		projectName = p.Project.Name
+	}
+	format, ok := summaries[p.Type]
+	if !ok {
+		return fmt.Sprintf("%s: %s on %s", p.Type, actor, projectName)
+	}
+	return fmt.Sprintf(format, actor, projectName)
+}
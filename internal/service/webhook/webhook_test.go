@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jorge-j1m/hackspark_server/ent"
+	"github.com/jorge-j1m/hackspark_server/internal/service/eventbus"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"type":"project.liked"}`)
+
+	// Receivers compute the HMAC-SHA256 of timestamp + "." + body with the secret of the webhook
+	got := Sign("whsec_test", "1700000000", body)
+	want := "sha256=87270a5f03221a268b4da7d89fec7e7f2b6f9047ef3dcd508146dec7973a2030"
+	if got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+
+	for name, other := range map[string]string{
+		"secret":    Sign("whsec_other", "1700000000", body),
+		"timestamp": Sign("whsec_test", "1700000001", body),
+		"body":      Sign("whsec_test", "1700000000", []byte(`{"type":"project.unliked"}`)),
+	} {
+		if other == got {
+			t.Errorf("signature unchanged with another %s", name)
+		}
+	}
+}
+
+func TestSendSignsRequests(t *testing.T) {
+	w := &ent.Webhook{Secret: NewSecret()}
+	delivery := &ent.WebhookDelivery{ID: "whd_1", EventType: "project.liked", Payload: `{"type":"project.liked","data":{}}`}
+
+	received := make(chan *http.Request, 1)
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		received <- r
+		rw.WriteHeader(http.StatusAccepted)
+		_, _ = io.WriteString(rw, "thanks")
+	}))
+	defer srv.Close()
+	w.URL = srv.URL
+
+	// The deliverer refuses private addresses, so the test sends with a plain client
+	d := &Deliverer{http: srv.Client()}
+	status, resBody, err := d.send(context.Background(), w, delivery)
+	if err != nil {
+		t.Fatalf("send() error = %v", err)
+	}
+	if status != http.StatusAccepted || resBody != "thanks" {
+		t.Errorf("send() = %d %q, want the response of the receiver", status, resBody)
+	}
+
+	r := <-received
+	if string(body) != delivery.Payload {
+		t.Errorf("body = %s, want the payload of the delivery", body)
+	}
+	for header, want := range map[string]string{
+		"Content-Type":         "application/json",
+		"X-HackSpark-Event":    "project.liked",
+		"X-HackSpark-Delivery": "whd_1",
+	} {
+		if got := r.Header.Get(header); got != want {
+			t.Errorf("%s = %q, want %q", header, got, want)
+		}
+	}
+
+	// Verified the way receivers do it
+	mac := hmac.New(sha256.New, []byte(w.Secret))
+	mac.Write([]byte(r.Header.Get("X-HackSpark-Timestamp") + "." + string(body)))
+	signature, ok := strings.CutPrefix(r.Header.Get("X-HackSpark-Signature"), "sha256=")
+	if !ok || !hmac.Equal([]byte(signature), []byte(hex.EncodeToString(mac.Sum(nil)))) {
+		t.Errorf("X-HackSpark-Signature = %q does not verify", r.Header.Get("X-HackSpark-Signature"))
+	}
+}
+
+func TestSubscribes(t *testing.T) {
+	all := &ent.Webhook{}
+	some := &ent.Webhook{EventTypes: []string{string(eventbus.ProjectLiked)}}
+
+	tests := []struct {
+		w    *ent.Webhook
+		t    eventbus.Type
+		want bool
+	}{
+		{w: all, t: eventbus.CommentCreated, want: true},
+		{w: some, t: eventbus.ProjectLiked, want: true},
+		{w: some, t: eventbus.CommentCreated, want: false},
+		// Test events are sent whatever the subscription
+		{w: some, t: TestEvent, want: true},
+	}
+	for _, tt := range tests {
+		if got := Subscribes(tt.w, tt.t); got != tt.want {
+			t.Errorf("Subscribes(%v, %s) = %t, want %t", tt.w.EventTypes, tt.t, got, tt.want)
+		}
+	}
+}